@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultAccessTokenTTL はアクセストークンの有効期間
+// サーバー側で失効させられないため短くし、リフレッシュトークンで更新する
+const DefaultAccessTokenTTL = 15 * time.Minute
+
+// AccessToken - 署名付きで自己完結したアクセストークンの内容
+// 検証にリポジトリを引かないため、失効はリフレッシュトークン側で行う
+type AccessToken struct {
+	id        string
+	userID    *UserID
+	issuedAt  time.Time
+	expiresAt time.Time
+}
+
+func NewAccessToken(userID *UserID, now time.Time, ttl time.Duration) (*AccessToken, error) {
+	if userID == nil {
+		return nil, EmptyFieldError{Field: "user ID"}
+	}
+	return &AccessToken{
+		id:        uuid.New().String(),
+		userID:    userID,
+		issuedAt:  now,
+		expiresAt: now.Add(ttl),
+	}, nil
+}
+
+func ReconstructAccessToken(id string, userID *UserID, issuedAt, expiresAt time.Time) *AccessToken {
+	return &AccessToken{id: id, userID: userID, issuedAt: issuedAt, expiresAt: expiresAt}
+}
+
+func (t *AccessToken) ID() string {
+	return t.id
+}
+
+func (t *AccessToken) UserID() *UserID {
+	return t.userID
+}
+
+func (t *AccessToken) IssuedAt() time.Time {
+	return t.issuedAt
+}
+
+func (t *AccessToken) ExpiresAt() time.Time {
+	return t.expiresAt
+}
+
+func (t *AccessToken) IsExpiredAt(now time.Time) bool {
+	return !now.Before(t.expiresAt)
+}
+
+// AccessTokenCodec はアクセストークンの署名・検証を行う（ポート）
+// Decode は署名と発行者を確かめる。有効期限は呼び出し側が時計で確かめる
+type AccessTokenCodec interface {
+	Encode(token *AccessToken) (string, error)
+	Decode(value string) (*AccessToken, error)
+	// PublicKeys は第三者が検証に使える公開鍵を返す（共有鍵は含めない）
+	PublicKeys() []PublicSigningKey
+}
+
+// PublicSigningKey - 公開できる検証用の鍵
+type PublicSigningKey struct {
+	KeyID     string
+	Algorithm string // "EdDSA" など JWS のアルゴリズム名
+	PublicKey []byte
+}
+
+type InvalidAccessTokenError struct {
+	Reason string
+}
+
+func (e InvalidAccessTokenError) Error() string {
+	return "invalid access token: " + e.Reason
+}
+
+func (e InvalidAccessTokenError) HTTPStatus() int {
+	return http.StatusUnauthorized
+}
@@ -0,0 +1,212 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// apiKeyScheme は API キーの先頭に付ける目印（漏洩したキーを検出しやすくする）
+	apiKeyScheme = "ak_"
+	// apiKeyUseResolution より短い間隔の利用では最終利用日時を更新しない
+	apiKeyUseResolution = time.Minute
+	MaxAPIKeyNameLength = 100
+)
+
+// APIKey - サービス間の呼び出しに使う API キー（集約ルート）
+// キーは "ak_<prefix>_<secret>" の形式で、発行時に一度だけ返す
+// prefix は検索と一覧での識別に使い、secret はハッシュのみを保存する
+type APIKey struct {
+	id          string
+	name        string
+	prefix      string
+	secretHash  string
+	permissions []Permission
+	createdBy   string // 発行した主体（利用者の UserID または API キーの ID）
+	createdAt   time.Time
+	expiresAt   *time.Time // nil の場合は期限なし
+	lastUsedAt  *time.Time
+	revokedAt   *time.Time
+}
+
+// NewAPIKey は新しい API キーとクライアントに渡すキーを返す
+func NewAPIKey(name string, permissions []Permission, createdBy string, now time.Time, expiresAt *time.Time) (*APIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", EmptyFieldError{Field: "API key name"}
+	}
+	if utf8.RuneCountInString(name) > MaxAPIKeyNameLength {
+		return nil, "", InvalidAPIKeyError{Reason: "name must be at most 100 characters"}
+	}
+	if len(permissions) == 0 {
+		return nil, "", InvalidPermissionError{Reason: "at least one permission is required"}
+	}
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, "", InvalidAPIKeyError{Reason: "expiry must be in the future"}
+	}
+
+	raw := make([]byte, 6)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", err
+	}
+	prefix := hex.EncodeToString(raw)
+	secret, err := newOpaqueToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &APIKey{
+		id:          uuid.New().String(),
+		name:        name,
+		prefix:      prefix,
+		secretHash:  hashOpaqueToken(secret),
+		permissions: permissions,
+		createdBy:   createdBy,
+		createdAt:   now,
+		expiresAt:   expiresAt,
+	}, apiKeyScheme + prefix + "_" + secret, nil
+}
+
+func ReconstructAPIKey(
+	id, name, prefix, secretHash string,
+	permissions []Permission,
+	createdBy string,
+	createdAt time.Time,
+	expiresAt, lastUsedAt, revokedAt *time.Time,
+) *APIKey {
+	return &APIKey{
+		id:          id,
+		name:        name,
+		prefix:      prefix,
+		secretHash:  secretHash,
+		permissions: permissions,
+		createdBy:   createdBy,
+		createdAt:   createdAt,
+		expiresAt:   expiresAt,
+		lastUsedAt:  lastUsedAt,
+		revokedAt:   revokedAt,
+	}
+}
+
+// ParseAPIKey はキーを prefix と secret に分ける
+func ParseAPIKey(key string) (prefix, secret string, err error) {
+	rest, ok := strings.CutPrefix(key, apiKeyScheme)
+	if !ok {
+		return "", "", UnauthenticatedError{Reason: "malformed API key"}
+	}
+	prefix, secret, ok = strings.Cut(rest, "_")
+	if !ok || prefix == "" || secret == "" {
+		return "", "", UnauthenticatedError{Reason: "malformed API key"}
+	}
+	return prefix, secret, nil
+}
+
+func (k *APIKey) ID() string {
+	return k.id
+}
+
+func (k *APIKey) Name() string {
+	return k.name
+}
+
+func (k *APIKey) Prefix() string {
+	return k.prefix
+}
+
+func (k *APIKey) SecretHash() string {
+	return k.secretHash
+}
+
+func (k *APIKey) Permissions() []Permission {
+	return k.permissions
+}
+
+func (k *APIKey) CreatedBy() string {
+	return k.createdBy
+}
+
+func (k *APIKey) CreatedAt() time.Time {
+	return k.createdAt
+}
+
+func (k *APIKey) ExpiresAt() *time.Time {
+	return k.expiresAt
+}
+
+func (k *APIKey) LastUsedAt() *time.Time {
+	return k.lastUsedAt
+}
+
+func (k *APIKey) RevokedAt() *time.Time {
+	return k.revokedAt
+}
+
+// MatchesSecret は secret がこのキーのものかを一定時間で比較する
+func (k *APIKey) MatchesSecret(secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashOpaqueToken(secret)), []byte(k.secretHash)) == 1
+}
+
+func (k *APIKey) IsRevoked() bool {
+	return k.revokedAt != nil
+}
+
+func (k *APIKey) IsExpiredAt(now time.Time) bool {
+	return k.expiresAt != nil && !now.Before(*k.expiresAt)
+}
+
+// Authenticate は secret を照合し、失効・期限切れでないかを確かめる
+// どの理由で失敗したかは呼び出し側に区別させない
+func (k *APIKey) Authenticate(secret string, now time.Time) error {
+	if !k.MatchesSecret(secret) || k.IsRevoked() || k.IsExpiredAt(now) {
+		return UnauthenticatedError{Reason: "invalid API key"}
+	}
+	return nil
+}
+
+// RecordUse は最終利用日時を更新し、保存が必要かを返す
+// 頻繁な呼び出しで書き込みが増えないよう、前回から一定時間が経つまでは更新しない
+func (k *APIKey) RecordUse(now time.Time) bool {
+	if k.lastUsedAt != nil && now.Sub(*k.lastUsedAt) < apiKeyUseResolution {
+		return false
+	}
+	k.lastUsedAt = &now
+	return true
+}
+
+// Revoke はキーを失効させる（失効済みなら何もしない）
+func (k *APIKey) Revoke(now time.Time) {
+	if k.revokedAt == nil {
+		k.revokedAt = &now
+	}
+}
+
+type InvalidAPIKeyError struct {
+	Reason string
+}
+
+func (e InvalidAPIKeyError) Error() string {
+	return "invalid API key: " + e.Reason
+}
+
+func (e InvalidAPIKeyError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
+
+type APIKeyNotFoundError struct {
+	ID string
+}
+
+func (e APIKeyNotFoundError) Error() string {
+	return "API key not found: " + e.ID
+}
+
+func (e APIKeyNotFoundError) HTTPStatus() int {
+	return http.StatusNotFound
+}
@@ -0,0 +1,202 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testAPIKeyNow = time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)
+
+func TestNewAPIKey_Validation(t *testing.T) {
+	past := testAPIKeyNow.Add(-time.Hour)
+
+	tests := []struct {
+		name        string
+		keyName     string
+		permissions []Permission
+		expiresAt   *time.Time
+		checkErr    func(error) bool
+	}{
+		{
+			name:        "名前が空",
+			keyName:     " ",
+			permissions: []Permission{PermissionUsersRead},
+			checkErr: func(err error) bool {
+				var e EmptyFieldError
+				return errors.As(err, &e)
+			},
+		},
+		{
+			name:    "権限がない",
+			keyName: "batch",
+			checkErr: func(err error) bool {
+				var e InvalidPermissionError
+				return errors.As(err, &e)
+			},
+		},
+		{
+			name:        "期限が過去",
+			keyName:     "batch",
+			permissions: []Permission{PermissionUsersRead},
+			expiresAt:   &past,
+			checkErr: func(err error) bool {
+				var e InvalidAPIKeyError
+				return errors.As(err, &e)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, _, err := NewAPIKey(tt.keyName, tt.permissions, "admin", testAPIKeyNow, tt.expiresAt)
+
+			// Assert
+			if !tt.checkErr(err) {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestAPIKey_Authenticate(t *testing.T) {
+	tests := []struct {
+		name    string
+		prepare func(key *APIKey)
+		secret  func(secret string) string
+		at      time.Time
+		wantErr bool
+	}{
+		{
+			name: "正しいキー",
+			at:   testAPIKeyNow.Add(time.Hour),
+		},
+		{
+			name:    "secret が異なる",
+			secret:  func(secret string) string { return secret + "x" },
+			at:      testAPIKeyNow.Add(time.Hour),
+			wantErr: true,
+		},
+		{
+			name:    "失効したキー",
+			prepare: func(key *APIKey) { key.Revoke(testAPIKeyNow) },
+			at:      testAPIKeyNow.Add(time.Hour),
+			wantErr: true,
+		},
+		{
+			name:    "期限切れのキー",
+			at:      testAPIKeyNow.Add(24 * time.Hour),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			expiresAt := testAPIKeyNow.Add(24 * time.Hour)
+			key, value, err := NewAPIKey("batch", []Permission{PermissionUsersRead}, "admin", testAPIKeyNow, &expiresAt)
+			if err != nil {
+				t.Fatalf("Failed to create API key: %v", err)
+			}
+			if tt.prepare != nil {
+				tt.prepare(key)
+			}
+			prefix, secret, err := ParseAPIKey(value)
+			if err != nil || prefix != key.Prefix() {
+				t.Fatalf("Failed to parse API key %q: %v", value, err)
+			}
+			if tt.secret != nil {
+				secret = tt.secret(secret)
+			}
+
+			// Act
+			err = key.Authenticate(secret, tt.at)
+
+			// Assert
+			var unauthenticated UnauthenticatedError
+			if tt.wantErr && !errors.As(err, &unauthenticated) {
+				t.Errorf("Expected UnauthenticatedError, but got %v", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Expected no error, but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestAPIKey_RecordUse_SkipsFrequentUpdates(t *testing.T) {
+	// Arrange
+	key, _, _ := NewAPIKey("batch", []Permission{PermissionUsersRead}, "admin", testAPIKeyNow, nil)
+
+	// Act & Assert
+	if !key.RecordUse(testAPIKeyNow) {
+		t.Error("Expected the first use to be recorded")
+	}
+	if key.RecordUse(testAPIKeyNow.Add(30 * time.Second)) {
+		t.Error("Expected a use within a minute not to be recorded")
+	}
+	if !key.RecordUse(testAPIKeyNow.Add(time.Minute)) {
+		t.Error("Expected a use after a minute to be recorded")
+	}
+	if !key.LastUsedAt().Equal(testAPIKeyNow.Add(time.Minute)) {
+		t.Errorf("Expected last used at %v, but got %v", testAPIKeyNow.Add(time.Minute), key.LastUsedAt())
+	}
+}
+
+func TestParseAPIKey_Malformed(t *testing.T) {
+	for _, value := range []string{"", "not-a-key", "ak_", "ak_prefixonly", "ak__secret"} {
+		t.Run(value, func(t *testing.T) {
+			// Act
+			_, _, err := ParseAPIKey(value)
+
+			// Assert
+			var e UnauthenticatedError
+			if !errors.As(err, &e) {
+				t.Errorf("Expected UnauthenticatedError, but got %v", err)
+			}
+		})
+	}
+}
+
+func TestParsePermissions(t *testing.T) {
+	// Act
+	permissions, err := ParsePermissions([]string{"users:read", "circles:write", "users:read"})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if len(permissions) != 2 || permissions[0] != PermissionUsersRead || permissions[1] != PermissionCirclesWrite {
+		t.Errorf("Expected duplicates to be removed, but got %v", permissions)
+	}
+
+	_, err = ParsePermissions([]string{"users:admin"})
+	var e InvalidPermissionError
+	if !errors.As(err, &e) || !strings.Contains(err.Error(), "users:admin") {
+		t.Errorf("Expected InvalidPermissionError, but got %v", err)
+	}
+}
+
+func TestPrincipal_HasPermission(t *testing.T) {
+	key, _, _ := NewAPIKey("batch", []Permission{PermissionCirclesWrite}, "admin", testAPIKeyNow, nil)
+
+	tests := []struct {
+		name      string
+		principal *Principal
+		want      bool
+	}{
+		{"利用者", NewUserPrincipal(NewUserID(), false), false},
+		{"管理者", NewUserPrincipal(NewUserID(), true), true},
+		{"権限を持つサービス", NewServicePrincipal(key), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.principal.HasPermission(PermissionCirclesWrite); got != tt.want {
+				t.Errorf("Expected %v, but got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,305 @@
+package domain
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditAction - 監査ログに記録する操作
+type AuditAction string
+
+const (
+	AuditUserCreated                 AuditAction = "user.created"
+	AuditUserUpdated                 AuditAction = "user.updated"
+	AuditUserDeleted                 AuditAction = "user.deleted"
+	AuditUserRestored                AuditAction = "user.restored"
+	AuditUserPurged                  AuditAction = "user.purged"
+	AuditUserEmailVerified           AuditAction = "user.email_verified"
+	AuditCalendarFeedTokenIssued     AuditAction = "user.calendar_feed_token_issued"
+	AuditSubscriptionUpgraded        AuditAction = "subscription.upgraded"
+	AuditSubscriptionDowngraded      AuditAction = "subscription.downgraded"
+	AuditSubscriptionCancelled       AuditAction = "subscription.cancelled"
+	AuditSubscriptionGranted         AuditAction = "subscription.granted"
+	AuditPaymentRecorded             AuditAction = "ledger.payment_recorded"
+	AuditRefundRecorded              AuditAction = "ledger.refund_recorded"
+	AuditBalancePaid                 AuditAction = "ledger.balance_paid"
+	AuditPaymentRefunded             AuditAction = "ledger.payment_refunded"
+	AuditNotificationSettingsUpdated AuditAction = "notification_settings.updated"
+	AuditCircleCreated               AuditAction = "circle.created"
+	AuditCircleMemberAdded           AuditAction = "circle.member_added"
+	AuditCircleMemberRemoved         AuditAction = "circle.member_removed"
+	AuditCircleMemberDetached        AuditAction = "circle.member_detached"
+	AuditCircleMemberReattached      AuditAction = "circle.member_reattached"
+	AuditCircleDeleted               AuditAction = "circle.deleted"
+	AuditCircleRestored              AuditAction = "circle.restored"
+	AuditCirclePurged                AuditAction = "circle.purged"
+	AuditCircleDuesChanged           AuditAction = "circle.dues_changed"
+	AuditCircleEventCreated          AuditAction = "circle_event.created"
+	AuditCircleEventUpdated          AuditAction = "circle_event.updated"
+	AuditCircleEventCancelled        AuditAction = "circle_event.cancelled"
+	AuditCircleEventRSVPRecorded     AuditAction = "circle_event.rsvp_recorded"
+	AuditCircleExpenseRecorded       AuditAction = "circle_expense.recorded"
+	AuditWebhookRegistered           AuditAction = "webhook.registered"
+	AuditShipmentCreated             AuditAction = "shipment.created"
+	AuditShipmentStatusUpdated       AuditAction = "shipment.status_updated"
+	AuditShipmentCancelled           AuditAction = "shipment.cancelled"
+	AuditAPIKeyCreated               AuditAction = "api_key.created"
+	AuditAPIKeyRevoked               AuditAction = "api_key.revoked"
+)
+
+// AuditTargetType - 操作の対象となった集約の種類
+type AuditTargetType string
+
+const (
+	AuditTargetUser                 AuditTargetType = "user"
+	AuditTargetLedger               AuditTargetType = "ledger" // ID はユーザーの UserID
+	AuditTargetNotificationSettings AuditTargetType = "notification_settings"
+	AuditTargetCircle               AuditTargetType = "circle"
+	AuditTargetCircleEvent          AuditTargetType = "circle_event"
+	AuditTargetCircleExpense        AuditTargetType = "circle_expense"
+	AuditTargetWebhook              AuditTargetType = "webhook"
+	AuditTargetShipment             AuditTargetType = "shipment"
+	AuditTargetAPIKey               AuditTargetType = "api_key"
+)
+
+// AuditTarget は操作の対象となった集約
+type AuditTarget struct {
+	Type AuditTargetType
+	ID   string
+}
+
+func UserAuditTarget(userID *UserID) AuditTarget {
+	return AuditTarget{Type: AuditTargetUser, ID: userID.Value()}
+}
+
+func CircleAuditTarget(circleID *CircleID) AuditTarget {
+	return AuditTarget{Type: AuditTargetCircle, ID: circleID.Value()}
+}
+
+// AuditActorType - 操作した主体の種類
+type AuditActorType string
+
+const (
+	AuditActorAnonymous AuditActorType = "anonymous"
+	AuditActorUser      AuditActorType = "user"
+	AuditActorService   AuditActorType = "service"
+)
+
+// AuditChange は1つの項目の変更前と変更後の値（作成時は Before、削除時は After が空）
+type AuditChange struct {
+	Field  string
+	Before string
+	After  string
+}
+
+// AuditEntry - 状態を変更した操作の監査記録
+// 記録後に変更・削除することはない
+type AuditEntry struct {
+	id         string
+	actorType  AuditActorType
+	actorID    string // 利用者の UserID または API キーの ID（匿名の場合は空）
+	action     AuditAction
+	target     AuditTarget
+	changes    []AuditChange
+	requestID  string
+	occurredAt time.Time
+}
+
+func NewAuditEntry(actor *Principal, action AuditAction, target AuditTarget, changes []AuditChange, requestID string, now time.Time) (*AuditEntry, error) {
+	if action == "" {
+		return nil, EmptyFieldError{Field: "audit action"}
+	}
+	if target.Type == "" || target.ID == "" {
+		return nil, EmptyFieldError{Field: "audit target"}
+	}
+
+	entry := &AuditEntry{
+		id:         uuid.New().String(),
+		actorType:  AuditActorAnonymous,
+		action:     action,
+		target:     target,
+		changes:    changes,
+		requestID:  requestID,
+		occurredAt: now,
+	}
+	switch {
+	case actor == nil:
+	case actor.IsService():
+		entry.actorType = AuditActorService
+		entry.actorID = actor.APIKeyID()
+	default:
+		entry.actorType = AuditActorUser
+		entry.actorID = actor.UserID().Value()
+	}
+	return entry, nil
+}
+
+func ReconstructAuditEntry(
+	id string,
+	actorType AuditActorType,
+	actorID string,
+	action AuditAction,
+	target AuditTarget,
+	changes []AuditChange,
+	requestID string,
+	occurredAt time.Time,
+) *AuditEntry {
+	return &AuditEntry{
+		id:         id,
+		actorType:  actorType,
+		actorID:    actorID,
+		action:     action,
+		target:     target,
+		changes:    changes,
+		requestID:  requestID,
+		occurredAt: occurredAt,
+	}
+}
+
+func (e *AuditEntry) ID() string {
+	return e.id
+}
+
+func (e *AuditEntry) ActorType() AuditActorType {
+	return e.actorType
+}
+
+func (e *AuditEntry) ActorID() string {
+	return e.actorID
+}
+
+func (e *AuditEntry) Action() AuditAction {
+	return e.action
+}
+
+func (e *AuditEntry) Target() AuditTarget {
+	return e.target
+}
+
+func (e *AuditEntry) Changes() []AuditChange {
+	return e.changes
+}
+
+func (e *AuditEntry) RequestID() string {
+	return e.requestID
+}
+
+func (e *AuditEntry) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AuditFilter は監査ログの検索条件（空の項目は条件にしない）
+type AuditFilter struct {
+	ActorID    string
+	Action     AuditAction
+	TargetType AuditTargetType
+	TargetID   string
+	RequestID  string
+	Since      *time.Time // この時刻以降
+	Until      *time.Time // この時刻より前
+	Limit      int
+}
+
+// Matches は entry が検索条件に合うかを返す（Limit は見ない）
+func (f AuditFilter) Matches(entry *AuditEntry) bool {
+	return (f.ActorID == "" || entry.actorID == f.ActorID) &&
+		(f.Action == "" || entry.action == f.Action) &&
+		(f.TargetType == "" || entry.target.Type == f.TargetType) &&
+		(f.TargetID == "" || entry.target.ID == f.TargetID) &&
+		(f.RequestID == "" || entry.requestID == f.RequestID) &&
+		(f.Since == nil || !entry.occurredAt.Before(*f.Since)) &&
+		(f.Until == nil || entry.occurredAt.Before(*f.Until))
+}
+
+// AuditSnapshot は差分を取るための集約の項目の値
+type AuditSnapshot map[string]string
+
+// UserAuditSnapshot はユーザーの監査対象の項目を返す
+func UserAuditSnapshot(user *User) AuditSnapshot {
+	snapshot := AuditSnapshot{
+		"firstName":     user.Name().FirstName(),
+		"lastName":      user.Name().LastName(),
+		"email":         user.Email().Value(),
+		"emailVerified": strconv.FormatBool(user.IsEmailVerified()),
+		"pendingEmail":  "",
+		"deletedAt":     auditTime(user.IsDeleted(), user.DeletedAt()),
+	}
+	if user.PendingEmail() != nil {
+		snapshot["pendingEmail"] = user.PendingEmail().Value()
+	}
+	if subscription := user.Subscription(); subscription != nil {
+		snapshot["subscription.plan"] = subscription.Plan().String()
+		snapshot["subscription.trial"] = strconv.FormatBool(subscription.IsTrial())
+		snapshot["subscription.expiresAt"] = auditTime(subscription.HasExpiry(), subscription.ExpiresAt())
+		snapshot["subscription.cancelledAt"] = auditTime(subscription.IsCancelled(), subscription.CancelledAt())
+	}
+	return snapshot
+}
+
+// CircleAuditSnapshot はサークルの監査対象の項目を返す
+func CircleAuditSnapshot(circle *Circle) AuditSnapshot {
+	memberIDs := make([]string, 0, circle.GetMemberCount())
+	for _, memberID := range circle.GetMemberIDs() {
+		memberIDs = append(memberIDs, memberID.Value())
+	}
+	sort.Strings(memberIDs)
+
+	snapshot := AuditSnapshot{
+		"name":           circle.Name().Value(),
+		"ownerId":        circle.OwnerID().Value(),
+		"memberIds":      strings.Join(memberIDs, ","),
+		"membershipDues": "",
+		"deletedAt":      auditTime(circle.IsDeleted(), circle.DeletedAt()),
+	}
+	if circle.HasMembershipDues() {
+		snapshot["membershipDues"] = circle.MembershipDues().String()
+	}
+	return snapshot
+}
+
+func auditTime(set bool, t time.Time) string {
+	if !set {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// DiffAuditSnapshots は値の変わった項目を項目名の順に返す
+// 作成時は before、削除時は after に nil を渡す
+func DiffAuditSnapshots(before, after AuditSnapshot) []AuditChange {
+	fields := make(map[string]bool)
+	for field := range before {
+		fields[field] = true
+	}
+	for field := range after {
+		fields[field] = true
+	}
+
+	var changes []AuditChange
+	for field := range fields {
+		if before[field] != after[field] {
+			changes = append(changes, AuditChange{Field: field, Before: before[field], After: after[field]})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes
+}
+
+type InvalidAuditFilterError struct {
+	Reason string
+}
+
+func (e InvalidAuditFilterError) Error() string {
+	return "invalid audit filter: " + e.Reason
+}
+
+func (e InvalidAuditFilterError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
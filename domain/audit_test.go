@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewAuditEntry_Actor(t *testing.T) {
+	userID := NewUserID()
+	service := newTestServicePrincipal(t, PermissionUsersWrite)
+
+	tests := []struct {
+		name          string
+		actor         *Principal
+		wantActorType AuditActorType
+		wantActorID   string
+	}{
+		{"匿名は ID を記録しない", nil, AuditActorAnonymous, ""},
+		{"利用者は UserID を記録する", NewUserPrincipal(userID, false), AuditActorUser, userID.Value()},
+		{"サービスは API キーの ID を記録する", service, AuditActorService, service.APIKeyID()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			entry, err := NewAuditEntry(tt.actor, AuditUserCreated, UserAuditTarget(userID), nil, "req-1", testAPIKeyNow)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if entry.ActorType() != tt.wantActorType || entry.ActorID() != tt.wantActorID {
+				t.Errorf("Expected actor %s %q, but got %s %q", tt.wantActorType, tt.wantActorID, entry.ActorType(), entry.ActorID())
+			}
+		})
+	}
+}
+
+func TestNewAuditEntry_EmptyField(t *testing.T) {
+	tests := []struct {
+		name   string
+		action AuditAction
+		target AuditTarget
+	}{
+		{"操作が空", "", AuditTarget{Type: AuditTargetUser, ID: "u-1"}},
+		{"対象の種類が空", AuditUserCreated, AuditTarget{ID: "u-1"}},
+		{"対象の ID が空", AuditUserCreated, AuditTarget{Type: AuditTargetUser}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := NewAuditEntry(nil, tt.action, tt.target, nil, "", testAPIKeyNow)
+
+			// Assert
+			var emptyErr EmptyFieldError
+			if !errors.As(err, &emptyErr) {
+				t.Errorf("Expected EmptyFieldError, but got %v", err)
+			}
+		})
+	}
+}
+
+func TestDiffAuditSnapshots(t *testing.T) {
+	tests := []struct {
+		name   string
+		before AuditSnapshot
+		after  AuditSnapshot
+		want   []AuditChange
+	}{
+		{
+			"作成時はすべての項目を After に記録する",
+			nil,
+			AuditSnapshot{"name": "テニス部", "ownerId": "u-1"},
+			[]AuditChange{{Field: "name", After: "テニス部"}, {Field: "ownerId", After: "u-1"}},
+		},
+		{
+			"更新時は変わった項目のみ記録する",
+			AuditSnapshot{"name": "テニス部", "memberIds": "u-2"},
+			AuditSnapshot{"name": "テニス部", "memberIds": "u-2,u-3"},
+			[]AuditChange{{Field: "memberIds", Before: "u-2", After: "u-2,u-3"}},
+		},
+		{
+			"削除時はすべての項目を Before に記録する",
+			AuditSnapshot{"email": "a@example.com"},
+			nil,
+			[]AuditChange{{Field: "email", Before: "a@example.com"}},
+		},
+		{
+			"変更がなければ空",
+			AuditSnapshot{"name": "テニス部"},
+			AuditSnapshot{"name": "テニス部"},
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got := DiffAuditSnapshots(tt.before, tt.after)
+
+			// Assert
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %+v, but got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestUserAuditSnapshot_ChangeName(t *testing.T) {
+	// Arrange
+	name, _ := NewFullName("太郎", "山田")
+	email, _ := NewEmail("taro@example.com")
+	user := NewUser(name, email, time.Now())
+	before := UserAuditSnapshot(user)
+	newName, _ := NewFullName("次郎", "山田")
+
+	// Act
+	user.ChangeName(newName, time.Now())
+	changes := DiffAuditSnapshots(before, UserAuditSnapshot(user))
+
+	// Assert
+	want := []AuditChange{{Field: "firstName", Before: "太郎", After: "次郎"}}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("Expected %+v, but got %+v", want, changes)
+	}
+}
@@ -0,0 +1,140 @@
+package domain
+
+// Action - 認可の対象となる操作
+type Action string
+
+const (
+	ActionCreateUser           Action = "users.create"
+	ActionViewUser             Action = "users.view"
+	ActionUpdateUser           Action = "users.update"
+	ActionDeleteUser           Action = "users.delete"
+	ActionRestoreUser          Action = "users.restore"
+	ActionExportUserCalendar   Action = "users.calendar"
+	ActionIssueCalendarToken   Action = "users.calendar.token"
+	ActionGrantSubscription    Action = "users.subscription.grant"
+	ActionRecordLedgerEntry    Action = "ledger.record"
+	ActionCreateCircle         Action = "circles.create"
+	ActionViewCircle           Action = "circles.view"
+	ActionRecommendCircles     Action = "circles.recommend"
+	ActionManageCircle         Action = "circles.manage"
+	ActionJoinCircle           Action = "circles.join"
+	ActionLeaveCircle          Action = "circles.leave"
+	ActionViewCircleExpenses   Action = "circles.expenses.view"
+	ActionRecordCircleExpense  Action = "circles.expenses.record"
+	ActionRecordExpensePayer   Action = "circles.expenses.payer"
+	ActionRespondCircleEvent   Action = "circles.events.rsvp"
+	ActionViewCircleWebhooks   Action = "circles.webhooks.view"
+	ActionViewShipment         Action = "shipments.view"
+	ActionQuoteShippingFee     Action = "shipments.quote"
+	ActionManageShipment       Action = "shipments.manage"
+	ActionManageAPIKeys        Action = "api_keys.manage"
+	ActionLinkExternalIdentity Action = "users.external_identities.link"
+	ActionViewAuditLog         Action = "audit.view"
+)
+
+// Resource は認可の判断に使う操作対象
+// UserID は本人かどうか、Circle はオーナー・参加者かどうかの判断に使う
+type Resource struct {
+	UserID *UserID
+	Circle *Circle
+}
+
+// accessRule は権限を持たない利用者に操作を許すかを判断する
+type accessRule func(userID *UserID, resource Resource) bool
+
+// policy - 操作ごとの認可の規則
+// permission を持つ主体（管理者・サービス）はいつでも許可する
+// サービスは permission がなければ拒否し、利用者には public または rule を適用する
+type policy struct {
+	permission Permission
+	public     bool       // 匿名でも許可する
+	rule       accessRule // nil の場合は permission を持つ主体のみ
+	reason     string     // rule で拒否したときの理由
+}
+
+var policies = map[Action]policy{
+	ActionCreateUser:           {permission: PermissionUsersWrite, public: true},
+	ActionViewUser:             {permission: PermissionUsersRead, rule: self, reason: "users may only view their own account"},
+	ActionUpdateUser:           {permission: PermissionUsersWrite, rule: self, reason: "users may only change their own account"},
+	ActionDeleteUser:           {permission: PermissionUsersWrite, rule: self, reason: "users may only delete their own account"},
+	ActionRestoreUser:          {permission: PermissionUsersWrite}, // 削除したユーザーはログインできない
+	ActionExportUserCalendar:   {permission: PermissionUsersRead, rule: self, reason: "users may only export their own calendar"},
+	ActionIssueCalendarToken:   {permission: PermissionUsersWrite, rule: self, reason: "users may only issue their own calendar feed token"},
+	ActionGrantSubscription:    {permission: PermissionBillingWrite}, // 無償のプレミアム契約は管理者のみが付与する
+	ActionRecordLedgerEntry:    {permission: PermissionBillingWrite},
+	ActionCreateCircle:         {permission: PermissionCirclesWrite, rule: self, reason: "users may only create circles they own"},
+	ActionViewCircle:           {permission: PermissionCirclesRead, public: true},
+	ActionRecommendCircles:     {permission: PermissionCirclesRead, public: true},
+	ActionManageCircle:         {permission: PermissionCirclesWrite, rule: circleOwner, reason: "only the circle owner may manage the circle"},
+	ActionJoinCircle:           {permission: PermissionCirclesWrite, rule: anyOf(circleOwner, self), reason: "only the circle owner may add other users"},
+	ActionLeaveCircle:          {permission: PermissionCirclesWrite, rule: anyOf(circleOwner, self), reason: "only the circle owner may remove other members"},
+	ActionViewCircleExpenses:   {permission: PermissionCirclesRead, rule: circleParticipant, reason: "only circle participants may view expenses"},
+	ActionRecordCircleExpense:  {permission: PermissionCirclesWrite, rule: circleParticipant, reason: "only circle participants may record expenses"},
+	ActionRecordExpensePayer:   {permission: PermissionCirclesWrite, rule: self, reason: "members may only record expenses they paid themselves"},
+	ActionRespondCircleEvent:   {permission: PermissionCirclesWrite, rule: self, reason: "users may only respond for themselves"},
+	ActionViewCircleWebhooks:   {permission: PermissionCirclesRead, rule: circleOwner, reason: "only the circle owner may view webhooks"},
+	ActionViewShipment:         {permission: PermissionShipmentsRead, rule: self, reason: "users may only view their own shipments"},
+	ActionQuoteShippingFee:     {permission: PermissionShipmentsRead, rule: self, reason: "users may only quote their own shipments"},
+	ActionManageShipment:       {permission: PermissionShipmentsWrite},
+	ActionManageAPIKeys:        {permission: PermissionAPIKeysManage},
+	ActionLinkExternalIdentity: {rule: self, reason: "users may only link accounts to themselves"},
+	ActionViewAuditLog:         {permission: PermissionAuditRead},
+}
+
+// Authorize は actor が resource に対して action を行えるかを判断する
+// 匿名で許可されない操作には UnauthenticatedError、それ以外の拒否には ForbiddenError を返す
+// 規則のない操作は拒否する
+func Authorize(actor *Principal, action Action, resource Resource) error {
+	p, exists := policies[action]
+	if !exists {
+		return ForbiddenError{Reason: "no policy for " + string(action)}
+	}
+
+	if actor != nil && p.permission != "" && actor.HasPermission(p.permission) {
+		return nil
+	}
+	if actor != nil && actor.IsService() {
+		if p.permission == "" {
+			return ForbiddenError{Reason: "services may not " + string(action)}
+		}
+		return ForbiddenError{Reason: "missing permission " + string(p.permission)}
+	}
+	if p.public {
+		return nil
+	}
+	if actor == nil {
+		return UnauthenticatedError{}
+	}
+	if p.rule == nil {
+		return ForbiddenError{Reason: "missing permission " + string(p.permission)}
+	}
+	if !p.rule(actor.UserID(), resource) {
+		return ForbiddenError{Reason: p.reason}
+	}
+	return nil
+}
+
+// self は操作対象が利用者本人の場合に許可する
+func self(userID *UserID, resource Resource) bool {
+	return resource.UserID != nil && resource.UserID.Equals(userID)
+}
+
+func circleOwner(userID *UserID, resource Resource) bool {
+	return resource.Circle != nil && resource.Circle.IsOwner(userID)
+}
+
+// circleParticipant はサークルのオーナーとメンバーに許可する
+func circleParticipant(userID *UserID, resource Resource) bool {
+	return resource.Circle != nil && resource.Circle.IsParticipant(userID)
+}
+
+func anyOf(rules ...accessRule) accessRule {
+	return func(userID *UserID, resource Resource) bool {
+		for _, rule := range rules {
+			if rule(userID, resource) {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func newTestServicePrincipal(t *testing.T, permissions ...Permission) *Principal {
+	t.Helper()
+
+	key, _, err := NewAPIKey("batch", permissions, "admin", testAPIKeyNow, nil)
+	if err != nil {
+		t.Fatalf("Failed to create API key: %v", err)
+	}
+	return NewServicePrincipal(key)
+}
+
+func TestAuthorize(t *testing.T) {
+	owner := NewUserID()
+	member := NewUserID()
+	stranger := NewUserID()
+	circleName, err := NewCircleName("テストサークル")
+	if err != nil {
+		t.Fatalf("Failed to create circle name: %v", err)
+	}
+	circle := NewCircle(circleName, owner)
+	circle.memberIDs = append(circle.memberIDs, member)
+
+	anonymous := (*Principal)(nil)
+	ownerActor := NewUserPrincipal(owner, false)
+	memberActor := NewUserPrincipal(member, false)
+	strangerActor := NewUserPrincipal(stranger, false)
+	admin := NewUserPrincipal(NewUserID(), true)
+	usersWriter := newTestServicePrincipal(t, PermissionUsersWrite)
+	circlesReader := newTestServicePrincipal(t, PermissionCirclesRead)
+
+	ownResource := Resource{UserID: owner}
+	circleResource := Resource{Circle: circle}
+
+	tests := []struct {
+		name     string
+		actor    *Principal
+		action   Action
+		resource Resource
+		wantErr  error // nil は許可
+	}{
+		{"匿名でもユーザーを登録できる", anonymous, ActionCreateUser, Resource{}, nil},
+		{"権限のないサービスはユーザーを登録できない", circlesReader, ActionCreateUser, Resource{}, ForbiddenError{}},
+		{"本人は自分を更新できる", ownerActor, ActionUpdateUser, ownResource, nil},
+		{"他のユーザーは更新できない", strangerActor, ActionUpdateUser, ownResource, ForbiddenError{}},
+		{"匿名は更新できない", anonymous, ActionUpdateUser, ownResource, UnauthenticatedError{}},
+		{"管理者は誰でも削除できる", admin, ActionDeleteUser, ownResource, nil},
+		{"本人でも削除したアカウントは復元できない", ownerActor, ActionRestoreUser, ownResource, ForbiddenError{}},
+		{"権限を持つサービスは削除したユーザーを復元できる", usersWriter, ActionRestoreUser, ownResource, nil},
+		{"権限を持つサービスは更新できる", usersWriter, ActionUpdateUser, ownResource, nil},
+		{"サービスは本人の規則では許可されない", circlesReader, ActionViewUser, ownResource, ForbiddenError{}},
+		{"匿名でもサークルを閲覧できる", anonymous, ActionViewCircle, circleResource, nil},
+		{"権限のないサービスはサークルを閲覧できない", usersWriter, ActionViewCircle, circleResource, ForbiddenError{}},
+		{"オーナーはサークルを管理できる", ownerActor, ActionManageCircle, circleResource, nil},
+		{"メンバーはサークルを管理できない", memberActor, ActionManageCircle, circleResource, ForbiddenError{}},
+		{"読み取り権限ではサークルを管理できない", circlesReader, ActionManageCircle, circleResource, ForbiddenError{}},
+		{"メンバーは立て替えを閲覧できる", memberActor, ActionViewCircleExpenses, circleResource, nil},
+		{"部外者は立て替えを閲覧できない", strangerActor, ActionViewCircleExpenses, circleResource, ForbiddenError{}},
+		{"本人は参加できる", strangerActor, ActionJoinCircle, Resource{Circle: circle, UserID: stranger}, nil},
+		{"オーナーは他のユーザーを追加できる", ownerActor, ActionJoinCircle, Resource{Circle: circle, UserID: stranger}, nil},
+		{"メンバーは他のユーザーを追加できない", memberActor, ActionJoinCircle, Resource{Circle: circle, UserID: stranger}, ForbiddenError{}},
+		{"利用者は台帳に記録できない", ownerActor, ActionRecordLedgerEntry, ownResource, ForbiddenError{}},
+		{"管理者は API キーを管理できる", admin, ActionManageAPIKeys, Resource{}, nil},
+		{"匿名は API キーを管理できない", anonymous, ActionManageAPIKeys, Resource{}, UnauthenticatedError{}},
+		{"サービスは外部のアカウントを紐付けられない", usersWriter, ActionLinkExternalIdentity, Resource{}, ForbiddenError{}},
+		{"利用者は監査ログを閲覧できない", ownerActor, ActionViewAuditLog, Resource{}, ForbiddenError{}},
+		{"管理者は監査ログを閲覧できる", admin, ActionViewAuditLog, Resource{}, nil},
+		{"規則のない操作は拒否する", admin, Action("unknown"), Resource{}, ForbiddenError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := Authorize(tt.actor, tt.action, tt.resource)
+
+			// Assert
+			switch tt.wantErr.(type) {
+			case nil:
+				if err != nil {
+					t.Errorf("Expected no error, but got: %v", err)
+				}
+			case UnauthenticatedError:
+				var e UnauthenticatedError
+				if !errors.As(err, &e) {
+					t.Errorf("Expected UnauthenticatedError, but got %v", err)
+				}
+			case ForbiddenError:
+				var e ForbiddenError
+				if !errors.As(err, &e) {
+					t.Errorf("Expected ForbiddenError, but got %v", err)
+				}
+			}
+		})
+	}
+}
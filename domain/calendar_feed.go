@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"crypto/subtle"
+	"time"
+)
+
+// CalendarFeedToken - ユーザーのカレンダーフィード（.ics）を購読するためのトークン
+// カレンダーアプリは認証情報を送れないため、URL の token パラメーターで本人のフィードであることを確かめる
+// ユーザーごとに1つで、発行し直すと以前のトークンは使えなくなる。トークンはハッシュのみを保存する
+type CalendarFeedToken struct {
+	userID    *UserID
+	tokenHash string
+	issuedAt  time.Time
+}
+
+// NewCalendarFeedToken は新しいトークンと購読 URL に含めるトークンを返す
+func NewCalendarFeedToken(userID *UserID, now time.Time) (*CalendarFeedToken, string, error) {
+	if userID == nil {
+		return nil, "", EmptyFieldError{Field: "user ID"}
+	}
+	token, err := newOpaqueToken()
+	if err != nil {
+		return nil, "", err
+	}
+	return &CalendarFeedToken{
+		userID:    userID,
+		tokenHash: hashOpaqueToken(token),
+		issuedAt:  now,
+	}, token, nil
+}
+
+func ReconstructCalendarFeedToken(userID *UserID, tokenHash string, issuedAt time.Time) *CalendarFeedToken {
+	return &CalendarFeedToken{
+		userID:    userID,
+		tokenHash: tokenHash,
+		issuedAt:  issuedAt,
+	}
+}
+
+func (t *CalendarFeedToken) UserID() *UserID {
+	return t.userID
+}
+
+func (t *CalendarFeedToken) TokenHash() string {
+	return t.tokenHash
+}
+
+func (t *CalendarFeedToken) IssuedAt() time.Time {
+	return t.issuedAt
+}
+
+// Authenticate は token を一定時間で照合する（一致しない場合は UnauthenticatedError）
+func (t *CalendarFeedToken) Authenticate(token string) error {
+	if subtle.ConstantTimeCompare([]byte(hashOpaqueToken(token)), []byte(t.tokenHash)) != 1 {
+		return UnauthenticatedError{Reason: "invalid calendar feed token"}
+	}
+	return nil
+}
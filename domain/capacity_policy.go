@@ -0,0 +1,168 @@
+package domain
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// 既定の定員ルール（プレミアム会員が閾値以上なら定員を拡大）
+const (
+	BasicMemberLimit       = 30
+	PremiumMemberLimit     = 50
+	PremiumMemberThreshold = 10
+)
+
+// CapacityPolicy - サークルの最大参加人数（オーナーを含む）を決めるポリシー
+type CapacityPolicy interface {
+	MaxParticipants(circleMembers *CircleMembers) int
+}
+
+func DefaultCapacityPolicy() CapacityPolicy {
+	return &PremiumThresholdCapacityPolicy{
+		basicLimit:   BasicMemberLimit,
+		premiumLimit: PremiumMemberLimit,
+		threshold:    PremiumMemberThreshold,
+	}
+}
+
+// PremiumThresholdCapacityPolicy - プレミアム会員数が閾値以上なら拡大定員を適用する
+type PremiumThresholdCapacityPolicy struct {
+	basicLimit   int
+	premiumLimit int
+	threshold    int
+}
+
+func NewPremiumThresholdCapacityPolicy(basicLimit, premiumLimit, threshold int) (*PremiumThresholdCapacityPolicy, error) {
+	if basicLimit < 1 {
+		return nil, InvalidCapacityPolicyError{Reason: fmt.Sprintf("basic limit must be positive: %d", basicLimit)}
+	}
+	if premiumLimit < basicLimit {
+		return nil, InvalidCapacityPolicyError{Reason: fmt.Sprintf("premium limit %d is less than basic limit %d", premiumLimit, basicLimit)}
+	}
+	if threshold < 0 {
+		return nil, InvalidCapacityPolicyError{Reason: fmt.Sprintf("premium threshold must not be negative: %d", threshold)}
+	}
+	return &PremiumThresholdCapacityPolicy{
+		basicLimit:   basicLimit,
+		premiumLimit: premiumLimit,
+		threshold:    threshold,
+	}, nil
+}
+
+func (p *PremiumThresholdCapacityPolicy) MaxParticipants(circleMembers *CircleMembers) int {
+	if circleMembers.CountPremiumMembers() >= p.threshold {
+		return p.premiumLimit
+	}
+	return p.basicLimit
+}
+
+// FlatCapacityPolicy - メンバー構成によらず一定の定員
+type FlatCapacityPolicy struct {
+	limit int
+}
+
+func NewFlatCapacityPolicy(limit int) (*FlatCapacityPolicy, error) {
+	if limit < 1 {
+		return nil, InvalidCapacityPolicyError{Reason: fmt.Sprintf("limit must be positive: %d", limit)}
+	}
+	return &FlatCapacityPolicy{limit: limit}, nil
+}
+
+func (p *FlatCapacityPolicy) MaxParticipants(circleMembers *CircleMembers) int {
+	return p.limit
+}
+
+// PurchasedCapacityPolicy - 基本ポリシーの定員にサークルが購入した追加枠を加算する
+type PurchasedCapacityPolicy struct {
+	base           CapacityPolicy
+	purchasedSeats int
+}
+
+func NewPurchasedCapacityPolicy(base CapacityPolicy, purchasedSeats int) (*PurchasedCapacityPolicy, error) {
+	if base == nil {
+		return nil, InvalidCapacityPolicyError{Reason: "base policy is required"}
+	}
+	if purchasedSeats < 0 {
+		return nil, InvalidCapacityPolicyError{Reason: fmt.Sprintf("purchased seats must not be negative: %d", purchasedSeats)}
+	}
+	return &PurchasedCapacityPolicy{base: base, purchasedSeats: purchasedSeats}, nil
+}
+
+func (p *PurchasedCapacityPolicy) MaxParticipants(circleMembers *CircleMembers) int {
+	return p.base.MaxParticipants(circleMembers) + p.purchasedSeats
+}
+
+// PremiumOwnerBonusCapacityPolicy - オーナーがプレミアム会員なら基本ポリシーの定員にボーナス枠を加算する
+type PremiumOwnerBonusCapacityPolicy struct {
+	base  CapacityPolicy
+	bonus int
+}
+
+func NewPremiumOwnerBonusCapacityPolicy(base CapacityPolicy, bonus int) (*PremiumOwnerBonusCapacityPolicy, error) {
+	if base == nil {
+		return nil, InvalidCapacityPolicyError{Reason: "base policy is required"}
+	}
+	if bonus < 0 {
+		return nil, InvalidCapacityPolicyError{Reason: fmt.Sprintf("bonus must not be negative: %d", bonus)}
+	}
+	return &PremiumOwnerBonusCapacityPolicy{base: base, bonus: bonus}, nil
+}
+
+func (p *PremiumOwnerBonusCapacityPolicy) MaxParticipants(circleMembers *CircleMembers) int {
+	limit := p.base.MaxParticipants(circleMembers)
+	if circleMembers.IsOwnerPremium() {
+		limit += p.bonus
+	}
+	return limit
+}
+
+// CapacityPolicyRegistry - サークルごとに適用する定員ポリシーを保持する
+// 個別に割り当てのないサークルには既定ポリシーを適用する
+type CapacityPolicyRegistry struct {
+	defaultPolicy CapacityPolicy
+	circles       map[string]CapacityPolicy
+	mu            sync.RWMutex
+}
+
+func NewCapacityPolicyRegistry(defaultPolicy CapacityPolicy) *CapacityPolicyRegistry {
+	if defaultPolicy == nil {
+		defaultPolicy = DefaultCapacityPolicy()
+	}
+	return &CapacityPolicyRegistry{
+		defaultPolicy: defaultPolicy,
+		circles:       make(map[string]CapacityPolicy),
+	}
+}
+
+func (r *CapacityPolicyRegistry) Assign(circleID *CircleID, policy CapacityPolicy) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.circles[circleID.Value()] = policy
+}
+
+func (r *CapacityPolicyRegistry) PolicyFor(circleID *CircleID) CapacityPolicy {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if circleID != nil {
+		if policy, exists := r.circles[circleID.Value()]; exists {
+			return policy
+		}
+	}
+	return r.defaultPolicy
+}
+
+// Capacity policy errors
+type InvalidCapacityPolicyError struct {
+	Reason string
+}
+
+func (e InvalidCapacityPolicyError) Error() string {
+	return "invalid capacity policy: " + e.Reason
+}
+
+func (e InvalidCapacityPolicyError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
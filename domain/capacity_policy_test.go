@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCapacityPolicies_MaxParticipants(t *testing.T) {
+	premiumThreshold, _ := NewPremiumThresholdCapacityPolicy(20, 40, 3)
+	flat, _ := NewFlatCapacityPolicy(100)
+	purchased, _ := NewPurchasedCapacityPolicy(flat, 25)
+	ownerBonus, _ := NewPremiumOwnerBonusCapacityPolicy(DefaultCapacityPolicy(), 5)
+
+	tests := []struct {
+		name         string
+		policy       CapacityPolicy
+		ownerPremium bool
+		premiumCount int
+		expected     int
+	}{
+		{"既定ポリシー：プレミアム9人", DefaultCapacityPolicy(), false, 9, BasicMemberLimit},
+		{"既定ポリシー：プレミアム10人", DefaultCapacityPolicy(), false, 10, PremiumMemberLimit},
+		{"閾値ポリシー：閾値未満", premiumThreshold, false, 2, 20},
+		{"閾値ポリシー：閾値到達", premiumThreshold, false, 3, 40},
+		{"固定ポリシー", flat, true, 10, 100},
+		{"購入枠ポリシー", purchased, false, 0, 125},
+		{"オーナーボーナス：一般オーナー", ownerBonus, false, 0, BasicMemberLimit},
+		{"オーナーボーナス：プレミアムオーナー", ownerBonus, true, 0, BasicMemberLimit + 5},
+		{"オーナーボーナス：プレミアムオーナーと拡大定員", ownerBonus, true, 9, PremiumMemberLimit + 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner := newTestOwner(t, tt.ownerPremium)
+			members := newTestMembers(t, 15, tt.premiumCount)
+
+			actual := tt.policy.MaxParticipants(NewCircleMembers(owner, members, time.Now()))
+
+			if actual != tt.expected {
+				t.Errorf("Expected %d, but got %d", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCapacityPolicies_InvalidParameters_ReturnsError(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func() error
+	}{
+		{"基本定員が0", func() error { _, err := NewPremiumThresholdCapacityPolicy(0, 50, 10); return err }},
+		{"拡大定員が基本定員未満", func() error { _, err := NewPremiumThresholdCapacityPolicy(30, 20, 10); return err }},
+		{"閾値が負数", func() error { _, err := NewPremiumThresholdCapacityPolicy(30, 50, -1); return err }},
+		{"固定定員が0", func() error { _, err := NewFlatCapacityPolicy(0); return err }},
+		{"購入枠のベースなし", func() error { _, err := NewPurchasedCapacityPolicy(nil, 10); return err }},
+		{"購入枠が負数", func() error { _, err := NewPurchasedCapacityPolicy(DefaultCapacityPolicy(), -1); return err }},
+		{"ボーナスが負数", func() error { _, err := NewPremiumOwnerBonusCapacityPolicy(DefaultCapacityPolicy(), -1); return err }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.build()
+			if _, ok := err.(InvalidCapacityPolicyError); !ok {
+				t.Errorf("Expected InvalidCapacityPolicyError, but got %T: %v", err, err)
+			}
+		})
+	}
+}
+
+func TestCircleMemberService_UsesPolicyAssignedToCircle(t *testing.T) {
+	owner := newTestOwner(t, false)
+	members := newTestMembers(t, 5, 0)
+	campaignCircle := newTestCircle(t, owner, members)
+	normalCircle := newTestCircle(t, owner, members)
+
+	flat, _ := NewFlatCapacityPolicy(7)
+	registry := NewCapacityPolicyRegistry(nil)
+	registry.Assign(campaignCircle.ID(), flat)
+	service := NewCircleMemberService(registry)
+	circleMembers := NewCircleMembers(owner, members, time.Now())
+
+	// 個別に割り当てたポリシーが使われる
+	capacity := service.GetCapacity(campaignCircle, circleMembers)
+	if capacity.MaxParticipants() != 7 {
+		t.Errorf("Expected 7, but got %d", capacity.MaxParticipants())
+	}
+	if err := campaignCircle.AddMember(NewUserID(), capacity, time.Now()); err != nil {
+		t.Errorf("Expected no error, but got: %v", err)
+	}
+	if err := campaignCircle.AddMember(NewUserID(), capacity, time.Now()); err == nil {
+		t.Error("Expected CircleFullError, but got nil")
+	}
+
+	// 割り当てのないサークルには既定ポリシーが使われる
+	if limit := service.GetMaxLimit(normalCircle, circleMembers); limit != BasicMemberLimit {
+		t.Errorf("Expected %d, but got %d", BasicMemberLimit, limit)
+	}
+}
@@ -1,9 +1,11 @@
 package domain
 
 import (
-	"errors"
-	"github.com/google/uuid"
+	"fmt"
+	"net/http"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 type CircleID struct {
@@ -16,10 +18,10 @@ func NewCircleID() *CircleID {
 
 func ReconstructCircleID(value string) (*CircleID, error) {
 	if value == "" {
-		return nil, errors.New("circle ID cannot be empty")
+		return nil, EmptyFieldError{Field: "circle ID"}
 	}
 	if _, err := uuid.Parse(value); err != nil {
-		return nil, errors.New("invalid circle ID format")
+		return nil, InvalidCircleIDError{Value: value}
 	}
 	return &CircleID{value: value}, nil
 }
@@ -40,30 +42,54 @@ func (c *CircleID) String() string {
 }
 
 type Circle struct {
-	id        *CircleID
-	name      *CircleName
-	ownerID   *UserID
-	memberIDs []*UserID
-	createdAt time.Time
+	eventRecorder
+	id             *CircleID
+	name           *CircleName
+	ownerID        *UserID
+	memberIDs      []*UserID
+	membershipDues *Money // nilの場合は会費なし
+	createdAt      time.Time
+	// アカウントを論理削除したメンバー（アカウントを復元したときにメンバーに戻す）
+	detachedMemberIDs []*UserID
+	deletedAt         time.Time // 論理削除した時刻（ゼロ値は削除されていない）
 }
 
 func NewCircle(name *CircleName, ownerID *UserID) *Circle {
-	return &Circle{
+	circle := &Circle{
 		id:        NewCircleID(),
 		name:      name,
 		ownerID:   ownerID,
 		memberIDs: []*UserID{},
 		createdAt: time.Now(),
 	}
+	circle.record(NewCircleCreated(circle.id, name, ownerID, circle.createdAt))
+	return circle
 }
 
-func ReconstructCircle(id *CircleID, name *CircleName, ownerID *UserID, memberIDs []*UserID, createdAt time.Time) *Circle {
+func ReconstructCircle(id *CircleID, name *CircleName, ownerID *UserID, memberIDs []*UserID, membershipDues *Money, createdAt time.Time) *Circle {
+	return ReconstructCircleWithDeletion(id, name, ownerID, memberIDs, nil, membershipDues, createdAt, time.Time{})
+}
+
+// ReconstructCircleWithDeletion は deletedAt がゼロ値でなければ論理削除済みとして再構成する
+func ReconstructCircleWithDeletion(
+	id *CircleID,
+	name *CircleName,
+	ownerID *UserID,
+	memberIDs []*UserID,
+	detachedMemberIDs []*UserID,
+	membershipDues *Money,
+	createdAt time.Time,
+	deletedAt time.Time,
+) *Circle {
 	return &Circle{
-		id:        id,
-		name:      name,
-		ownerID:   ownerID,
-		memberIDs: memberIDs,
-		createdAt: createdAt,
+		id:                id,
+		name:              name,
+		ownerID:           ownerID,
+		memberIDs:         memberIDs,
+		membershipDues:    membershipDues,
+		createdAt:         createdAt,
+		detachedMemberIDs: detachedMemberIDs,
+		deletedAt:         deletedAt,
 	}
 }
 
@@ -83,6 +109,23 @@ func (c *Circle) CreatedAt() time.Time {
 	return c.createdAt
 }
 
+func (c *Circle) MembershipDues() *Money {
+	return c.membershipDues
+}
+
+func (c *Circle) HasMembershipDues() bool {
+	return c.membershipDues != nil
+}
+
+// ChangeMembershipDues は入会時に請求する会費を設定する（nilで会費なし）
+func (c *Circle) ChangeMembershipDues(dues *Money) error {
+	if dues != nil && !dues.IsPositive() {
+		return InvalidCircleDuesError{Reason: "membership dues must be positive: " + dues.String()}
+	}
+	c.membershipDues = dues
+	return nil
+}
+
 func (c *Circle) GetMemberIDs() []*UserID {
 	// 防御的コピーを返す
 	memberIDs := make([]*UserID, len(c.memberIDs))
@@ -102,19 +145,100 @@ func (c *Circle) ChangeName(name *CircleName) {
 	c.name = name
 }
 
-func (c *Circle) AddMember(userID *UserID) {
+// AddMember は定員を超える場合 CircleFullError を返し、メンバーを追加しない
+// 追加により定員に達した場合は CircleFilled も記録する
+func (c *Circle) AddMember(userID *UserID, capacity *CircleCapacity, now time.Time) error {
+	if !c.CanAddMember(capacity) {
+		return CircleFullError{MaxParticipants: capacity.MaxParticipants()}
+	}
 	c.memberIDs = append(c.memberIDs, userID)
+	c.record(NewMemberJoined(c.id, userID, now))
+	if c.IsFull(capacity) {
+		c.record(NewCircleFilled(c.id, c.ownerID, capacity.MaxParticipants(), now))
+	}
+	return nil
 }
 
-func (c *Circle) RemoveMember(userID *UserID) {
+// RemoveMember はメンバーだった場合のみ MemberLeft を記録する
+func (c *Circle) RemoveMember(userID *UserID, now time.Time) {
 	for i, memberID := range c.memberIDs {
 		if memberID.Equals(userID) {
 			c.memberIDs = append(c.memberIDs[:i], c.memberIDs[i+1:]...)
+			c.record(NewMemberLeft(c.id, userID, now))
 			break
 		}
 	}
 }
 
+// DetachMember はアカウントを論理削除したメンバーを外し、復元に備えて覚えておく
+// メンバーだった場合のみ MemberLeft を記録して true を返す
+func (c *Circle) DetachMember(userID *UserID, now time.Time) bool {
+	if !c.IsMember(userID) {
+		return false
+	}
+	c.RemoveMember(userID, now)
+	c.detachedMemberIDs = append(c.detachedMemberIDs, userID)
+	return true
+}
+
+// ReattachMember はアカウントを復元したメンバーをサークルに戻す
+// 外している間に定員に達した場合は戻さずに CircleFullError を返す（どちらの場合も覚えていた記録は消す）
+func (c *Circle) ReattachMember(userID *UserID, capacity *CircleCapacity, now time.Time) error {
+	if !c.ForgetDetachedMember(userID) {
+		return nil
+	}
+	return c.AddMember(userID, capacity, now)
+}
+
+// ForgetDetachedMember は外したメンバーの記録を消す（記録があった場合のみ true を返す）
+func (c *Circle) ForgetDetachedMember(userID *UserID) bool {
+	for i, memberID := range c.detachedMemberIDs {
+		if memberID.Equals(userID) {
+			c.detachedMemberIDs = append(c.detachedMemberIDs[:i], c.detachedMemberIDs[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Circle) IsDetachedMember(userID *UserID) bool {
+	for _, memberID := range c.detachedMemberIDs {
+		if memberID.Equals(userID) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Circle) GetDetachedMemberIDs() []*UserID {
+	detachedMemberIDs := make([]*UserID, len(c.detachedMemberIDs))
+	copy(detachedMemberIDs, c.detachedMemberIDs)
+	return detachedMemberIDs
+}
+
+func (c *Circle) IsDeleted() bool {
+	return !c.deletedAt.IsZero()
+}
+
+// DeletedAt は論理削除した時刻を返す（削除されていない場合はゼロ値）
+func (c *Circle) DeletedAt() time.Time {
+	return c.deletedAt
+}
+
+// MarkDeleted は論理削除する（メンバーはそのまま残し、復元するとそのまま戻る）
+func (c *Circle) MarkDeleted(now time.Time) error {
+	if c.IsDeleted() {
+		return AlreadyDeletedError{ID: c.id.Value()}
+	}
+	c.deletedAt = now
+	return nil
+}
+
+// Restore は論理削除を取り消す（復元できる期間の判定は DeletionPolicy で行う）
+func (c *Circle) Restore() {
+	c.deletedAt = time.Time{}
+}
+
 func (c *Circle) IsMember(userID *UserID) bool {
 	for _, memberID := range c.memberIDs {
 		if memberID.Equals(userID) {
@@ -131,16 +255,31 @@ func (c *Circle) IsOwner(userID *UserID) bool {
 	return c.ownerID.Equals(userID)
 }
 
-func (c *Circle) CanAddMember() bool {
-	return c.GetTotalParticipants() < 30
+// IsParticipant はオーナーまたはメンバーかを返す
+func (c *Circle) IsParticipant(userID *UserID) bool {
+	return c.IsOwner(userID) || c.IsMember(userID)
 }
 
-func (c *Circle) IsFull() bool {
-	return !c.CanAddMember()
+func (c *Circle) CanAddMember(capacity *CircleCapacity) bool {
+	if capacity == nil {
+		return false
+	}
+	return c.GetTotalParticipants() < capacity.MaxParticipants()
+}
+
+func (c *Circle) IsFull(capacity *CircleCapacity) bool {
+	return !c.CanAddMember(capacity)
 }
 
-func (c *Circle) GetAvailableSlots() int {
-	return 30 - c.GetTotalParticipants()
+func (c *Circle) GetAvailableSlots(capacity *CircleCapacity) int {
+	if capacity == nil {
+		return 0
+	}
+	slots := capacity.MaxParticipants() - c.GetTotalParticipants()
+	if slots < 0 {
+		return 0
+	}
+	return slots
 }
 
 func (c *Circle) Equals(other *Circle) bool {
@@ -150,16 +289,42 @@ func (c *Circle) Equals(other *Circle) bool {
 	return c.id.Equals(other.id)
 }
 
+// CircleCapacity - サークルの定員（オーナーを含む最大参加人数）値オブジェクト
+type CircleCapacity struct {
+	maxParticipants int
+}
+
+func NewCircleCapacity(maxParticipants int) (*CircleCapacity, error) {
+	if maxParticipants < 1 {
+		return nil, InvalidCircleCapacityError{Value: maxParticipants}
+	}
+	return &CircleCapacity{maxParticipants: maxParticipants}, nil
+}
+
+func (c *CircleCapacity) MaxParticipants() int {
+	return c.maxParticipants
+}
+
+func (c *CircleCapacity) Equals(other *CircleCapacity) bool {
+	if other == nil {
+		return false
+	}
+	return c.maxParticipants == other.maxParticipants
+}
+
 // CircleMembers - サークルメンバー集合エンティティ
+// プレミアム会員かどうかは asOf 時点の契約で判定する
 type CircleMembers struct {
 	owner   *User
 	members []*User
+	asOf    time.Time
 }
 
-func NewCircleMembers(owner *User, members []*User) *CircleMembers {
+func NewCircleMembers(owner *User, members []*User, asOf time.Time) *CircleMembers {
 	return &CircleMembers{
 		owner:   owner,
 		members: members,
+		asOf:    asOf,
 	}
 }
 
@@ -167,13 +332,13 @@ func (cm *CircleMembers) CountPremiumMembers() int {
 	count := 0
 
 	// オーナーのプレミアム判定
-	if cm.owner != nil && cm.owner.IsPremium() {
+	if cm.owner != nil && cm.owner.IsPremiumAt(cm.asOf) {
 		count++
 	}
 
 	// メンバーのプレミアム判定
 	for _, member := range cm.members {
-		if member != nil && member.IsPremium() {
+		if member != nil && member.IsPremiumAt(cm.asOf) {
 			count++
 		}
 	}
@@ -181,6 +346,10 @@ func (cm *CircleMembers) CountPremiumMembers() int {
 	return count
 }
 
+func (cm *CircleMembers) IsOwnerPremium() bool {
+	return cm.owner != nil && cm.owner.IsPremiumAt(cm.asOf)
+}
+
 func (cm *CircleMembers) GetTotalParticipants() int {
 	return 1 + len(cm.members) // オーナー1名 + メンバー数
 }
@@ -190,36 +359,28 @@ func (cm *CircleMembers) GetMemberCount() int {
 }
 
 // CircleMemberService - サークルメンバー管理サービス
-const (
-	BasicMemberLimit       = 30
-	PremiumMemberLimit     = 50
-	PremiumMemberThreshold = 10
-)
-
-type CircleMemberService struct{}
-
-func NewCircleMemberService() *CircleMemberService {
-	return &CircleMemberService{}
+// 定員の算出はサークルごとに選択された CapacityPolicy に委譲する
+type CircleMemberService struct {
+	policies *CapacityPolicyRegistry
 }
 
-func (s *CircleMemberService) GetMaxLimit(circleMembers *CircleMembers) int {
-	premiumCount := circleMembers.CountPremiumMembers()
-	if premiumCount >= PremiumMemberThreshold {
-		return PremiumMemberLimit
+func NewCircleMemberService(policies *CapacityPolicyRegistry) *CircleMemberService {
+	if policies == nil {
+		policies = NewCapacityPolicyRegistry(DefaultCapacityPolicy())
+	}
+	return &CircleMemberService{
+		policies: policies,
 	}
-	return BasicMemberLimit
 }
 
-func (s *CircleMemberService) CanAddMember(circleMembers *CircleMembers) bool {
-	currentParticipants := circleMembers.GetTotalParticipants()
-	maxLimit := s.GetMaxLimit(circleMembers)
-	return currentParticipants < maxLimit
+func (s *CircleMemberService) GetMaxLimit(circle *Circle, circleMembers *CircleMembers) int {
+	return s.policies.PolicyFor(circle.ID()).MaxParticipants(circleMembers)
 }
 
-func (s *CircleMemberService) GetAvailableSlots(circleMembers *CircleMembers) int {
-	maxLimit := s.GetMaxLimit(circleMembers)
-	totalParticipants := circleMembers.GetTotalParticipants()
-	return maxLimit - totalParticipants
+// GetCapacity はメンバー構成から決まる定員を返す
+// 定員の判定自体は Circle 集約が行う
+func (s *CircleMemberService) GetCapacity(circle *Circle, circleMembers *CircleMembers) *CircleCapacity {
+	return &CircleCapacity{maxParticipants: s.GetMaxLimit(circle, circleMembers)}
 }
 
 // CircleExistenceService - サークル存在確認サービス
@@ -282,3 +443,76 @@ func (s *CircleRecommendationService) isRecentlyCreated(circle *Circle) bool {
 func (s *CircleRecommendationService) hasEnoughMembers(circle *Circle) bool {
 	return circle.GetTotalParticipants() >= MinMembersForRecommendation
 }
+
+// Circle related errors
+type CircleNotFoundError struct {
+	ID string
+}
+
+func (e CircleNotFoundError) Error() string {
+	return "circle not found: " + e.ID
+}
+
+func (e CircleNotFoundError) HTTPStatus() int {
+	return http.StatusNotFound
+}
+
+type CircleAlreadyExistsError struct {
+	Name string
+}
+
+func (e CircleAlreadyExistsError) Error() string {
+	return "circle name already exists: " + e.Name
+}
+
+func (e CircleAlreadyExistsError) HTTPStatus() int {
+	return http.StatusConflict
+}
+
+type InvalidCircleIDError struct {
+	Value string
+}
+
+func (e InvalidCircleIDError) Error() string {
+	return "invalid circle ID: " + e.Value
+}
+
+func (e InvalidCircleIDError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
+
+type CircleFullError struct {
+	MaxParticipants int
+}
+
+func (e CircleFullError) Error() string {
+	return fmt.Sprintf("circle is full: maximum %d participants (including owner) allowed", e.MaxParticipants)
+}
+
+func (e CircleFullError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
+
+type InvalidCircleCapacityError struct {
+	Value int
+}
+
+func (e InvalidCircleCapacityError) Error() string {
+	return fmt.Sprintf("invalid circle capacity: %d", e.Value)
+}
+
+func (e InvalidCircleCapacityError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
+
+type InvalidCircleDuesError struct {
+	Reason string
+}
+
+func (e InvalidCircleDuesError) Error() string {
+	return "invalid circle dues: " + e.Reason
+}
+
+func (e InvalidCircleDuesError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
@@ -0,0 +1,406 @@
+package domain
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CircleEventID struct {
+	value string
+}
+
+func NewCircleEventID() *CircleEventID {
+	return &CircleEventID{value: uuid.New().String()}
+}
+
+func ReconstructCircleEventID(value string) (*CircleEventID, error) {
+	if value == "" {
+		return nil, EmptyFieldError{Field: "event ID"}
+	}
+	if _, err := uuid.Parse(value); err != nil {
+		return nil, InvalidCircleEventError{Reason: "invalid event ID: " + value}
+	}
+	return &CircleEventID{value: value}, nil
+}
+
+func (e *CircleEventID) Value() string {
+	return e.value
+}
+
+func (e *CircleEventID) Equals(other *CircleEventID) bool {
+	if other == nil {
+		return false
+	}
+	return e.value == other.value
+}
+
+func (e *CircleEventID) String() string {
+	return e.value
+}
+
+// EventSchedule 値オブジェクト - イベントの開始・終了時刻
+type EventSchedule struct {
+	startsAt time.Time
+	endsAt   time.Time
+}
+
+func NewEventSchedule(startsAt, endsAt time.Time) (*EventSchedule, error) {
+	if startsAt.IsZero() {
+		return nil, EmptyFieldError{Field: "event start"}
+	}
+	if endsAt.IsZero() {
+		return nil, EmptyFieldError{Field: "event end"}
+	}
+	if !endsAt.After(startsAt) {
+		return nil, InvalidCircleEventError{Reason: "event must end after it starts"}
+	}
+	return &EventSchedule{startsAt: startsAt, endsAt: endsAt}, nil
+}
+
+func (s *EventSchedule) StartsAt() time.Time {
+	return s.startsAt
+}
+
+func (s *EventSchedule) EndsAt() time.Time {
+	return s.endsAt
+}
+
+// HasEndedAt は指定時刻にイベントが終了しているかを返す
+func (s *EventSchedule) HasEndedAt(now time.Time) bool {
+	return !now.Before(s.endsAt)
+}
+
+func (s *EventSchedule) Equals(other *EventSchedule) bool {
+	if other == nil {
+		return false
+	}
+	return s.startsAt.Equal(other.startsAt) && s.endsAt.Equal(other.endsAt)
+}
+
+// RSVPStatus - 出欠の回答
+type RSVPStatus string
+
+const (
+	RSVPGoing    RSVPStatus = "going"
+	RSVPMaybe    RSVPStatus = "maybe"
+	RSVPDeclined RSVPStatus = "declined"
+)
+
+func ParseRSVPStatus(value string) (RSVPStatus, error) {
+	status := RSVPStatus(value)
+	switch status {
+	case RSVPGoing, RSVPMaybe, RSVPDeclined:
+		return status, nil
+	}
+	if value == "" {
+		return "", EmptyFieldError{Field: "RSVP status"}
+	}
+	return "", InvalidCircleEventError{Reason: "unknown RSVP status: " + value}
+}
+
+func (s RSVPStatus) String() string {
+	return string(s)
+}
+
+// RSVP - 参加者1人の出欠の回答
+type RSVP struct {
+	userID      *UserID
+	status      RSVPStatus
+	respondedAt time.Time
+}
+
+func ReconstructRSVP(userID *UserID, status RSVPStatus, respondedAt time.Time) *RSVP {
+	return &RSVP{userID: userID, status: status, respondedAt: respondedAt}
+}
+
+func (r *RSVP) UserID() *UserID {
+	return r.userID
+}
+
+func (r *RSVP) Status() RSVPStatus {
+	return r.status
+}
+
+func (r *RSVP) RespondedAt() time.Time {
+	return r.respondedAt
+}
+
+// CircleEvent - サークルの集まり（集約ルート）
+// 出欠はサークルの参加者のみ回答でき、「参加」の人数は定員を超えない
+type CircleEvent struct {
+	id          *CircleEventID
+	circleID    *CircleID
+	title       string
+	schedule    *EventSchedule
+	location    string
+	capacity    int // 0は定員なし
+	rsvps       []*RSVP
+	createdAt   time.Time
+	cancelledAt time.Time // ゼロ値は開催予定
+}
+
+func NewCircleEvent(circle *Circle, title string, schedule *EventSchedule, location string, capacity int, now time.Time) (*CircleEvent, error) {
+	if circle == nil {
+		return nil, EmptyFieldError{Field: "circle"}
+	}
+	event := &CircleEvent{
+		id:        NewCircleEventID(),
+		circleID:  circle.ID(),
+		createdAt: now,
+	}
+	if err := event.apply(title, schedule, location, capacity); err != nil {
+		return nil, err
+	}
+	if schedule.HasEndedAt(now) {
+		return nil, InvalidCircleEventError{Reason: "event has already ended"}
+	}
+	return event, nil
+}
+
+func ReconstructCircleEvent(
+	id *CircleEventID,
+	circleID *CircleID,
+	title string,
+	schedule *EventSchedule,
+	location string,
+	capacity int,
+	rsvps []*RSVP,
+	createdAt time.Time,
+	cancelledAt time.Time,
+) *CircleEvent {
+	return &CircleEvent{
+		id:          id,
+		circleID:    circleID,
+		title:       title,
+		schedule:    schedule,
+		location:    location,
+		capacity:    capacity,
+		rsvps:       rsvps,
+		createdAt:   createdAt,
+		cancelledAt: cancelledAt,
+	}
+}
+
+func (e *CircleEvent) ID() *CircleEventID {
+	return e.id
+}
+
+func (e *CircleEvent) CircleID() *CircleID {
+	return e.circleID
+}
+
+func (e *CircleEvent) Title() string {
+	return e.title
+}
+
+func (e *CircleEvent) Schedule() *EventSchedule {
+	return e.schedule
+}
+
+func (e *CircleEvent) Location() string {
+	return e.location
+}
+
+func (e *CircleEvent) Capacity() int {
+	return e.capacity
+}
+
+func (e *CircleEvent) HasCapacity() bool {
+	return e.capacity > 0
+}
+
+func (e *CircleEvent) RSVPs() []*RSVP {
+	// 防御的コピーを返す
+	rsvps := make([]*RSVP, len(e.rsvps))
+	copy(rsvps, e.rsvps)
+	return rsvps
+}
+
+func (e *CircleEvent) CreatedAt() time.Time {
+	return e.createdAt
+}
+
+func (e *CircleEvent) CancelledAt() time.Time {
+	return e.cancelledAt
+}
+
+func (e *CircleEvent) IsCancelled() bool {
+	return !e.cancelledAt.IsZero()
+}
+
+// IsUpcomingAt は指定時刻にイベントが終了していないかを返す（取り消し済みも含む）
+func (e *CircleEvent) IsUpcomingAt(now time.Time) bool {
+	return !e.schedule.HasEndedAt(now)
+}
+
+// GoingCount は「参加」と回答した人数を返す
+func (e *CircleEvent) GoingCount() int {
+	count := 0
+	for _, rsvp := range e.rsvps {
+		if rsvp.status == RSVPGoing {
+			count++
+		}
+	}
+	return count
+}
+
+// RSVPOf は参加者の回答を返す（未回答の場合はnil）
+func (e *CircleEvent) RSVPOf(userID *UserID) *RSVP {
+	for _, rsvp := range e.rsvps {
+		if rsvp.userID.Equals(userID) {
+			return rsvp
+		}
+	}
+	return nil
+}
+
+// Update はイベントの内容を変更する
+// 定員は「参加」と回答済みの人数より少なくできない
+func (e *CircleEvent) Update(title string, schedule *EventSchedule, location string, capacity int, now time.Time) error {
+	if err := e.ensureOpen(now); err != nil {
+		return err
+	}
+	if capacity > 0 && capacity < e.GoingCount() {
+		return InvalidCircleEventError{
+			Reason: "capacity " + strconv.Itoa(capacity) + " is below the " + strconv.Itoa(e.GoingCount()) + " members already going",
+		}
+	}
+	if schedule != nil && schedule.HasEndedAt(now) {
+		return InvalidCircleEventError{Reason: "event cannot be moved into the past"}
+	}
+	return e.apply(title, schedule, location, capacity)
+}
+
+// Cancel はイベントを取り消す（回答は記録として残す）
+func (e *CircleEvent) Cancel(now time.Time) error {
+	if err := e.ensureOpen(now); err != nil {
+		return err
+	}
+	e.cancelledAt = now
+	return nil
+}
+
+// RespondRSVP は参加者の出欠を記録する（回答済みの場合は上書き）
+func (e *CircleEvent) RespondRSVP(circle *Circle, userID *UserID, status RSVPStatus, now time.Time) error {
+	if circle == nil || !circle.ID().Equals(e.circleID) {
+		return InvalidCircleEventError{Reason: "event does not belong to the circle"}
+	}
+	if userID == nil {
+		return EmptyFieldError{Field: "user"}
+	}
+	if !circle.IsParticipant(userID) {
+		return NotCircleParticipantError{UserID: userID.Value()}
+	}
+	if err := e.ensureOpen(now); err != nil {
+		return err
+	}
+
+	current := e.RSVPOf(userID)
+	alreadyGoing := current != nil && current.status == RSVPGoing
+	if status == RSVPGoing && !alreadyGoing && e.HasCapacity() && e.GoingCount() >= e.capacity {
+		return CircleEventFullError{EventID: e.id.Value(), Capacity: e.capacity}
+	}
+
+	if current != nil {
+		current.status = status
+		current.respondedAt = now
+		return nil
+	}
+	e.rsvps = append(e.rsvps, &RSVP{userID: userID, status: status, respondedAt: now})
+	return nil
+}
+
+// ensureOpen は取り消し済み・終了済みのイベントへの変更を防ぐ
+func (e *CircleEvent) ensureOpen(now time.Time) error {
+	if e.IsCancelled() {
+		return CircleEventClosedError{EventID: e.id.Value(), Reason: "cancelled"}
+	}
+	if e.schedule.HasEndedAt(now) {
+		return CircleEventClosedError{EventID: e.id.Value(), Reason: "ended"}
+	}
+	return nil
+}
+
+func (e *CircleEvent) apply(title string, schedule *EventSchedule, location string, capacity int) error {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return EmptyFieldError{Field: "event title"}
+	}
+	if len([]rune(title)) > 100 {
+		return InvalidCircleEventError{Reason: "title must be 100 characters or less"}
+	}
+	if schedule == nil {
+		return EmptyFieldError{Field: "event schedule"}
+	}
+	if capacity < 0 {
+		return InvalidCircleEventError{Reason: "capacity must not be negative"}
+	}
+
+	e.title = title
+	e.schedule = schedule
+	e.location = strings.TrimSpace(location)
+	e.capacity = capacity
+	return nil
+}
+
+func (e *CircleEvent) Equals(other *CircleEvent) bool {
+	if other == nil {
+		return false
+	}
+	return e.id.Equals(other.id)
+}
+
+// Circle event related errors
+type InvalidCircleEventError struct {
+	Reason string
+}
+
+func (e InvalidCircleEventError) Error() string {
+	return "invalid circle event: " + e.Reason
+}
+
+func (e InvalidCircleEventError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
+
+type CircleEventNotFoundError struct {
+	ID string
+}
+
+func (e CircleEventNotFoundError) Error() string {
+	return "circle event not found: " + e.ID
+}
+
+func (e CircleEventNotFoundError) HTTPStatus() int {
+	return http.StatusNotFound
+}
+
+type CircleEventFullError struct {
+	EventID  string
+	Capacity int
+}
+
+func (e CircleEventFullError) Error() string {
+	return "circle event is full: " + e.EventID + " (capacity " + strconv.Itoa(e.Capacity) + ")"
+}
+
+func (e CircleEventFullError) HTTPStatus() int {
+	return http.StatusConflict
+}
+
+type CircleEventClosedError struct {
+	EventID string
+	Reason  string
+}
+
+func (e CircleEventClosedError) Error() string {
+	return "circle event is " + e.Reason + ": " + e.EventID
+}
+
+func (e CircleEventClosedError) HTTPStatus() int {
+	return http.StatusConflict
+}
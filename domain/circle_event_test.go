@@ -0,0 +1,218 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+var testEventNow = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+// テスト用ヘルパー：testEventNow の1日後に2時間のイベントを作成する
+func newTestCircleEvent(t *testing.T, circle *Circle, capacity int) *CircleEvent {
+	t.Helper()
+
+	schedule := mustSchedule(t, testEventNow.Add(24*time.Hour), testEventNow.Add(26*time.Hour))
+	event, err := NewCircleEvent(circle, "土曜の練習会", schedule, "市民体育館", capacity, testEventNow)
+	if err != nil {
+		t.Fatalf("Failed to create event: %v", err)
+	}
+	return event
+}
+
+func mustSchedule(t *testing.T, startsAt, endsAt time.Time) *EventSchedule {
+	t.Helper()
+
+	schedule, err := NewEventSchedule(startsAt, endsAt)
+	if err != nil {
+		t.Fatalf("Failed to create schedule: %v", err)
+	}
+	return schedule
+}
+
+func TestNewCircleEvent_InvalidInput_ReturnsError(t *testing.T) {
+	circle, _ := newTestExpenseCircle(t, 1)
+	future := mustSchedule(t, testEventNow.Add(time.Hour), testEventNow.Add(2*time.Hour))
+	past := mustSchedule(t, testEventNow.Add(-2*time.Hour), testEventNow.Add(-time.Hour))
+
+	tests := []struct {
+		name     string
+		title    string
+		schedule *EventSchedule
+		capacity int
+	}{
+		{"タイトルが空", "  ", future, 0},
+		{"日程がない", "練習会", nil, 0},
+		{"定員が負", "練習会", future, -1},
+		{"終了済みの日程", "練習会", past, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			event, err := NewCircleEvent(circle, tt.title, tt.schedule, "", tt.capacity, testEventNow)
+
+			// Assert
+			if err == nil {
+				t.Fatalf("Expected error, but got event %v", event)
+			}
+		})
+	}
+}
+
+func TestNewEventSchedule_EndBeforeStart_ReturnsError(t *testing.T) {
+	// Act
+	_, err := NewEventSchedule(testEventNow, testEventNow)
+
+	// Assert
+	var invalid InvalidCircleEventError
+	if !errors.As(err, &invalid) {
+		t.Fatalf("Expected InvalidCircleEventError, but got %v", err)
+	}
+}
+
+func TestCircleEvent_RespondRSVP_CapacityAndChanges(t *testing.T) {
+	circle, participants := newTestExpenseCircle(t, 2)
+
+	tests := []struct {
+		name          string
+		responses     []RSVPStatus // participants[i] が順に回答する
+		expectedGoing int
+		expectFull    bool
+	}{
+		{"定員内の参加", []RSVPStatus{RSVPGoing, RSVPGoing}, 2, false},
+		{"定員を超える参加", []RSVPStatus{RSVPGoing, RSVPGoing, RSVPGoing}, 2, true},
+		{"未定・不参加は定員に数えない", []RSVPStatus{RSVPGoing, RSVPMaybe, RSVPDeclined}, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			event := newTestCircleEvent(t, circle, 2)
+
+			// Act
+			var lastErr error
+			for i, status := range tt.responses {
+				lastErr = event.RespondRSVP(circle, participants[i], status, testEventNow)
+			}
+
+			// Assert
+			var full CircleEventFullError
+			if tt.expectFull != errors.As(lastErr, &full) {
+				t.Fatalf("Expected full error = %v, but got %v", tt.expectFull, lastErr)
+			}
+			if event.GoingCount() != tt.expectedGoing {
+				t.Errorf("Expected %d going, but got %d", tt.expectedGoing, event.GoingCount())
+			}
+		})
+	}
+}
+
+func TestCircleEvent_RespondRSVP_ChangingAnswerFreesSeat(t *testing.T) {
+	// Arrange
+	circle, participants := newTestExpenseCircle(t, 1)
+	event := newTestCircleEvent(t, circle, 1)
+	if err := event.RespondRSVP(circle, participants[0], RSVPGoing, testEventNow); err != nil {
+		t.Fatalf("Failed to RSVP: %v", err)
+	}
+
+	// Act
+	declineErr := event.RespondRSVP(circle, participants[0], RSVPDeclined, testEventNow.Add(time.Minute))
+	goingErr := event.RespondRSVP(circle, participants[1], RSVPGoing, testEventNow.Add(2*time.Minute))
+
+	// Assert
+	if declineErr != nil || goingErr != nil {
+		t.Fatalf("Expected no error, but got %v / %v", declineErr, goingErr)
+	}
+	if len(event.RSVPs()) != 2 {
+		t.Errorf("Expected 2 RSVPs (answers are overwritten), but got %d", len(event.RSVPs()))
+	}
+	if event.RSVPOf(participants[0]).Status() != RSVPDeclined {
+		t.Errorf("Expected first participant to be declined, but got %s", event.RSVPOf(participants[0]).Status())
+	}
+}
+
+func TestCircleEvent_RespondRSVP_Rejected(t *testing.T) {
+	circle, participants := newTestExpenseCircle(t, 1)
+
+	tests := []struct {
+		name   string
+		setup  func(event *CircleEvent)
+		userID *UserID
+		now    time.Time
+		check  func(err error) bool
+	}{
+		{
+			"サークル外のユーザー", func(*CircleEvent) {}, NewUserID(), testEventNow,
+			func(err error) bool { var e NotCircleParticipantError; return errors.As(err, &e) },
+		},
+		{
+			"取り消し済み", func(event *CircleEvent) { event.Cancel(testEventNow) }, participants[0], testEventNow,
+			func(err error) bool { var e CircleEventClosedError; return errors.As(err, &e) },
+		},
+		{
+			"終了済み", func(*CircleEvent) {}, participants[0], testEventNow.Add(48 * time.Hour),
+			func(err error) bool { var e CircleEventClosedError; return errors.As(err, &e) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			event := newTestCircleEvent(t, circle, 0)
+			tt.setup(event)
+
+			// Act
+			err := event.RespondRSVP(circle, tt.userID, RSVPGoing, tt.now)
+
+			// Assert
+			if !tt.check(err) {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestCircleEvent_Update_CapacityBelowGoing_ReturnsError(t *testing.T) {
+	// Arrange
+	circle, participants := newTestExpenseCircle(t, 1)
+	event := newTestCircleEvent(t, circle, 0)
+	for _, participant := range participants {
+		if err := event.RespondRSVP(circle, participant, RSVPGoing, testEventNow); err != nil {
+			t.Fatalf("Failed to RSVP: %v", err)
+		}
+	}
+
+	// Act
+	err := event.Update("練習会", event.Schedule(), "", 1, testEventNow)
+
+	// Assert
+	var invalid InvalidCircleEventError
+	if !errors.As(err, &invalid) {
+		t.Fatalf("Expected InvalidCircleEventError, but got %v", err)
+	}
+	if event.Capacity() != 0 {
+		t.Errorf("Expected capacity to be unchanged, but got %d", event.Capacity())
+	}
+}
+
+func TestCircleEvent_Cancel_Twice_ReturnsError(t *testing.T) {
+	// Arrange
+	circle, _ := newTestExpenseCircle(t, 1)
+	event := newTestCircleEvent(t, circle, 0)
+	if err := event.Cancel(testEventNow); err != nil {
+		t.Fatalf("Failed to cancel: %v", err)
+	}
+
+	// Act
+	err := event.Cancel(testEventNow)
+
+	// Assert
+	var closed CircleEventClosedError
+	if !errors.As(err, &closed) {
+		t.Fatalf("Expected CircleEventClosedError, but got %v", err)
+	}
+	if !event.IsCancelled() {
+		t.Error("Expected event to be cancelled")
+	}
+}
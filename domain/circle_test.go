@@ -0,0 +1,252 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// テスト用ヘルパー：premiumCount人のプレミアムユーザーを含むメンバーを作成する
+func newTestMembers(t *testing.T, count, premiumCount int) []*User {
+	t.Helper()
+
+	members := make([]*User, 0, count)
+	for i := 0; i < count; i++ {
+		name, err := NewFullName(fmt.Sprintf("member%d", i), "test")
+		if err != nil {
+			t.Fatalf("Failed to create name: %v", err)
+		}
+		email, err := NewEmail(fmt.Sprintf("member%d@example.com", i))
+		if err != nil {
+			t.Fatalf("Failed to create email: %v", err)
+		}
+		members = append(members, ReconstructUser(NewUserID(), name, email, i < premiumCount))
+	}
+	return members
+}
+
+func newTestCircle(t *testing.T, owner *User, members []*User) *Circle {
+	t.Helper()
+
+	circleName, err := NewCircleName("テストサークル")
+	if err != nil {
+		t.Fatalf("Failed to create circle name: %v", err)
+	}
+	circle := NewCircle(circleName, owner.ID())
+	for _, member := range members {
+		// 定員チェックを迂回するためにテスト準備では直接追加する
+		circle.memberIDs = append(circle.memberIDs, member.ID())
+	}
+	return circle
+}
+
+func newTestOwner(t *testing.T, isPremium bool) *User {
+	t.Helper()
+
+	name, _ := NewFullName("オーナー", "テスト")
+	email, _ := NewEmail("owner@example.com")
+	if isPremium {
+		return ReconstructUser(NewUserID(), name, email, true)
+	}
+	return NewUser(name, email, time.Now())
+}
+
+// CircleCapacity tests
+func TestNewCircleCapacity_InvalidValue_ReturnsError(t *testing.T) {
+	for _, value := range []int{0, -1} {
+		capacity, err := NewCircleCapacity(value)
+		if err == nil {
+			t.Errorf("Expected error for capacity %d, but got none", value)
+		}
+		if capacity != nil {
+			t.Errorf("Expected nil capacity for %d", value)
+		}
+		if _, ok := err.(InvalidCircleCapacityError); !ok {
+			t.Errorf("Expected InvalidCircleCapacityError, but got %T", err)
+		}
+	}
+}
+
+// CircleMemberService tests
+func TestCircleMemberService_GetCapacity_PremiumBoundary(t *testing.T) {
+	tests := []struct {
+		name          string
+		ownerPremium  bool
+		premiumCount  int
+		expectedLimit int
+	}{
+		{"プレミアム0人は基本定員", false, 0, BasicMemberLimit},
+		{"プレミアム9人は基本定員", false, 9, BasicMemberLimit},
+		{"プレミアム10人で定員拡大", false, 10, PremiumMemberLimit},
+		{"オーナーを含めてプレミアム10人で定員拡大", true, 9, PremiumMemberLimit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner := newTestOwner(t, tt.ownerPremium)
+			members := newTestMembers(t, 20, tt.premiumCount)
+			circle := newTestCircle(t, owner, members)
+
+			capacity := NewCircleMemberService(nil).GetCapacity(circle, NewCircleMembers(owner, members, time.Now()))
+
+			if capacity.MaxParticipants() != tt.expectedLimit {
+				t.Errorf("Expected limit %d, but got %d", tt.expectedLimit, capacity.MaxParticipants())
+			}
+		})
+	}
+}
+
+// Circle capacity tests
+func TestCircle_AddMember_RespectsCapacityAtPremiumBoundary(t *testing.T) {
+	tests := []struct {
+		name         string
+		premiumCount int
+		expectError  bool
+	}{
+		// オーナー1名 + メンバー29名 = 30名
+		{"プレミアム9人では30名で満員", 9, true},
+		{"プレミアム10人では30名でも追加可能", 10, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner := newTestOwner(t, false)
+			members := newTestMembers(t, 29, tt.premiumCount)
+			circle := newTestCircle(t, owner, members)
+			capacity := NewCircleMemberService(nil).GetCapacity(circle, NewCircleMembers(owner, members, time.Now()))
+
+			err := circle.AddMember(NewUserID(), capacity, time.Now())
+
+			if tt.expectError {
+				if _, ok := err.(CircleFullError); !ok {
+					t.Errorf("Expected CircleFullError, but got %T: %v", err, err)
+				}
+				if circle.GetTotalParticipants() != 30 {
+					t.Errorf("Expected participants to stay 30, but got %d", circle.GetTotalParticipants())
+				}
+				if !circle.IsFull(capacity) {
+					t.Error("Expected circle to be full")
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error, but got: %v", err)
+				}
+				if circle.GetTotalParticipants() != 31 {
+					t.Errorf("Expected 31 participants, but got %d", circle.GetTotalParticipants())
+				}
+			}
+		})
+	}
+}
+
+func TestCircle_AddMember_PremiumLimitReached_ReturnsError(t *testing.T) {
+	owner := newTestOwner(t, true)
+	members := newTestMembers(t, 49, 10)
+	circle := newTestCircle(t, owner, members)
+	capacity := NewCircleMemberService(nil).GetCapacity(circle, NewCircleMembers(owner, members, time.Now()))
+
+	err := circle.AddMember(NewUserID(), capacity, time.Now())
+
+	if fullErr, ok := err.(CircleFullError); !ok {
+		t.Errorf("Expected CircleFullError, but got %T", err)
+	} else if fullErr.MaxParticipants != PremiumMemberLimit {
+		t.Errorf("Expected max participants %d, but got %d", PremiumMemberLimit, fullErr.MaxParticipants)
+	}
+}
+
+func TestCircle_GetAvailableSlots(t *testing.T) {
+	owner := newTestOwner(t, false)
+	members := newTestMembers(t, 9, 9)
+	circle := newTestCircle(t, owner, members)
+
+	basic, _ := NewCircleCapacity(BasicMemberLimit)
+	premium, _ := NewCircleCapacity(PremiumMemberLimit)
+	small, _ := NewCircleCapacity(5)
+
+	if slots := circle.GetAvailableSlots(basic); slots != 20 {
+		t.Errorf("Expected 20 slots, but got %d", slots)
+	}
+	if slots := circle.GetAvailableSlots(premium); slots != 40 {
+		t.Errorf("Expected 40 slots, but got %d", slots)
+	}
+	// 定員を超えている場合でも負数にはならない
+	if slots := circle.GetAvailableSlots(small); slots != 0 {
+		t.Errorf("Expected 0 slots, but got %d", slots)
+	}
+	if circle.CanAddMember(nil) {
+		t.Error("Expected CanAddMember to be false without capacity")
+	}
+}
+
+func TestCircle_DetachMember_ReattachMember(t *testing.T) {
+	// Arrange
+	owner := newTestOwner(t, false)
+	members := newTestMembers(t, 2, 0)
+	circle := newTestCircle(t, owner, members)
+	capacity, _ := NewCircleCapacity(BasicMemberLimit)
+	detached := members[0].ID()
+
+	// Act
+	wasMember := circle.DetachMember(detached, time.Now())
+
+	// Assert
+	if !wasMember || circle.IsMember(detached) || !circle.IsDetachedMember(detached) {
+		t.Fatal("Expected the member to be detached")
+	}
+	if circle.GetMemberCount() != 1 {
+		t.Errorf("Expected 1 member, but got %d", circle.GetMemberCount())
+	}
+
+	// Act
+	err := circle.ReattachMember(detached, capacity, time.Now())
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if !circle.IsMember(detached) || circle.IsDetachedMember(detached) {
+		t.Error("Expected the member to be reattached")
+	}
+}
+
+func TestCircle_ReattachMember_Full(t *testing.T) {
+	// Arrange
+	owner := newTestOwner(t, false)
+	members := newTestMembers(t, 2, 0)
+	circle := newTestCircle(t, owner, members)
+	detached := members[0].ID()
+	circle.DetachMember(detached, time.Now())
+	// 外している間に定員に達した
+	circle.memberIDs = append(circle.memberIDs, NewUserID())
+	capacity, _ := NewCircleCapacity(3)
+
+	// Act
+	err := circle.ReattachMember(detached, capacity, time.Now())
+
+	// Assert
+	var fullErr CircleFullError
+	if !errors.As(err, &fullErr) {
+		t.Fatalf("Expected CircleFullError, but got %v", err)
+	}
+	if circle.IsMember(detached) || circle.IsDetachedMember(detached) {
+		t.Error("Expected the detached record to be forgotten without reattaching")
+	}
+}
+
+func TestCircle_DetachMember_NotMember(t *testing.T) {
+	// Arrange
+	owner := newTestOwner(t, false)
+	circle := newTestCircle(t, owner, nil)
+	capacity, _ := NewCircleCapacity(BasicMemberLimit)
+	stranger := NewUserID()
+
+	// Act
+	wasMember := circle.DetachMember(stranger, time.Now())
+	err := circle.ReattachMember(stranger, capacity, time.Now())
+
+	// Assert
+	if wasMember || err != nil || circle.IsMember(stranger) {
+		t.Errorf("Expected nothing to change for a non-member, but got detached=%v err=%v", wasMember, err)
+	}
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock - 現在時刻の取得を抽象化する（テストで時刻を固定・進められるようにする）
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock - システム時刻を返す Clock
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// FixedClock - 任意の時刻を返す Clock（テスト用）
+type FixedClock struct {
+	now time.Time
+	mu  sync.RWMutex
+}
+
+func NewFixedClock(now time.Time) *FixedClock {
+	return &FixedClock{now: now}
+}
+
+func (c *FixedClock) Now() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.now
+}
+
+func (c *FixedClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = now
+}
+
+func (c *FixedClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
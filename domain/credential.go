@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"net/http"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	MinPasswordLength = 8
+	MaxPasswordLength = 128 // ハッシュ計算の負荷を抑えるための上限
+)
+
+// Password 値オブジェクト - 設定する新しいパスワード
+// ログイン時に入力されたパスワードには長さの制約を課さない
+type Password struct {
+	value string
+}
+
+func NewPassword(value string) (*Password, error) {
+	length := utf8.RuneCountInString(value)
+	if length < MinPasswordLength {
+		return nil, InvalidPasswordError{Reason: "password must be at least 8 characters"}
+	}
+	if length > MaxPasswordLength {
+		return nil, InvalidPasswordError{Reason: "password must be at most 128 characters"}
+	}
+	return &Password{value: value}, nil
+}
+
+func (p *Password) Value() string {
+	return p.value
+}
+
+// String はログなどに平文が出ないよう伏せ字を返す
+func (p *Password) String() string {
+	return "********"
+}
+
+// PasswordHasher はパスワードのハッシュ化と照合を行う（ポート）
+// ハッシュにはソルトとパラメーターを含め、照合に他の情報を必要としない
+type PasswordHasher interface {
+	Hash(password *Password) (string, error)
+	Verify(hash, password string) (bool, error)
+}
+
+// Credential - ユーザーのパスワードによる認証情報
+// パスワードを設定していないユーザーは Credential を持たない
+type Credential struct {
+	userID       *UserID
+	passwordHash string
+	updatedAt    time.Time
+}
+
+func NewCredential(userID *UserID, passwordHash string, now time.Time) (*Credential, error) {
+	if userID == nil {
+		return nil, EmptyFieldError{Field: "user ID"}
+	}
+	if passwordHash == "" {
+		return nil, EmptyFieldError{Field: "password hash"}
+	}
+	return &Credential{userID: userID, passwordHash: passwordHash, updatedAt: now}, nil
+}
+
+func ReconstructCredential(userID *UserID, passwordHash string, updatedAt time.Time) *Credential {
+	return &Credential{userID: userID, passwordHash: passwordHash, updatedAt: updatedAt}
+}
+
+func (c *Credential) UserID() *UserID {
+	return c.userID
+}
+
+func (c *Credential) PasswordHash() string {
+	return c.passwordHash
+}
+
+func (c *Credential) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+type InvalidPasswordError struct {
+	Reason string
+}
+
+func (e InvalidPasswordError) Error() string {
+	return "invalid password: " + e.Reason
+}
+
+func (e InvalidPasswordError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
+
+// InvalidCredentialsError はメールアドレスとパスワードの組み合わせが誤っている場合に返す
+// どちらが誤っているかは区別しない
+type InvalidCredentialsError struct{}
+
+func (e InvalidCredentialsError) Error() string {
+	return "invalid email or password"
+}
+
+func (e InvalidCredentialsError) HTTPStatus() int {
+	return http.StatusUnauthorized
+}
+
+// UnauthenticatedError は認証が必要な操作を匿名で行おうとした場合に返す
+type UnauthenticatedError struct {
+	Reason string
+}
+
+func (e UnauthenticatedError) Error() string {
+	if e.Reason == "" {
+		return "authentication required"
+	}
+	return "authentication required: " + e.Reason
+}
+
+func (e UnauthenticatedError) HTTPStatus() int {
+	return http.StatusUnauthorized
+}
+
+// ForbiddenError は認証済みでも許可されていない操作で返す
+type ForbiddenError struct {
+	Reason string
+}
+
+func (e ForbiddenError) Error() string {
+	return "forbidden: " + e.Reason
+}
+
+func (e ForbiddenError) HTTPStatus() int {
+	return http.StatusForbidden
+}
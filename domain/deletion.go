@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"net/http"
+	"time"
+)
+
+const (
+	DefaultDeletionGracePeriod = 30 * 24 * time.Hour
+	DefaultDeletionRetention   = 90 * 24 * time.Hour
+)
+
+// DeletionPolicy - 論理削除したユーザー・サークルを復元できる期間と、物理削除するまでの保持期間
+type DeletionPolicy struct {
+	gracePeriod time.Duration
+	retention   time.Duration
+}
+
+// NewDeletionPolicy は復元できる期間より短い保持期間を拒否する（復元できるうちに物理削除しない）
+func NewDeletionPolicy(gracePeriod, retention time.Duration) (DeletionPolicy, error) {
+	if gracePeriod <= 0 {
+		return DeletionPolicy{}, InvalidDeletionPolicyError{Reason: "grace period must be positive"}
+	}
+	if retention < gracePeriod {
+		return DeletionPolicy{}, InvalidDeletionPolicyError{Reason: "retention must not be shorter than the grace period"}
+	}
+	return DeletionPolicy{gracePeriod: gracePeriod, retention: retention}, nil
+}
+
+func DefaultDeletionPolicy() DeletionPolicy {
+	return DeletionPolicy{gracePeriod: DefaultDeletionGracePeriod, retention: DefaultDeletionRetention}
+}
+
+func (p DeletionPolicy) GracePeriod() time.Duration {
+	return p.gracePeriod
+}
+
+func (p DeletionPolicy) Retention() time.Duration {
+	return p.retention
+}
+
+// RestoreDeadline は deletedAt に削除したデータを復元できる期限（この時刻より前まで）を返す
+func (p DeletionPolicy) RestoreDeadline(deletedAt time.Time) time.Time {
+	return deletedAt.Add(p.gracePeriod)
+}
+
+// CanRestore は deletedAt に削除したデータを now に復元できるかを返す
+func (p DeletionPolicy) CanRestore(deletedAt, now time.Time) bool {
+	return now.Before(p.RestoreDeadline(deletedAt))
+}
+
+// PurgeCutoff はこの時刻より前に削除したデータを now に物理削除してよいことを表す
+func (p DeletionPolicy) PurgeCutoff(now time.Time) time.Time {
+	return now.Add(-p.retention)
+}
+
+type InvalidDeletionPolicyError struct {
+	Reason string
+}
+
+func (e InvalidDeletionPolicyError) Error() string {
+	return "invalid deletion policy: " + e.Reason
+}
+
+func (e InvalidDeletionPolicyError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
+
+type AlreadyDeletedError struct {
+	ID string
+}
+
+func (e AlreadyDeletedError) Error() string {
+	return "already deleted: " + e.ID
+}
+
+func (e AlreadyDeletedError) HTTPStatus() int {
+	return http.StatusConflict
+}
+
+// RestorePeriodExpiredError - 復元できる期間を過ぎた
+type RestorePeriodExpiredError struct {
+	ID       string
+	Deadline time.Time
+}
+
+func (e RestorePeriodExpiredError) Error() string {
+	return "restore period expired for " + e.ID + " at " + e.Deadline.UTC().Format(time.RFC3339)
+}
+
+func (e RestorePeriodExpiredError) HTTPStatus() int {
+	return http.StatusGone
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewDeletionPolicy_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		gracePeriod time.Duration
+		retention   time.Duration
+	}{
+		{"復元できる期間がゼロ", 0, time.Hour},
+		{"保持期間が復元できる期間より短い", 2 * time.Hour, time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := NewDeletionPolicy(tt.gracePeriod, tt.retention)
+
+			// Assert
+			var policyErr InvalidDeletionPolicyError
+			if !errors.As(err, &policyErr) {
+				t.Errorf("Expected InvalidDeletionPolicyError, but got %v", err)
+			}
+		})
+	}
+}
+
+func TestDeletionPolicy_CanRestore(t *testing.T) {
+	deletedAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	policy, _ := NewDeletionPolicy(24*time.Hour, 48*time.Hour)
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want bool
+	}{
+		{"削除した直後", deletedAt, true},
+		{"期限の直前", deletedAt.Add(24*time.Hour - time.Nanosecond), true},
+		{"期限ちょうどは復元できない", deletedAt.Add(24 * time.Hour), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got := policy.CanRestore(deletedAt, tt.now)
+
+			// Assert
+			if got != tt.want {
+				t.Errorf("Expected %v, but got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDeletionPolicy_PurgeCutoff(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	policy, _ := NewDeletionPolicy(24*time.Hour, 48*time.Hour)
+
+	// Act
+	cutoff := policy.PurgeCutoff(now)
+
+	// Assert
+	if want := now.Add(-48 * time.Hour); !cutoff.Equal(want) {
+		t.Errorf("Expected %v, but got %v", want, cutoff)
+	}
+}
+
+func TestUser_MarkDeleted_Twice(t *testing.T) {
+	// Arrange
+	user := newTestOwner(t, false)
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	if err := user.MarkDeleted(now); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Act
+	err := user.MarkDeleted(now.Add(time.Hour))
+
+	// Assert
+	var deletedErr AlreadyDeletedError
+	if !errors.As(err, &deletedErr) {
+		t.Errorf("Expected AlreadyDeletedError, but got %v", err)
+	}
+	if !user.DeletedAt().Equal(now) {
+		t.Errorf("Expected deletedAt to stay %v, but got %v", now, user.DeletedAt())
+	}
+}
@@ -0,0 +1,182 @@
+package domain
+
+import (
+	"time"
+)
+
+// DomainEvent は集約で起きた出来事
+// 集約は変更時にイベントを記録し、保存に成功した後にまとめて配信される
+type DomainEvent interface {
+	EventName() string
+	OccurredAt() time.Time
+}
+
+// EventPublisher はドメインイベントの配信先（ポート）
+type EventPublisher interface {
+	Publish(events ...DomainEvent) error
+}
+
+// eventRecorder は集約が記録したイベントを保持する（集約に埋め込んで使う）
+type eventRecorder struct {
+	events []DomainEvent
+}
+
+func (r *eventRecorder) record(event DomainEvent) {
+	r.events = append(r.events, event)
+}
+
+// PendingEvents は記録済みで未配信のイベントを返す
+func (r *eventRecorder) PendingEvents() []DomainEvent {
+	events := make([]DomainEvent, len(r.events))
+	copy(events, r.events)
+	return events
+}
+
+// ClearEvents は保存に成功した後に記録を空にする
+func (r *eventRecorder) ClearEvents() {
+	r.events = nil
+}
+
+type eventMeta struct {
+	occurredAt time.Time
+}
+
+func (m eventMeta) OccurredAt() time.Time {
+	return m.occurredAt
+}
+
+// User events
+type UserRegistered struct {
+	eventMeta
+	UserID *UserID
+	Name   *FullName
+	Email  *Email
+}
+
+func NewUserRegistered(userID *UserID, name *FullName, email *Email, occurredAt time.Time) UserRegistered {
+	return UserRegistered{eventMeta: eventMeta{occurredAt}, UserID: userID, Name: name, Email: email}
+}
+
+func (UserRegistered) EventName() string {
+	return "user.registered"
+}
+
+type UserRenamed struct {
+	eventMeta
+	UserID  *UserID
+	OldName *FullName
+	NewName *FullName
+}
+
+func NewUserRenamed(userID *UserID, oldName, newName *FullName, occurredAt time.Time) UserRenamed {
+	return UserRenamed{eventMeta: eventMeta{occurredAt}, UserID: userID, OldName: oldName, NewName: newName}
+}
+
+func (UserRenamed) EventName() string {
+	return "user.renamed"
+}
+
+type UserEmailChanged struct {
+	eventMeta
+	UserID   *UserID
+	OldEmail *Email
+	NewEmail *Email
+}
+
+func NewUserEmailChanged(userID *UserID, oldEmail, newEmail *Email, occurredAt time.Time) UserEmailChanged {
+	return UserEmailChanged{eventMeta: eventMeta{occurredAt}, UserID: userID, OldEmail: oldEmail, NewEmail: newEmail}
+}
+
+func (UserEmailChanged) EventName() string {
+	return "user.email_changed"
+}
+
+// EmailVerificationRequested は登録時・メールアドレスの変更時に記録する
+// 購読側が確認用トークンを発行して Email 宛てに送る
+type EmailVerificationRequested struct {
+	eventMeta
+	UserID *UserID
+	Email  *Email
+}
+
+func NewEmailVerificationRequested(userID *UserID, email *Email, occurredAt time.Time) EmailVerificationRequested {
+	return EmailVerificationRequested{eventMeta: eventMeta{occurredAt}, UserID: userID, Email: email}
+}
+
+func (EmailVerificationRequested) EventName() string {
+	return "user.email_verification_requested"
+}
+
+type UserEmailVerified struct {
+	eventMeta
+	UserID *UserID
+	Email  *Email
+}
+
+func NewUserEmailVerified(userID *UserID, email *Email, occurredAt time.Time) UserEmailVerified {
+	return UserEmailVerified{eventMeta: eventMeta{occurredAt}, UserID: userID, Email: email}
+}
+
+func (UserEmailVerified) EventName() string {
+	return "user.email_verified"
+}
+
+// Circle events
+type CircleCreated struct {
+	eventMeta
+	CircleID *CircleID
+	Name     *CircleName
+	OwnerID  *UserID
+}
+
+func NewCircleCreated(circleID *CircleID, name *CircleName, ownerID *UserID, occurredAt time.Time) CircleCreated {
+	return CircleCreated{eventMeta: eventMeta{occurredAt}, CircleID: circleID, Name: name, OwnerID: ownerID}
+}
+
+func (CircleCreated) EventName() string {
+	return "circle.created"
+}
+
+type MemberJoined struct {
+	eventMeta
+	CircleID *CircleID
+	UserID   *UserID
+}
+
+func NewMemberJoined(circleID *CircleID, userID *UserID, occurredAt time.Time) MemberJoined {
+	return MemberJoined{eventMeta: eventMeta{occurredAt}, CircleID: circleID, UserID: userID}
+}
+
+func (MemberJoined) EventName() string {
+	return "circle.member_joined"
+}
+
+type MemberLeft struct {
+	eventMeta
+	CircleID *CircleID
+	UserID   *UserID
+}
+
+func NewMemberLeft(circleID *CircleID, userID *UserID, occurredAt time.Time) MemberLeft {
+	return MemberLeft{eventMeta: eventMeta{occurredAt}, CircleID: circleID, UserID: userID}
+}
+
+func (MemberLeft) EventName() string {
+	return "circle.member_left"
+}
+
+// CircleFilled はメンバーの追加で定員に達したときに記録する
+type CircleFilled struct {
+	eventMeta
+	CircleID        *CircleID
+	OwnerID         *UserID
+	MaxParticipants int
+}
+
+func NewCircleFilled(circleID *CircleID, ownerID *UserID, maxParticipants int, occurredAt time.Time) CircleFilled {
+	return CircleFilled{eventMeta: eventMeta{occurredAt}, CircleID: circleID, OwnerID: ownerID, MaxParticipants: maxParticipants}
+}
+
+func (CircleFilled) EventName() string {
+	return "circle.filled"
+}
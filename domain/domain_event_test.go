@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func eventNames(events []DomainEvent) []string {
+	names := make([]string, 0, len(events))
+	for _, event := range events {
+		names = append(names, event.EventName())
+	}
+	return names
+}
+
+func assertEventNames(t *testing.T, events []DomainEvent, expected ...string) {
+	t.Helper()
+
+	names := eventNames(events)
+	if len(names) != len(expected) {
+		t.Fatalf("Expected events %v, but got %v", expected, names)
+	}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Errorf("Expected events %v, but got %v", expected, names)
+			return
+		}
+	}
+}
+
+func TestUser_Events_RecordedOnlyForActualChanges(t *testing.T) {
+	// Arrange
+	user := newTestOwner(t, false)
+	sameName, _ := NewFullName("オーナー", "テスト")
+	newName, _ := NewFullName("花子", "テスト")
+	newEmail, _ := NewEmail("hanako@example.com")
+
+	// Act
+	user.ChangeName(sameName, time.Now())
+	user.ChangeName(newName, time.Now())
+	user.RequestEmailChange(newEmail, time.Now())
+	events := user.PendingEvents()
+
+	// Assert
+	assertEventNames(t, events,
+		"user.registered", "user.email_verification_requested", "user.renamed",
+		"user.email_changed", "user.email_verification_requested")
+	changed := events[3].(UserEmailChanged)
+	if changed.OldEmail.Value() != "owner@example.com" || !changed.NewEmail.Equals(newEmail) {
+		t.Errorf("Unexpected email change: %v -> %v", changed.OldEmail, changed.NewEmail)
+	}
+	user.ClearEvents()
+	if len(user.PendingEvents()) != 0 {
+		t.Error("Expected events to be cleared after ClearEvents")
+	}
+}
+
+func TestReconstructUser_RecordsNoEvents(t *testing.T) {
+	// Arrange
+	name, _ := NewFullName("太郎", "山田")
+	email, _ := NewEmail("taro@example.com")
+
+	// Act
+	user := ReconstructUser(NewUserID(), name, email, false)
+
+	// Assert
+	if events := user.PendingEvents(); len(events) != 0 {
+		t.Errorf("Expected no events, but got %v", eventNames(events))
+	}
+}
+
+func TestCircle_Events_MembershipChanges(t *testing.T) {
+	// Arrange
+	owner := newTestOwner(t, false)
+	member := newTestMembers(t, 1, 0)[0]
+	circle := newTestCircle(t, owner, nil)
+	capacity, _ := NewCircleCapacity(30)
+	joinedAt := time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)
+	leftAt := joinedAt.Add(time.Hour)
+
+	// Act
+	if err := circle.AddMember(member.ID(), capacity, joinedAt); err != nil {
+		t.Fatalf("Failed to add member: %v", err)
+	}
+	circle.RemoveMember(member.ID(), leftAt)
+	circle.RemoveMember(member.ID(), leftAt) // メンバーでない場合は記録しない
+	events := circle.PendingEvents()
+
+	// Assert
+	assertEventNames(t, events, "circle.created", "circle.member_joined", "circle.member_left")
+	joined := events[1].(MemberJoined)
+	if !joined.CircleID.Equals(circle.ID()) || !joined.UserID.Equals(member.ID()) {
+		t.Errorf("Unexpected MemberJoined: %+v", joined)
+	}
+	// 発生時刻は呼び出し側の時計で決まる
+	if !joined.OccurredAt().Equal(joinedAt) || !events[2].OccurredAt().Equal(leftAt) {
+		t.Errorf("Expected events at %v and %v, but got %v and %v", joinedAt, leftAt, joined.OccurredAt(), events[2].OccurredAt())
+	}
+}
+
+func TestCircle_AddMember_ReachesCapacity_RecordsCircleFilled(t *testing.T) {
+	// Arrange
+	owner := newTestOwner(t, false)
+	members := newTestMembers(t, 2, 0)
+	circle := newTestCircle(t, owner, nil)
+	circle.ClearEvents()
+	capacity, _ := NewCircleCapacity(3)
+
+	// Act
+	for _, member := range members {
+		if err := circle.AddMember(member.ID(), capacity, time.Now()); err != nil {
+			t.Fatalf("Failed to add member: %v", err)
+		}
+	}
+	events := circle.PendingEvents()
+
+	// Assert
+	assertEventNames(t, events, "circle.member_joined", "circle.member_joined", "circle.filled")
+	filled := events[2].(CircleFilled)
+	if !filled.OwnerID.Equals(owner.ID()) || filled.MaxParticipants != 3 {
+		t.Errorf("Unexpected CircleFilled: %+v", filled)
+	}
+}
+
+func TestCircle_AddMember_Full_RecordsNoEvent(t *testing.T) {
+	// Arrange
+	owner := newTestOwner(t, false)
+	circle := newTestCircle(t, owner, nil)
+	circle.ClearEvents()
+	capacity, _ := NewCircleCapacity(1)
+
+	// Act
+	err := circle.AddMember(NewUserID(), capacity, time.Now())
+
+	// Assert
+	if err == nil {
+		t.Fatal("Expected CircleFullError, but got none")
+	}
+	if events := circle.PendingEvents(); len(events) != 0 {
+		t.Errorf("Expected no events, but got %v", eventNames(events))
+	}
+}
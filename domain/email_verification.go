@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"net/http"
+	"time"
+)
+
+// DefaultEmailVerificationTTL は確認用トークンの有効期間の初期値
+const DefaultEmailVerificationTTL = 24 * time.Hour
+
+// EmailVerificationToken 値オブジェクト - メールアドレスの所有を確認するためのトークンの内容
+// 改ざんを防ぐ署名は EmailVerificationTokenCodec が付ける
+type EmailVerificationToken struct {
+	userID    *UserID
+	email     *Email
+	expiresAt time.Time
+}
+
+func NewEmailVerificationToken(userID *UserID, email *Email, expiresAt time.Time) (*EmailVerificationToken, error) {
+	if userID == nil {
+		return nil, EmptyFieldError{Field: "user ID"}
+	}
+	if email == nil {
+		return nil, EmptyFieldError{Field: "email"}
+	}
+	return &EmailVerificationToken{userID: userID, email: email, expiresAt: expiresAt}, nil
+}
+
+func (t *EmailVerificationToken) UserID() *UserID {
+	return t.userID
+}
+
+func (t *EmailVerificationToken) Email() *Email {
+	return t.email
+}
+
+func (t *EmailVerificationToken) ExpiresAt() time.Time {
+	return t.expiresAt
+}
+
+func (t *EmailVerificationToken) IsExpiredAt(now time.Time) bool {
+	return !now.Before(t.expiresAt)
+}
+
+// EmailVerificationTokenCodec はトークンを署名付きの文字列にする（ポート）
+// Decode は署名が正しくない場合 InvalidEmailVerificationTokenError を返す
+type EmailVerificationTokenCodec interface {
+	Encode(token *EmailVerificationToken) (string, error)
+	Decode(value string) (*EmailVerificationToken, error)
+}
+
+type InvalidEmailVerificationTokenError struct {
+	Reason string
+}
+
+func (e InvalidEmailVerificationTokenError) Error() string {
+	return "invalid email verification token: " + e.Reason
+}
+
+func (e InvalidEmailVerificationTokenError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
+
+type EmailVerificationTokenExpiredError struct {
+	ExpiresAt time.Time
+}
+
+func (e EmailVerificationTokenExpiredError) Error() string {
+	return "email verification token expired at " + e.ExpiresAt.Format(time.RFC3339)
+}
+
+func (e EmailVerificationTokenExpiredError) HTTPStatus() int {
+	return http.StatusGone
+}
+
+// EmailNotVerifiedError はメールアドレスの確認が必要な操作で返す
+type EmailNotVerifiedError struct {
+	UserID string
+}
+
+func (e EmailNotVerifiedError) Error() string {
+	return "email address is not verified: " + e.UserID
+}
+
+func (e EmailNotVerifiedError) HTTPStatus() int {
+	return http.StatusForbidden
+}
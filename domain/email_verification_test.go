@@ -0,0 +1,180 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+var testVerificationNow = time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)
+
+func newVerifiedTestUser(t *testing.T) *User {
+	t.Helper()
+
+	name, _ := NewFullName("太郎", "山田")
+	email, _ := NewEmail("taro@example.com")
+	return ReconstructUserWithEmailVerification(NewUserID(), name, email, true, nil, nil)
+}
+
+func newTestVerificationToken(t *testing.T, user *User, email string, expiresAt time.Time) *EmailVerificationToken {
+	t.Helper()
+
+	value, _ := NewEmail(email)
+	token, err := NewEmailVerificationToken(user.ID(), value, expiresAt)
+	if err != nil {
+		t.Fatalf("Failed to create token: %v", err)
+	}
+	return token
+}
+
+func TestUser_RequestEmailChange_VerifiedEmail_KeepsOldEmailUntilConfirmed(t *testing.T) {
+	// Arrange
+	user := newVerifiedTestUser(t)
+	newEmail, _ := NewEmail("yamada@example.com")
+
+	// Act
+	user.RequestEmailChange(newEmail, testVerificationNow)
+
+	// Assert
+	if user.Email().Value() != "taro@example.com" || !user.IsEmailVerified() {
+		t.Errorf("Expected the verified email to stay active, but got %s (verified=%v)", user.Email(), user.IsEmailVerified())
+	}
+	if !newEmail.Equals(user.PendingEmail()) {
+		t.Errorf("Expected pending email %s, but got %v", newEmail, user.PendingEmail())
+	}
+	assertEventNames(t, user.PendingEvents(), "user.email_verification_requested")
+}
+
+func TestUser_RequestEmailChange_SameEmail_CancelsPendingChange(t *testing.T) {
+	// Arrange
+	user := newVerifiedTestUser(t)
+	newEmail, _ := NewEmail("yamada@example.com")
+	user.RequestEmailChange(newEmail, testVerificationNow)
+	user.ClearEvents()
+
+	// Act
+	user.RequestEmailChange(user.Email(), testVerificationNow)
+
+	// Assert
+	if user.PendingEmail() != nil {
+		t.Errorf("Expected the pending change to be cancelled, but got %v", user.PendingEmail())
+	}
+	if events := user.PendingEvents(); len(events) != 0 {
+		t.Errorf("Expected no events, but got %v", eventNames(events))
+	}
+}
+
+func TestUser_VerifyEmail(t *testing.T) {
+	expiresAt := testVerificationNow.Add(time.Hour)
+
+	tests := []struct {
+		name           string
+		pendingEmail   string // 空の場合は変更を依頼しない
+		verified       bool
+		tokenEmail     string
+		otherUser      bool
+		expiresAt      time.Time
+		expectedEmail  string
+		expectedEvents []string
+		checkErr       func(error) bool
+	}{
+		{
+			name:           "確認待ちのアドレスに切り替わる",
+			pendingEmail:   "yamada@example.com",
+			verified:       true,
+			tokenEmail:     "yamada@example.com",
+			expiresAt:      expiresAt,
+			expectedEmail:  "yamada@example.com",
+			expectedEvents: []string{"user.email_changed", "user.email_verified"},
+		},
+		{
+			name:           "登録時のアドレスを確認済みにする",
+			tokenEmail:     "taro@example.com",
+			expiresAt:      expiresAt,
+			expectedEmail:  "taro@example.com",
+			expectedEvents: []string{"user.email_verified"},
+		},
+		{
+			name:          "確認済みのアドレスは何もしない",
+			verified:      true,
+			tokenEmail:    "taro@example.com",
+			expiresAt:     expiresAt,
+			expectedEmail: "taro@example.com",
+		},
+		{
+			name:          "期限切れのトークン",
+			pendingEmail:  "yamada@example.com",
+			verified:      true,
+			tokenEmail:    "yamada@example.com",
+			expiresAt:     testVerificationNow,
+			expectedEmail: "taro@example.com",
+			checkErr: func(err error) bool {
+				var e EmailVerificationTokenExpiredError
+				return errors.As(err, &e)
+			},
+		},
+		{
+			name:          "取り消された変更のトークン",
+			verified:      true,
+			tokenEmail:    "yamada@example.com",
+			expiresAt:     expiresAt,
+			expectedEmail: "taro@example.com",
+			checkErr: func(err error) bool {
+				var e InvalidEmailVerificationTokenError
+				return errors.As(err, &e)
+			},
+		},
+		{
+			name:          "他のユーザーのトークン",
+			tokenEmail:    "taro@example.com",
+			otherUser:     true,
+			expiresAt:     expiresAt,
+			expectedEmail: "taro@example.com",
+			checkErr: func(err error) bool {
+				var e InvalidEmailVerificationTokenError
+				return errors.As(err, &e)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			name, _ := NewFullName("太郎", "山田")
+			email, _ := NewEmail("taro@example.com")
+			user := ReconstructUserWithEmailVerification(NewUserID(), name, email, tt.verified, nil, nil)
+			if tt.pendingEmail != "" {
+				pending, _ := NewEmail(tt.pendingEmail)
+				user.RequestEmailChange(pending, testVerificationNow)
+				user.ClearEvents()
+			}
+			tokenUser := user
+			if tt.otherUser {
+				tokenUser = newVerifiedTestUser(t)
+			}
+			token := newTestVerificationToken(t, tokenUser, tt.tokenEmail, tt.expiresAt)
+
+			// Act
+			err := user.VerifyEmail(token, testVerificationNow)
+
+			// Assert
+			if tt.checkErr != nil {
+				if !tt.checkErr(err) {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Expected no error, but got: %v", err)
+				}
+				if !user.IsEmailVerified() || user.PendingEmail() != nil {
+					t.Errorf("Expected a verified email without pending change, but got verified=%v pending=%v",
+						user.IsEmailVerified(), user.PendingEmail())
+				}
+			}
+			if user.Email().Value() != tt.expectedEmail {
+				t.Errorf("Expected email %s, but got %s", tt.expectedEmail, user.Email())
+			}
+			assertEventNames(t, user.PendingEvents(), tt.expectedEvents...)
+		})
+	}
+}
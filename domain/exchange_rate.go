@@ -0,0 +1,243 @@
+package domain
+
+import (
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ExchangeRate 値オブジェクト - ある時点の通貨換算レート（1 from = rate to）
+type ExchangeRate struct {
+	from   string
+	to     string
+	rate   *big.Rat // 浮動小数点の誤差を避けるため有理数で保持する
+	asOf   time.Time
+	source string
+}
+
+// NewExchangeRate はレートを10進数の文字列（例: "151.23"）で受け取る
+func NewExchangeRate(from, to, rate string, asOf time.Time, source string) (*ExchangeRate, error) {
+	fromCurrency, err := LookupCurrency(from)
+	if err != nil {
+		return nil, err
+	}
+	toCurrency, err := LookupCurrency(to)
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := new(big.Rat).SetString(strings.TrimSpace(rate))
+	if !ok || value.Sign() <= 0 {
+		return nil, InvalidExchangeRateError{Reason: "rate must be a positive decimal: " + rate}
+	}
+	if asOf.IsZero() {
+		return nil, InvalidExchangeRateError{Reason: "rate date is required"}
+	}
+	if strings.TrimSpace(source) == "" {
+		return nil, EmptyFieldError{Field: "rate source"}
+	}
+
+	return &ExchangeRate{
+		from:   fromCurrency.code,
+		to:     toCurrency.code,
+		rate:   value,
+		asOf:   asOf,
+		source: source,
+	}, nil
+}
+
+// identityRate は同一通貨間の換算に使うレート
+func identityRate(currency string, asOf time.Time) *ExchangeRate {
+	return &ExchangeRate{
+		from:   currency,
+		to:     currency,
+		rate:   big.NewRat(1, 1),
+		asOf:   asOf,
+		source: "identity",
+	}
+}
+
+func (r *ExchangeRate) From() string {
+	return r.from
+}
+
+func (r *ExchangeRate) To() string {
+	return r.to
+}
+
+// Rate はレートを10進数の文字列で返す（末尾の0は省く）
+func (r *ExchangeRate) Rate() string {
+	text := r.rate.FloatString(10)
+	text = strings.TrimRight(text, "0")
+	return strings.TrimSuffix(text, ".")
+}
+
+func (r *ExchangeRate) AsOf() time.Time {
+	return r.asOf
+}
+
+func (r *ExchangeRate) Source() string {
+	return r.source
+}
+
+// Inverse は逆方向（to → from）のレートを返す
+func (r *ExchangeRate) Inverse() *ExchangeRate {
+	return &ExchangeRate{
+		from:   r.to,
+		to:     r.from,
+		rate:   new(big.Rat).Inv(r.rate),
+		asOf:   r.asOf,
+		source: r.source,
+	}
+}
+
+func (r *ExchangeRate) Equals(other *ExchangeRate) bool {
+	if other == nil {
+		return false
+	}
+	return r.from == other.from && r.to == other.to && r.rate.Cmp(other.rate) == 0 &&
+		r.asOf.Equal(other.asOf) && r.source == other.source
+}
+
+// ExchangeRateProvider - 為替レートの取得元へのポート
+type ExchangeRateProvider interface {
+	// RateAt は at 時点で有効なレート（at 以前で最も新しいもの）を返す
+	// 見つからない場合は ExchangeRateNotFoundError を返す
+	RateAt(from, to string, at time.Time) (*ExchangeRate, error)
+}
+
+// ConvertedMoney - 換算結果と、その根拠となったレート
+type ConvertedMoney struct {
+	original    *Money
+	converted   *Money
+	rate        *ExchangeRate
+	convertedAt time.Time // どの時点のレートで換算したか
+}
+
+func (c *ConvertedMoney) Original() *Money {
+	return c.original
+}
+
+func (c *ConvertedMoney) Money() *Money {
+	return c.converted
+}
+
+func (c *ConvertedMoney) Rate() *ExchangeRate {
+	return c.rate
+}
+
+func (c *ConvertedMoney) ConvertedAt() time.Time {
+	return c.convertedAt
+}
+
+// CurrencyConverter - 日付を指定して通貨を換算するドメインサービス
+type CurrencyConverter struct {
+	provider ExchangeRateProvider
+	rounding RoundingMode
+}
+
+func NewCurrencyConverter(provider ExchangeRateProvider, rounding RoundingMode) *CurrencyConverter {
+	return &CurrencyConverter{
+		provider: provider,
+		rounding: rounding,
+	}
+}
+
+// Convert は at 時点のレートで money を to 通貨に換算する
+// 通貨ごとの補助単位の桁数の違いを考慮し、換算先の最小単位に丸める
+func (c *CurrencyConverter) Convert(money *Money, to string, at time.Time) (*ConvertedMoney, error) {
+	if money == nil {
+		return nil, EmptyFieldError{Field: "money"}
+	}
+	toCurrency, err := LookupCurrency(to)
+	if err != nil {
+		return nil, err
+	}
+
+	if money.currency == toCurrency.code {
+		return &ConvertedMoney{
+			original:    money,
+			converted:   money,
+			rate:        identityRate(money.currency, at),
+			convertedAt: at,
+		}, nil
+	}
+
+	rate, err := c.provider.RateAt(money.currency, toCurrency.code, at)
+	if err != nil {
+		return nil, err
+	}
+
+	// amount(最小単位) / 10^from桁 * rate * 10^to桁
+	value := new(big.Rat).Mul(new(big.Rat).SetInt64(money.amount), rate.rate)
+	value.Mul(value, new(big.Rat).SetFrac(pow10(toCurrency.minorUnits), pow10(money.MinorUnits())))
+
+	amount, err := roundQuotient(value.Num(), value.Denom(), c.rounding)
+	if err != nil {
+		return nil, err
+	}
+	if !amount.IsInt64() {
+		return nil, MoneyOverflowError{Operation: "convert"}
+	}
+
+	return &ConvertedMoney{
+		original:    money,
+		converted:   &Money{amount: amount.Int64(), currency: toCurrency.code},
+		rate:        rate,
+		convertedAt: at,
+	}, nil
+}
+
+// Total は複数通貨の金額を at 時点のレートで to 通貨に換算して合計する
+func (c *CurrencyConverter) Total(amounts []*Money, to string, at time.Time) (*Money, []*ConvertedMoney, error) {
+	total, err := NewMoney(0, to)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conversions := make([]*ConvertedMoney, 0, len(amounts))
+	for _, amount := range amounts {
+		converted, err := c.Convert(amount, to, at)
+		if err != nil {
+			return nil, nil, err
+		}
+		total, err = total.Add(converted.converted)
+		if err != nil {
+			return nil, nil, err
+		}
+		conversions = append(conversions, converted)
+	}
+	return total, conversions, nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// Exchange rate related errors
+type InvalidExchangeRateError struct {
+	Reason string
+}
+
+func (e InvalidExchangeRateError) Error() string {
+	return "invalid exchange rate: " + e.Reason
+}
+
+func (e InvalidExchangeRateError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
+
+type ExchangeRateNotFoundError struct {
+	From string
+	To   string
+	At   time.Time
+}
+
+func (e ExchangeRateNotFoundError) Error() string {
+	return "exchange rate not found: " + e.From + "/" + e.To + " at " + e.At.Format(time.RFC3339)
+}
+
+func (e ExchangeRateNotFoundError) HTTPStatus() int {
+	return http.StatusUnprocessableEntity
+}
@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+// fixedRateProvider はテスト用に1つのレートだけを返す
+type fixedRateProvider struct {
+	rate *ExchangeRate
+}
+
+func (p *fixedRateProvider) RateAt(from, to string, at time.Time) (*ExchangeRate, error) {
+	if p.rate.From() == from && p.rate.To() == to && !p.rate.AsOf().After(at) {
+		return p.rate, nil
+	}
+	return nil, ExchangeRateNotFoundError{From: from, To: to, At: at}
+}
+
+func mustExchangeRate(t *testing.T, from, to, rate string, asOf time.Time) *ExchangeRate {
+	t.Helper()
+
+	exchangeRate, err := NewExchangeRate(from, to, rate, asOf, "test")
+	if err != nil {
+		t.Fatalf("Failed to create exchange rate: %v", err)
+	}
+	return exchangeRate
+}
+
+func TestNewExchangeRate_InvalidInput_ReturnsError(t *testing.T) {
+	asOf := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		from   string
+		rate   string
+		asOf   time.Time
+		source string
+	}{
+		{"未知の通貨", "XXX", "1.5", asOf, "test"},
+		{"数値でないレート", "USD", "abc", asOf, "test"},
+		{"0のレート", "USD", "0", asOf, "test"},
+		{"負のレート", "USD", "-1", asOf, "test"},
+		{"日付なし", "USD", "1.5", time.Time{}, "test"},
+		{"取得元なし", "USD", "1.5", asOf, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := NewExchangeRate(tt.from, "JPY", tt.rate, tt.asOf, tt.source)
+			if err == nil {
+				t.Error("Expected error, but got nil")
+			}
+			if rate != nil {
+				t.Error("Expected nil rate")
+			}
+		})
+	}
+}
+
+func TestCurrencyConverter_Convert(t *testing.T) {
+	asOf := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	at := asOf.Add(24 * time.Hour)
+
+	tests := []struct {
+		name     string
+		rate     *ExchangeRate
+		amount   int64
+		from     string
+		to       string
+		expected int64
+	}{
+		{"ドルから円（補助単位2桁→0桁）", mustExchangeRate(t, "USD", "JPY", "151.23", asOf), 1000, "USD", "JPY", 1512},
+		{"円からドル（補助単位0桁→2桁）", mustExchangeRate(t, "JPY", "USD", "0.0066", asOf), 1000, "JPY", "USD", 660},
+		{"ドルからクウェートディナール（2桁→3桁）", mustExchangeRate(t, "USD", "KWD", "0.3075", asOf), 1000, "USD", "KWD", 3075},
+		{"銀行丸め", mustExchangeRate(t, "USD", "JPY", "150.5", asOf), 100, "USD", "JPY", 150},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			converter := NewCurrencyConverter(&fixedRateProvider{rate: tt.rate}, RoundHalfEven)
+
+			// Act
+			converted, err := converter.Convert(mustMoney(t, tt.amount, tt.from), tt.to, at)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if converted.Money().Amount() != tt.expected || converted.Money().Currency() != tt.to {
+				t.Errorf("Expected %d %s, but got %v", tt.expected, tt.to, converted.Money())
+			}
+			if !converted.Rate().Equals(tt.rate) || !converted.ConvertedAt().Equal(at) {
+				t.Error("Expected conversion to carry the rate used")
+			}
+		})
+	}
+}
+
+func TestCurrencyConverter_Convert_Provenance(t *testing.T) {
+	asOf := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	rate, _ := NewExchangeRate("EUR", "USD", "1.0850", asOf, "ecb")
+	converter := NewCurrencyConverter(&fixedRateProvider{rate: rate}, RoundHalfUp)
+
+	converted, err := converter.Convert(mustMoney(t, 1000, "EUR"), "USD", asOf)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if converted.Rate().Rate() != "1.085" || converted.Rate().Source() != "ecb" || !converted.Rate().AsOf().Equal(asOf) {
+		t.Errorf("Unexpected provenance: %s from %s at %v",
+			converted.Rate().Rate(), converted.Rate().Source(), converted.Rate().AsOf())
+	}
+	if !converted.Original().Equals(mustMoney(t, 1000, "EUR")) {
+		t.Errorf("Expected original amount to be kept, but got %v", converted.Original())
+	}
+}
+
+func TestCurrencyConverter_Convert_SameCurrency_UsesIdentityRate(t *testing.T) {
+	converter := NewCurrencyConverter(&fixedRateProvider{}, RoundHalfUp)
+	money := mustMoney(t, 500, "JPY")
+
+	converted, err := converter.Convert(money, "JPY", time.Now())
+
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if !converted.Money().Equals(money) || converted.Rate().Source() != "identity" || converted.Rate().Rate() != "1" {
+		t.Errorf("Expected identity conversion, but got %v at %s", converted.Money(), converted.Rate().Rate())
+	}
+}
+
+func TestCurrencyConverter_Convert_RateNotYetPublished_ReturnsError(t *testing.T) {
+	asOf := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	converter := NewCurrencyConverter(&fixedRateProvider{rate: mustExchangeRate(t, "USD", "JPY", "150", asOf)}, RoundHalfUp)
+
+	_, err := converter.Convert(mustMoney(t, 100, "USD"), "JPY", asOf.Add(-time.Hour))
+
+	if _, ok := err.(ExchangeRateNotFoundError); !ok {
+		t.Errorf("Expected ExchangeRateNotFoundError, but got %T", err)
+	}
+}
+
+func TestLedger_BalanceIn_MixedCurrencies(t *testing.T) {
+	// Arrange
+	asOf := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	converter := NewCurrencyConverter(&fixedRateProvider{rate: mustExchangeRate(t, "USD", "JPY", "150", asOf)}, RoundHalfUp)
+	ledger := NewLedger(NewUserID())
+	ledger.Charge(mustMoney(t, 1000, "JPY"), "Dues", "circle_dues:a", asOf)
+	ledger.Charge(mustMoney(t, 500, "USD"), "Dues", "circle_dues:b", asOf)
+	ledger.RecordPayment(mustMoney(t, 200, "USD"), "Payment", "payment:ch_1", asOf)
+
+	// Act
+	balance, conversions, err := ledger.BalanceIn("JPY", converter)
+
+	// Assert: 1000 + 750 - 300
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if balance.Amount() != 1450 || balance.Currency() != "JPY" {
+		t.Errorf("Expected ¥1450, but got %v", balance)
+	}
+	if len(conversions) != 3 {
+		t.Errorf("Expected 3 conversions, but got %d", len(conversions))
+	}
+}
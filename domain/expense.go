@@ -0,0 +1,321 @@
+package domain
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ExpenseID struct {
+	value string
+}
+
+func NewExpenseID() *ExpenseID {
+	return &ExpenseID{value: uuid.New().String()}
+}
+
+func ReconstructExpenseID(value string) (*ExpenseID, error) {
+	if value == "" {
+		return nil, EmptyFieldError{Field: "expense ID"}
+	}
+	if _, err := uuid.Parse(value); err != nil {
+		return nil, InvalidExpenseError{Reason: "invalid expense ID: " + value}
+	}
+	return &ExpenseID{value: value}, nil
+}
+
+func (e *ExpenseID) Value() string {
+	return e.value
+}
+
+func (e *ExpenseID) Equals(other *ExpenseID) bool {
+	if other == nil {
+		return false
+	}
+	return e.value == other.value
+}
+
+func (e *ExpenseID) String() string {
+	return e.value
+}
+
+// SplitMethod - 費用の分け方
+type SplitMethod string
+
+const (
+	SplitEqually SplitMethod = "equal"  // 均等割り
+	SplitShares  SplitMethod = "shares" // 口数（比率）で按分
+	SplitExact   SplitMethod = "exact"  // 金額を個別に指定
+)
+
+func ParseSplitMethod(value string) (SplitMethod, error) {
+	method := SplitMethod(value)
+	switch method {
+	case SplitEqually, SplitShares, SplitExact:
+		return method, nil
+	}
+	return "", InvalidExpenseError{Reason: "unknown split method: " + value}
+}
+
+func (m SplitMethod) String() string {
+	return string(m)
+}
+
+// ExpenseSplit 値オブジェクト - 誰がどのように費用を負担するか
+type ExpenseSplit struct {
+	method       SplitMethod
+	participants []*UserID
+	shares       []int64  // SplitShares の場合のみ
+	amounts      []*Money // SplitExact の場合のみ
+}
+
+func NewEqualSplit(participants []*UserID) (*ExpenseSplit, error) {
+	if err := validateSplitParticipants(participants); err != nil {
+		return nil, err
+	}
+	return &ExpenseSplit{method: SplitEqually, participants: participants}, nil
+}
+
+func NewSharesSplit(participants []*UserID, shares []int64) (*ExpenseSplit, error) {
+	if err := validateSplitParticipants(participants); err != nil {
+		return nil, err
+	}
+	if len(shares) != len(participants) {
+		return nil, InvalidExpenseError{Reason: "shares must be given for each participant"}
+	}
+	for _, share := range shares {
+		if share <= 0 {
+			return nil, InvalidExpenseError{Reason: "shares must be positive"}
+		}
+	}
+	return &ExpenseSplit{method: SplitShares, participants: participants, shares: shares}, nil
+}
+
+func NewExactSplit(participants []*UserID, amounts []*Money) (*ExpenseSplit, error) {
+	if err := validateSplitParticipants(participants); err != nil {
+		return nil, err
+	}
+	if len(amounts) != len(participants) {
+		return nil, InvalidExpenseError{Reason: "amounts must be given for each participant"}
+	}
+	for _, amount := range amounts {
+		if amount == nil || amount.IsNegative() {
+			return nil, InvalidExpenseError{Reason: "exact amounts must not be negative"}
+		}
+	}
+	return &ExpenseSplit{method: SplitExact, participants: participants, amounts: amounts}, nil
+}
+
+func validateSplitParticipants(participants []*UserID) error {
+	if len(participants) == 0 {
+		return InvalidExpenseError{Reason: "at least one participant is required"}
+	}
+	seen := make(map[string]bool)
+	for _, participant := range participants {
+		if participant == nil {
+			return EmptyFieldError{Field: "participant"}
+		}
+		if seen[participant.Value()] {
+			return InvalidExpenseError{Reason: "duplicate participant: " + participant.Value()}
+		}
+		seen[participant.Value()] = true
+	}
+	return nil
+}
+
+func (s *ExpenseSplit) Method() SplitMethod {
+	return s.method
+}
+
+// allocate は総額を参加者ごとの負担額に分ける（合計は必ず総額に一致する）
+func (s *ExpenseSplit) allocate(total *Money) ([]*Money, error) {
+	switch s.method {
+	case SplitEqually:
+		return total.Split(len(s.participants))
+	case SplitShares:
+		return total.Allocate(s.shares)
+	case SplitExact:
+		sum, err := NewMoney(0, total.Currency())
+		if err != nil {
+			return nil, err
+		}
+		for _, amount := range s.amounts {
+			if sum, err = sum.Add(amount); err != nil {
+				return nil, err
+			}
+		}
+		if !sum.Equals(total) {
+			return nil, InvalidExpenseError{Reason: "exact amounts " + sum.String() + " do not add up to " + total.String()}
+		}
+		return s.amounts, nil
+	}
+	return nil, InvalidExpenseError{Reason: "unknown split method: " + s.method.String()}
+}
+
+// ExpenseShare - 参加者1人の負担額
+type ExpenseShare struct {
+	participantID *UserID
+	amount        *Money
+}
+
+func NewExpenseShare(participantID *UserID, amount *Money) *ExpenseShare {
+	return &ExpenseShare{participantID: participantID, amount: amount}
+}
+
+func (s *ExpenseShare) ParticipantID() *UserID {
+	return s.participantID
+}
+
+func (s *ExpenseShare) Amount() *Money {
+	return s.amount
+}
+
+// CircleExpense - サークル活動で立て替えた費用（集約ルート）
+type CircleExpense struct {
+	id          *ExpenseID
+	circleID    *CircleID
+	payerID     *UserID
+	amount      *Money
+	description string
+	splitMethod SplitMethod
+	shares      []*ExpenseShare
+	incurredAt  time.Time
+}
+
+// NewCircleExpense は立て替えた費用を記録する
+// 立て替えた人・負担する人はいずれもサークルの参加者（オーナーまたはメンバー）でなければならない
+func NewCircleExpense(circle *Circle, payerID *UserID, amount *Money, description string, split *ExpenseSplit, incurredAt time.Time) (*CircleExpense, error) {
+	if circle == nil {
+		return nil, EmptyFieldError{Field: "circle"}
+	}
+	if amount == nil {
+		return nil, EmptyFieldError{Field: "amount"}
+	}
+	if !amount.IsPositive() {
+		return nil, InvalidExpenseError{Reason: "amount must be positive: " + amount.String()}
+	}
+	if strings.TrimSpace(description) == "" {
+		return nil, EmptyFieldError{Field: "description"}
+	}
+	if split == nil {
+		return nil, EmptyFieldError{Field: "split"}
+	}
+	if payerID == nil {
+		return nil, EmptyFieldError{Field: "payer"}
+	}
+	if !circle.IsParticipant(payerID) {
+		return nil, NotCircleParticipantError{UserID: payerID.Value()}
+	}
+	for _, participant := range split.participants {
+		if !circle.IsParticipant(participant) {
+			return nil, NotCircleParticipantError{UserID: participant.Value()}
+		}
+	}
+
+	amounts, err := split.allocate(amount)
+	if err != nil {
+		return nil, err
+	}
+	shares := make([]*ExpenseShare, len(amounts))
+	for i, shareAmount := range amounts {
+		if shareAmount.Currency() != amount.Currency() {
+			return nil, CurrencyMismatchError{Currency1: amount.Currency(), Currency2: shareAmount.Currency()}
+		}
+		shares[i] = NewExpenseShare(split.participants[i], shareAmount)
+	}
+
+	return &CircleExpense{
+		id:          NewExpenseID(),
+		circleID:    circle.ID(),
+		payerID:     payerID,
+		amount:      amount,
+		description: strings.TrimSpace(description),
+		splitMethod: split.method,
+		shares:      shares,
+		incurredAt:  incurredAt,
+	}, nil
+}
+
+func ReconstructCircleExpense(
+	id *ExpenseID,
+	circleID *CircleID,
+	payerID *UserID,
+	amount *Money,
+	description string,
+	splitMethod SplitMethod,
+	shares []*ExpenseShare,
+	incurredAt time.Time,
+) *CircleExpense {
+	return &CircleExpense{
+		id:          id,
+		circleID:    circleID,
+		payerID:     payerID,
+		amount:      amount,
+		description: description,
+		splitMethod: splitMethod,
+		shares:      shares,
+		incurredAt:  incurredAt,
+	}
+}
+
+func (e *CircleExpense) ID() *ExpenseID {
+	return e.id
+}
+
+func (e *CircleExpense) CircleID() *CircleID {
+	return e.circleID
+}
+
+func (e *CircleExpense) PayerID() *UserID {
+	return e.payerID
+}
+
+func (e *CircleExpense) Amount() *Money {
+	return e.amount
+}
+
+func (e *CircleExpense) Description() string {
+	return e.description
+}
+
+func (e *CircleExpense) SplitMethod() SplitMethod {
+	return e.splitMethod
+}
+
+func (e *CircleExpense) Shares() []*ExpenseShare {
+	// 防御的コピーを返す
+	shares := make([]*ExpenseShare, len(e.shares))
+	copy(shares, e.shares)
+	return shares
+}
+
+func (e *CircleExpense) IncurredAt() time.Time {
+	return e.incurredAt
+}
+
+// Expense related errors
+type InvalidExpenseError struct {
+	Reason string
+}
+
+func (e InvalidExpenseError) Error() string {
+	return "invalid expense: " + e.Reason
+}
+
+func (e InvalidExpenseError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
+
+type NotCircleParticipantError struct {
+	UserID string
+}
+
+func (e NotCircleParticipantError) Error() string {
+	return "user is not a circle participant: " + e.UserID
+}
+
+func (e NotCircleParticipantError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
@@ -0,0 +1,235 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// テスト用ヘルパー：オーナーとメンバー count 人のサークルを作成する
+func newTestExpenseCircle(t *testing.T, count int) (*Circle, []*UserID) {
+	t.Helper()
+
+	owner := newTestMembers(t, 1, 0)[0]
+	members := newTestMembers(t, count, 0)
+	circle := newTestCircle(t, owner, members)
+
+	participants := []*UserID{owner.ID()}
+	for _, member := range members {
+		participants = append(participants, member.ID())
+	}
+	return circle, participants
+}
+
+func shareAmounts(expense *CircleExpense) []int64 {
+	var amounts []int64
+	for _, share := range expense.Shares() {
+		amounts = append(amounts, share.Amount().Amount())
+	}
+	return amounts
+}
+
+func TestNewCircleExpense_Splits_AllocatesWholeAmount(t *testing.T) {
+	circle, participants := newTestExpenseCircle(t, 2)
+
+	tests := []struct {
+		name     string
+		amount   int64
+		split    func() (*ExpenseSplit, error)
+		expected []int64
+	}{
+		{
+			"均等割り（割り切れる）", 3000,
+			func() (*ExpenseSplit, error) { return NewEqualSplit(participants) },
+			[]int64{1000, 1000, 1000},
+		},
+		{
+			"均等割り（端数は先頭から配分）", 1000,
+			func() (*ExpenseSplit, error) { return NewEqualSplit(participants) },
+			[]int64{334, 333, 333},
+		},
+		{
+			"口数で按分", 6000,
+			func() (*ExpenseSplit, error) { return NewSharesSplit(participants, []int64{1, 2, 3}) },
+			[]int64{1000, 2000, 3000},
+		},
+		{
+			"金額を個別に指定", 5000,
+			func() (*ExpenseSplit, error) {
+				return NewExactSplit(participants, []*Money{
+					mustMoney(t, 3000, "JPY"), mustMoney(t, 2000, "JPY"), mustMoney(t, 0, "JPY"),
+				})
+			},
+			[]int64{3000, 2000, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			split, err := tt.split()
+			if err != nil {
+				t.Fatalf("Failed to create split: %v", err)
+			}
+
+			// Act
+			expense, err := NewCircleExpense(circle, participants[0], mustMoney(t, tt.amount, "JPY"), "合宿費", split, time.Now())
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, but got %v", err)
+			}
+			actual := shareAmounts(expense)
+			if len(actual) != len(tt.expected) {
+				t.Fatalf("Expected %d shares, but got %d", len(tt.expected), len(actual))
+			}
+			for i := range tt.expected {
+				if actual[i] != tt.expected[i] {
+					t.Errorf("Expected shares %v, but got %v", tt.expected, actual)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestNewCircleExpense_NonParticipant_ReturnsError(t *testing.T) {
+	circle, participants := newTestExpenseCircle(t, 1)
+	outsider := newTestMembers(t, 1, 0)[0].ID()
+
+	tests := []struct {
+		name         string
+		payer        *UserID
+		participants []*UserID
+	}{
+		{"立て替えた人が参加者でない", outsider, participants},
+		{"負担する人が参加者でない", participants[0], []*UserID{participants[0], outsider}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			split, err := NewEqualSplit(tt.participants)
+			if err != nil {
+				t.Fatalf("Failed to create split: %v", err)
+			}
+
+			// Act
+			expense, err := NewCircleExpense(circle, tt.payer, mustMoney(t, 1000, "JPY"), "会場費", split, time.Now())
+
+			// Assert
+			var notParticipant NotCircleParticipantError
+			if !errors.As(err, &notParticipant) {
+				t.Fatalf("Expected NotCircleParticipantError, but got %v", err)
+			}
+			if notParticipant.UserID != outsider.Value() {
+				t.Errorf("Expected user %s, but got %s", outsider.Value(), notParticipant.UserID)
+			}
+			if expense != nil {
+				t.Error("Expected nil expense")
+			}
+		})
+	}
+}
+
+func TestNewCircleExpense_InvalidInput_ReturnsError(t *testing.T) {
+	circle, participants := newTestExpenseCircle(t, 1)
+
+	tests := []struct {
+		name        string
+		amount      int64
+		description string
+		split       func() (*ExpenseSplit, error)
+	}{
+		{
+			"金額が0", 0, "会場費",
+			func() (*ExpenseSplit, error) { return NewEqualSplit(participants) },
+		},
+		{
+			"説明が空", 1000, "  ",
+			func() (*ExpenseSplit, error) { return NewEqualSplit(participants) },
+		},
+		{
+			"指定金額の合計が総額と一致しない", 1000, "会場費",
+			func() (*ExpenseSplit, error) {
+				return NewExactSplit(participants, []*Money{mustMoney(t, 500, "JPY"), mustMoney(t, 400, "JPY")})
+			},
+		},
+		{
+			"指定金額の通貨が異なる", 1000, "会場費",
+			func() (*ExpenseSplit, error) {
+				return NewExactSplit(participants, []*Money{mustMoney(t, 500, "JPY"), mustMoney(t, 500, "USD")})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			split, err := tt.split()
+			if err != nil {
+				t.Fatalf("Failed to create split: %v", err)
+			}
+
+			// Act
+			expense, err := NewCircleExpense(circle, participants[0], mustMoney(t, tt.amount, "JPY"), tt.description, split, time.Now())
+
+			// Assert
+			if err == nil {
+				t.Error("Expected error, but got nil")
+			}
+			if expense != nil {
+				t.Error("Expected nil expense")
+			}
+		})
+	}
+}
+
+func TestNewExpenseSplit_InvalidInput_ReturnsError(t *testing.T) {
+	_, participants := newTestExpenseCircle(t, 1)
+
+	tests := []struct {
+		name  string
+		split func() (*ExpenseSplit, error)
+	}{
+		{"参加者なし", func() (*ExpenseSplit, error) { return NewEqualSplit(nil) }},
+		{"参加者の重複", func() (*ExpenseSplit, error) {
+			return NewEqualSplit([]*UserID{participants[0], participants[0]})
+		}},
+		{"口数の数が合わない", func() (*ExpenseSplit, error) {
+			return NewSharesSplit(participants, []int64{1})
+		}},
+		{"口数が0", func() (*ExpenseSplit, error) {
+			return NewSharesSplit(participants, []int64{1, 0})
+		}},
+		{"負の指定金額", func() (*ExpenseSplit, error) {
+			return NewExactSplit(participants, []*Money{mustMoney(t, 100, "JPY"), mustMoney(t, -100, "JPY")})
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			split, err := tt.split()
+
+			// Assert
+			if err == nil {
+				t.Error("Expected error, but got nil")
+			}
+			if split != nil {
+				t.Error("Expected nil split")
+			}
+		})
+	}
+}
+
+func TestParseSplitMethod_UnknownMethod_ReturnsError(t *testing.T) {
+	// Act
+	_, err := ParseSplitMethod("random")
+
+	// Assert
+	var invalid InvalidExpenseError
+	if !errors.As(err, &invalid) {
+		t.Errorf("Expected InvalidExpenseError, but got %v", err)
+	}
+}
@@ -0,0 +1,303 @@
+package domain
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LedgerEntryID struct {
+	value string
+}
+
+func NewLedgerEntryID() *LedgerEntryID {
+	return &LedgerEntryID{value: uuid.New().String()}
+}
+
+func ReconstructLedgerEntryID(value string) (*LedgerEntryID, error) {
+	if value == "" {
+		return nil, EmptyFieldError{Field: "ledger entry ID"}
+	}
+	if _, err := uuid.Parse(value); err != nil {
+		return nil, InvalidLedgerEntryError{Reason: "invalid ledger entry ID: " + value}
+	}
+	return &LedgerEntryID{value: value}, nil
+}
+
+func (l *LedgerEntryID) Value() string {
+	return l.value
+}
+
+func (l *LedgerEntryID) Equals(other *LedgerEntryID) bool {
+	if other == nil {
+		return false
+	}
+	return l.value == other.value
+}
+
+func (l *LedgerEntryID) String() string {
+	return l.value
+}
+
+// LedgerEntryType - 台帳記録の種別
+type LedgerEntryType string
+
+const (
+	LedgerEntryCharge  LedgerEntryType = "charge"  // 請求（ユーザーの支払義務が増える）
+	LedgerEntryPayment LedgerEntryType = "payment" // 入金（支払義務が減る）
+	LedgerEntryRefund  LedgerEntryType = "refund"  // 返金（入金の取り消し）
+)
+
+func ParseLedgerEntryType(value string) (LedgerEntryType, error) {
+	entryType := LedgerEntryType(value)
+	switch entryType {
+	case LedgerEntryCharge, LedgerEntryPayment, LedgerEntryRefund:
+		return entryType, nil
+	}
+	return "", InvalidLedgerEntryError{Reason: "unknown entry type: " + value}
+}
+
+func (t LedgerEntryType) String() string {
+	return string(t)
+}
+
+// LedgerEntry - 台帳の1記録（追記のみで変更しない）
+// amount は常に正の値で、残高への符号は種別で決まる
+type LedgerEntry struct {
+	id          *LedgerEntryID
+	userID      *UserID
+	entryType   LedgerEntryType
+	amount      *Money
+	description string
+	reference   string // 請求元や決済の識別子（例: subscription:premium_monthly）
+	occurredAt  time.Time
+	idempotent  bool // 参照キーで一意になる記録か
+}
+
+func newLedgerEntry(userID *UserID, entryType LedgerEntryType, amount *Money, description, reference string, idempotent bool, occurredAt time.Time) (*LedgerEntry, error) {
+	if amount == nil {
+		return nil, EmptyFieldError{Field: "amount"}
+	}
+	if !amount.IsPositive() {
+		return nil, InvalidLedgerEntryError{Reason: "amount must be positive: " + amount.String()}
+	}
+	return &LedgerEntry{
+		id:          NewLedgerEntryID(),
+		userID:      userID,
+		entryType:   entryType,
+		amount:      amount,
+		description: description,
+		reference:   reference,
+		occurredAt:  occurredAt,
+		idempotent:  idempotent,
+	}, nil
+}
+
+func ReconstructLedgerEntry(
+	id *LedgerEntryID,
+	userID *UserID,
+	entryType LedgerEntryType,
+	amount *Money,
+	description string,
+	reference string,
+	occurredAt time.Time,
+) *LedgerEntry {
+	return &LedgerEntry{
+		id:          id,
+		userID:      userID,
+		entryType:   entryType,
+		amount:      amount,
+		description: description,
+		reference:   reference,
+		occurredAt:  occurredAt,
+		idempotent:  isIdempotentEntry(entryType, reference),
+	}
+}
+
+func (e *LedgerEntry) ID() *LedgerEntryID {
+	return e.id
+}
+
+func (e *LedgerEntry) UserID() *UserID {
+	return e.userID
+}
+
+func (e *LedgerEntry) Type() LedgerEntryType {
+	return e.entryType
+}
+
+func (e *LedgerEntry) Amount() *Money {
+	return e.amount
+}
+
+func (e *LedgerEntry) Description() string {
+	return e.description
+}
+
+func (e *LedgerEntry) Reference() string {
+	return e.reference
+}
+
+func (e *LedgerEntry) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// IsIdempotent は参照キーで一意になる記録（参照キー付きの入金・返金と ChargeOnce の請求）かを返す
+// 通常の請求は更新のたびに同じ参照キーで記録されるため対象外
+func (e *LedgerEntry) IsIdempotent() bool {
+	return e.idempotent
+}
+
+// isIdempotentEntry は参照キーだけで一意になると分かる記録かを返す
+func isIdempotentEntry(entryType LedgerEntryType, reference string) bool {
+	return reference != "" && entryType != LedgerEntryCharge
+}
+
+// IdempotencyKey はリポジトリで一意性を保証するためのキー（対象外の記録は空文字）
+func (e *LedgerEntry) IdempotencyKey() string {
+	if !e.IsIdempotent() {
+		return ""
+	}
+	return e.entryType.String() + ":" + e.reference
+}
+
+// applyTo は残高（ユーザーの支払義務）にこの記録を反映した値を返す
+func (e *LedgerEntry) applyTo(balance *Money) (*Money, error) {
+	if e.entryType == LedgerEntryPayment {
+		return balance.Subtract(e.amount)
+	}
+	return balance.Add(e.amount)
+}
+
+// Ledger - ユーザーごとの追記専用の請求・入金台帳
+type Ledger struct {
+	userID  *UserID
+	entries []*LedgerEntry
+}
+
+func NewLedger(userID *UserID) *Ledger {
+	return &Ledger{
+		userID:  userID,
+		entries: []*LedgerEntry{},
+	}
+}
+
+func ReconstructLedger(userID *UserID, entries []*LedgerEntry) *Ledger {
+	return &Ledger{
+		userID:  userID,
+		entries: entries,
+	}
+}
+
+func (l *Ledger) UserID() *UserID {
+	return l.userID
+}
+
+func (l *Ledger) Entries() []*LedgerEntry {
+	// 防御的コピーを返す
+	entries := make([]*LedgerEntry, len(l.entries))
+	copy(entries, l.entries)
+	return entries
+}
+
+func (l *Ledger) Charge(amount *Money, description, reference string, now time.Time) (*LedgerEntry, error) {
+	return l.record(LedgerEntryCharge, amount, description, reference, false, now)
+}
+
+// ChargeOnce は参照キーごとに1度だけ請求する
+// 同じ参照キーで請求済みの場合は DuplicateLedgerEntryError を返す
+func (l *Ledger) ChargeOnce(amount *Money, description, reference string, now time.Time) (*LedgerEntry, error) {
+	if reference == "" {
+		return nil, EmptyFieldError{Field: "reference"}
+	}
+	return l.record(LedgerEntryCharge, amount, description, reference, true, now)
+}
+
+func (l *Ledger) RecordPayment(amount *Money, description, reference string, now time.Time) (*LedgerEntry, error) {
+	return l.record(LedgerEntryPayment, amount, description, reference, isIdempotentEntry(LedgerEntryPayment, reference), now)
+}
+
+func (l *Ledger) RecordRefund(amount *Money, description, reference string, now time.Time) (*LedgerEntry, error) {
+	return l.record(LedgerEntryRefund, amount, description, reference, isIdempotentEntry(LedgerEntryRefund, reference), now)
+}
+
+// HasReference は同じ種別・参照キーの記録が既にあるかを返す
+func (l *Ledger) HasReference(entryType LedgerEntryType, reference string) bool {
+	for _, entry := range l.entries {
+		if entry.entryType == entryType && entry.reference == reference {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *Ledger) record(entryType LedgerEntryType, amount *Money, description, reference string, idempotent bool, now time.Time) (*LedgerEntry, error) {
+	// 入金・返金は決済事業者のIDを参照キーとし、同じキーの二重計上を拒否する
+	if idempotent && l.HasReference(entryType, reference) {
+		return nil, DuplicateLedgerEntryError{Reference: reference}
+	}
+
+	entry, err := newLedgerEntry(l.userID, entryType, amount, description, reference, idempotent, now)
+	if err != nil {
+		return nil, err
+	}
+	l.entries = append(l.entries, entry)
+	return entry, nil
+}
+
+// Balance はユーザーの未払残高（請求 - 入金 + 返金）を返す
+// 記録がない場合は nil を返し、通貨が混在する場合は CurrencyMismatchError、
+// 桁あふれの場合は MoneyOverflowError を返す
+func (l *Ledger) Balance() (*Money, error) {
+	var balance *Money
+	for _, entry := range l.entries {
+		if balance == nil {
+			balance = &Money{amount: 0, currency: entry.amount.currency}
+		}
+		applied, err := entry.applyTo(balance)
+		if err != nil {
+			return nil, err
+		}
+		balance = applied
+	}
+	return balance, nil
+}
+
+// BalanceIn は各記録を発生時点のレートで currency に換算し、未払残高を返す
+// 通貨が混在する台帳を1つの通貨で報告するために使う（記録がない場合は0）
+func (l *Ledger) BalanceIn(currency string, converter *CurrencyConverter) (*Money, []*ConvertedMoney, error) {
+	balance, err := NewMoney(0, currency)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conversions := make([]*ConvertedMoney, 0, len(l.entries))
+	for _, entry := range l.entries {
+		converted, err := converter.Convert(entry.amount, currency, entry.occurredAt)
+		if err != nil {
+			return nil, nil, err
+		}
+		convertedEntry := *entry
+		convertedEntry.amount = converted.Money()
+		balance, err = convertedEntry.applyTo(balance)
+		if err != nil {
+			return nil, nil, err
+		}
+		conversions = append(conversions, converted)
+	}
+	return balance, conversions, nil
+}
+
+// Ledger related errors
+type InvalidLedgerEntryError struct {
+	Reason string
+}
+
+func (e InvalidLedgerEntryError) Error() string {
+	return "invalid ledger entry: " + e.Reason
+}
+
+func (e InvalidLedgerEntryError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
@@ -0,0 +1,222 @@
+package domain
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func mustMoney(t *testing.T, amount int64, currency string) *Money {
+	t.Helper()
+
+	money, err := NewMoney(amount, currency)
+	if err != nil {
+		t.Fatalf("Failed to create money: %v", err)
+	}
+	return money
+}
+
+func TestLedger_Balance(t *testing.T) {
+	now := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		record   func(l *Ledger) error
+		expected int64
+	}{
+		{
+			name: "請求のみ",
+			record: func(l *Ledger) error {
+				_, err := l.Charge(mustMoney(t, 500, "JPY"), "月額", "", now)
+				return err
+			},
+			expected: 500,
+		},
+		{
+			name: "請求と同額の入金で残高ゼロ",
+			record: func(l *Ledger) error {
+				if _, err := l.Charge(mustMoney(t, 500, "JPY"), "月額", "", now); err != nil {
+					return err
+				}
+				_, err := l.RecordPayment(mustMoney(t, 500, "JPY"), "入金", "", now)
+				return err
+			},
+			expected: 0,
+		},
+		{
+			name: "過入金の返金で残高ゼロ",
+			record: func(l *Ledger) error {
+				if _, err := l.Charge(mustMoney(t, 500, "JPY"), "月額", "", now); err != nil {
+					return err
+				}
+				if _, err := l.RecordPayment(mustMoney(t, 800, "JPY"), "入金", "", now); err != nil {
+					return err
+				}
+				_, err := l.RecordRefund(mustMoney(t, 300, "JPY"), "返金", "", now)
+				return err
+			},
+			expected: 0,
+		},
+		{
+			name: "入金のみは負の残高（前払い）",
+			record: func(l *Ledger) error {
+				_, err := l.RecordPayment(mustMoney(t, 1000, "JPY"), "前払い", "", now)
+				return err
+			},
+			expected: -1000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ledger := NewLedger(NewUserID())
+			if err := tt.record(ledger); err != nil {
+				t.Fatalf("Failed to record: %v", err)
+			}
+
+			balance, err := ledger.Balance()
+
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if balance.Amount() != tt.expected {
+				t.Errorf("Expected balance %d, but got %d", tt.expected, balance.Amount())
+			}
+		})
+	}
+}
+
+func TestLedger_Balance_EmptyLedger_ReturnsNil(t *testing.T) {
+	balance, err := NewLedger(NewUserID()).Balance()
+	if err != nil {
+		t.Errorf("Expected no error, but got: %v", err)
+	}
+	if balance != nil {
+		t.Errorf("Expected nil balance, but got %v", balance)
+	}
+}
+
+func TestLedger_Balance_MixedCurrencies_ReturnsError(t *testing.T) {
+	now := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	ledger := NewLedger(NewUserID())
+	ledger.Charge(mustMoney(t, 500, "JPY"), "月額", "", now)
+	ledger.Charge(mustMoney(t, 500, "USD"), "Monthly", "", now)
+
+	balance, err := ledger.Balance()
+
+	if _, ok := err.(CurrencyMismatchError); !ok {
+		t.Errorf("Expected CurrencyMismatchError, but got %T", err)
+	}
+	if balance != nil {
+		t.Error("Expected nil balance for mixed currencies")
+	}
+}
+
+func TestLedger_Record_NonPositiveAmount_ReturnsError(t *testing.T) {
+	now := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	ledger := NewLedger(NewUserID())
+
+	for _, amount := range []int64{0, -100} {
+		entry, err := ledger.Charge(mustMoney(t, amount, "JPY"), "不正", "", now)
+		if _, ok := err.(InvalidLedgerEntryError); !ok {
+			t.Errorf("Expected InvalidLedgerEntryError for %d, but got %T", amount, err)
+		}
+		if entry != nil {
+			t.Error("Expected nil entry")
+		}
+	}
+	if len(ledger.Entries()) != 0 {
+		t.Error("Expected rejected entries not to be recorded")
+	}
+}
+
+func TestPlanPriceList_PriceOf(t *testing.T) {
+	list := DefaultPlanPriceList()
+
+	price, err := list.PriceOf(SubscriptionPlanPremiumMonthly)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if !price.Equals(mustMoney(t, 500, "JPY")) {
+		t.Errorf("Expected ¥500, but got %s", price)
+	}
+
+	if _, err := list.PriceOf(SubscriptionPlanFree); err == nil {
+		t.Error("Expected error for free plan")
+	}
+}
+
+func TestCircle_ChangeMembershipDues(t *testing.T) {
+	owner := newTestOwner(t, false)
+	circle := newTestCircle(t, owner, nil)
+
+	var invalid InvalidCircleDuesError
+	if err := circle.ChangeMembershipDues(mustMoney(t, 0, "JPY")); !errors.As(err, &invalid) || invalid.HTTPStatus() != http.StatusBadRequest {
+		t.Errorf("Expected InvalidCircleDuesError for zero dues, but got %v", err)
+	}
+	if err := circle.ChangeMembershipDues(mustMoney(t, 1000, "JPY")); err != nil {
+		t.Errorf("Expected no error, but got: %v", err)
+	}
+	if !circle.HasMembershipDues() {
+		t.Error("Expected circle to have dues")
+	}
+	if err := circle.ChangeMembershipDues(nil); err != nil {
+		t.Errorf("Expected no error, but got: %v", err)
+	}
+	if circle.HasMembershipDues() {
+		t.Error("Expected dues to be removed")
+	}
+}
+
+func TestLedger_RecordPayment_DuplicateReference_ReturnsError(t *testing.T) {
+	// Arrange
+	ledger := NewLedger(NewUserID())
+	now := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	reference := PaymentReference("ch_000001")
+	if _, err := ledger.RecordPayment(mustMoney(t, 500, "JPY"), "Payment", reference, now); err != nil {
+		t.Fatalf("Failed to record payment: %v", err)
+	}
+
+	// Act
+	_, err := ledger.RecordPayment(mustMoney(t, 500, "JPY"), "Payment", reference, now)
+
+	// Assert
+	if _, ok := err.(DuplicateLedgerEntryError); !ok {
+		t.Errorf("Expected DuplicateLedgerEntryError, but got %T", err)
+	}
+	if len(ledger.Entries()) != 1 {
+		t.Errorf("Expected 1 entry, but got %d", len(ledger.Entries()))
+	}
+
+	// 請求は同じ参照キーで繰り返し記録できる
+	for i := 0; i < 2; i++ {
+		if _, err := ledger.Charge(mustMoney(t, 500, "JPY"), "Monthly", "subscription:premium_monthly", now); err != nil {
+			t.Errorf("Expected repeated charge to succeed, but got: %v", err)
+		}
+	}
+}
+
+func TestLedger_ChargeOnce_DuplicateReference_ReturnsError(t *testing.T) {
+	// Arrange
+	ledger := NewLedger(NewUserID())
+	now := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	first, err := ledger.ChargeOnce(mustMoney(t, 3000, "JPY"), "Dues", "circle_dues:a:b", now)
+	if err != nil {
+		t.Fatalf("Failed to charge: %v", err)
+	}
+
+	// Act
+	_, err = ledger.ChargeOnce(mustMoney(t, 3000, "JPY"), "Dues", "circle_dues:a:b", now)
+
+	// Assert
+	if _, ok := err.(DuplicateLedgerEntryError); !ok {
+		t.Errorf("Expected DuplicateLedgerEntryError, but got %T", err)
+	}
+	if first.IdempotencyKey() != "charge:circle_dues:a:b" {
+		t.Errorf("Expected the charge to carry an idempotency key, but got %q", first.IdempotencyKey())
+	}
+	if len(ledger.Entries()) != 1 {
+		t.Errorf("Expected 1 entry, but got %d", len(ledger.Entries()))
+	}
+}
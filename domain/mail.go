@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Locale - 通知の言語
+type Locale string
+
+const (
+	LocaleJapanese Locale = "ja"
+	LocaleEnglish  Locale = "en"
+)
+
+func ParseLocale(value string) (Locale, error) {
+	locale := Locale(strings.ToLower(strings.TrimSpace(value)))
+	switch locale {
+	case LocaleJapanese, LocaleEnglish:
+		return locale, nil
+	}
+	return "", InvalidMailMessageError{Reason: "unsupported locale: " + value}
+}
+
+func (l Locale) String() string {
+	return string(l)
+}
+
+// MailMessage - 送信するメール（値オブジェクト）
+// 本文はプレーンテキスト。件名に改行を含めるとヘッダーを改ざんできるため拒否する
+type MailMessage struct {
+	to      *Email
+	subject string
+	body    string
+}
+
+func NewMailMessage(to *Email, subject, body string) (*MailMessage, error) {
+	if to == nil {
+		return nil, EmptyFieldError{Field: "recipient"}
+	}
+	subject = strings.TrimSpace(subject)
+	if subject == "" {
+		return nil, EmptyFieldError{Field: "subject"}
+	}
+	if strings.ContainsAny(subject, "\r\n") {
+		return nil, InvalidMailMessageError{Reason: "subject must be a single line"}
+	}
+	if strings.TrimSpace(body) == "" {
+		return nil, EmptyFieldError{Field: "body"}
+	}
+	return &MailMessage{to: to, subject: subject, body: body}, nil
+}
+
+func (m *MailMessage) To() *Email {
+	return m.to
+}
+
+func (m *MailMessage) Subject() string {
+	return m.subject
+}
+
+func (m *MailMessage) Body() string {
+	return m.body
+}
+
+// Mailer はメールの送信先（ポート）
+type Mailer interface {
+	Send(message *MailMessage) error
+}
+
+type InvalidMailMessageError struct {
+	Reason string
+}
+
+func (e InvalidMailMessageError) Error() string {
+	return "invalid mail message: " + e.Reason
+}
+
+func (e InvalidMailMessageError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
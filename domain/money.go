@@ -0,0 +1,427 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Currency - ISO 4217 の通貨メタデータ
+type Currency struct {
+	code       string
+	minorUnits int    // 補助単位の桁数（JPY=0, USD=2, KWD=3）
+	symbol     string // 空の場合は通貨コードを後置して表示する
+}
+
+// currencies は取り扱い可能な通貨の一覧
+var currencies = map[string]Currency{
+	"JPY": {code: "JPY", minorUnits: 0, symbol: "¥"},
+	"USD": {code: "USD", minorUnits: 2, symbol: "$"},
+	"EUR": {code: "EUR", minorUnits: 2, symbol: "€"},
+	"GBP": {code: "GBP", minorUnits: 2, symbol: "£"},
+	"AUD": {code: "AUD", minorUnits: 2, symbol: "A$"},
+	"CAD": {code: "CAD", minorUnits: 2, symbol: "CA$"},
+	"CHF": {code: "CHF", minorUnits: 2},
+	"CNY": {code: "CNY", minorUnits: 2, symbol: "CN¥"},
+	"KRW": {code: "KRW", minorUnits: 0, symbol: "₩"},
+	"KWD": {code: "KWD", minorUnits: 3},
+}
+
+func LookupCurrency(code string) (*Currency, error) {
+	if code == "" {
+		return nil, EmptyFieldError{Field: "currency"}
+	}
+	currency, exists := currencies[strings.ToUpper(code)]
+	if !exists {
+		return nil, InvalidCurrencyError{Value: strings.ToUpper(code)}
+	}
+	return &currency, nil
+}
+
+func (c *Currency) Code() string {
+	return c.code
+}
+
+func (c *Currency) MinorUnits() int {
+	return c.minorUnits
+}
+
+func (c *Currency) Symbol() string {
+	return c.symbol
+}
+
+// RoundingMode - 最小単位未満の端数の丸め方
+type RoundingMode int
+
+const (
+	RoundHalfUp   RoundingMode = iota // 四捨五入（0.5は0から遠い方へ）
+	RoundHalfEven                     // 銀行丸め（0.5は偶数側へ）
+	RoundDown                         // 切り捨て（0方向）
+	RoundUp                           // 切り上げ（0から遠い方向）
+)
+
+// Money 値オブジェクト
+type Money struct {
+	amount   int64  // 最小単位で保存（例：JPYなら円、USDならcents）
+	currency string // 通貨コード（ISO 4217）
+}
+
+func NewMoney(amount int64, currency string) (*Money, error) {
+	info, err := LookupCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{
+		amount:   amount,
+		currency: info.code,
+	}, nil
+}
+
+func (m *Money) Amount() int64 {
+	return m.amount
+}
+
+func (m *Money) Currency() string {
+	return m.currency
+}
+
+// MinorUnits は通貨の補助単位の桁数を返す
+func (m *Money) MinorUnits() int {
+	return currencies[m.currency].minorUnits
+}
+
+// String は補助単位の桁数に従って表示する（例: ¥1000, $12.34, -£0.50, 1.250 KWD）
+func (m *Money) String() string {
+	info := currencies[m.currency]
+
+	sign := ""
+	if m.amount < 0 {
+		sign = "-"
+	}
+	// math.MinInt64 でも桁あふれしないよう符号なしで扱う
+	abs := uint64(m.amount)
+	if m.amount < 0 {
+		abs = -abs
+	}
+
+	digits := strconv.FormatUint(abs, 10)
+	if info.minorUnits > 0 {
+		if len(digits) <= info.minorUnits {
+			digits = strings.Repeat("0", info.minorUnits-len(digits)+1) + digits
+		}
+		point := len(digits) - info.minorUnits
+		digits = digits[:point] + "." + digits[point:]
+	}
+
+	if info.symbol == "" {
+		return sign + digits + " " + m.currency
+	}
+	return sign + info.symbol + digits
+}
+
+func (m *Money) Equals(other *Money) bool {
+	if other == nil {
+		return false
+	}
+	return m.amount == other.amount && m.currency == other.currency
+}
+
+func (m *Money) Add(other *Money) (*Money, error) {
+	if err := m.validateSameCurrency(other); err != nil {
+		return nil, err
+	}
+
+	sum := m.amount + other.amount
+	if (other.amount > 0 && sum < m.amount) || (other.amount < 0 && sum > m.amount) {
+		return nil, MoneyOverflowError{Operation: "add"}
+	}
+	return &Money{amount: sum, currency: m.currency}, nil
+}
+
+func (m *Money) Subtract(other *Money) (*Money, error) {
+	if err := m.validateSameCurrency(other); err != nil {
+		return nil, err
+	}
+
+	diff := m.amount - other.amount
+	if (other.amount > 0 && diff > m.amount) || (other.amount < 0 && diff < m.amount) {
+		return nil, MoneyOverflowError{Operation: "subtract"}
+	}
+	return &Money{amount: diff, currency: m.currency}, nil
+}
+
+func (m *Money) Multiply(multiplier int64) (*Money, error) {
+	product := new(big.Int).Mul(big.NewInt(m.amount), big.NewInt(multiplier))
+	if !product.IsInt64() {
+		return nil, MoneyOverflowError{Operation: "multiply"}
+	}
+	return &Money{amount: product.Int64(), currency: m.currency}, nil
+}
+
+func (m *Money) Negate() (*Money, error) {
+	if m.amount == math.MinInt64 {
+		return nil, MoneyOverflowError{Operation: "negate"}
+	}
+	return &Money{amount: -m.amount, currency: m.currency}, nil
+}
+
+// MultiplyRatio は金額に numerator/denominator を掛け、端数を mode で丸める
+// 例: 8.5% は MultiplyRatio(85, 1000, mode)
+func (m *Money) MultiplyRatio(numerator, denominator int64, mode RoundingMode) (*Money, error) {
+	if denominator == 0 {
+		return nil, InvalidAllocationError{Reason: "denominator must not be zero"}
+	}
+
+	dividend := new(big.Int).Mul(big.NewInt(m.amount), big.NewInt(numerator))
+	quotient, err := roundQuotient(dividend, big.NewInt(denominator), mode)
+	if err != nil {
+		return nil, err
+	}
+
+	if !quotient.IsInt64() {
+		return nil, MoneyOverflowError{Operation: "multiply ratio"}
+	}
+	return &Money{amount: quotient.Int64(), currency: m.currency}, nil
+}
+
+// Percent は金額の percent% を返す（例: Percent(10, RoundDown) で1割）
+func (m *Money) Percent(percent int64, mode RoundingMode) (*Money, error) {
+	return m.MultiplyRatio(percent, 100, mode)
+}
+
+// roundQuotient は dividend / divisor を mode で整数に丸める
+func roundQuotient(dividend, divisor *big.Int, mode RoundingMode) (*big.Int, error) {
+	quotient, remainder := new(big.Int).QuoRem(dividend, divisor, new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient, nil
+	}
+
+	roundAway, err := shouldRoundAway(quotient, remainder, divisor, mode)
+	if err != nil {
+		return nil, err
+	}
+	if roundAway {
+		// 正確な値の符号の方向へ1単位進める
+		quotient.Add(quotient, big.NewInt(int64(remainder.Sign()*divisor.Sign())))
+	}
+	return quotient, nil
+}
+
+// shouldRoundAway は切り捨てた商を0から遠い方向へ丸めるべきかを返す
+func shouldRoundAway(quotient, remainder, divisor *big.Int, mode RoundingMode) (bool, error) {
+	// |remainder|*2 と |divisor| を比べて端数が半分を超えるかを判定する
+	twice := new(big.Int).Abs(remainder)
+	twice.Lsh(twice, 1)
+	half := twice.Cmp(new(big.Int).Abs(divisor))
+
+	switch mode {
+	case RoundDown:
+		return false, nil
+	case RoundUp:
+		return true, nil
+	case RoundHalfUp:
+		return half >= 0, nil
+	case RoundHalfEven:
+		return half > 0 || (half == 0 && quotient.Bit(0) == 1), nil
+	}
+	return false, InvalidAllocationError{Reason: fmt.Sprintf("unknown rounding mode: %d", mode)}
+}
+
+// Allocate は金額を比率に従って分配する
+// 端数は最小単位ずつ、切り捨てで失われた割合が大きい順（同じなら先頭から）に配るため、
+// 分配後の合計は常に元の金額と一致する
+func (m *Money) Allocate(ratios []int64) ([]*Money, error) {
+	if len(ratios) == 0 {
+		return nil, InvalidAllocationError{Reason: "ratios must not be empty"}
+	}
+
+	total := new(big.Int)
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, InvalidAllocationError{Reason: "ratios must not be negative"}
+		}
+		total.Add(total, big.NewInt(ratio))
+	}
+	if total.Sign() == 0 {
+		return nil, InvalidAllocationError{Reason: "ratios must not all be zero"}
+	}
+
+	// 符号なしの金額で分配し、最後に符号を戻す
+	amount := new(big.Int).Abs(big.NewInt(m.amount))
+	shares := make([]*big.Int, len(ratios))
+	remainders := make([]*big.Int, len(ratios))
+	allocated := new(big.Int)
+	for i, ratio := range ratios {
+		product := new(big.Int).Mul(amount, big.NewInt(ratio))
+		shares[i], remainders[i] = new(big.Int).QuoRem(product, total, new(big.Int))
+		allocated.Add(allocated, shares[i])
+	}
+
+	order := make([]int, len(ratios))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]].Cmp(remainders[order[b]]) > 0
+	})
+
+	leftover := new(big.Int).Sub(amount, allocated).Int64()
+	for i := int64(0); i < leftover; i++ {
+		shares[order[i]].Add(shares[order[i]], big.NewInt(1))
+	}
+
+	results := make([]*Money, len(ratios))
+	for i, share := range shares {
+		if m.amount < 0 {
+			share.Neg(share)
+		}
+		results[i] = &Money{amount: share.Int64(), currency: m.currency}
+	}
+	return results, nil
+}
+
+// Split は金額を n 等分する（端数は先頭から1単位ずつ配る）
+func (m *Money) Split(n int) ([]*Money, error) {
+	if n <= 0 {
+		return nil, InvalidAllocationError{Reason: "split count must be positive"}
+	}
+	ratios := make([]int64, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return m.Allocate(ratios)
+}
+
+// Compare は m < other なら -1、等しければ 0、m > other なら 1 を返す
+func (m *Money) Compare(other *Money) (int, error) {
+	if err := m.validateSameCurrency(other); err != nil {
+		return 0, err
+	}
+	switch {
+	case m.amount < other.amount:
+		return -1, nil
+	case m.amount > other.amount:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+func (m *Money) GreaterThan(other *Money) (bool, error) {
+	result, err := m.Compare(other)
+	return result > 0, err
+}
+
+func (m *Money) LessThan(other *Money) (bool, error) {
+	result, err := m.Compare(other)
+	return result < 0, err
+}
+
+func (m *Money) IsPositive() bool {
+	return m.amount > 0
+}
+
+func (m *Money) IsNegative() bool {
+	return m.amount < 0
+}
+
+func (m *Money) IsZero() bool {
+	return m.amount == 0
+}
+
+func (m *Money) validateSameCurrency(other *Money) error {
+	if other == nil {
+		return EmptyFieldError{Field: "money"}
+	}
+	if m.currency != other.currency {
+		return CurrencyMismatchError{Currency1: m.currency, Currency2: other.currency}
+	}
+	return nil
+}
+
+// moneyJSON はJSON表現（金額は最小単位の整数）
+type moneyJSON struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+func (m *Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(moneyJSON{Amount: m.amount, Currency: m.currency})
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var raw moneyJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	money, err := NewMoney(raw.Amount, raw.Currency)
+	if err != nil {
+		return err
+	}
+	*m = *money
+	return nil
+}
+
+// Value は1カラムに保存する場合の表現（例: "1234 JPY"）を返す
+// 金額と通貨を別カラムに保存するテーブルでは Amount / Currency を使う
+func (m *Money) Value() (driver.Value, error) {
+	return strconv.FormatInt(m.amount, 10) + " " + m.currency, nil
+}
+
+func (m *Money) Scan(src any) error {
+	var text string
+	switch value := src.(type) {
+	case string:
+		text = value
+	case []byte:
+		text = string(value)
+	default:
+		return fmt.Errorf("cannot scan %T into Money", src)
+	}
+
+	amountText, currency, found := strings.Cut(text, " ")
+	if !found {
+		return fmt.Errorf("invalid money value: %q", text)
+	}
+	amount, err := strconv.ParseInt(amountText, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid money amount: %q", text)
+	}
+	money, err := NewMoney(amount, currency)
+	if err != nil {
+		return err
+	}
+	*m = *money
+	return nil
+}
+
+// Money related errors
+type MoneyOverflowError struct {
+	Operation string
+}
+
+func (e MoneyOverflowError) Error() string {
+	return "money overflow in " + e.Operation
+}
+
+func (e MoneyOverflowError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
+
+type InvalidAllocationError struct {
+	Reason string
+}
+
+func (e InvalidAllocationError) Error() string {
+	return "invalid allocation: " + e.Reason
+}
+
+func (e InvalidAllocationError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
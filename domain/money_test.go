@@ -0,0 +1,280 @@
+package domain
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestMoney_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   int64
+		currency string
+		expected string
+	}{
+		{"円（補助単位なし）", 1000, "JPY", "¥1000"},
+		{"ドル", 1234, "USD", "$12.34"},
+		{"ポンド", 5, "GBP", "£0.05"},
+		{"豪ドル", 100000, "AUD", "A$1000.00"},
+		{"カナダドルの負の値", -150, "CAD", "-CA$1.50"},
+		{"記号のない通貨", 1250, "KWD", "1.250 KWD"},
+		{"最小値でも桁あふれしない", math.MinInt64, "JPY", "-¥9223372036854775808"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			money := mustMoney(t, tt.amount, tt.currency)
+			if money.String() != tt.expected {
+				t.Errorf("Expected %q, but got %q", tt.expected, money.String())
+			}
+		})
+	}
+}
+
+func TestLookupCurrency_MinorUnits(t *testing.T) {
+	tests := []struct {
+		code       string
+		minorUnits int
+	}{
+		{"JPY", 0},
+		{"usd", 2},
+		{"KWD", 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			currency, err := LookupCurrency(tt.code)
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if currency.MinorUnits() != tt.minorUnits {
+				t.Errorf("Expected %d minor units, but got %d", tt.minorUnits, currency.MinorUnits())
+			}
+		})
+	}
+
+	if _, err := LookupCurrency("XXX"); err == nil {
+		t.Error("Expected error for unknown currency")
+	}
+}
+
+func TestMoney_Allocate(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   int64
+		ratios   []int64
+		expected []int64
+	}{
+		{"3等分の端数は先頭へ", 1000, []int64{1, 1, 1}, []int64{334, 333, 333}},
+		{"割り切れる比率", 1000, []int64{3, 7}, []int64{300, 700}},
+		{"端数は失われた割合が大きい順", 100, []int64{1, 2, 2}, []int64{20, 40, 40}},
+		{"端数が同じなら先頭へ", 5, []int64{3, 7}, []int64{2, 3}},
+		{"端数の大きい方へ", 7, []int64{3, 7}, []int64{2, 5}},
+		{"比率0は受け取らない", 10, []int64{0, 1, 2}, []int64{0, 3, 7}},
+		{"負の金額", -1000, []int64{1, 1, 1}, []int64{-334, -333, -333}},
+		{"大きな金額でも桁あふれしない", math.MaxInt64, []int64{math.MaxInt64, 1}, []int64{math.MaxInt64 - 1, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			shares, err := mustMoney(t, tt.amount, "JPY").Allocate(tt.ratios)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			var total int64
+			for i, share := range shares {
+				if share.Amount() != tt.expected[i] {
+					t.Errorf("Expected share[%d] = %d, but got %d", i, tt.expected[i], share.Amount())
+				}
+				total += share.Amount()
+			}
+			if total != tt.amount {
+				t.Errorf("Expected total %d, but got %d", tt.amount, total)
+			}
+		})
+	}
+}
+
+func TestMoney_Allocate_InvalidRatios_ReturnsError(t *testing.T) {
+	tests := []struct {
+		name   string
+		ratios []int64
+	}{
+		{"空", []int64{}},
+		{"全て0", []int64{0, 0}},
+		{"負の比率", []int64{1, -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := mustMoney(t, 1000, "JPY").Allocate(tt.ratios)
+			if _, ok := err.(InvalidAllocationError); !ok {
+				t.Errorf("Expected InvalidAllocationError, but got %T", err)
+			}
+		})
+	}
+}
+
+func TestMoney_Split(t *testing.T) {
+	shares, err := mustMoney(t, 1001, "USD").Split(4)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	expected := []int64{251, 250, 250, 250}
+	for i, share := range shares {
+		if share.Amount() != expected[i] {
+			t.Errorf("Expected share[%d] = %d, but got %d", i, expected[i], share.Amount())
+		}
+	}
+}
+
+func TestMoney_MultiplyRatio_RoundingModes(t *testing.T) {
+	tests := []struct {
+		name        string
+		amount      int64
+		numerator   int64
+		denominator int64
+		mode        RoundingMode
+		expected    int64
+	}{
+		{"四捨五入 0.5は切り上げ", 25, 1, 10, RoundHalfUp, 3},
+		{"四捨五入 負の0.5", -25, 1, 10, RoundHalfUp, -3},
+		{"銀行丸め 2.5は2", 25, 1, 10, RoundHalfEven, 2},
+		{"銀行丸め 3.5は4", 35, 1, 10, RoundHalfEven, 4},
+		{"銀行丸め 半分超は切り上げ", 26, 1, 10, RoundHalfEven, 3},
+		{"切り捨て", 29, 1, 10, RoundDown, 2},
+		{"切り上げ", 21, 1, 10, RoundUp, 3},
+		{"負の切り上げは0から遠い方向", -21, 1, 10, RoundUp, -3},
+		{"割り切れる場合は丸めない", 30, 1, 10, RoundUp, 3},
+		{"8.5%", 1000, 85, 1000, RoundHalfUp, 85},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := mustMoney(t, tt.amount, "JPY").MultiplyRatio(tt.numerator, tt.denominator, tt.mode)
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if result.Amount() != tt.expected {
+				t.Errorf("Expected %d, but got %d", tt.expected, result.Amount())
+			}
+		})
+	}
+}
+
+func TestMoney_Percent(t *testing.T) {
+	// 消費税10%（1円未満切り捨て）
+	tax, err := mustMoney(t, 1999, "JPY").Percent(10, RoundDown)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if tax.Amount() != 199 {
+		t.Errorf("Expected 199, but got %d", tax.Amount())
+	}
+
+	if _, err := mustMoney(t, 100, "JPY").MultiplyRatio(1, 0, RoundDown); err == nil {
+		t.Error("Expected error for zero denominator")
+	}
+}
+
+func TestMoney_Overflow_ReturnsError(t *testing.T) {
+	max := mustMoney(t, math.MaxInt64, "JPY")
+	min := mustMoney(t, math.MinInt64, "JPY")
+	one := mustMoney(t, 1, "JPY")
+
+	tests := []struct {
+		name string
+		op   func() (*Money, error)
+	}{
+		{"加算", func() (*Money, error) { return max.Add(one) }},
+		{"減算", func() (*Money, error) { return min.Subtract(one) }},
+		{"乗算", func() (*Money, error) { return max.Multiply(2) }},
+		{"符号反転", func() (*Money, error) { return min.Negate() }},
+		{"比率の乗算", func() (*Money, error) { return max.MultiplyRatio(3, 2, RoundDown) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.op()
+			if _, ok := err.(MoneyOverflowError); !ok {
+				t.Errorf("Expected MoneyOverflowError, but got %T", err)
+			}
+			if result != nil {
+				t.Errorf("Expected nil result, but got %v", result)
+			}
+		})
+	}
+}
+
+func TestMoney_Compare(t *testing.T) {
+	small := mustMoney(t, 100, "JPY")
+	large := mustMoney(t, 200, "JPY")
+
+	if result, _ := small.Compare(large); result != -1 {
+		t.Errorf("Expected -1, but got %d", result)
+	}
+	if greater, _ := large.GreaterThan(small); !greater {
+		t.Error("Expected large > small")
+	}
+	if less, _ := large.LessThan(small); less {
+		t.Error("Expected large not < small")
+	}
+	if _, err := small.Compare(mustMoney(t, 100, "USD")); err == nil {
+		t.Error("Expected error when comparing different currencies")
+	}
+}
+
+func TestMoney_JSON_RoundTrip(t *testing.T) {
+	// Arrange
+	original := mustMoney(t, 1234, "USD")
+
+	// Act
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	var decoded Money
+	err = json.Unmarshal(data, &decoded)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if string(data) != `{"amount":1234,"currency":"USD"}` {
+		t.Errorf("Unexpected JSON: %s", data)
+	}
+	if !decoded.Equals(original) {
+		t.Errorf("Expected %v, but got %v", original, &decoded)
+	}
+
+	if err := json.Unmarshal([]byte(`{"amount":1,"currency":"XXX"}`), &decoded); err == nil {
+		t.Error("Expected error for unknown currency")
+	}
+}
+
+func TestMoney_SQL_RoundTrip(t *testing.T) {
+	original := mustMoney(t, -500, "EUR")
+
+	value, err := original.Value()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	var scanned Money
+	if err := scanned.Scan([]byte(value.(string))); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if !scanned.Equals(original) {
+		t.Errorf("Expected %v, but got %v", original, &scanned)
+	}
+
+	for _, invalid := range []any{"500", "abc EUR", "500 XXX", nil} {
+		if err := scanned.Scan(invalid); err == nil {
+			t.Errorf("Expected error for %v", invalid)
+		}
+	}
+}
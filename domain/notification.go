@@ -0,0 +1,393 @@
+package domain
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationKind - ユーザーに通知する出来事の種類
+type NotificationKind string
+
+const (
+	NotificationAddedToCircle NotificationKind = "added_to_circle" // サークルにメンバーとして追加された
+	NotificationCircleFilled  NotificationKind = "circle_filled"   // 所有するサークルが定員に達した
+	NotificationEmailChanged  NotificationKind = "email_changed"   // メールアドレスが変更された
+)
+
+// NotificationKinds は設定できる通知の種類
+func NotificationKinds() []NotificationKind {
+	return []NotificationKind{NotificationAddedToCircle, NotificationCircleFilled, NotificationEmailChanged}
+}
+
+func ParseNotificationKind(value string) (NotificationKind, error) {
+	for _, kind := range NotificationKinds() {
+		if string(kind) == value {
+			return kind, nil
+		}
+	}
+	return "", InvalidNotificationPreferencesError{Reason: "unknown notification kind: " + value}
+}
+
+func (k NotificationKind) String() string {
+	return string(k)
+}
+
+// NotificationChannel - 通知の受け取り方
+type NotificationChannel string
+
+const (
+	NotificationImmediate NotificationChannel = "immediate" // すぐに送る（おやすみ時間中は明けてから）
+	NotificationDigest    NotificationChannel = "digest"    // 1日1回まとめて送る
+	NotificationOff       NotificationChannel = "off"       // 送らない
+)
+
+func ParseNotificationChannel(value string) (NotificationChannel, error) {
+	channel := NotificationChannel(value)
+	switch channel {
+	case NotificationImmediate, NotificationDigest, NotificationOff:
+		return channel, nil
+	}
+	return "", InvalidNotificationPreferencesError{Reason: "unknown notification channel: " + value}
+}
+
+func (c NotificationChannel) String() string {
+	return string(c)
+}
+
+// TimeOfDay 値オブジェクト - 1日のうちの時刻（分単位）
+type TimeOfDay struct {
+	minutes int
+}
+
+// ParseTimeOfDay は "HH:MM" 形式の時刻を解析する
+func ParseTimeOfDay(value string) (*TimeOfDay, error) {
+	var hour, minute int
+	if _, err := fmt.Sscanf(value, "%d:%d", &hour, &minute); err != nil || len(value) != 5 {
+		return nil, InvalidNotificationPreferencesError{Reason: "time must be HH:MM: " + value}
+	}
+	if hour < 0 || hour > 23 || minute < 0 || minute > 59 {
+		return nil, InvalidNotificationPreferencesError{Reason: "time out of range: " + value}
+	}
+	return &TimeOfDay{minutes: hour*60 + minute}, nil
+}
+
+func ReconstructTimeOfDay(minutes int) *TimeOfDay {
+	return &TimeOfDay{minutes: minutes}
+}
+
+func (t *TimeOfDay) Minutes() int {
+	return t.minutes
+}
+
+func (t *TimeOfDay) String() string {
+	return fmt.Sprintf("%02d:%02d", t.minutes/60, t.minutes%60)
+}
+
+// on は指定時刻と同じ日（タイムゾーン内）のこの時刻を返す
+func (t *TimeOfDay) on(day time.Time) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), t.minutes/60, t.minutes%60, 0, 0, day.Location())
+}
+
+// nextAfter は指定時刻より後で最初にこの時刻になる日時を返す
+func (t *TimeOfDay) nextAfter(now time.Time) time.Time {
+	next := t.on(now)
+	if !next.After(now) {
+		next = t.on(now.AddDate(0, 0, 1))
+	}
+	return next
+}
+
+func minuteOfDay(t time.Time) int {
+	return t.Hour()*60 + t.Minute()
+}
+
+// QuietHours 値オブジェクト - 通知を控える時間帯（日付をまたいでもよい）
+type QuietHours struct {
+	start *TimeOfDay
+	end   *TimeOfDay
+}
+
+func NewQuietHours(start, end *TimeOfDay) (*QuietHours, error) {
+	if start == nil || end == nil {
+		return nil, EmptyFieldError{Field: "quiet hours"}
+	}
+	if start.minutes == end.minutes {
+		return nil, InvalidNotificationPreferencesError{Reason: "quiet hours must not start and end at the same time"}
+	}
+	return &QuietHours{start: start, end: end}, nil
+}
+
+func (q *QuietHours) Start() *TimeOfDay {
+	return q.start
+}
+
+func (q *QuietHours) End() *TimeOfDay {
+	return q.end
+}
+
+// Contains は現地時刻がおやすみ時間に含まれるかを返す（開始を含み終了を含まない）
+func (q *QuietHours) Contains(local time.Time) bool {
+	m := minuteOfDay(local)
+	if q.start.minutes < q.end.minutes {
+		return q.start.minutes <= m && m < q.end.minutes
+	}
+	return m >= q.start.minutes || m < q.end.minutes
+}
+
+// NotificationPreferences - ユーザーごとの通知設定（集約ルート）
+// 設定していない種類はすぐに送る
+type NotificationPreferences struct {
+	userID     *UserID
+	locale     Locale
+	timeZone   *time.Location
+	channels   map[NotificationKind]NotificationChannel
+	quietHours *QuietHours // nil はおやすみ時間なし
+	digestAt   *TimeOfDay  // まとめて送る時刻
+	updatedAt  time.Time
+}
+
+// DefaultDigestTime はまとめて送る時刻の初期値（8:00）
+var DefaultDigestTime = &TimeOfDay{minutes: 8 * 60}
+
+// DefaultNotificationPreferences は設定を保存していないユーザーの通知設定を返す
+func DefaultNotificationPreferences(userID *UserID, locale Locale) *NotificationPreferences {
+	return &NotificationPreferences{
+		userID:   userID,
+		locale:   locale,
+		timeZone: time.UTC,
+		channels: make(map[NotificationKind]NotificationChannel),
+		digestAt: DefaultDigestTime,
+	}
+}
+
+func ReconstructNotificationPreferences(
+	userID *UserID,
+	locale Locale,
+	timeZone *time.Location,
+	channels map[NotificationKind]NotificationChannel,
+	quietHours *QuietHours,
+	digestAt *TimeOfDay,
+	updatedAt time.Time,
+) *NotificationPreferences {
+	return &NotificationPreferences{
+		userID:     userID,
+		locale:     locale,
+		timeZone:   timeZone,
+		channels:   channels,
+		quietHours: quietHours,
+		digestAt:   digestAt,
+		updatedAt:  updatedAt,
+	}
+}
+
+func (p *NotificationPreferences) UserID() *UserID {
+	return p.userID
+}
+
+func (p *NotificationPreferences) Locale() Locale {
+	return p.locale
+}
+
+func (p *NotificationPreferences) TimeZone() *time.Location {
+	return p.timeZone
+}
+
+func (p *NotificationPreferences) QuietHours() *QuietHours {
+	return p.quietHours
+}
+
+func (p *NotificationPreferences) DigestAt() *TimeOfDay {
+	return p.digestAt
+}
+
+func (p *NotificationPreferences) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+// ChannelFor は通知の種類ごとの受け取り方を返す
+func (p *NotificationPreferences) ChannelFor(kind NotificationKind) NotificationChannel {
+	if channel, ok := p.channels[kind]; ok {
+		return channel
+	}
+	return NotificationImmediate
+}
+
+// Channels はすべての種類の受け取り方を返す
+func (p *NotificationPreferences) Channels() map[NotificationKind]NotificationChannel {
+	channels := make(map[NotificationKind]NotificationChannel, len(NotificationKinds()))
+	for _, kind := range NotificationKinds() {
+		channels[kind] = p.ChannelFor(kind)
+	}
+	return channels
+}
+
+func (p *NotificationPreferences) ChangeLocale(locale Locale, now time.Time) {
+	p.locale = locale
+	p.updatedAt = now
+}
+
+func (p *NotificationPreferences) ChangeTimeZone(timeZone *time.Location, now time.Time) error {
+	if timeZone == nil {
+		return EmptyFieldError{Field: "time zone"}
+	}
+	p.timeZone = timeZone
+	p.updatedAt = now
+	return nil
+}
+
+func (p *NotificationPreferences) ChangeChannel(kind NotificationKind, channel NotificationChannel, now time.Time) {
+	p.channels[kind] = channel
+	p.updatedAt = now
+}
+
+// ChangeQuietHours は nil を渡すとおやすみ時間を解除する
+func (p *NotificationPreferences) ChangeQuietHours(quietHours *QuietHours, now time.Time) {
+	p.quietHours = quietHours
+	p.updatedAt = now
+}
+
+func (p *NotificationPreferences) ChangeDigestTime(digestAt *TimeOfDay, now time.Time) error {
+	if digestAt == nil {
+		return EmptyFieldError{Field: "digest time"}
+	}
+	p.digestAt = digestAt
+	p.updatedAt = now
+	return nil
+}
+
+// ScheduleFor は通知を送る時刻を決める
+// 送らない場合は false を返す。おやすみ時間中に送る時刻になる場合は明けるまで遅らせる
+func (p *NotificationPreferences) ScheduleFor(kind NotificationKind, now time.Time) (time.Time, bool) {
+	switch p.ChannelFor(kind) {
+	case NotificationOff:
+		return time.Time{}, false
+	case NotificationDigest:
+		return p.afterQuietHours(p.digestAt.nextAfter(now.In(p.timeZone))), true
+	default:
+		return p.afterQuietHours(now.In(p.timeZone)), true
+	}
+}
+
+// NextDigestAt は次にまとめて送る時刻を返す
+func (p *NotificationPreferences) NextDigestAt(now time.Time) time.Time {
+	return p.afterQuietHours(p.digestAt.nextAfter(now.In(p.timeZone)))
+}
+
+func (p *NotificationPreferences) afterQuietHours(local time.Time) time.Time {
+	if p.quietHours == nil || !p.quietHours.Contains(local) {
+		return local
+	}
+	return p.quietHours.end.nextAfter(local)
+}
+
+// PendingNotification - まとめて送る、またはおやすみ時間明けに送る通知
+// 作成時の言語で描画した件名と本文を保持する
+type PendingNotification struct {
+	id           string
+	userID       *UserID
+	kind         NotificationKind
+	message      *MailMessage
+	digest       bool // まとめて送る通知か（false はおやすみ時間で遅らせた通知）
+	deliverAfter time.Time
+	createdAt    time.Time
+	sentAt       time.Time
+}
+
+func NewPendingNotification(userID *UserID, kind NotificationKind, message *MailMessage, digest bool, deliverAfter, now time.Time) (*PendingNotification, error) {
+	if userID == nil {
+		return nil, EmptyFieldError{Field: "user ID"}
+	}
+	if message == nil {
+		return nil, EmptyFieldError{Field: "message"}
+	}
+	return &PendingNotification{
+		id:           uuid.New().String(),
+		userID:       userID,
+		kind:         kind,
+		message:      message,
+		digest:       digest,
+		deliverAfter: deliverAfter,
+		createdAt:    now,
+	}, nil
+}
+
+func ReconstructPendingNotification(
+	id string,
+	userID *UserID,
+	kind NotificationKind,
+	message *MailMessage,
+	digest bool,
+	deliverAfter time.Time,
+	createdAt time.Time,
+	sentAt time.Time,
+) *PendingNotification {
+	return &PendingNotification{
+		id:           id,
+		userID:       userID,
+		kind:         kind,
+		message:      message,
+		digest:       digest,
+		deliverAfter: deliverAfter,
+		createdAt:    createdAt,
+		sentAt:       sentAt,
+	}
+}
+
+func (n *PendingNotification) ID() string {
+	return n.id
+}
+
+func (n *PendingNotification) UserID() *UserID {
+	return n.userID
+}
+
+func (n *PendingNotification) Kind() NotificationKind {
+	return n.kind
+}
+
+func (n *PendingNotification) Message() *MailMessage {
+	return n.message
+}
+
+func (n *PendingNotification) IsDigest() bool {
+	return n.digest
+}
+
+func (n *PendingNotification) DeliverAfter() time.Time {
+	return n.deliverAfter
+}
+
+func (n *PendingNotification) CreatedAt() time.Time {
+	return n.createdAt
+}
+
+func (n *PendingNotification) SentAt() time.Time {
+	return n.sentAt
+}
+
+func (n *PendingNotification) IsSent() bool {
+	return !n.sentAt.IsZero()
+}
+
+func (n *PendingNotification) IsDueAt(now time.Time) bool {
+	return !n.IsSent() && !n.deliverAfter.After(now)
+}
+
+func (n *PendingNotification) MarkSent(now time.Time) {
+	n.sentAt = now
+}
+
+type InvalidNotificationPreferencesError struct {
+	Reason string
+}
+
+func (e InvalidNotificationPreferencesError) Error() string {
+	return "invalid notification preferences: " + e.Reason
+}
+
+func (e InvalidNotificationPreferencesError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func mustTimeOfDay(t *testing.T, value string) *TimeOfDay {
+	t.Helper()
+
+	timeOfDay, err := ParseTimeOfDay(value)
+	if err != nil {
+		t.Fatalf("Failed to parse time of day %q: %v", value, err)
+	}
+	return timeOfDay
+}
+
+func TestParseTimeOfDay(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expected    int
+		expectError bool
+	}{
+		{"朝", "07:30", 7*60 + 30, false},
+		{"深夜0時", "00:00", 0, false},
+		{"1日の最後", "23:59", 23*60 + 59, false},
+		{"24時は不可", "24:00", 0, true},
+		{"60分は不可", "12:60", 0, true},
+		{"ゼロ埋めなしは不可", "7:30", 0, true},
+		{"形式が違う", "7時半", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			timeOfDay, err := ParseTimeOfDay(tt.value)
+
+			// Assert
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error for %q, but got none", tt.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if timeOfDay.Minutes() != tt.expected || timeOfDay.String() != tt.value {
+				t.Errorf("Expected %d (%s), but got %d (%s)", tt.expected, tt.value, timeOfDay.Minutes(), timeOfDay)
+			}
+		})
+	}
+}
+
+func TestNotificationPreferences_ScheduleFor(t *testing.T) {
+	// Arrange
+	jst := time.FixedZone("JST", 9*60*60)
+	preferences := DefaultNotificationPreferences(NewUserID(), LocaleJapanese)
+	setAt := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	preferences.ChangeTimeZone(jst, setAt)
+	quietHours, _ := NewQuietHours(mustTimeOfDay(t, "22:00"), mustTimeOfDay(t, "07:00"))
+	preferences.ChangeQuietHours(quietHours, setAt)
+	preferences.ChangeChannel(NotificationAddedToCircle, NotificationDigest, setAt)
+	preferences.ChangeChannel(NotificationEmailChanged, NotificationOff, setAt)
+	at := func(day, hour, minute int) time.Time { return time.Date(2025, 7, day, hour, minute, 0, 0, jst) }
+
+	tests := []struct {
+		name       string
+		kind       NotificationKind
+		now        time.Time
+		expected   time.Time
+		expectSend bool
+	}{
+		{"日中はすぐに送る", NotificationCircleFilled, at(1, 12, 0), at(1, 12, 0), true},
+		{"おやすみ時間中は明けるまで遅らせる", NotificationCircleFilled, at(1, 23, 0), at(2, 7, 0), true},
+		{"日付をまたいだおやすみ時間", NotificationCircleFilled, at(2, 6, 59), at(2, 7, 0), true},
+		{"おやすみ時間の終了時刻は送る", NotificationCircleFilled, at(2, 7, 0), at(2, 7, 0), true},
+		{"まとめて送る通知は次の8時", NotificationAddedToCircle, at(1, 12, 0), at(2, 8, 0), true},
+		{"8時前なら当日の8時", NotificationAddedToCircle, at(1, 7, 30), at(1, 8, 0), true},
+		{"オフは送らない", NotificationEmailChanged, at(1, 12, 0), time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			deliverAt, ok := preferences.ScheduleFor(tt.kind, tt.now.UTC())
+
+			// Assert
+			if ok != tt.expectSend {
+				t.Fatalf("Expected send=%v, but got %v", tt.expectSend, ok)
+			}
+			if ok && !deliverAt.Equal(tt.expected) {
+				t.Errorf("Expected %v, but got %v", tt.expected, deliverAt.In(jst))
+			}
+		})
+	}
+}
+
+func TestNotificationPreferences_DigestInsideQuietHours_DeferredToQuietEnd(t *testing.T) {
+	// Arrange
+	preferences := DefaultNotificationPreferences(NewUserID(), LocaleEnglish)
+	now := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+	quietHours, _ := NewQuietHours(mustTimeOfDay(t, "06:00"), mustTimeOfDay(t, "09:00"))
+	preferences.ChangeQuietHours(quietHours, now)
+
+	// Act
+	next := preferences.NextDigestAt(now)
+
+	// Assert
+	expected := time.Date(2025, 7, 2, 9, 0, 0, 0, time.UTC)
+	if !next.Equal(expected) {
+		t.Errorf("Expected the 08:00 digest to move to %v, but got %v", expected, next)
+	}
+}
+
+func TestNewQuietHours_SameStartAndEnd_ReturnsError(t *testing.T) {
+	// Act
+	_, err := NewQuietHours(mustTimeOfDay(t, "22:00"), mustTimeOfDay(t, "22:00"))
+
+	// Assert
+	if err == nil {
+		t.Error("Expected error, but got none")
+	}
+}
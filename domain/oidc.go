@@ -0,0 +1,223 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"time"
+)
+
+const (
+	// DefaultOIDCLoginTTL は外部の認証に送り出してから戻ってくるまでの猶予
+	DefaultOIDCLoginTTL = 10 * time.Minute
+
+	// idTokenClockSkew は ID トークンの期限を確かめるときに許容する時計のずれ
+	idTokenClockSkew = time.Minute
+)
+
+// OIDCLoginRequest - OpenID プロバイダーに送り出したログインの途中状態
+// state でコールバックと照合し、nonce で ID トークンを、PKCE の code_verifier で認可コードを結び付ける
+// 一度しか使えない
+type OIDCLoginRequest struct {
+	state        string
+	nonce        string
+	codeVerifier string
+	linkUserID   *UserID // ログイン中のユーザーが外部のアカウントを紐付ける場合に設定する
+	createdAt    time.Time
+	expiresAt    time.Time
+}
+
+func NewOIDCLoginRequest(linkUserID *UserID, now time.Time, ttl time.Duration) (*OIDCLoginRequest, error) {
+	state, err := newOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := newOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+	codeVerifier, err := newOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+	return &OIDCLoginRequest{
+		state:        state,
+		nonce:        nonce,
+		codeVerifier: codeVerifier,
+		linkUserID:   linkUserID,
+		createdAt:    now,
+		expiresAt:    now.Add(ttl),
+	}, nil
+}
+
+func ReconstructOIDCLoginRequest(
+	state, nonce, codeVerifier string,
+	linkUserID *UserID,
+	createdAt, expiresAt time.Time,
+) *OIDCLoginRequest {
+	return &OIDCLoginRequest{
+		state:        state,
+		nonce:        nonce,
+		codeVerifier: codeVerifier,
+		linkUserID:   linkUserID,
+		createdAt:    createdAt,
+		expiresAt:    expiresAt,
+	}
+}
+
+func (r *OIDCLoginRequest) State() string {
+	return r.state
+}
+
+func (r *OIDCLoginRequest) Nonce() string {
+	return r.nonce
+}
+
+func (r *OIDCLoginRequest) CodeVerifier() string {
+	return r.codeVerifier
+}
+
+// CodeChallenge は PKCE の S256 方式のチャレンジを返す
+func (r *OIDCLoginRequest) CodeChallenge() string {
+	return PKCEChallenge(r.codeVerifier)
+}
+
+func (r *OIDCLoginRequest) LinkUserID() *UserID {
+	return r.linkUserID
+}
+
+func (r *OIDCLoginRequest) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+func (r *OIDCLoginRequest) ExpiresAt() time.Time {
+	return r.expiresAt
+}
+
+func (r *OIDCLoginRequest) IsExpiredAt(now time.Time) bool {
+	return !now.Before(r.expiresAt)
+}
+
+// PKCEChallenge は code_verifier から S256 方式の code_challenge を求める（RFC 7636）
+func PKCEChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// IDTokenClaims - 署名・発行者・対象者を確かめた ID トークンの内容
+type IDTokenClaims struct {
+	Issuer        string
+	Subject       string
+	IssuedAt      time.Time
+	ExpiresAt     time.Time
+	Nonce         string
+	Email         string
+	EmailVerified bool
+	GivenName     string
+	FamilyName    string
+}
+
+// Validate はログインの途中状態と時計に照らして ID トークンを確かめる
+func (c *IDTokenClaims) Validate(request *OIDCLoginRequest, now time.Time) error {
+	if c.Subject == "" {
+		return InvalidIDTokenError{Reason: "missing subject"}
+	}
+	if c.Nonce != request.Nonce() {
+		return InvalidIDTokenError{Reason: "nonce mismatch"}
+	}
+	if !now.Before(c.ExpiresAt.Add(idTokenClockSkew)) {
+		return InvalidIDTokenError{Reason: "token has expired"}
+	}
+	if c.IssuedAt.After(now.Add(idTokenClockSkew)) {
+		return InvalidIDTokenError{Reason: "token issued in the future"}
+	}
+	return nil
+}
+
+// OIDCProvider は外部の OpenID プロバイダーとのやり取りを行う（ポート）
+type OIDCProvider interface {
+	Issuer() string
+	// AuthorizationURL は利用者を送り出す認可エンドポイントの URL を返す（loginHint は空でもよい）
+	AuthorizationURL(state, nonce, codeChallenge, loginHint string) (string, error)
+	// ExchangeCode は認可コードをトークンと交換し、ID トークンの署名・発行者・対象者を確かめて内容を返す
+	ExchangeCode(code, codeVerifier string) (*IDTokenClaims, error)
+}
+
+// ExternalIdentity - OpenID プロバイダーのアカウント（発行者と subject の組）とユーザーの紐付け
+type ExternalIdentity struct {
+	issuer   string
+	subject  string
+	userID   *UserID
+	linkedAt time.Time
+}
+
+func NewExternalIdentity(issuer, subject string, userID *UserID, now time.Time) (*ExternalIdentity, error) {
+	if issuer == "" {
+		return nil, EmptyFieldError{Field: "issuer"}
+	}
+	if subject == "" {
+		return nil, EmptyFieldError{Field: "subject"}
+	}
+	if userID == nil {
+		return nil, EmptyFieldError{Field: "user ID"}
+	}
+	return &ExternalIdentity{issuer: issuer, subject: subject, userID: userID, linkedAt: now}, nil
+}
+
+func ReconstructExternalIdentity(issuer, subject string, userID *UserID, linkedAt time.Time) *ExternalIdentity {
+	return &ExternalIdentity{issuer: issuer, subject: subject, userID: userID, linkedAt: linkedAt}
+}
+
+func (i *ExternalIdentity) Issuer() string {
+	return i.issuer
+}
+
+func (i *ExternalIdentity) Subject() string {
+	return i.subject
+}
+
+func (i *ExternalIdentity) UserID() *UserID {
+	return i.userID
+}
+
+func (i *ExternalIdentity) LinkedAt() time.Time {
+	return i.linkedAt
+}
+
+// InvalidOIDCLoginError はコールバックがログインの途中状態と合わない場合に返す
+type InvalidOIDCLoginError struct {
+	Reason string
+}
+
+func (e InvalidOIDCLoginError) Error() string {
+	return "invalid OpenID Connect login: " + e.Reason
+}
+
+func (e InvalidOIDCLoginError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
+
+type InvalidIDTokenError struct {
+	Reason string
+}
+
+func (e InvalidIDTokenError) Error() string {
+	return "invalid ID token: " + e.Reason
+}
+
+func (e InvalidIDTokenError) HTTPStatus() int {
+	return http.StatusUnauthorized
+}
+
+// ExternalIdentityConflictError は外部のアカウントを紐付けられない場合に返す
+type ExternalIdentityConflictError struct {
+	Reason string
+}
+
+func (e ExternalIdentityConflictError) Error() string {
+	return "cannot link external account: " + e.Reason
+}
+
+func (e ExternalIdentityConflictError) HTTPStatus() int {
+	return http.StatusConflict
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+var testOIDCNow = time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)
+
+func TestPKCEChallenge_RFC7636Example(t *testing.T) {
+	// Act
+	challenge := PKCEChallenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk")
+
+	// Assert
+	if challenge != "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM" {
+		t.Errorf("Expected the RFC 7636 example challenge, but got %s", challenge)
+	}
+}
+
+func TestNewOIDCLoginRequest_GeneratesUniqueSecrets(t *testing.T) {
+	// Act
+	first, _ := NewOIDCLoginRequest(nil, testOIDCNow, DefaultOIDCLoginTTL)
+	second, _ := NewOIDCLoginRequest(nil, testOIDCNow, DefaultOIDCLoginTTL)
+
+	// Assert
+	if first.State() == second.State() || first.Nonce() == second.Nonce() || first.CodeVerifier() == second.CodeVerifier() {
+		t.Error("Expected each login request to have its own state, nonce and code verifier")
+	}
+	if first.State() == first.Nonce() || first.CodeChallenge() == first.CodeVerifier() {
+		t.Error("Expected state, nonce and challenge to differ")
+	}
+	if !first.ExpiresAt().Equal(testOIDCNow.Add(DefaultOIDCLoginTTL)) {
+		t.Errorf("Expected expiry %v, but got %v", testOIDCNow.Add(DefaultOIDCLoginTTL), first.ExpiresAt())
+	}
+}
+
+func TestIDTokenClaims_Validate(t *testing.T) {
+	request, _ := NewOIDCLoginRequest(nil, testOIDCNow, DefaultOIDCLoginTTL)
+
+	tests := []struct {
+		name          string
+		modify        func(c *IDTokenClaims)
+		expectedError bool
+	}{
+		{"有効なトークン", func(c *IDTokenClaims) {}, false},
+		{"時計のずれの範囲内で期限切れ", func(c *IDTokenClaims) { c.ExpiresAt = testOIDCNow.Add(-30 * time.Second) }, false},
+		{"期限切れ", func(c *IDTokenClaims) { c.ExpiresAt = testOIDCNow.Add(-time.Minute) }, true},
+		{"未来に発行された", func(c *IDTokenClaims) { c.IssuedAt = testOIDCNow.Add(2 * time.Minute) }, true},
+		{"nonce の不一致", func(c *IDTokenClaims) { c.Nonce = "other" }, true},
+		{"subject なし", func(c *IDTokenClaims) { c.Subject = "" }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			claims := &IDTokenClaims{
+				Issuer:    "https://idp.example.test",
+				Subject:   "sso-001",
+				IssuedAt:  testOIDCNow,
+				ExpiresAt: testOIDCNow.Add(5 * time.Minute),
+				Nonce:     request.Nonce(),
+			}
+			tt.modify(claims)
+
+			// Act
+			err := claims.Validate(request, testOIDCNow)
+
+			// Assert
+			if !tt.expectedError {
+				if err != nil {
+					t.Errorf("Expected no error, but got: %v", err)
+				}
+				return
+			}
+			var invalid InvalidIDTokenError
+			if !errors.As(err, &invalid) {
+				t.Errorf("Expected InvalidIDTokenError, but got %v", err)
+			}
+		})
+	}
+}
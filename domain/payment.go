@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"net/http"
+	"time"
+)
+
+// PaymentStatus - 決済処理の状態
+type PaymentStatus string
+
+const (
+	PaymentSucceeded PaymentStatus = "succeeded"
+	PaymentPending   PaymentStatus = "pending" // 結果は後からWebhookで通知される
+	PaymentDeclined  PaymentStatus = "declined"
+)
+
+type ChargeRequest struct {
+	UserID         *UserID
+	Amount         *Money
+	Description    string
+	IdempotencyKey string // 同じキーの再送は同じ結果を返す
+}
+
+type ChargeResult struct {
+	ChargeID      string
+	Status        PaymentStatus
+	DeclineReason string
+}
+
+type RefundRequest struct {
+	UserID         *UserID
+	ChargeID       string
+	Amount         *Money
+	IdempotencyKey string
+}
+
+type RefundResult struct {
+	RefundID string
+	Status   PaymentStatus
+}
+
+// PaymentWebhookEventType - 決済事業者から通知されるイベント種別
+type PaymentWebhookEventType string
+
+const (
+	WebhookChargeSucceeded PaymentWebhookEventType = "charge.succeeded"
+	WebhookChargeFailed    PaymentWebhookEventType = "charge.failed"
+	WebhookRefundSucceeded PaymentWebhookEventType = "refund.succeeded"
+)
+
+// PaymentWebhookEvent - 署名検証済みのWebhookイベント
+type PaymentWebhookEvent struct {
+	EventID    string
+	Type       PaymentWebhookEventType
+	ChargeID   string
+	RefundID   string
+	UserID     *UserID
+	Amount     *Money
+	OccurredAt time.Time
+}
+
+// PaymentGateway - 外部決済事業者へのポート
+type PaymentGateway interface {
+	Charge(request ChargeRequest) (*ChargeResult, error)
+	Refund(request RefundRequest) (*RefundResult, error)
+	// VerifyWebhook は署名を検証し、正しい場合のみイベントを返す
+	VerifyWebhook(payload []byte, signature string) (*PaymentWebhookEvent, error)
+}
+
+// 台帳の参照キー（決済事業者のIDと対応付け、二重計上を防ぐ）
+func PaymentReference(chargeID string) string {
+	return "payment:" + chargeID
+}
+
+func RefundReference(refundID string) string {
+	return "refund:" + refundID
+}
+
+// Payment related errors
+type PaymentDeclinedError struct {
+	Reason string
+}
+
+func (e PaymentDeclinedError) Error() string {
+	return "payment declined: " + e.Reason
+}
+
+func (e PaymentDeclinedError) HTTPStatus() int {
+	return http.StatusPaymentRequired
+}
+
+type InvalidWebhookSignatureError struct{}
+
+func (e InvalidWebhookSignatureError) Error() string {
+	return "invalid webhook signature"
+}
+
+func (e InvalidWebhookSignatureError) HTTPStatus() int {
+	return http.StatusUnauthorized
+}
+
+type DuplicateLedgerEntryError struct {
+	Reference string
+}
+
+func (e DuplicateLedgerEntryError) Error() string {
+	return "ledger entry already recorded: " + e.Reference
+}
+
+func (e DuplicateLedgerEntryError) HTTPStatus() int {
+	return http.StatusConflict
+}
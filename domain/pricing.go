@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"net/http"
+)
+
+// PlanPriceList - プレミアムプランの料金表
+type PlanPriceList struct {
+	prices map[SubscriptionPlan]*Money
+}
+
+func NewPlanPriceList(prices map[SubscriptionPlan]*Money) (*PlanPriceList, error) {
+	list := &PlanPriceList{prices: make(map[SubscriptionPlan]*Money)}
+	for plan, price := range prices {
+		if !plan.IsPremium() {
+			return nil, InvalidSubscriptionPlanError{Value: plan.String()}
+		}
+		if price == nil || !price.IsPositive() {
+			return nil, InvalidLedgerEntryError{Reason: "plan price must be positive: " + plan.String()}
+		}
+		list.prices[plan] = price
+	}
+	return list, nil
+}
+
+// DefaultPlanPriceList は既定の料金（円建て）を返す
+func DefaultPlanPriceList() *PlanPriceList {
+	monthly, _ := NewMoney(500, "JPY")
+	yearly, _ := NewMoney(5000, "JPY")
+	list, _ := NewPlanPriceList(map[SubscriptionPlan]*Money{
+		SubscriptionPlanPremiumMonthly: monthly,
+		SubscriptionPlanPremiumYearly:  yearly,
+	})
+	return list
+}
+
+func (l *PlanPriceList) PriceOf(plan SubscriptionPlan) (*Money, error) {
+	price, exists := l.prices[plan]
+	if !exists {
+		return nil, PriceNotFoundError{Plan: plan.String()}
+	}
+	return price, nil
+}
+
+// Pricing related errors
+type PriceNotFoundError struct {
+	Plan string
+}
+
+func (e PriceNotFoundError) Error() string {
+	return "price not found for plan: " + e.Plan
+}
+
+func (e PriceNotFoundError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"net/http"
+)
+
+// Permission - サービス（API キー）や管理者に与える権限
+type Permission string
+
+const (
+	PermissionUsersRead      Permission = "users:read"
+	PermissionUsersWrite     Permission = "users:write"
+	PermissionCirclesRead    Permission = "circles:read"
+	PermissionCirclesWrite   Permission = "circles:write"
+	PermissionShipmentsRead  Permission = "shipments:read"
+	PermissionShipmentsWrite Permission = "shipments:write"
+	PermissionBillingWrite   Permission = "billing:write"
+	PermissionAPIKeysManage  Permission = "api_keys:manage"
+	PermissionAuditRead      Permission = "audit:read"
+)
+
+// permissions は付与できる権限の一覧
+var permissions = []Permission{
+	PermissionUsersRead,
+	PermissionUsersWrite,
+	PermissionCirclesRead,
+	PermissionCirclesWrite,
+	PermissionShipmentsRead,
+	PermissionShipmentsWrite,
+	PermissionBillingWrite,
+	PermissionAPIKeysManage,
+	PermissionAuditRead,
+}
+
+// ParsePermissions は権限名を検証し、重複を取り除く
+func ParsePermissions(names []string) ([]Permission, error) {
+	if len(names) == 0 {
+		return nil, InvalidPermissionError{Reason: "at least one permission is required"}
+	}
+
+	seen := make(map[Permission]bool)
+	var parsed []Permission
+	for _, name := range names {
+		permission := Permission(name)
+		if !isPermission(permission) {
+			return nil, InvalidPermissionError{Reason: "unknown permission: " + name}
+		}
+		if !seen[permission] {
+			seen[permission] = true
+			parsed = append(parsed, permission)
+		}
+	}
+	return parsed, nil
+}
+
+// AllPermissions は付与できるすべての権限を返す
+func AllPermissions() []Permission {
+	return append([]Permission(nil), permissions...)
+}
+
+func isPermission(permission Permission) bool {
+	for _, supported := range permissions {
+		if permission == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// Principal - API を呼び出す主体
+// ログインした利用者か、API キーで認証したサービスのいずれか
+// 利用者は権限を持たず、Authorize の規則（本人・サークルのオーナーなど）で操作できる（管理者はすべての権限を持つ）
+// サービスは API キーに与えられた権限の範囲でのみ操作できる
+type Principal struct {
+	userID        *UserID
+	administrator bool
+	apiKeyID      string
+	name          string
+	permissions   []Permission
+}
+
+func NewUserPrincipal(userID *UserID, administrator bool) *Principal {
+	return &Principal{userID: userID, administrator: administrator}
+}
+
+func NewServicePrincipal(key *APIKey) *Principal {
+	return &Principal{apiKeyID: key.ID(), name: key.Name(), permissions: key.Permissions()}
+}
+
+// UserID は利用者の UserID を返す（サービスの場合は nil）
+func (p *Principal) UserID() *UserID {
+	return p.userID
+}
+
+// APIKeyID はサービスが使った API キーの ID を返す（利用者の場合は空）
+func (p *Principal) APIKeyID() string {
+	return p.apiKeyID
+}
+
+// Name はサービスの名前（API キーの名前）を返す
+func (p *Principal) Name() string {
+	return p.name
+}
+
+func (p *Principal) IsService() bool {
+	return p.apiKeyID != ""
+}
+
+func (p *Principal) IsAdministrator() bool {
+	return p.userID != nil && p.administrator
+}
+
+// IsUser は主体が指定した利用者本人かを返す
+func (p *Principal) IsUser(userID *UserID) bool {
+	return p.userID != nil && p.userID.Equals(userID)
+}
+
+func (p *Principal) HasPermission(permission Permission) bool {
+	if p.IsAdministrator() {
+		return true
+	}
+	for _, granted := range p.permissions {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Administrators は管理者として扱う利用者の一覧（設定で与える）
+type Administrators struct {
+	userIDs map[string]bool
+}
+
+func NewAdministrators(userIDs ...*UserID) *Administrators {
+	administrators := &Administrators{userIDs: make(map[string]bool)}
+	for _, userID := range userIDs {
+		administrators.userIDs[userID.Value()] = true
+	}
+	return administrators
+}
+
+func (a *Administrators) Contains(userID *UserID) bool {
+	return a != nil && userID != nil && a.userIDs[userID.Value()]
+}
+
+type InvalidPermissionError struct {
+	Reason string
+}
+
+func (e InvalidPermissionError) Error() string {
+	return "invalid permission: " + e.Reason
+}
+
+func (e InvalidPermissionError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
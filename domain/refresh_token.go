@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultRefreshTokenTTL はリフレッシュトークンの有効期間（使うたびに新しいトークンで延びる）
+const DefaultRefreshTokenTTL = 30 * 24 * time.Hour
+
+// RefreshToken - アクセストークンを更新するための一度きりのトークン
+// 使うと同じファミリーの新しいトークンに置き換わる。使用済みのトークンが再び使われた場合は
+// 漏洩とみなし、ファミリー全体を失効させる
+type RefreshToken struct {
+	id        string
+	familyID  string
+	userID    *UserID
+	tokenHash string
+	issuedAt  time.Time
+	expiresAt time.Time
+	rotatedAt *time.Time
+	revokedAt *time.Time
+}
+
+// NewRefreshToken はログイン時に新しいファミリーの最初のトークンを返す
+func NewRefreshToken(userID *UserID, now time.Time, ttl time.Duration) (*RefreshToken, string, error) {
+	if userID == nil {
+		return nil, "", EmptyFieldError{Field: "user ID"}
+	}
+	return issueRefreshToken(uuid.New().String(), userID, now, ttl)
+}
+
+func ReconstructRefreshToken(
+	id, familyID string,
+	userID *UserID,
+	tokenHash string,
+	issuedAt, expiresAt time.Time,
+	rotatedAt, revokedAt *time.Time,
+) *RefreshToken {
+	return &RefreshToken{
+		id:        id,
+		familyID:  familyID,
+		userID:    userID,
+		tokenHash: tokenHash,
+		issuedAt:  issuedAt,
+		expiresAt: expiresAt,
+		rotatedAt: rotatedAt,
+		revokedAt: revokedAt,
+	}
+}
+
+func issueRefreshToken(familyID string, userID *UserID, now time.Time, ttl time.Duration) (*RefreshToken, string, error) {
+	token, err := newOpaqueToken()
+	if err != nil {
+		return nil, "", err
+	}
+	return &RefreshToken{
+		id:        uuid.New().String(),
+		familyID:  familyID,
+		userID:    userID,
+		tokenHash: HashRefreshToken(token),
+		issuedAt:  now,
+		expiresAt: now.Add(ttl),
+	}, token, nil
+}
+
+// HashRefreshToken は保存・検索に使うトークンのハッシュを返す
+func HashRefreshToken(token string) string {
+	return hashOpaqueToken(token)
+}
+
+func (t *RefreshToken) ID() string {
+	return t.id
+}
+
+func (t *RefreshToken) FamilyID() string {
+	return t.familyID
+}
+
+func (t *RefreshToken) UserID() *UserID {
+	return t.userID
+}
+
+func (t *RefreshToken) TokenHash() string {
+	return t.tokenHash
+}
+
+func (t *RefreshToken) IssuedAt() time.Time {
+	return t.issuedAt
+}
+
+func (t *RefreshToken) ExpiresAt() time.Time {
+	return t.expiresAt
+}
+
+func (t *RefreshToken) RotatedAt() *time.Time {
+	return t.rotatedAt
+}
+
+func (t *RefreshToken) RevokedAt() *time.Time {
+	return t.revokedAt
+}
+
+func (t *RefreshToken) IsExpiredAt(now time.Time) bool {
+	return !now.Before(t.expiresAt)
+}
+
+func (t *RefreshToken) IsRevoked() bool {
+	return t.revokedAt != nil
+}
+
+// Rotate はこのトークンを使用済みにし、同じファミリーの次のトークンを返す
+// 使用済みのトークンには RefreshTokenReusedError を返す（呼び出し側でファミリーを失効させる）
+func (t *RefreshToken) Rotate(now time.Time, ttl time.Duration) (*RefreshToken, string, error) {
+	if t.revokedAt != nil {
+		return nil, "", InvalidRefreshTokenError{Reason: "token has been revoked"}
+	}
+	if t.rotatedAt != nil {
+		return nil, "", RefreshTokenReusedError{FamilyID: t.familyID}
+	}
+	if t.IsExpiredAt(now) {
+		return nil, "", InvalidRefreshTokenError{Reason: "token has expired"}
+	}
+
+	next, token, err := issueRefreshToken(t.familyID, t.userID, now, ttl)
+	if err != nil {
+		return nil, "", err
+	}
+	t.rotatedAt = &now
+	return next, token, nil
+}
+
+// Revoke はトークンを失効させる（失効済みなら何もしない）
+func (t *RefreshToken) Revoke(now time.Time) {
+	if t.revokedAt == nil {
+		t.revokedAt = &now
+	}
+}
+
+type InvalidRefreshTokenError struct {
+	Reason string
+}
+
+func (e InvalidRefreshTokenError) Error() string {
+	return "invalid refresh token: " + e.Reason
+}
+
+func (e InvalidRefreshTokenError) HTTPStatus() int {
+	return http.StatusUnauthorized
+}
+
+// RefreshTokenReusedError は使用済みのリフレッシュトークンが再び使われた場合に返す
+type RefreshTokenReusedError struct {
+	FamilyID string
+}
+
+func (e RefreshTokenReusedError) Error() string {
+	return "refresh token reuse detected; all tokens of this login have been revoked"
+}
+
+func (e RefreshTokenReusedError) HTTPStatus() int {
+	return http.StatusUnauthorized
+}
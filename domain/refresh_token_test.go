@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+var testRefreshNow = time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)
+
+func TestRefreshToken_Rotate(t *testing.T) {
+	tests := []struct {
+		name     string
+		prepare  func(token *RefreshToken)
+		at       time.Time
+		checkErr func(error) bool
+	}{
+		{
+			name: "未使用のトークンは次のトークンに置き換わる",
+			at:   testRefreshNow.Add(time.Hour),
+		},
+		{
+			name: "使用済みのトークンは再利用として扱う",
+			prepare: func(token *RefreshToken) {
+				token.Rotate(testRefreshNow, time.Hour)
+			},
+			at: testRefreshNow.Add(time.Minute),
+			checkErr: func(err error) bool {
+				var e RefreshTokenReusedError
+				return errors.As(err, &e)
+			},
+		},
+		{
+			name: "失効したトークン",
+			prepare: func(token *RefreshToken) {
+				token.Revoke(testRefreshNow)
+			},
+			at: testRefreshNow.Add(time.Minute),
+			checkErr: func(err error) bool {
+				var e InvalidRefreshTokenError
+				return errors.As(err, &e)
+			},
+		},
+		{
+			name: "期限切れのトークン",
+			at:   testRefreshNow.Add(24 * time.Hour),
+			checkErr: func(err error) bool {
+				var e InvalidRefreshTokenError
+				return errors.As(err, &e)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			token, value, err := NewRefreshToken(NewUserID(), testRefreshNow, 24*time.Hour)
+			if err != nil {
+				t.Fatalf("Failed to create token: %v", err)
+			}
+			if tt.prepare != nil {
+				tt.prepare(token)
+			}
+
+			// Act
+			next, nextValue, err := token.Rotate(tt.at, 24*time.Hour)
+
+			// Assert
+			if tt.checkErr != nil {
+				if !tt.checkErr(err) {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if token.RotatedAt() == nil || !token.RotatedAt().Equal(tt.at) {
+				t.Errorf("Expected the token to be marked as rotated at %v, but got %v", tt.at, token.RotatedAt())
+			}
+			if next.FamilyID() != token.FamilyID() || !next.UserID().Equals(token.UserID()) {
+				t.Error("Expected the next token to continue the same family")
+			}
+			if nextValue == value || next.TokenHash() != HashRefreshToken(nextValue) {
+				t.Error("Expected a new token value matching its hash")
+			}
+			if !next.ExpiresAt().Equal(tt.at.Add(24 * time.Hour)) {
+				t.Errorf("Expected expiry %v, but got %v", tt.at.Add(24*time.Hour), next.ExpiresAt())
+			}
+		})
+	}
+}
@@ -1,16 +1,144 @@
 package domain
 
+import (
+	"time"
+)
+
+// UserRepository の検索は論理削除したユーザーを含まない
+// 論理削除は MarkDeleted したユーザーを Save して行い、Delete は物理削除する
 type UserRepository interface {
 	FindByID(id *UserID) (*User, error)
 	FindByName(name *FullName) (*User, error)
+	FindByEmail(email *Email) (*User, error)
+	FindDeletedByID(id *UserID) (*User, error)
+	// FindDeletedBefore は before より前に論理削除したユーザーを返す
+	FindDeletedBefore(before time.Time) ([]*User, error)
 	Save(user *User) error
 	Delete(id *UserID) error
 }
 
+// CircleRepository の検索は論理削除したサークルを含まない
+// 論理削除は MarkDeleted したサークルを Save して行い、Delete は物理削除する
 type CircleRepository interface {
 	FindByID(id *CircleID) (*Circle, error)
 	FindByName(name *CircleName) (*Circle, error)
 	FindAll() ([]*Circle, error)
+	FindDeletedByID(id *CircleID) (*Circle, error)
+	FindDeletedByOwnerID(ownerID *UserID) ([]*Circle, error)
+	// FindDeletedBefore は before より前に論理削除したサークルを返す
+	FindDeletedBefore(before time.Time) ([]*Circle, error)
 	Save(circle *Circle) error
 	Delete(id *CircleID) error
 }
+
+// LedgerRepository は追記専用のため更新・削除を持たない
+type LedgerRepository interface {
+	FindByUserID(userID *UserID) (*Ledger, error)
+	Append(entry *LedgerEntry) error
+}
+
+type CircleExpenseRepository interface {
+	FindByCircleID(circleID *CircleID) ([]*CircleExpense, error)
+	Save(expense *CircleExpense) error
+}
+
+type ShipmentRepository interface {
+	FindByID(id *ShipmentID) (*Shipment, error)
+	FindByRecipientID(recipientID *UserID) ([]*Shipment, error)
+	Save(shipment *Shipment) error
+	// DetachRecipient は受取人を物理削除する前に、その受取人の発送から受取人を外す
+	DetachRecipient(recipientID *UserID) error
+}
+
+type CircleEventRepository interface {
+	FindByID(id *CircleEventID) (*CircleEvent, error)
+	FindByCircleID(circleID *CircleID) ([]*CircleEvent, error)
+	Save(event *CircleEvent) error
+}
+
+type WebhookRepository interface {
+	FindByID(id *WebhookID) (*WebhookSubscription, error)
+	FindByCircleID(circleID *CircleID) ([]*WebhookSubscription, error)
+	Save(webhook *WebhookSubscription) error
+}
+
+// WebhookDeliveryRepository は配信ログと配信待ちの管理を兼ねる
+type WebhookDeliveryRepository interface {
+	FindByWebhookID(webhookID *WebhookID) ([]*WebhookDelivery, error)
+	FindByEventKey(webhookID *WebhookID, eventKey string) (*WebhookDelivery, error)
+	FindDue(now time.Time, limit int) ([]*WebhookDelivery, error)
+	Save(delivery *WebhookDelivery) error
+}
+
+// NotificationPreferencesRepository は設定を保存していない場合 nil を返す
+type NotificationPreferencesRepository interface {
+	FindByUserID(userID *UserID) (*NotificationPreferences, error)
+	Save(preferences *NotificationPreferences) error
+}
+
+type PendingNotificationRepository interface {
+	FindDue(now time.Time) ([]*PendingNotification, error)
+	Save(notification *PendingNotification) error
+}
+
+// CredentialRepository はパスワードを設定していないユーザーに nil を返す
+type CredentialRepository interface {
+	FindByUserID(userID *UserID) (*Credential, error)
+	Save(credential *Credential) error
+	Delete(userID *UserID) error
+}
+
+type SessionRepository interface {
+	FindByTokenHash(tokenHash string) (*Session, error)
+	Save(session *Session) error
+	Delete(id string) error
+	DeleteByUserID(userID *UserID) error
+}
+
+// CalendarFeedTokenRepository はユーザーごとに最新のトークンのみを保持する（Save は以前のトークンを置き換える）
+type CalendarFeedTokenRepository interface {
+	FindByUserID(userID *UserID) (*CalendarFeedToken, error)
+	Save(token *CalendarFeedToken) error
+	DeleteByUserID(userID *UserID) error
+}
+
+// RefreshTokenRepository は使用済み・失効したトークンも残し、再利用の検知に使う
+type RefreshTokenRepository interface {
+	FindByTokenHash(tokenHash string) (*RefreshToken, error)
+	FindByFamilyID(familyID string) ([]*RefreshToken, error)
+	Save(token *RefreshToken) error
+	// SaveRotation は保存済みの rotated が未使用・未失効の場合に限り、rotated を使用済みにして next を保存する
+	// 両方を保存するか、どちらも保存しない。別のリクエストが先に使っていた場合は RefreshTokenReusedError を返す
+	SaveRotation(rotated, next *RefreshToken) error
+	DeleteByUserID(userID *UserID) error
+}
+
+// OIDCLoginRequestRepository は state でログインの途中状態を引く
+type OIDCLoginRequestRepository interface {
+	FindByState(state string) (*OIDCLoginRequest, error)
+	Save(request *OIDCLoginRequest) error
+	Delete(state string) error
+}
+
+// ExternalIdentityRepository は紐付けのない外部のアカウントに nil を返す
+type ExternalIdentityRepository interface {
+	FindBySubject(issuer, subject string) (*ExternalIdentity, error)
+	Save(identity *ExternalIdentity) error
+}
+
+// APIKeyRepository は失効したキーも残し、一覧で確認できるようにする
+type APIKeyRepository interface {
+	FindByID(id string) (*APIKey, error)
+	FindByPrefix(prefix string) (*APIKey, error)
+	FindAll() ([]*APIKey, error)
+	Save(key *APIKey) error
+	// RecordUse は最終利用日時のみを更新する（認証中に失効したキーを Save で元に戻さないため）
+	RecordUse(id string, at time.Time) error
+}
+
+// AuditRepository は追記のみを許し、記録を変更・削除する操作を持たない
+type AuditRepository interface {
+	Append(entry *AuditEntry) error
+	// Find は条件に合う記録を新しい順に最大 filter.Limit 件返す
+	Find(filter AuditFilter) ([]*AuditEntry, error)
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultSessionTTL はログインしてからセッションが切れるまでの期間
+const DefaultSessionTTL = 7 * 24 * time.Hour
+
+// Session - ログイン中のセッション
+// トークンはログイン時に一度だけ返し、保存するのはハッシュのみ
+type Session struct {
+	id        string
+	userID    *UserID
+	tokenHash string
+	createdAt time.Time
+	expiresAt time.Time
+}
+
+// NewSession は新しいセッションとクライアントに渡すトークンを返す
+func NewSession(userID *UserID, now time.Time, ttl time.Duration) (*Session, string, error) {
+	if userID == nil {
+		return nil, "", EmptyFieldError{Field: "user ID"}
+	}
+	token, err := newOpaqueToken()
+	if err != nil {
+		return nil, "", err
+	}
+	return &Session{
+		id:        uuid.New().String(),
+		userID:    userID,
+		tokenHash: HashSessionToken(token),
+		createdAt: now,
+		expiresAt: now.Add(ttl),
+	}, token, nil
+}
+
+func ReconstructSession(id string, userID *UserID, tokenHash string, createdAt, expiresAt time.Time) *Session {
+	return &Session{
+		id:        id,
+		userID:    userID,
+		tokenHash: tokenHash,
+		createdAt: createdAt,
+		expiresAt: expiresAt,
+	}
+}
+
+// HashSessionToken は保存・検索に使うトークンのハッシュを返す
+// トークンは十分に長い乱数のため、ソルトなしの SHA-256 で足りる
+func HashSessionToken(token string) string {
+	return hashOpaqueToken(token)
+}
+
+// newOpaqueToken はクライアントに渡す 256 ビットの乱数トークンを返す
+func newOpaqueToken() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func hashOpaqueToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *Session) ID() string {
+	return s.id
+}
+
+func (s *Session) UserID() *UserID {
+	return s.userID
+}
+
+func (s *Session) TokenHash() string {
+	return s.tokenHash
+}
+
+func (s *Session) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s *Session) ExpiresAt() time.Time {
+	return s.expiresAt
+}
+
+func (s *Session) IsExpiredAt(now time.Time) bool {
+	return !now.Before(s.expiresAt)
+}
@@ -0,0 +1,280 @@
+package domain
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// MemberBalance - 参加者の貸し借り（正: 受け取る側、負: 支払う側）
+type MemberBalance struct {
+	userID *UserID
+	net    *Money
+}
+
+func (b *MemberBalance) UserID() *UserID {
+	return b.userID
+}
+
+func (b *MemberBalance) Net() *Money {
+	return b.net
+}
+
+// SettlementTransfer - 精算のための送金（from が to に amount を支払う）
+type SettlementTransfer struct {
+	from   *UserID
+	to     *UserID
+	amount *Money
+}
+
+func (t *SettlementTransfer) From() *UserID {
+	return t.from
+}
+
+func (t *SettlementTransfer) To() *UserID {
+	return t.to
+}
+
+func (t *SettlementTransfer) Amount() *Money {
+	return t.amount
+}
+
+// Settlement - サークルの精算結果（通貨ごとに精算し、通貨をまたいで相殺しない）
+type Settlement struct {
+	circleID  *CircleID
+	balances  []*MemberBalance
+	transfers []*SettlementTransfer
+}
+
+func (s *Settlement) CircleID() *CircleID {
+	return s.circleID
+}
+
+func (s *Settlement) Balances() []*MemberBalance {
+	balances := make([]*MemberBalance, len(s.balances))
+	copy(balances, s.balances)
+	return balances
+}
+
+func (s *Settlement) Transfers() []*SettlementTransfer {
+	transfers := make([]*SettlementTransfer, len(s.transfers))
+	copy(transfers, s.transfers)
+	return transfers
+}
+
+// IsSettled は精算が不要（全員の貸し借りが0）かを返す
+func (s *Settlement) IsSettled() bool {
+	return len(s.transfers) == 0
+}
+
+// SettlementService - 立て替え費用から「誰が誰にいくら払うか」を求めるドメインサービス
+type SettlementService struct{}
+
+func NewSettlementService() *SettlementService {
+	return &SettlementService{}
+}
+
+// netPosition は精算計算中の1人分の残額（最小単位）
+type netPosition struct {
+	userID *UserID
+	amount int64
+}
+
+// maxExactSettlementPositions - 送金回数を厳密に最小化する、通貨ごとの貸し借りのある参加者数の上限
+// 部分集合の表（2^n 件）を使うため、これを超える通貨は貪欲法のみで求める（参加者数-1回以下は保証する）
+const maxExactSettlementPositions = 20
+
+// Settle はサークルの費用から送金回数が最小になる送金の一覧を求める
+// 貸し借りのある参加者が maxExactSettlementPositions 人を超える通貨は貪欲法で求めるため、最小とは限らない
+func (s *SettlementService) Settle(circleID *CircleID, expenses []*CircleExpense) (*Settlement, error) {
+	// 通貨ごと・参加者ごとの貸し借りを集計する
+	nets := make(map[string]map[string]*MemberBalance)
+	credit := func(userID *UserID, amount *Money, negate bool) error {
+		byUser, exists := nets[amount.Currency()]
+		if !exists {
+			byUser = make(map[string]*MemberBalance)
+			nets[amount.Currency()] = byUser
+		}
+		balance, exists := byUser[userID.Value()]
+		if !exists {
+			zero, err := NewMoney(0, amount.Currency())
+			if err != nil {
+				return err
+			}
+			balance = &MemberBalance{userID: userID, net: zero}
+			byUser[userID.Value()] = balance
+		}
+
+		var err error
+		if negate {
+			balance.net, err = balance.net.Subtract(amount)
+		} else {
+			balance.net, err = balance.net.Add(amount)
+		}
+		return err
+	}
+
+	for _, expense := range expenses {
+		if !expense.CircleID().Equals(circleID) {
+			return nil, InvalidExpenseError{Reason: "expense belongs to another circle: " + expense.ID().Value()}
+		}
+		if err := credit(expense.PayerID(), expense.Amount(), false); err != nil {
+			return nil, err
+		}
+		for _, share := range expense.Shares() {
+			if err := credit(share.ParticipantID(), share.Amount(), true); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	settlement := &Settlement{circleID: circleID}
+	for _, currency := range sortedKeys(nets) {
+		byUser := nets[currency]
+
+		var creditors, debtors []*netPosition
+		for _, userID := range sortedKeys(byUser) {
+			balance := byUser[userID]
+			settlement.balances = append(settlement.balances, balance)
+			switch {
+			case balance.net.IsPositive():
+				creditors = append(creditors, &netPosition{userID: balance.userID, amount: balance.net.Amount()})
+			case balance.net.IsNegative():
+				debtors = append(debtors, &netPosition{userID: balance.userID, amount: -balance.net.Amount()})
+			}
+		}
+
+		for _, transfer := range minimizeTransfers(debtors, creditors) {
+			amount := &Money{amount: transfer.amount, currency: currency}
+			settlement.transfers = append(settlement.transfers, &SettlementTransfer{
+				from:   transfer.from,
+				to:     transfer.to,
+				amount: amount,
+			})
+		}
+	}
+
+	return settlement, nil
+}
+
+type pendingTransfer struct {
+	from   *UserID
+	to     *UserID
+	amount int64
+}
+
+// minimizeTransfers は参加者を貸し借りの合計が0になる組にできるだけ多く分け、組ごとに精算する
+// k人の組は k-1 回で精算できるため、送金回数は「参加者数 - 組の数」となり、組の数が最大のとき最小になる
+// 組の数は部分集合の動的計画法で求める（groups[mask] は mask の参加者を分けられる組の数の最大値）
+func minimizeTransfers(debtors, creditors []*netPosition) []pendingTransfer {
+	positions := append(append([]*netPosition{}, debtors...), creditors...)
+	if len(positions) > maxExactSettlementPositions {
+		return matchTransfers(debtors, creditors)
+	}
+
+	// 債務者は負、債権者は正の残額として扱う
+	signed := make([]int64, len(positions))
+	for i, position := range positions {
+		signed[i] = position.amount
+		if i < len(debtors) {
+			signed[i] = -position.amount
+		}
+	}
+
+	full := 1<<len(positions) - 1
+	sums := make([]int64, full+1)
+	groups := make([]int8, full+1)
+	for mask := 1; mask <= full; mask++ {
+		sums[mask] = sums[mask&(mask-1)] + signed[bits.TrailingZeros(uint(mask))]
+		var best int8
+		for rest := mask; rest != 0; rest &= rest - 1 {
+			if g := groups[mask&^(1<<bits.TrailingZeros(uint(rest)))]; g > best {
+				best = g
+			}
+		}
+		if sums[mask] == 0 {
+			best++
+		}
+		groups[mask] = best
+	}
+
+	// 表をたどって1人ずつ取り出し、残りの合計が0になるたびにそこまでを1つの組として精算する
+	var transfers []pendingTransfer
+	var groupDebtors, groupCreditors []*netPosition
+	for mask := full; mask != 0; {
+		want := groups[mask]
+		if sums[mask] == 0 {
+			want--
+		}
+		for rest := mask; rest != 0; rest &= rest - 1 {
+			i := bits.TrailingZeros(uint(rest))
+			if groups[mask&^(1<<i)] != want {
+				continue
+			}
+			if i < len(debtors) {
+				groupDebtors = append(groupDebtors, positions[i])
+			} else {
+				groupCreditors = append(groupCreditors, positions[i])
+			}
+			mask &^= 1 << i
+			break
+		}
+		if sums[mask] == 0 {
+			transfers = append(transfers, matchTransfers(groupDebtors, groupCreditors)...)
+			groupDebtors, groupCreditors = nil, nil
+		}
+	}
+	return transfers
+}
+
+// matchTransfers は債務者（支払う額）と債権者（受け取る額）を貪欲法で対応させる
+// 1回の送金で少なくとも1人の残額が0になるため、送金回数は参加者数-1以下になる
+func matchTransfers(debtors, creditors []*netPosition) []pendingTransfer {
+	var transfers []pendingTransfer
+
+	// 同額の組は1回の送金で両者とも精算できる
+	for _, debtor := range debtors {
+		for _, creditor := range creditors {
+			if debtor.amount > 0 && debtor.amount == creditor.amount {
+				transfers = append(transfers, pendingTransfer{from: debtor.userID, to: creditor.userID, amount: debtor.amount})
+				debtor.amount = 0
+				creditor.amount = 0
+				break
+			}
+		}
+	}
+
+	for {
+		debtor := largestPosition(debtors)
+		creditor := largestPosition(creditors)
+		if debtor == nil || creditor == nil {
+			break
+		}
+
+		amount := min(debtor.amount, creditor.amount)
+		transfers = append(transfers, pendingTransfer{from: debtor.userID, to: creditor.userID, amount: amount})
+		debtor.amount -= amount
+		creditor.amount -= amount
+	}
+
+	return transfers
+}
+
+// largestPosition は残額が最大の参加者を返す（同額の場合は先頭、全員0ならnil）
+func largestPosition(positions []*netPosition) *netPosition {
+	var largest *netPosition
+	for _, position := range positions {
+		if position.amount > 0 && (largest == nil || position.amount > largest.amount) {
+			largest = position
+		}
+	}
+	return largest
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,281 @@
+package domain
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func newTestEqualExpense(t *testing.T, circle *Circle, payer *UserID, amount *Money, participants []*UserID) *CircleExpense {
+	t.Helper()
+
+	split, err := NewEqualSplit(participants)
+	if err != nil {
+		t.Fatalf("Failed to create split: %v", err)
+	}
+	expense, err := NewCircleExpense(circle, payer, amount, "テスト費用", split, time.Now())
+	if err != nil {
+		t.Fatalf("Failed to create expense: %v", err)
+	}
+	return expense
+}
+
+// applyTransfers は送金を反映した後の貸し借りを通貨・参加者ごとに返す
+func applyTransfers(settlement *Settlement) map[string]int64 {
+	nets := make(map[string]int64)
+	for _, balance := range settlement.Balances() {
+		nets[balance.Net().Currency()+"/"+balance.UserID().Value()] += balance.Net().Amount()
+	}
+	for _, transfer := range settlement.Transfers() {
+		currency := transfer.Amount().Currency()
+		nets[currency+"/"+transfer.From().Value()] += transfer.Amount().Amount()
+		nets[currency+"/"+transfer.To().Value()] -= transfer.Amount().Amount()
+	}
+	return nets
+}
+
+func TestSettlementService_Settle_TransfersSettleAllBalances(t *testing.T) {
+	circle, participants := newTestExpenseCircle(t, 3)
+	a, b, c, d := participants[0], participants[1], participants[2], participants[3]
+
+	tests := []struct {
+		name              string
+		expenses          func() []*CircleExpense
+		expectedTransfers int
+	}{
+		{
+			"費用なし",
+			func() []*CircleExpense { return nil },
+			0,
+		},
+		{
+			"1人が全員分を立て替え",
+			func() []*CircleExpense {
+				return []*CircleExpense{newTestEqualExpense(t, circle, a, mustMoney(t, 4000, "JPY"), participants)}
+			},
+			3,
+		},
+		{
+			"立て替えが相殺されて精算不要",
+			func() []*CircleExpense {
+				return []*CircleExpense{
+					newTestEqualExpense(t, circle, a, mustMoney(t, 2000, "JPY"), []*UserID{a, b}),
+					newTestEqualExpense(t, circle, b, mustMoney(t, 2000, "JPY"), []*UserID{a, b}),
+				}
+			},
+			0,
+		},
+		{
+			"同額の組は直接精算する",
+			func() []*CircleExpense {
+				return []*CircleExpense{
+					newTestEqualExpense(t, circle, a, mustMoney(t, 2000, "JPY"), []*UserID{a, c}),
+					newTestEqualExpense(t, circle, b, mustMoney(t, 3000, "JPY"), []*UserID{b, d}),
+				}
+			},
+			2,
+		},
+		{
+			"通貨ごとに精算する",
+			func() []*CircleExpense {
+				return []*CircleExpense{
+					newTestEqualExpense(t, circle, a, mustMoney(t, 2000, "JPY"), []*UserID{a, b}),
+					newTestEqualExpense(t, circle, b, mustMoney(t, 2000, "USD"), []*UserID{a, b}),
+				}
+			},
+			2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			service := NewSettlementService()
+
+			// Act
+			settlement, err := service.Settle(circle.ID(), tt.expenses())
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, but got %v", err)
+			}
+			if len(settlement.Transfers()) != tt.expectedTransfers {
+				t.Errorf("Expected %d transfers, but got %d", tt.expectedTransfers, len(settlement.Transfers()))
+			}
+			if settlement.IsSettled() != (tt.expectedTransfers == 0) {
+				t.Errorf("Expected IsSettled %v", tt.expectedTransfers == 0)
+			}
+			for key, net := range applyTransfers(settlement) {
+				if net != 0 {
+					t.Errorf("Expected %s to be settled, but %d remains", key, net)
+				}
+			}
+		})
+	}
+}
+
+func TestSettlementService_Settle_MinimalTransfers(t *testing.T) {
+	tests := []struct {
+		name              string
+		nets              []int64 // 参加者ごとの貸し借り（合計は0）
+		expectedTransfers int
+	}{
+		// 貪欲法では5回になるが、{-5, +1, +4} と {-3, -3, +6} に分ければ4回で済む
+		{"2つの組に分けられる", []int64{-3000, -3000, -5000, 1000, 6000, 4000}, 4},
+		{"同額の組は1回ずつ", []int64{-2000, -7000, 2000, 7000}, 2},
+		{"3つの組に分けられる", []int64{-1000, -2000, -4000, 3000, 1000, 3000, 500, -500}, 5},
+		{"組に分けられなければ参加者数-1", []int64{-3000, -4000, 2000, 5000}, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			circle, participants := newTestExpenseCircle(t, len(tt.nets)-1)
+			expenses := expensesForNets(t, circle, participants, tt.nets)
+
+			// Act
+			settlement, err := NewSettlementService().Settle(circle.ID(), expenses)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, but got %v", err)
+			}
+			if len(settlement.Transfers()) != tt.expectedTransfers {
+				t.Errorf("Expected %d transfers, but got %d", tt.expectedTransfers, len(settlement.Transfers()))
+			}
+			for key, net := range applyTransfers(settlement) {
+				if net != 0 {
+					t.Errorf("Expected %s to be settled, but %d remains", key, net)
+				}
+			}
+		})
+	}
+}
+
+// expensesForNets は各参加者の貸し借りが nets になる費用を作る（債権者が最後の参加者へ立て替えた形にする）
+func expensesForNets(t *testing.T, circle *Circle, participants []*UserID, nets []int64) []*CircleExpense {
+	t.Helper()
+
+	hub := participants[len(participants)-1]
+	var expenses []*CircleExpense
+	for i, net := range nets[:len(nets)-1] {
+		switch {
+		case net > 0:
+			expenses = append(expenses, newTestEqualExpense(t, circle, participants[i], mustMoney(t, net, "JPY"), []*UserID{hub}))
+		case net < 0:
+			expenses = append(expenses, newTestEqualExpense(t, circle, hub, mustMoney(t, -net, "JPY"), []*UserID{participants[i]}))
+		}
+	}
+	return expenses
+}
+
+func TestSettlementService_Settle_LargeGroup_FallsBackToGreedy(t *testing.T) {
+	// Arrange: 貸し借りのある参加者が上限を超える
+	count := maxExactSettlementPositions + 4
+	circle, participants := newTestExpenseCircle(t, count-1)
+	nets := make([]int64, count)
+	for i := 0; i < count-1; i++ {
+		nets[i] = int64(i%5+1) * 100
+		if i%2 == 0 {
+			nets[i] = -nets[i]
+		}
+		nets[count-1] -= nets[i]
+	}
+	expenses := expensesForNets(t, circle, participants, nets)
+
+	// Act
+	settlement, err := NewSettlementService().Settle(circle.ID(), expenses)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if len(settlement.Transfers()) > count-1 {
+		t.Errorf("Expected at most %d transfers, but got %d", count-1, len(settlement.Transfers()))
+	}
+	for key, net := range applyTransfers(settlement) {
+		if net != 0 {
+			t.Errorf("Expected %s to be settled, but %d remains", key, net)
+		}
+	}
+}
+
+func TestSettlementService_Settle_AtMostUnsettledMinusOneTransfers(t *testing.T) {
+	circle, participants := newTestExpenseCircle(t, 7)
+	random := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 200; i++ {
+		// Arrange
+		var expenses []*CircleExpense
+		for j := 0; j < 1+random.Intn(6); j++ {
+			payer := participants[random.Intn(len(participants))]
+			split := participants[:1+random.Intn(len(participants))]
+			expenses = append(expenses, newTestEqualExpense(t, circle, payer, mustMoney(t, int64(1+random.Intn(50))*100, "JPY"), split))
+		}
+
+		// Act
+		settlement, err := NewSettlementService().Settle(circle.ID(), expenses)
+
+		// Assert
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		unsettled := 0
+		for _, balance := range settlement.Balances() {
+			if !balance.Net().IsZero() {
+				unsettled++
+			}
+		}
+		if unsettled > 0 && len(settlement.Transfers()) > unsettled-1 {
+			t.Errorf("Expected at most %d transfers, but got %d", unsettled-1, len(settlement.Transfers()))
+		}
+		for key, net := range applyTransfers(settlement) {
+			if net != 0 {
+				t.Errorf("Expected %s to be settled, but %d remains", key, net)
+			}
+		}
+	}
+}
+
+func TestSettlementService_Settle_DebtorPaysPayer(t *testing.T) {
+	// Arrange
+	circle, participants := newTestExpenseCircle(t, 1)
+	owner, member := participants[0], participants[1]
+	expense := newTestEqualExpense(t, circle, owner, mustMoney(t, 3000, "JPY"), participants)
+
+	// Act
+	settlement, err := NewSettlementService().Settle(circle.ID(), []*CircleExpense{expense})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	transfers := settlement.Transfers()
+	if len(transfers) != 1 {
+		t.Fatalf("Expected 1 transfer, but got %d", len(transfers))
+	}
+	if !transfers[0].From().Equals(member) || !transfers[0].To().Equals(owner) {
+		t.Error("Expected member to pay owner")
+	}
+	if transfers[0].Amount().Amount() != 1500 {
+		t.Errorf("Expected 1500, but got %d", transfers[0].Amount().Amount())
+	}
+}
+
+func TestSettlementService_Settle_OtherCircleExpense_ReturnsError(t *testing.T) {
+	// Arrange
+	circle, participants := newTestExpenseCircle(t, 1)
+	other, _ := newTestExpenseCircle(t, 1)
+	expense := newTestEqualExpense(t, circle, participants[0], mustMoney(t, 1000, "JPY"), participants)
+
+	// Act
+	settlement, err := NewSettlementService().Settle(other.ID(), []*CircleExpense{expense})
+
+	// Assert
+	if err == nil {
+		t.Error("Expected error, but got nil")
+	}
+	if settlement != nil {
+		t.Error("Expected nil settlement")
+	}
+}
@@ -0,0 +1,395 @@
+package domain
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ShipmentID struct {
+	value string
+}
+
+func NewShipmentID() *ShipmentID {
+	return &ShipmentID{value: uuid.New().String()}
+}
+
+func ReconstructShipmentID(value string) (*ShipmentID, error) {
+	if value == "" {
+		return nil, EmptyFieldError{Field: "shipment ID"}
+	}
+	if _, err := uuid.Parse(value); err != nil {
+		return nil, InvalidShipmentError{Reason: "invalid shipment ID: " + value}
+	}
+	return &ShipmentID{value: value}, nil
+}
+
+func (s *ShipmentID) Value() string {
+	return s.value
+}
+
+func (s *ShipmentID) Equals(other *ShipmentID) bool {
+	if other == nil {
+		return false
+	}
+	return s.value == other.value
+}
+
+func (s *ShipmentID) String() string {
+	return s.value
+}
+
+// MaxDimensionCm - 荷物の1辺の上限（cm）。これを超える荷物は扱わない
+const MaxDimensionCm = 300
+
+// Dimensions 値オブジェクト - 荷物の外寸（cm）
+type Dimensions struct {
+	lengthCm int64
+	widthCm  int64
+	heightCm int64
+}
+
+func NewDimensions(lengthCm, widthCm, heightCm int64) (*Dimensions, error) {
+	if lengthCm <= 0 || widthCm <= 0 || heightCm <= 0 {
+		return nil, InvalidShipmentError{Reason: "dimensions must be positive"}
+	}
+	if lengthCm > MaxDimensionCm || widthCm > MaxDimensionCm || heightCm > MaxDimensionCm {
+		return nil, InvalidShipmentError{Reason: fmt.Sprintf("each side must be at most %d cm", MaxDimensionCm)}
+	}
+	return &Dimensions{lengthCm: lengthCm, widthCm: widthCm, heightCm: heightCm}, nil
+}
+
+func (d *Dimensions) LengthCm() int64 {
+	return d.lengthCm
+}
+
+func (d *Dimensions) WidthCm() int64 {
+	return d.widthCm
+}
+
+func (d *Dimensions) HeightCm() int64 {
+	return d.heightCm
+}
+
+// VolumeCm3 は体積（立方センチメートル）を返す
+func (d *Dimensions) VolumeCm3() int64 {
+	return d.lengthCm * d.widthCm * d.heightCm
+}
+
+func (d *Dimensions) Equals(other *Dimensions) bool {
+	if other == nil {
+		return false
+	}
+	return d.lengthCm == other.lengthCm && d.widthCm == other.widthCm && d.heightCm == other.heightCm
+}
+
+// Baggage 値オブジェクト - 発送する荷物1個
+type Baggage struct {
+	description string
+	weightGrams int64
+	dimensions  *Dimensions
+}
+
+func NewBaggage(description string, weightGrams int64, dimensions *Dimensions) (*Baggage, error) {
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return nil, EmptyFieldError{Field: "baggage description"}
+	}
+	if weightGrams <= 0 {
+		return nil, InvalidShipmentError{Reason: "baggage weight must be positive"}
+	}
+	if dimensions == nil {
+		return nil, EmptyFieldError{Field: "baggage dimensions"}
+	}
+	return &Baggage{
+		description: description,
+		weightGrams: weightGrams,
+		dimensions:  dimensions,
+	}, nil
+}
+
+func (b *Baggage) Description() string {
+	return b.description
+}
+
+func (b *Baggage) WeightGrams() int64 {
+	return b.weightGrams
+}
+
+func (b *Baggage) Dimensions() *Dimensions {
+	return b.dimensions
+}
+
+func (b *Baggage) Equals(other *Baggage) bool {
+	if other == nil {
+		return false
+	}
+	return b.description == other.description && b.weightGrams == other.weightGrams &&
+		b.dimensions.Equals(other.dimensions)
+}
+
+// ShipmentStatus - 発送状況
+type ShipmentStatus string
+
+const (
+	ShipmentStatusPending   ShipmentStatus = "pending"   // 発送待ち
+	ShipmentStatusShipped   ShipmentStatus = "shipped"   // 発送済み
+	ShipmentStatusDelivered ShipmentStatus = "delivered" // 配達完了
+	ShipmentStatusReturned  ShipmentStatus = "returned"  // 返送
+	ShipmentStatusCancelled ShipmentStatus = "cancelled" // 発送前に取り消し
+)
+
+func ParseShipmentStatus(value string) (ShipmentStatus, error) {
+	status := ShipmentStatus(value)
+	switch status {
+	case ShipmentStatusPending, ShipmentStatusShipped, ShipmentStatusDelivered,
+		ShipmentStatusReturned, ShipmentStatusCancelled:
+		return status, nil
+	}
+	if value == "" {
+		return "", EmptyFieldError{Field: "shipment status"}
+	}
+	return "", InvalidShipmentError{Reason: "unknown shipment status: " + value}
+}
+
+func (s ShipmentStatus) String() string {
+	return string(s)
+}
+
+// IsFinal は以降の状態遷移がない状態かを返す
+func (s ShipmentStatus) IsFinal() bool {
+	return s == ShipmentStatusDelivered || s == ShipmentStatusReturned || s == ShipmentStatusCancelled
+}
+
+// Shipment - サークルメンバーへのグッズ発送（集約ルート）
+// 状態は pending → shipped → delivered/returned の順にのみ遷移し、発送前に限り取り消せる
+type Shipment struct {
+	id             *ShipmentID
+	recipientID    *UserID
+	baggage        []*Baggage
+	fee            *ShippingFee // 配送地域と送料の内訳を含む
+	status         ShipmentStatus
+	trackingNumber string
+	createdAt      time.Time
+	shippedAt      time.Time
+	completedAt    time.Time // 配達完了・返送・取り消しの時刻
+}
+
+func NewShipment(recipientID *UserID, baggage []*Baggage, fee *ShippingFee, createdAt time.Time) (*Shipment, error) {
+	if recipientID == nil {
+		return nil, EmptyFieldError{Field: "recipient"}
+	}
+	if len(baggage) == 0 {
+		return nil, InvalidShipmentError{Reason: "at least one baggage is required"}
+	}
+	for _, item := range baggage {
+		if item == nil {
+			return nil, EmptyFieldError{Field: "baggage"}
+		}
+	}
+	if fee == nil {
+		return nil, EmptyFieldError{Field: "shipping fee"}
+	}
+	if fee.Total().IsNegative() {
+		return nil, InvalidShipmentError{Reason: "shipping fee must not be negative: " + fee.Total().String()}
+	}
+
+	items := make([]*Baggage, len(baggage))
+	copy(items, baggage)
+	return &Shipment{
+		id:          NewShipmentID(),
+		recipientID: recipientID,
+		baggage:     items,
+		fee:         fee,
+		status:      ShipmentStatusPending,
+		createdAt:   createdAt,
+	}, nil
+}
+
+func ReconstructShipment(
+	id *ShipmentID,
+	recipientID *UserID,
+	baggage []*Baggage,
+	fee *ShippingFee,
+	status ShipmentStatus,
+	trackingNumber string,
+	createdAt time.Time,
+	shippedAt time.Time,
+	completedAt time.Time,
+) *Shipment {
+	return &Shipment{
+		id:             id,
+		recipientID:    recipientID,
+		baggage:        baggage,
+		fee:            fee,
+		status:         status,
+		trackingNumber: trackingNumber,
+		createdAt:      createdAt,
+		shippedAt:      shippedAt,
+		completedAt:    completedAt,
+	}
+}
+
+func (s *Shipment) ID() *ShipmentID {
+	return s.id
+}
+
+// RecipientID は受取人を物理削除した発送では nil を返す
+func (s *Shipment) RecipientID() *UserID {
+	return s.recipientID
+}
+
+// DetachRecipient は物理削除した受取人との関係を外す（発送と送料の記録は残す）
+func (s *Shipment) DetachRecipient() {
+	s.recipientID = nil
+}
+
+func (s *Shipment) Baggage() []*Baggage {
+	// 防御的コピーを返す
+	baggage := make([]*Baggage, len(s.baggage))
+	copy(baggage, s.baggage)
+	return baggage
+}
+
+// Fee は送料の合計を返す
+func (s *Shipment) Fee() *Money {
+	return s.fee.Total()
+}
+
+// FeeBreakdown は送料の内訳を返す
+func (s *Shipment) FeeBreakdown() *ShippingFee {
+	return s.fee
+}
+
+func (s *Shipment) DestinationZone() string {
+	return s.fee.Zone()
+}
+
+func (s *Shipment) Status() ShipmentStatus {
+	return s.status
+}
+
+func (s *Shipment) TrackingNumber() string {
+	return s.trackingNumber
+}
+
+func (s *Shipment) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s *Shipment) ShippedAt() time.Time {
+	return s.shippedAt
+}
+
+func (s *Shipment) CompletedAt() time.Time {
+	return s.completedAt
+}
+
+// TotalWeightGrams は荷物の合計重量（g）を返す
+func (s *Shipment) TotalWeightGrams() int64 {
+	var total int64
+	for _, item := range s.baggage {
+		total += item.weightGrams
+	}
+	return total
+}
+
+// Ship は追跡番号を付けて発送済みにする
+func (s *Shipment) Ship(trackingNumber string, now time.Time) error {
+	trackingNumber = strings.TrimSpace(trackingNumber)
+	if trackingNumber == "" {
+		return EmptyFieldError{Field: "tracking number"}
+	}
+	if err := s.transition(ShipmentStatusShipped, now); err != nil {
+		return err
+	}
+	s.trackingNumber = trackingNumber
+	s.shippedAt = now
+	return nil
+}
+
+// Deliver は配達完了にする
+func (s *Shipment) Deliver(now time.Time) error {
+	return s.transition(ShipmentStatusDelivered, now)
+}
+
+// Return は受け取られずに返送されたことを記録する
+func (s *Shipment) Return(now time.Time) error {
+	return s.transition(ShipmentStatusReturned, now)
+}
+
+// Cancel は発送前の取り消し
+func (s *Shipment) Cancel(now time.Time) error {
+	return s.transition(ShipmentStatusCancelled, now)
+}
+
+// transition は許可された状態遷移のみを行う
+func (s *Shipment) transition(to ShipmentStatus, now time.Time) error {
+	allowed := false
+	switch to {
+	case ShipmentStatusShipped, ShipmentStatusCancelled:
+		allowed = s.status == ShipmentStatusPending
+	case ShipmentStatusDelivered, ShipmentStatusReturned:
+		allowed = s.status == ShipmentStatusShipped
+		if allowed && now.Before(s.shippedAt) {
+			return InvalidShipmentError{Reason: "completion time is before shipping time"}
+		}
+	}
+	if !allowed {
+		return InvalidShipmentTransitionError{From: s.status, To: to}
+	}
+
+	s.status = to
+	if to.IsFinal() {
+		s.completedAt = now
+	}
+	return nil
+}
+
+func (s *Shipment) Equals(other *Shipment) bool {
+	if other == nil {
+		return false
+	}
+	return s.id.Equals(other.id)
+}
+
+// Shipment related errors
+type InvalidShipmentError struct {
+	Reason string
+}
+
+func (e InvalidShipmentError) Error() string {
+	return "invalid shipment: " + e.Reason
+}
+
+func (e InvalidShipmentError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
+
+type InvalidShipmentTransitionError struct {
+	From ShipmentStatus
+	To   ShipmentStatus
+}
+
+func (e InvalidShipmentTransitionError) Error() string {
+	return "cannot change shipment status from " + e.From.String() + " to " + e.To.String()
+}
+
+func (e InvalidShipmentTransitionError) HTTPStatus() int {
+	return http.StatusConflict
+}
+
+type ShipmentNotFoundError struct {
+	ID string
+}
+
+func (e ShipmentNotFoundError) Error() string {
+	return "shipment not found: " + e.ID
+}
+
+func (e ShipmentNotFoundError) HTTPStatus() int {
+	return http.StatusNotFound
+}
@@ -0,0 +1,271 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func newTestBaggage(t *testing.T, weightGrams int64) *Baggage {
+	t.Helper()
+
+	dimensions, err := NewDimensions(30, 20, 10)
+	if err != nil {
+		t.Fatalf("Failed to create dimensions: %v", err)
+	}
+	baggage, err := NewBaggage("サークルTシャツ", weightGrams, dimensions)
+	if err != nil {
+		t.Fatalf("Failed to create baggage: %v", err)
+	}
+	return baggage
+}
+
+func newTestShippingFee(t *testing.T, baggage []*Baggage) *ShippingFee {
+	t.Helper()
+
+	fee, err := NewShippingFeeCalculator(DefaultShippingRateTable()).Calculate(baggage, "domestic", false)
+	if err != nil {
+		t.Fatalf("Failed to calculate shipping fee: %v", err)
+	}
+	return fee
+}
+
+func newTestShipment(t *testing.T, createdAt time.Time) *Shipment {
+	t.Helper()
+
+	baggage := []*Baggage{newTestBaggage(t, 300), newTestBaggage(t, 450)}
+	shipment, err := NewShipment(NewUserID(), baggage, newTestShippingFee(t, baggage), createdAt)
+	if err != nil {
+		t.Fatalf("Failed to create shipment: %v", err)
+	}
+	return shipment
+}
+
+func TestNewShipment_Success(t *testing.T) {
+	// Arrange
+	createdAt := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	// Act
+	shipment := newTestShipment(t, createdAt)
+
+	// Assert
+	if shipment.Status() != ShipmentStatusPending {
+		t.Errorf("Expected status pending, but got %s", shipment.Status())
+	}
+	if shipment.TotalWeightGrams() != 750 {
+		t.Errorf("Expected total weight 750g, but got %d", shipment.TotalWeightGrams())
+	}
+	if len(shipment.Baggage()) != 2 {
+		t.Errorf("Expected 2 baggage, but got %d", len(shipment.Baggage()))
+	}
+	if !shipment.CreatedAt().Equal(createdAt) {
+		t.Errorf("Expected created at %v, but got %v", createdAt, shipment.CreatedAt())
+	}
+}
+
+func TestNewShipment_InvalidInput_ReturnsError(t *testing.T) {
+	baggage := []*Baggage{newTestBaggage(t, 300)}
+	fee := newTestShippingFee(t, baggage)
+	negativeFee, err := ReconstructShippingFee("domestic", 300, 1200, 1200, []*ShippingFeeItem{
+		NewShippingFeeItem(ShippingFeeItemBase, "base", mustMoney(t, -1, "JPY")),
+	})
+	if err != nil {
+		t.Fatalf("Failed to reconstruct shipping fee: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		recipient *UserID
+		baggage   []*Baggage
+		fee       *ShippingFee
+	}{
+		{"受取人なし", nil, baggage, fee},
+		{"荷物なし", NewUserID(), nil, fee},
+		{"送料なし", NewUserID(), baggage, nil},
+		{"負の送料", NewUserID(), baggage, negativeFee},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			shipment, err := NewShipment(tt.recipient, tt.baggage, tt.fee, time.Now())
+
+			// Assert
+			if err == nil {
+				t.Error("Expected error, but got nil")
+			}
+			if shipment != nil {
+				t.Error("Expected nil shipment")
+			}
+		})
+	}
+}
+
+func TestNewDimensions_OutOfRange_ReturnsError(t *testing.T) {
+	tests := []struct {
+		name                      string
+		lengthCm, widthCm, height int64
+	}{
+		{"0cmの辺", 0, 20, 10},
+		{"上限を1cm超える", MaxDimensionCm + 1, 20, 10},
+		{"桁あふれする大きさ", 3_000_000, 3_000_000, 3_000_000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			dimensions, err := NewDimensions(tt.lengthCm, tt.widthCm, tt.height)
+
+			// Assert
+			var invalid InvalidShipmentError
+			if !errors.As(err, &invalid) {
+				t.Errorf("Expected InvalidShipmentError, but got %v", err)
+			}
+			if dimensions != nil {
+				t.Error("Expected nil dimensions")
+			}
+		})
+	}
+
+	if _, err := NewDimensions(MaxDimensionCm, MaxDimensionCm, MaxDimensionCm); err != nil {
+		t.Errorf("Expected the maximum size to be accepted, but got %v", err)
+	}
+}
+
+func TestNewBaggage_InvalidInput_ReturnsError(t *testing.T) {
+	dimensions, _ := NewDimensions(30, 20, 10)
+
+	tests := []struct {
+		name        string
+		description string
+		weightGrams int64
+		dimensions  *Dimensions
+	}{
+		{"説明が空", " ", 300, dimensions},
+		{"重量が0", "タオル", 0, dimensions},
+		{"寸法なし", "タオル", 300, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			baggage, err := NewBaggage(tt.description, tt.weightGrams, tt.dimensions)
+
+			// Assert
+			if err == nil {
+				t.Error("Expected error, but got nil")
+			}
+			if baggage != nil {
+				t.Error("Expected nil baggage")
+			}
+		})
+	}
+}
+
+func TestShipment_Transitions(t *testing.T) {
+	createdAt := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	shippedAt := createdAt.Add(24 * time.Hour)
+	completedAt := shippedAt.Add(48 * time.Hour)
+
+	tests := []struct {
+		name     string
+		act      func(s *Shipment) error
+		expected ShipmentStatus
+		allowed  bool
+	}{
+		{"発送待ちから発送", func(s *Shipment) error {
+			return s.Ship("TRK-1", shippedAt)
+		}, ShipmentStatusShipped, true},
+		{"発送待ちから取り消し", func(s *Shipment) error {
+			return s.Cancel(shippedAt)
+		}, ShipmentStatusCancelled, true},
+		{"発送済みから配達完了", func(s *Shipment) error {
+			s.Ship("TRK-1", shippedAt)
+			return s.Deliver(completedAt)
+		}, ShipmentStatusDelivered, true},
+		{"発送済みから返送", func(s *Shipment) error {
+			s.Ship("TRK-1", shippedAt)
+			return s.Return(completedAt)
+		}, ShipmentStatusReturned, true},
+		{"発送前の配達完了は不可", func(s *Shipment) error {
+			return s.Deliver(completedAt)
+		}, ShipmentStatusPending, false},
+		{"発送後の取り消しは不可", func(s *Shipment) error {
+			s.Ship("TRK-1", shippedAt)
+			return s.Cancel(completedAt)
+		}, ShipmentStatusShipped, false},
+		{"配達完了後の返送は不可", func(s *Shipment) error {
+			s.Ship("TRK-1", shippedAt)
+			s.Deliver(completedAt)
+			return s.Return(completedAt)
+		}, ShipmentStatusDelivered, false},
+		{"取り消し後の発送は不可", func(s *Shipment) error {
+			s.Cancel(shippedAt)
+			return s.Ship("TRK-1", completedAt)
+		}, ShipmentStatusCancelled, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			shipment := newTestShipment(t, createdAt)
+
+			// Act
+			err := tt.act(shipment)
+
+			// Assert
+			if tt.allowed && err != nil {
+				t.Fatalf("Expected no error, but got %v", err)
+			}
+			if !tt.allowed {
+				var transitionErr InvalidShipmentTransitionError
+				if !errors.As(err, &transitionErr) {
+					t.Fatalf("Expected InvalidShipmentTransitionError, but got %v", err)
+				}
+			}
+			if shipment.Status() != tt.expected {
+				t.Errorf("Expected status %s, but got %s", tt.expected, shipment.Status())
+			}
+		})
+	}
+}
+
+func TestShipment_Ship_RecordsTracking(t *testing.T) {
+	// Arrange
+	createdAt := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	shipment := newTestShipment(t, createdAt)
+	shippedAt := createdAt.Add(time.Hour)
+
+	// Act
+	err := shipment.Ship(" TRK-123 ", shippedAt)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if shipment.TrackingNumber() != "TRK-123" {
+		t.Errorf("Expected tracking number TRK-123, but got %q", shipment.TrackingNumber())
+	}
+	if !shipment.ShippedAt().Equal(shippedAt) {
+		t.Errorf("Expected shipped at %v, but got %v", shippedAt, shipment.ShippedAt())
+	}
+	if !shipment.CompletedAt().IsZero() {
+		t.Error("Expected shipment not to be completed")
+	}
+}
+
+func TestShipment_Ship_WithoutTrackingNumber_ReturnsError(t *testing.T) {
+	// Arrange
+	shipment := newTestShipment(t, time.Now())
+
+	// Act
+	err := shipment.Ship("", time.Now())
+
+	// Assert
+	if err == nil {
+		t.Error("Expected error, but got nil")
+	}
+	if shipment.Status() != ShipmentStatusPending {
+		t.Errorf("Expected status pending, but got %s", shipment.Status())
+	}
+}
@@ -0,0 +1,437 @@
+package domain
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// 容積重量の既定の換算係数（cm³/kg）。縦×横×高さ(cm) / 5000 = kg
+const DefaultVolumetricDivisor = 5000
+
+// WeightBracket - 重量帯ごとの基本料金（maxGrams 以下の荷物に適用）
+type WeightBracket struct {
+	maxGrams int64
+	fee      *Money
+}
+
+func NewWeightBracket(maxGrams int64, fee *Money) (*WeightBracket, error) {
+	if maxGrams <= 0 {
+		return nil, InvalidShippingRateError{Reason: "bracket weight must be positive"}
+	}
+	if fee == nil || fee.IsNegative() {
+		return nil, InvalidShippingRateError{Reason: "bracket fee must not be negative"}
+	}
+	return &WeightBracket{maxGrams: maxGrams, fee: fee}, nil
+}
+
+func (b *WeightBracket) MaxGrams() int64 {
+	return b.maxGrams
+}
+
+func (b *WeightBracket) Fee() *Money {
+	return b.fee
+}
+
+// ShippingZoneRate - 配送地域ごとの料金
+type ShippingZoneRate struct {
+	zone               string
+	brackets           []*WeightBracket // 重量の昇順
+	overweightFeePerKg *Money           // 最大の重量帯を超えた分の1kgごとの追加料金（nilの場合は受け付けない）
+}
+
+func NewShippingZoneRate(zone string, brackets []*WeightBracket, overweightFeePerKg *Money) (*ShippingZoneRate, error) {
+	zone = strings.TrimSpace(zone)
+	if zone == "" {
+		return nil, EmptyFieldError{Field: "shipping zone"}
+	}
+	if len(brackets) == 0 {
+		return nil, InvalidShippingRateError{Reason: "zone " + zone + " has no weight brackets"}
+	}
+
+	sorted := make([]*WeightBracket, len(brackets))
+	copy(sorted, brackets)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].maxGrams < sorted[j].maxGrams
+	})
+	currency := sorted[0].fee.Currency()
+	for i, bracket := range sorted {
+		if i > 0 && bracket.maxGrams == sorted[i-1].maxGrams {
+			return nil, InvalidShippingRateError{Reason: "zone " + zone + " has duplicate weight brackets"}
+		}
+		if bracket.fee.Currency() != currency {
+			return nil, CurrencyMismatchError{Currency1: currency, Currency2: bracket.fee.Currency()}
+		}
+	}
+	if overweightFeePerKg != nil {
+		if !overweightFeePerKg.IsPositive() {
+			return nil, InvalidShippingRateError{Reason: "overweight fee must be positive"}
+		}
+		if overweightFeePerKg.Currency() != currency {
+			return nil, CurrencyMismatchError{Currency1: currency, Currency2: overweightFeePerKg.Currency()}
+		}
+	}
+
+	return &ShippingZoneRate{
+		zone:               zone,
+		brackets:           sorted,
+		overweightFeePerKg: overweightFeePerKg,
+	}, nil
+}
+
+func (r *ShippingZoneRate) Zone() string {
+	return r.zone
+}
+
+func (r *ShippingZoneRate) currency() string {
+	return r.brackets[0].fee.Currency()
+}
+
+// ShippingRateTable - 送料の料金表
+type ShippingRateTable struct {
+	volumetricDivisor      int64
+	premiumDiscountPercent int64
+	zones                  map[string]*ShippingZoneRate
+}
+
+func NewShippingRateTable(volumetricDivisor, premiumDiscountPercent int64, zones []*ShippingZoneRate) (*ShippingRateTable, error) {
+	if volumetricDivisor <= 0 {
+		return nil, InvalidShippingRateError{Reason: "volumetric divisor must be positive"}
+	}
+	if premiumDiscountPercent < 0 || premiumDiscountPercent > 100 {
+		return nil, InvalidShippingRateError{Reason: "premium discount must be between 0 and 100 percent"}
+	}
+	if len(zones) == 0 {
+		return nil, InvalidShippingRateError{Reason: "at least one zone is required"}
+	}
+
+	table := &ShippingRateTable{
+		volumetricDivisor:      volumetricDivisor,
+		premiumDiscountPercent: premiumDiscountPercent,
+		zones:                  make(map[string]*ShippingZoneRate),
+	}
+	for _, zone := range zones {
+		if _, exists := table.zones[zone.zone]; exists {
+			return nil, InvalidShippingRateError{Reason: "duplicate zone: " + zone.zone}
+		}
+		table.zones[zone.zone] = zone
+	}
+	return table, nil
+}
+
+// DefaultShippingRateTable は既定の料金表（円建て・国内向け）を返す
+func DefaultShippingRateTable() *ShippingRateTable {
+	yen := func(amount int64) *Money {
+		money, _ := NewMoney(amount, "JPY")
+		return money
+	}
+	zone := func(name string, fees [3]int64, overweight int64) *ShippingZoneRate {
+		var brackets []*WeightBracket
+		for i, maxGrams := range []int64{1000, 5000, 10000} {
+			bracket, _ := NewWeightBracket(maxGrams, yen(fees[i]))
+			brackets = append(brackets, bracket)
+		}
+		rate, _ := NewShippingZoneRate(name, brackets, yen(overweight))
+		return rate
+	}
+
+	table, _ := NewShippingRateTable(DefaultVolumetricDivisor, 10, []*ShippingZoneRate{
+		zone("local", [3]int64{500, 800, 1200}, 100),
+		zone("domestic", [3]int64{700, 1100, 1600}, 150),
+		zone("remote", [3]int64{1200, 1900, 2800}, 250),
+	})
+	return table
+}
+
+// Zones は配送地域の一覧を名前順に返す
+func (t *ShippingRateTable) Zones() []string {
+	return sortedKeys(t.zones)
+}
+
+func (t *ShippingRateTable) VolumetricDivisor() int64 {
+	return t.volumetricDivisor
+}
+
+func (t *ShippingRateTable) PremiumDiscountPercent() int64 {
+	return t.premiumDiscountPercent
+}
+
+func (t *ShippingRateTable) zoneRate(zone string) (*ShippingZoneRate, error) {
+	rate, exists := t.zones[zone]
+	if !exists {
+		return nil, UnknownShippingZoneError{Zone: zone}
+	}
+	return rate, nil
+}
+
+// ShippingFeeItemCode - 送料明細の種類
+type ShippingFeeItemCode string
+
+const (
+	ShippingFeeItemBase            ShippingFeeItemCode = "base"             // 重量帯の基本料金
+	ShippingFeeItemOverweight      ShippingFeeItemCode = "overweight"       // 最大重量帯の超過料金
+	ShippingFeeItemPremiumDiscount ShippingFeeItemCode = "premium_discount" // プレミアム会員割引（負の金額）
+)
+
+func ParseShippingFeeItemCode(value string) (ShippingFeeItemCode, error) {
+	code := ShippingFeeItemCode(value)
+	switch code {
+	case ShippingFeeItemBase, ShippingFeeItemOverweight, ShippingFeeItemPremiumDiscount:
+		return code, nil
+	}
+	return "", InvalidShippingRateError{Reason: "unknown fee item: " + value}
+}
+
+func (c ShippingFeeItemCode) String() string {
+	return string(c)
+}
+
+// ShippingFeeItem - 送料明細の1行
+type ShippingFeeItem struct {
+	code        ShippingFeeItemCode
+	description string
+	amount      *Money
+}
+
+func NewShippingFeeItem(code ShippingFeeItemCode, description string, amount *Money) *ShippingFeeItem {
+	return &ShippingFeeItem{code: code, description: description, amount: amount}
+}
+
+func (i *ShippingFeeItem) Code() ShippingFeeItemCode {
+	return i.code
+}
+
+func (i *ShippingFeeItem) Description() string {
+	return i.description
+}
+
+func (i *ShippingFeeItem) Amount() *Money {
+	return i.amount
+}
+
+// ShippingFee 値オブジェクト - 送料とその内訳（表示・監査用に計算の根拠を残す）
+type ShippingFee struct {
+	zone                  string
+	actualWeightGrams     int64
+	volumetricWeightGrams int64
+	chargeableWeightGrams int64 // 実重量と容積重量の大きい方
+	items                 []*ShippingFeeItem
+	total                 *Money
+}
+
+// ReconstructShippingFee は保存済みの明細から送料を復元する（合計は明細から求める）
+func ReconstructShippingFee(zone string, actualWeightGrams, volumetricWeightGrams, chargeableWeightGrams int64, items []*ShippingFeeItem) (*ShippingFee, error) {
+	if len(items) == 0 {
+		return nil, InvalidShippingRateError{Reason: "shipping fee has no items"}
+	}
+
+	total, err := NewMoney(0, items[0].amount.Currency())
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if total, err = total.Add(item.amount); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ShippingFee{
+		zone:                  zone,
+		actualWeightGrams:     actualWeightGrams,
+		volumetricWeightGrams: volumetricWeightGrams,
+		chargeableWeightGrams: chargeableWeightGrams,
+		items:                 items,
+		total:                 total,
+	}, nil
+}
+
+func (f *ShippingFee) Zone() string {
+	return f.zone
+}
+
+func (f *ShippingFee) ActualWeightGrams() int64 {
+	return f.actualWeightGrams
+}
+
+func (f *ShippingFee) VolumetricWeightGrams() int64 {
+	return f.volumetricWeightGrams
+}
+
+func (f *ShippingFee) ChargeableWeightGrams() int64 {
+	return f.chargeableWeightGrams
+}
+
+func (f *ShippingFee) Items() []*ShippingFeeItem {
+	// 防御的コピーを返す
+	items := make([]*ShippingFeeItem, len(f.items))
+	copy(items, f.items)
+	return items
+}
+
+func (f *ShippingFee) Total() *Money {
+	return f.total
+}
+
+// ShippingFeeCalculator - 荷物・配送地域・会員種別から送料を求めるドメインサービス
+type ShippingFeeCalculator struct {
+	table *ShippingRateTable
+}
+
+func NewShippingFeeCalculator(table *ShippingRateTable) *ShippingFeeCalculator {
+	return &ShippingFeeCalculator{table: table}
+}
+
+// Calculate は送料を計算する
+// 課金重量は実重量と容積重量の大きい方とし、重量帯の基本料金・超過料金・プレミアム割引の順に明細を作る
+func (c *ShippingFeeCalculator) Calculate(baggage []*Baggage, zone string, isPremium bool) (*ShippingFee, error) {
+	if len(baggage) == 0 {
+		return nil, InvalidShipmentError{Reason: "at least one baggage is required"}
+	}
+	rate, err := c.table.zoneRate(zone)
+	if err != nil {
+		return nil, err
+	}
+
+	var actual, volumetric int64
+	for _, item := range baggage {
+		if item == nil {
+			return nil, EmptyFieldError{Field: "baggage"}
+		}
+		volumetricGrams, err := c.volumetricWeightGrams(item.dimensions)
+		if err != nil {
+			return nil, err
+		}
+		if actual, err = addWeightGrams(actual, item.weightGrams); err != nil {
+			return nil, err
+		}
+		if volumetric, err = addWeightGrams(volumetric, volumetricGrams); err != nil {
+			return nil, err
+		}
+	}
+	chargeable := max(actual, volumetric)
+
+	items, err := c.weightItems(rate, chargeable)
+	if err != nil {
+		return nil, err
+	}
+
+	subtotal, err := NewMoney(0, rate.currency())
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if subtotal, err = subtotal.Add(item.amount); err != nil {
+			return nil, err
+		}
+	}
+
+	if isPremium && c.table.premiumDiscountPercent > 0 {
+		// 割引額の1円未満（最小単位未満）は切り捨てる
+		discount, err := subtotal.Percent(c.table.premiumDiscountPercent, RoundDown)
+		if err != nil {
+			return nil, err
+		}
+		if !discount.IsZero() {
+			negated, err := discount.Negate()
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, NewShippingFeeItem(ShippingFeeItemPremiumDiscount,
+				fmt.Sprintf("Premium member discount (%d%%)", c.table.premiumDiscountPercent), negated))
+		}
+	}
+
+	return ReconstructShippingFee(rate.zone, actual, volumetric, chargeable, items)
+}
+
+// volumetricWeightGrams は容積重量（g、1g未満は切り上げ）を返す
+// 桁あふれで容積重量が負になり、実重量で安く計算されることがないよう積を検査する
+func (c *ShippingFeeCalculator) volumetricWeightGrams(dimensions *Dimensions) (int64, error) {
+	grams := big.NewInt(dimensions.LengthCm())
+	grams.Mul(grams, big.NewInt(dimensions.WidthCm()))
+	grams.Mul(grams, big.NewInt(dimensions.HeightCm()))
+	grams.Mul(grams, big.NewInt(1000))
+	if !grams.IsInt64() {
+		return 0, InvalidShipmentError{Reason: "baggage dimensions are too large"}
+	}
+	return (grams.Int64() + c.table.volumetricDivisor - 1) / c.table.volumetricDivisor, nil
+}
+
+// addWeightGrams は重量の合計を返す（int64 に収まらない場合はエラー）
+func addWeightGrams(total, grams int64) (int64, error) {
+	sum := new(big.Int).Add(big.NewInt(total), big.NewInt(grams))
+	if !sum.IsInt64() {
+		return 0, InvalidShipmentError{Reason: "total baggage weight is too large"}
+	}
+	return sum.Int64(), nil
+}
+
+// weightItems は課金重量に対する基本料金と超過料金の明細を返す
+func (c *ShippingFeeCalculator) weightItems(rate *ShippingZoneRate, chargeableGrams int64) ([]*ShippingFeeItem, error) {
+	for _, bracket := range rate.brackets {
+		if chargeableGrams <= bracket.maxGrams {
+			return []*ShippingFeeItem{
+				NewShippingFeeItem(ShippingFeeItemBase,
+					fmt.Sprintf("Zone %s, up to %dg", rate.zone, bracket.maxGrams), bracket.fee),
+			}, nil
+		}
+	}
+
+	largest := rate.brackets[len(rate.brackets)-1]
+	if rate.overweightFeePerKg == nil {
+		return nil, ShipmentTooHeavyError{WeightGrams: chargeableGrams, MaxGrams: largest.maxGrams}
+	}
+
+	// 超過分は1kg単位で切り上げる
+	overweightKg := (chargeableGrams - largest.maxGrams + 999) / 1000
+	surcharge, err := rate.overweightFeePerKg.Multiply(overweightKg)
+	if err != nil {
+		return nil, err
+	}
+	return []*ShippingFeeItem{
+		NewShippingFeeItem(ShippingFeeItemBase,
+			fmt.Sprintf("Zone %s, up to %dg", rate.zone, largest.maxGrams), largest.fee),
+		NewShippingFeeItem(ShippingFeeItemOverweight,
+			fmt.Sprintf("Overweight %dkg x %s", overweightKg, rate.overweightFeePerKg), surcharge),
+	}, nil
+}
+
+// Shipping fee related errors
+type InvalidShippingRateError struct {
+	Reason string
+}
+
+func (e InvalidShippingRateError) Error() string {
+	return "invalid shipping rate: " + e.Reason
+}
+
+func (e InvalidShippingRateError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
+
+type UnknownShippingZoneError struct {
+	Zone string
+}
+
+func (e UnknownShippingZoneError) Error() string {
+	return "unknown shipping zone: " + e.Zone
+}
+
+func (e UnknownShippingZoneError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
+
+type ShipmentTooHeavyError struct {
+	WeightGrams int64
+	MaxGrams    int64
+}
+
+func (e ShipmentTooHeavyError) Error() string {
+	return fmt.Sprintf("shipment weighs %dg, which exceeds the maximum of %dg", e.WeightGrams, e.MaxGrams)
+}
+
+func (e ShipmentTooHeavyError) HTTPStatus() int {
+	return http.StatusUnprocessableEntity
+}
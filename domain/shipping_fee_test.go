@@ -0,0 +1,200 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func newTestBaggageWithSize(t *testing.T, weightGrams, lengthCm, widthCm, heightCm int64) *Baggage {
+	t.Helper()
+
+	dimensions, err := NewDimensions(lengthCm, widthCm, heightCm)
+	if err != nil {
+		t.Fatalf("Failed to create dimensions: %v", err)
+	}
+	baggage, err := NewBaggage("グッズ", weightGrams, dimensions)
+	if err != nil {
+		t.Fatalf("Failed to create baggage: %v", err)
+	}
+	return baggage
+}
+
+func feeItemCodes(fee *ShippingFee) []ShippingFeeItemCode {
+	var codes []ShippingFeeItemCode
+	for _, item := range fee.Items() {
+		codes = append(codes, item.Code())
+	}
+	return codes
+}
+
+func TestShippingFeeCalculator_Calculate(t *testing.T) {
+	// 既定の料金表（domestic: 1kgまで700円、5kgまで1100円、10kgまで1600円、超過1kgごと150円、プレミアム10%引き）
+	calculator := NewShippingFeeCalculator(DefaultShippingRateTable())
+
+	tests := []struct {
+		name               string
+		baggage            func() []*Baggage
+		zone               string
+		isPremium          bool
+		expectedChargeable int64
+		expectedCodes      []ShippingFeeItemCode
+		expectedTotal      int64
+	}{
+		{
+			"実重量で課金", func() []*Baggage {
+				return []*Baggage{newTestBaggageWithSize(t, 800, 10, 10, 10)}
+			}, "domestic", false,
+			800, []ShippingFeeItemCode{ShippingFeeItemBase}, 700,
+		},
+		{
+			"軽くて大きい荷物は容積重量で課金", func() []*Baggage {
+				// 50×40×10 / 5000 = 4kg、20×10×10 / 5000 = 0.4kg（実重量は2.5kg）
+				return []*Baggage{newTestBaggageWithSize(t, 2000, 50, 40, 10), newTestBaggageWithSize(t, 500, 20, 10, 10)}
+			}, "domestic", false,
+			4400, []ShippingFeeItemCode{ShippingFeeItemBase}, 1100,
+		},
+		{
+			"最大重量帯の超過分は1kg単位で切り上げ", func() []*Baggage {
+				return []*Baggage{newTestBaggageWithSize(t, 12001, 10, 10, 10)}
+			}, "domestic", false,
+			12001, []ShippingFeeItemCode{ShippingFeeItemBase, ShippingFeeItemOverweight}, 1600 + 150*3,
+		},
+		{
+			"プレミアム会員は割引", func() []*Baggage {
+				return []*Baggage{newTestBaggageWithSize(t, 800, 10, 10, 10)}
+			}, "domestic", true,
+			800, []ShippingFeeItemCode{ShippingFeeItemBase, ShippingFeeItemPremiumDiscount}, 630,
+		},
+		{
+			"配送地域ごとの料金", func() []*Baggage {
+				return []*Baggage{newTestBaggageWithSize(t, 800, 10, 10, 10)}
+			}, "remote", false,
+			800, []ShippingFeeItemCode{ShippingFeeItemBase}, 1200,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			fee, err := calculator.Calculate(tt.baggage(), tt.zone, tt.isPremium)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, but got %v", err)
+			}
+			if fee.ChargeableWeightGrams() != tt.expectedChargeable {
+				t.Errorf("Expected chargeable weight %d, but got %d", tt.expectedChargeable, fee.ChargeableWeightGrams())
+			}
+			codes := feeItemCodes(fee)
+			if len(codes) != len(tt.expectedCodes) {
+				t.Fatalf("Expected items %v, but got %v", tt.expectedCodes, codes)
+			}
+			for i := range codes {
+				if codes[i] != tt.expectedCodes[i] {
+					t.Errorf("Expected items %v, but got %v", tt.expectedCodes, codes)
+					break
+				}
+			}
+			if fee.Total().Amount() != tt.expectedTotal {
+				t.Errorf("Expected total %d, but got %d", tt.expectedTotal, fee.Total().Amount())
+			}
+			if fee.Zone() != tt.zone {
+				t.Errorf("Expected zone %s, but got %s", tt.zone, fee.Zone())
+			}
+		})
+	}
+}
+
+func TestShippingFeeCalculator_Calculate_ReturnsError(t *testing.T) {
+	bracket, _ := NewWeightBracket(1000, mustMoney(t, 500, "JPY"))
+	zone, _ := NewShippingZoneRate("local", []*WeightBracket{bracket}, nil)
+	table, err := NewShippingRateTable(DefaultVolumetricDivisor, 0, []*ShippingZoneRate{zone})
+	if err != nil {
+		t.Fatalf("Failed to create rate table: %v", err)
+	}
+	calculator := NewShippingFeeCalculator(table)
+
+	t.Run("未知の配送地域", func(t *testing.T) {
+		// Act
+		_, err := calculator.Calculate([]*Baggage{newTestBaggageWithSize(t, 500, 10, 10, 10)}, "moon", false)
+
+		// Assert
+		var unknown UnknownShippingZoneError
+		if !errors.As(err, &unknown) {
+			t.Errorf("Expected UnknownShippingZoneError, but got %v", err)
+		}
+	})
+
+	t.Run("重量の合計が桁あふれする", func(t *testing.T) {
+		// Act
+		heavy := newTestBaggageWithSize(t, math.MaxInt64/2+1, 10, 10, 10)
+		_, err := calculator.Calculate([]*Baggage{heavy, heavy}, "local", false)
+
+		// Assert
+		var invalid InvalidShipmentError
+		if !errors.As(err, &invalid) {
+			t.Errorf("Expected InvalidShipmentError, but got %v", err)
+		}
+	})
+
+	t.Run("超過料金のない地域で最大重量を超える", func(t *testing.T) {
+		// Act
+		_, err := calculator.Calculate([]*Baggage{newTestBaggageWithSize(t, 1500, 10, 10, 10)}, "local", false)
+
+		// Assert
+		var tooHeavy ShipmentTooHeavyError
+		if !errors.As(err, &tooHeavy) {
+			t.Fatalf("Expected ShipmentTooHeavyError, but got %v", err)
+		}
+		if tooHeavy.MaxGrams != 1000 {
+			t.Errorf("Expected max 1000g, but got %d", tooHeavy.MaxGrams)
+		}
+	})
+}
+
+func TestNewShippingRateTable_InvalidInput_ReturnsError(t *testing.T) {
+	bracket, _ := NewWeightBracket(1000, mustMoney(t, 500, "JPY"))
+	usdBracket, _ := NewWeightBracket(2000, mustMoney(t, 500, "USD"))
+	zone, _ := NewShippingZoneRate("local", []*WeightBracket{bracket}, nil)
+
+	tests := []struct {
+		name  string
+		build func() (*ShippingRateTable, error)
+	}{
+		{"換算係数が0", func() (*ShippingRateTable, error) {
+			return NewShippingRateTable(0, 0, []*ShippingZoneRate{zone})
+		}},
+		{"割引率が100%超", func() (*ShippingRateTable, error) {
+			return NewShippingRateTable(DefaultVolumetricDivisor, 101, []*ShippingZoneRate{zone})
+		}},
+		{"配送地域なし", func() (*ShippingRateTable, error) {
+			return NewShippingRateTable(DefaultVolumetricDivisor, 0, nil)
+		}},
+		{"配送地域の重複", func() (*ShippingRateTable, error) {
+			return NewShippingRateTable(DefaultVolumetricDivisor, 0, []*ShippingZoneRate{zone, zone})
+		}},
+		{"重量帯の通貨が混在", func() (*ShippingRateTable, error) {
+			mixed, err := NewShippingZoneRate("mixed", []*WeightBracket{bracket, usdBracket}, nil)
+			if err != nil {
+				return nil, err
+			}
+			return NewShippingRateTable(DefaultVolumetricDivisor, 0, []*ShippingZoneRate{mixed})
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			table, err := tt.build()
+
+			// Assert
+			if err == nil {
+				t.Error("Expected error, but got nil")
+			}
+			if table != nil {
+				t.Error("Expected nil table")
+			}
+		})
+	}
+}
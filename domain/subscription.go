@@ -0,0 +1,287 @@
+package domain
+
+import (
+	"net/http"
+	"time"
+)
+
+// SubscriptionPlan - 契約プラン
+type SubscriptionPlan string
+
+const (
+	SubscriptionPlanFree           SubscriptionPlan = "free"
+	SubscriptionPlanPremiumMonthly SubscriptionPlan = "premium_monthly"
+	SubscriptionPlanPremiumYearly  SubscriptionPlan = "premium_yearly"
+)
+
+// 無料トライアルの期間（日数）
+const SubscriptionTrialDays = 14
+
+func ParseSubscriptionPlan(value string) (SubscriptionPlan, error) {
+	plan := SubscriptionPlan(value)
+	switch plan {
+	case SubscriptionPlanFree, SubscriptionPlanPremiumMonthly, SubscriptionPlanPremiumYearly:
+		return plan, nil
+	}
+	if value == "" {
+		return "", EmptyFieldError{Field: "subscription plan"}
+	}
+	return "", InvalidSubscriptionPlanError{Value: value}
+}
+
+func (p SubscriptionPlan) String() string {
+	return string(p)
+}
+
+func (p SubscriptionPlan) IsPremium() bool {
+	return p == SubscriptionPlanPremiumMonthly || p == SubscriptionPlanPremiumYearly
+}
+
+// rank はアップグレード・ダウングレードの判定に使うプランの序列
+func (p SubscriptionPlan) rank() int {
+	switch p {
+	case SubscriptionPlanPremiumMonthly:
+		return 1
+	case SubscriptionPlanPremiumYearly:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// periodEnd は startedAt から1契約期間後の時刻を返す
+func (p SubscriptionPlan) periodEnd(startedAt time.Time) time.Time {
+	if p == SubscriptionPlanPremiumYearly {
+		return startedAt.AddDate(1, 0, 0)
+	}
+	return startedAt.AddDate(0, 1, 0)
+}
+
+// Subscription - ユーザーの契約状態を表す値オブジェクト
+// 状態遷移は新しい Subscription を返し、自身は変更しない
+type Subscription struct {
+	plan        SubscriptionPlan
+	startedAt   time.Time
+	expiresAt   time.Time // ゼロ値は無期限
+	isTrial     bool
+	trialUsed   bool
+	cancelledAt time.Time // ゼロ値は未解約
+}
+
+func NewFreeSubscription() *Subscription {
+	return &Subscription{plan: SubscriptionPlanFree}
+}
+
+// NewComplimentarySubscription は期限なしのプレミアム契約を作成する（管理者が付与するプレミアム会員用）
+func NewComplimentarySubscription(startedAt time.Time) *Subscription {
+	return &Subscription{
+		plan:      SubscriptionPlanPremiumMonthly,
+		startedAt: startedAt,
+	}
+}
+
+func ReconstructSubscription(
+	plan SubscriptionPlan,
+	startedAt time.Time,
+	expiresAt time.Time,
+	isTrial bool,
+	trialUsed bool,
+	cancelledAt time.Time,
+) (*Subscription, error) {
+	if _, err := ParseSubscriptionPlan(string(plan)); err != nil {
+		return nil, err
+	}
+	if !expiresAt.IsZero() && expiresAt.Before(startedAt) {
+		return nil, SubscriptionChangeError{Reason: "expiry is before start"}
+	}
+	if isTrial && !plan.IsPremium() {
+		return nil, SubscriptionChangeError{Reason: "trial requires a premium plan"}
+	}
+	return &Subscription{
+		plan:        plan,
+		startedAt:   startedAt,
+		expiresAt:   expiresAt,
+		isTrial:     isTrial,
+		trialUsed:   trialUsed || isTrial,
+		cancelledAt: cancelledAt,
+	}, nil
+}
+
+func (s *Subscription) Plan() SubscriptionPlan {
+	return s.plan
+}
+
+func (s *Subscription) StartedAt() time.Time {
+	return s.startedAt
+}
+
+func (s *Subscription) ExpiresAt() time.Time {
+	return s.expiresAt
+}
+
+func (s *Subscription) HasExpiry() bool {
+	return !s.expiresAt.IsZero()
+}
+
+func (s *Subscription) IsTrial() bool {
+	return s.isTrial
+}
+
+func (s *Subscription) TrialUsed() bool {
+	return s.trialUsed
+}
+
+func (s *Subscription) CancelledAt() time.Time {
+	return s.cancelledAt
+}
+
+func (s *Subscription) IsCancelled() bool {
+	return !s.cancelledAt.IsZero()
+}
+
+// IsPremiumAt は指定時刻にプレミアム特典が有効かを返す
+func (s *Subscription) IsPremiumAt(now time.Time) bool {
+	if !s.plan.IsPremium() {
+		return false
+	}
+	if now.Before(s.startedAt) {
+		return false
+	}
+	return !s.HasExpiry() || now.Before(s.expiresAt)
+}
+
+// EffectivePlanAt は期限切れを考慮した指定時刻のプランを返す
+func (s *Subscription) EffectivePlanAt(now time.Time) SubscriptionPlan {
+	if s.IsPremiumAt(now) {
+		return s.plan
+	}
+	return SubscriptionPlanFree
+}
+
+func (s *Subscription) Equals(other *Subscription) bool {
+	if other == nil {
+		return false
+	}
+	return s.plan == other.plan &&
+		s.startedAt.Equal(other.startedAt) &&
+		s.expiresAt.Equal(other.expiresAt) &&
+		s.isTrial == other.isTrial &&
+		s.trialUsed == other.trialUsed &&
+		s.cancelledAt.Equal(other.cancelledAt)
+}
+
+// startTrial は無料プランからトライアルを開始する（トライアルは1度のみ）
+func (s *Subscription) startTrial(plan SubscriptionPlan, now time.Time) (*Subscription, error) {
+	if !plan.IsPremium() {
+		return nil, SubscriptionChangeError{Reason: "trial is only available for premium plans"}
+	}
+	if s.trialUsed {
+		return nil, SubscriptionChangeError{Reason: "trial has already been used"}
+	}
+	if s.EffectivePlanAt(now).IsPremium() {
+		return nil, SubscriptionChangeError{Reason: "already subscribed to a premium plan"}
+	}
+	return &Subscription{
+		plan:      plan,
+		startedAt: now,
+		expiresAt: now.AddDate(0, 0, SubscriptionTrialDays),
+		isTrial:   true,
+		trialUsed: true,
+	}, nil
+}
+
+// upgrade は上位プランの有料契約を開始する（トライアル中は同じプランへの本契約も可能）
+func (s *Subscription) upgrade(plan SubscriptionPlan, now time.Time) (*Subscription, error) {
+	if !plan.IsPremium() {
+		return nil, SubscriptionChangeError{Reason: "cannot upgrade to " + plan.String()}
+	}
+	current := s.EffectivePlanAt(now)
+	convertingTrial := s.isTrial && current.IsPremium() && plan.rank() >= current.rank()
+	if !convertingTrial && plan.rank() <= current.rank() {
+		return nil, SubscriptionChangeError{Reason: "cannot upgrade from " + current.String() + " to " + plan.String()}
+	}
+	return &Subscription{
+		plan:      plan,
+		startedAt: now,
+		expiresAt: plan.periodEnd(now),
+		trialUsed: s.trialUsed,
+	}, nil
+}
+
+// downgrade は下位プランへ変更する
+// 無料プランへは即時に、下位のプレミアムプランへは変更時点から新しいプランの1契約期間に切り替える
+// 支払い済みの期限（トライアルの期限を含む）を超えては延長しない
+func (s *Subscription) downgrade(plan SubscriptionPlan, now time.Time) (*Subscription, error) {
+	current := s.EffectivePlanAt(now)
+	if plan.rank() >= current.rank() {
+		return nil, SubscriptionChangeError{Reason: "cannot downgrade from " + current.String() + " to " + plan.String()}
+	}
+	if !plan.IsPremium() {
+		return &Subscription{plan: SubscriptionPlanFree, trialUsed: s.trialUsed}, nil
+	}
+	expiresAt := plan.periodEnd(now)
+	if s.HasExpiry() && s.expiresAt.Before(expiresAt) {
+		expiresAt = s.expiresAt
+	}
+	return &Subscription{
+		plan:        plan,
+		startedAt:   now,
+		expiresAt:   expiresAt,
+		isTrial:     s.isTrial,
+		trialUsed:   s.trialUsed,
+		cancelledAt: s.cancelledAt,
+	}, nil
+}
+
+// grantComplimentary は期限なしのプレミアム契約に切り替える
+// 残っている有料契約やトライアルは置き換える
+func (s *Subscription) grantComplimentary(now time.Time) (*Subscription, error) {
+	if s.IsPremiumAt(now) && !s.HasExpiry() {
+		return nil, SubscriptionChangeError{Reason: "complimentary premium is already granted"}
+	}
+	granted := NewComplimentarySubscription(now)
+	granted.trialUsed = s.trialUsed
+	return granted, nil
+}
+
+// cancel は契約を解約する
+// 有料契約は期限まで特典を維持し、トライアルと期限なし契約は即時終了する
+func (s *Subscription) cancel(now time.Time) (*Subscription, error) {
+	if !s.IsPremiumAt(now) {
+		return nil, SubscriptionChangeError{Reason: "no active premium subscription"}
+	}
+	if s.IsCancelled() {
+		return nil, SubscriptionChangeError{Reason: "subscription is already cancelled"}
+	}
+	cancelled := *s
+	cancelled.cancelledAt = now
+	if s.isTrial || !s.HasExpiry() {
+		cancelled.expiresAt = now
+	}
+	return &cancelled, nil
+}
+
+// Subscription related errors
+type InvalidSubscriptionPlanError struct {
+	Value string
+}
+
+func (e InvalidSubscriptionPlanError) Error() string {
+	return "invalid subscription plan: " + e.Value
+}
+
+func (e InvalidSubscriptionPlanError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
+
+type SubscriptionChangeError struct {
+	Reason string
+}
+
+func (e SubscriptionChangeError) Error() string {
+	return "subscription change not allowed: " + e.Reason
+}
+
+func (e SubscriptionChangeError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
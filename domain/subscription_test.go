@@ -0,0 +1,293 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+var subscriptionBaseTime = time.Date(2025, 1, 10, 9, 0, 0, 0, time.UTC)
+
+func newTestSubscriber(t *testing.T) *User {
+	t.Helper()
+
+	name, _ := NewFullName("太郎", "田中")
+	email, _ := NewEmail("taro@example.com")
+	return ReconstructUserWithSubscription(NewUserID(), name, email, NewFreeSubscription())
+}
+
+func TestParseSubscriptionPlan(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"無料プラン", "free", false},
+		{"月額プラン", "premium_monthly", false},
+		{"年額プラン", "premium_yearly", false},
+		{"空文字", "", true},
+		{"未知のプラン", "gold", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := ParseSubscriptionPlan(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error for %q, but got none", tt.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Expected no error, but got: %v", err)
+			}
+			if plan.String() != tt.value {
+				t.Errorf("Expected plan %q, but got %q", tt.value, plan)
+			}
+		})
+	}
+}
+
+func TestUser_IsPremiumAt_ExpiresAtPeriodEnd(t *testing.T) {
+	clock := NewFixedClock(subscriptionBaseTime)
+	user := newTestSubscriber(t)
+
+	if err := user.UpgradeSubscription(SubscriptionPlanPremiumMonthly, clock.Now()); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if !user.IsPremiumAt(clock.Now()) {
+		t.Error("Expected user to be premium after upgrade")
+	}
+
+	// 期限の直前まではプレミアム
+	clock.Set(user.Subscription().ExpiresAt().Add(-time.Second))
+	if !user.IsPremiumAt(clock.Now()) {
+		t.Error("Expected user to be premium before expiry")
+	}
+
+	// 期限に達すると自動的にプレミアムでなくなる
+	clock.Set(user.Subscription().ExpiresAt())
+	if user.IsPremiumAt(clock.Now()) {
+		t.Error("Expected user not to be premium after expiry")
+	}
+}
+
+func TestUser_StartTrial(t *testing.T) {
+	clock := NewFixedClock(subscriptionBaseTime)
+	user := newTestSubscriber(t)
+
+	if err := user.StartTrial(SubscriptionPlanPremiumMonthly, clock.Now()); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if !user.Subscription().IsTrial() || !user.IsPremiumAt(clock.Now()) {
+		t.Error("Expected premium trial")
+	}
+	expectedExpiry := subscriptionBaseTime.AddDate(0, 0, SubscriptionTrialDays)
+	if !user.Subscription().ExpiresAt().Equal(expectedExpiry) {
+		t.Errorf("Expected expiry %v, but got %v", expectedExpiry, user.Subscription().ExpiresAt())
+	}
+
+	// トライアル終了後に再度トライアルはできない
+	clock.Set(expectedExpiry)
+	err := user.StartTrial(SubscriptionPlanPremiumMonthly, clock.Now())
+	if _, ok := err.(SubscriptionChangeError); !ok {
+		t.Errorf("Expected SubscriptionChangeError, but got %T", err)
+	}
+}
+
+func TestUser_SubscriptionTransitions(t *testing.T) {
+	tests := []struct {
+		name        string
+		setup       func(u *User, now time.Time) error
+		change      func(u *User, now time.Time) error
+		wantErr     bool
+		wantPlan    SubscriptionPlan
+		wantPremium bool
+	}{
+		{
+			name:        "無料から月額へアップグレード",
+			change:      func(u *User, now time.Time) error { return u.UpgradeSubscription(SubscriptionPlanPremiumMonthly, now) },
+			wantPlan:    SubscriptionPlanPremiumMonthly,
+			wantPremium: true,
+		},
+		{
+			name:        "月額から年額へアップグレード",
+			setup:       func(u *User, now time.Time) error { return u.UpgradeSubscription(SubscriptionPlanPremiumMonthly, now) },
+			change:      func(u *User, now time.Time) error { return u.UpgradeSubscription(SubscriptionPlanPremiumYearly, now) },
+			wantPlan:    SubscriptionPlanPremiumYearly,
+			wantPremium: true,
+		},
+		{
+			name:        "トライアルから同じプランの本契約へ",
+			setup:       func(u *User, now time.Time) error { return u.StartTrial(SubscriptionPlanPremiumMonthly, now) },
+			change:      func(u *User, now time.Time) error { return u.UpgradeSubscription(SubscriptionPlanPremiumMonthly, now) },
+			wantPlan:    SubscriptionPlanPremiumMonthly,
+			wantPremium: true,
+		},
+		{
+			name:    "同じプランへのアップグレードは不可",
+			setup:   func(u *User, now time.Time) error { return u.UpgradeSubscription(SubscriptionPlanPremiumYearly, now) },
+			change:  func(u *User, now time.Time) error { return u.UpgradeSubscription(SubscriptionPlanPremiumYearly, now) },
+			wantErr: true,
+		},
+		{
+			name:    "無料プランへのアップグレードは不可",
+			change:  func(u *User, now time.Time) error { return u.UpgradeSubscription(SubscriptionPlanFree, now) },
+			wantErr: true,
+		},
+		{
+			name:  "年額から月額へダウングレード",
+			setup: func(u *User, now time.Time) error { return u.UpgradeSubscription(SubscriptionPlanPremiumYearly, now) },
+			change: func(u *User, now time.Time) error {
+				return u.DowngradeSubscription(SubscriptionPlanPremiumMonthly, now)
+			},
+			wantPlan:    SubscriptionPlanPremiumMonthly,
+			wantPremium: true,
+		},
+		{
+			name:        "無料プランへのダウングレードは即時",
+			setup:       func(u *User, now time.Time) error { return u.UpgradeSubscription(SubscriptionPlanPremiumMonthly, now) },
+			change:      func(u *User, now time.Time) error { return u.DowngradeSubscription(SubscriptionPlanFree, now) },
+			wantPlan:    SubscriptionPlanFree,
+			wantPremium: false,
+		},
+		{
+			name:    "無料プランからのダウングレードは不可",
+			change:  func(u *User, now time.Time) error { return u.DowngradeSubscription(SubscriptionPlanFree, now) },
+			wantErr: true,
+		},
+		{
+			name:        "有料契約の解約は期限まで有効",
+			setup:       func(u *User, now time.Time) error { return u.UpgradeSubscription(SubscriptionPlanPremiumMonthly, now) },
+			change:      func(u *User, now time.Time) error { return u.CancelSubscription(now) },
+			wantPlan:    SubscriptionPlanPremiumMonthly,
+			wantPremium: true,
+		},
+		{
+			name:        "トライアルの解約は即時終了",
+			setup:       func(u *User, now time.Time) error { return u.StartTrial(SubscriptionPlanPremiumYearly, now) },
+			change:      func(u *User, now time.Time) error { return u.CancelSubscription(now) },
+			wantPlan:    SubscriptionPlanPremiumYearly,
+			wantPremium: false,
+		},
+		{
+			name:        "無償のプレミアムは有料契約を置き換える",
+			setup:       func(u *User, now time.Time) error { return u.UpgradeSubscription(SubscriptionPlanPremiumYearly, now) },
+			change:      func(u *User, now time.Time) error { return u.GrantComplimentarySubscription(now) },
+			wantPlan:    SubscriptionPlanPremiumMonthly,
+			wantPremium: true,
+		},
+		{
+			name:    "無償のプレミアムの重複付与は不可",
+			setup:   func(u *User, now time.Time) error { return u.GrantComplimentarySubscription(now) },
+			change:  func(u *User, now time.Time) error { return u.GrantComplimentarySubscription(now) },
+			wantErr: true,
+		},
+		{
+			name:    "無料プランは解約不可",
+			change:  func(u *User, now time.Time) error { return u.CancelSubscription(now) },
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := NewFixedClock(subscriptionBaseTime)
+			user := newTestSubscriber(t)
+			if tt.setup != nil {
+				if err := tt.setup(user, clock.Now()); err != nil {
+					t.Fatalf("Setup failed: %v", err)
+				}
+			}
+
+			err := tt.change(user, clock.Now())
+
+			if tt.wantErr {
+				if _, ok := err.(SubscriptionChangeError); !ok {
+					t.Errorf("Expected SubscriptionChangeError, but got %T: %v", err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if user.Subscription().Plan() != tt.wantPlan {
+				t.Errorf("Expected plan %s, but got %s", tt.wantPlan, user.Subscription().Plan())
+			}
+			if user.IsPremiumAt(clock.Now()) != tt.wantPremium {
+				t.Errorf("Expected IsPremium %v, but got %v", tt.wantPremium, user.IsPremiumAt(clock.Now()))
+			}
+		})
+	}
+}
+
+func TestUser_DowngradeSubscription_YearlyToMonthly_RecomputesPeriodEnd(t *testing.T) {
+	yearlyEnd := subscriptionBaseTime.AddDate(1, 0, 0)
+	tests := []struct {
+		name       string
+		elapsed    func(t time.Time) time.Time
+		wantExpiry func(now time.Time) time.Time
+	}{
+		{
+			name:       "月額の1契約期間に切り替わる",
+			elapsed:    func(t time.Time) time.Time { return t.AddDate(0, 2, 0) },
+			wantExpiry: func(now time.Time) time.Time { return now.AddDate(0, 1, 0) },
+		},
+		{
+			name:       "年額の期限を超えては延長しない",
+			elapsed:    func(t time.Time) time.Time { return t.AddDate(0, 11, 20) },
+			wantExpiry: func(now time.Time) time.Time { return yearlyEnd },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			clock := NewFixedClock(subscriptionBaseTime)
+			user := newTestSubscriber(t)
+			if err := user.UpgradeSubscription(SubscriptionPlanPremiumYearly, clock.Now()); err != nil {
+				t.Fatalf("Setup failed: %v", err)
+			}
+			clock.Set(tt.elapsed(subscriptionBaseTime))
+
+			// Act
+			err := user.DowngradeSubscription(SubscriptionPlanPremiumMonthly, clock.Now())
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			expiry := tt.wantExpiry(clock.Now())
+			if !user.Subscription().ExpiresAt().Equal(expiry) {
+				t.Errorf("Expected expiry %v, but got %v", expiry, user.Subscription().ExpiresAt())
+			}
+			if !user.IsPremiumAt(expiry.Add(-time.Second)) || user.IsPremiumAt(expiry) {
+				t.Error("Expected premium to last exactly until the recomputed expiry")
+			}
+		})
+	}
+}
+
+func TestCircleMemberService_PremiumExpiryReducesCapacity(t *testing.T) {
+	clock := NewFixedClock(subscriptionBaseTime)
+	owner := newTestSubscriber(t)
+	var members []*User
+	for i := 0; i < 10; i++ {
+		member := newTestSubscriber(t)
+		if err := member.UpgradeSubscription(SubscriptionPlanPremiumMonthly, clock.Now()); err != nil {
+			t.Fatalf("Failed to upgrade member: %v", err)
+		}
+		members = append(members, member)
+	}
+	circle := newTestCircle(t, owner, members)
+	service := NewCircleMemberService(nil)
+
+	if limit := service.GetMaxLimit(circle, NewCircleMembers(owner, members, clock.Now())); limit != PremiumMemberLimit {
+		t.Errorf("Expected %d while subscriptions are active, but got %d", PremiumMemberLimit, limit)
+	}
+
+	// 契約期限が過ぎると定員が基本定員に戻る
+	clock.Set(subscriptionBaseTime.AddDate(0, 1, 0))
+	if limit := service.GetMaxLimit(circle, NewCircleMembers(owner, members, clock.Now())); limit != BasicMemberLimit {
+		t.Errorf("Expected %d after expiry, but got %d", BasicMemberLimit, limit)
+	}
+}
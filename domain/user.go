@@ -2,6 +2,7 @@ package domain
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -40,28 +41,86 @@ func (u *UserID) String() string {
 }
 
 type User struct {
-	id        *UserID
-	name      *FullName
-	email     *Email
-	isPremium bool
+	eventRecorder
+	id            *UserID
+	name          *FullName
+	email         *Email
+	emailVerified bool
+	pendingEmail  *Email // 確認待ちの新しいメールアドレス（確認されるまで email を使い続ける）
+	subscription  *Subscription
+	deletedAt     time.Time // 論理削除した時刻（ゼロ値は削除されていない）
 }
 
-func NewUser(name *FullName, email *Email, isPremium bool) *User {
-	return &User{
-		id:        NewUserID(),
-		name:      name,
-		email:     email,
-		isPremium: isPremium,
+// NewUser は無料プランで登録する
+// メールアドレスは未確認の状態で登録し、確認を依頼する
+func NewUser(name *FullName, email *Email, now time.Time) *User {
+	user := &User{
+		id:           NewUserID(),
+		name:         name,
+		email:        email,
+		subscription: NewFreeSubscription(),
 	}
+	user.record(NewUserRegistered(user.id, name, email, now))
+	user.record(NewEmailVerificationRequested(user.id, email, now))
+	return user
 }
 
+// ReconstructUser はメールアドレスを確認済みとして再構成する
 func ReconstructUser(id *UserID, name *FullName, email *Email, isPremium bool) *User {
 	return &User{
-		id:        id,
-		name:      name,
-		email:     email,
-		isPremium: isPremium,
+		id:            id,
+		name:          name,
+		email:         email,
+		emailVerified: true,
+		subscription:  initialSubscription(isPremium, time.Time{}),
+	}
+}
+
+// ReconstructUserWithSubscription はメールアドレスを確認済みとして再構成する
+func ReconstructUserWithSubscription(id *UserID, name *FullName, email *Email, subscription *Subscription) *User {
+	return ReconstructUserWithEmailVerification(id, name, email, true, nil, subscription)
+}
+
+func ReconstructUserWithEmailVerification(
+	id *UserID,
+	name *FullName,
+	email *Email,
+	emailVerified bool,
+	pendingEmail *Email,
+	subscription *Subscription,
+) *User {
+	return ReconstructUserWithDeletion(id, name, email, emailVerified, pendingEmail, subscription, time.Time{})
+}
+
+// ReconstructUserWithDeletion は deletedAt がゼロ値でなければ論理削除済みとして再構成する
+func ReconstructUserWithDeletion(
+	id *UserID,
+	name *FullName,
+	email *Email,
+	emailVerified bool,
+	pendingEmail *Email,
+	subscription *Subscription,
+	deletedAt time.Time,
+) *User {
+	if subscription == nil {
+		subscription = NewFreeSubscription()
+	}
+	return &User{
+		id:            id,
+		name:          name,
+		email:         email,
+		emailVerified: emailVerified,
+		pendingEmail:  pendingEmail,
+		subscription:  subscription,
+		deletedAt:     deletedAt,
+	}
+}
+
+func initialSubscription(isPremium bool, startedAt time.Time) *Subscription {
+	if isPremium {
+		return NewComplimentarySubscription(startedAt)
 	}
+	return NewFreeSubscription()
 }
 
 func (u *User) ID() *UserID {
@@ -76,16 +135,168 @@ func (u *User) Email() *Email {
 	return u.email
 }
 
-func (u *User) ChangeName(name *FullName) {
+// ChangeName は名前が変わった場合のみ UserRenamed を記録する
+func (u *User) ChangeName(name *FullName, now time.Time) {
+	if u.name.Equals(name) {
+		return
+	}
+	u.record(NewUserRenamed(u.id, u.name, name, now))
 	u.name = name
 }
 
-func (u *User) ChangeEmail(email *Email) {
-	u.email = email
+// IsEmailVerified は現在のメールアドレスが確認済みかを返す
+func (u *User) IsEmailVerified() bool {
+	return u.emailVerified
+}
+
+// PendingEmail は確認待ちの新しいメールアドレスを返す（なければ nil）
+func (u *User) PendingEmail() *Email {
+	return u.pendingEmail
+}
+
+// RequestEmailChange はメールアドレスの変更を受け付け、新しいアドレスの確認を依頼する
+// 確認済みのアドレスは新しいアドレスが確認されるまで使い続ける
+// 未確認のアドレスは守る必要がないため、すぐに置き換える
+// 同じアドレスを指定した場合は確認を依頼し直す（確認済みの現在のアドレスなら変更を取り消す）
+func (u *User) RequestEmailChange(email *Email, now time.Time) {
+	switch {
+	case u.email.Equals(email):
+		u.pendingEmail = nil
+		if !u.emailVerified {
+			u.record(NewEmailVerificationRequested(u.id, email, now))
+		}
+	case u.emailVerified:
+		u.pendingEmail = email
+		u.record(NewEmailVerificationRequested(u.id, email, now))
+	default:
+		u.record(NewUserEmailChanged(u.id, u.email, email, now))
+		u.email = email
+		u.pendingEmail = nil
+		u.record(NewEmailVerificationRequested(u.id, email, now))
+	}
+}
+
+// VerifyEmail はトークンのアドレスを確認済みにする
+// 確認待ちのアドレスであれば現在のアドレスと置き換えて UserEmailChanged を記録する
+// 既に確認済みの現在のアドレスであれば何もしない
+func (u *User) VerifyEmail(token *EmailVerificationToken, now time.Time) error {
+	if !u.id.Equals(token.UserID()) {
+		return InvalidEmailVerificationTokenError{Reason: "token was issued for another user"}
+	}
+	if token.IsExpiredAt(now) {
+		return EmailVerificationTokenExpiredError{ExpiresAt: token.ExpiresAt()}
+	}
+
+	email := token.Email()
+	switch {
+	case u.pendingEmail != nil && u.pendingEmail.Equals(email):
+		u.record(NewUserEmailChanged(u.id, u.email, email, now))
+		u.email = email
+		u.pendingEmail = nil
+	case u.email.Equals(email):
+		if u.emailVerified {
+			return nil
+		}
+	default:
+		return InvalidEmailVerificationTokenError{Reason: "email address is no longer awaiting verification"}
+	}
+	u.emailVerified = true
+	u.record(NewUserEmailVerified(u.id, email, now))
+	return nil
+}
+
+// IsAwaitingVerification は指定したアドレスの確認を待っているかを返す
+func (u *User) IsAwaitingVerification(email *Email) bool {
+	if u.pendingEmail != nil {
+		return u.pendingEmail.Equals(email)
+	}
+	return !u.emailVerified && u.email.Equals(email)
+}
+
+func (u *User) Subscription() *Subscription {
+	return u.subscription
+}
+
+// IsPremiumAt は指定時刻に契約が有効かを判定する
+// 期限切れの契約は自動的にプレミアム扱いでなくなる
+func (u *User) IsPremiumAt(now time.Time) bool {
+	return u.subscription.IsPremiumAt(now)
+}
+
+func (u *User) StartTrial(plan SubscriptionPlan, now time.Time) error {
+	subscription, err := u.subscription.startTrial(plan, now)
+	if err != nil {
+		return err
+	}
+	u.subscription = subscription
+	return nil
+}
+
+// CanUpgradeSubscription は UpgradeSubscription できるかを確かめる（契約は変更しない）
+// 料金を請求してから契約を変更するために使う
+func (u *User) CanUpgradeSubscription(plan SubscriptionPlan, now time.Time) error {
+	_, err := u.subscription.upgrade(plan, now)
+	return err
+}
+
+func (u *User) UpgradeSubscription(plan SubscriptionPlan, now time.Time) error {
+	subscription, err := u.subscription.upgrade(plan, now)
+	if err != nil {
+		return err
+	}
+	u.subscription = subscription
+	return nil
+}
+
+func (u *User) DowngradeSubscription(plan SubscriptionPlan, now time.Time) error {
+	subscription, err := u.subscription.downgrade(plan, now)
+	if err != nil {
+		return err
+	}
+	u.subscription = subscription
+	return nil
+}
+
+// GrantComplimentarySubscription は期限なしのプレミアム契約を付与する（管理者のみが行う）
+func (u *User) GrantComplimentarySubscription(now time.Time) error {
+	subscription, err := u.subscription.grantComplimentary(now)
+	if err != nil {
+		return err
+	}
+	u.subscription = subscription
+	return nil
+}
+
+func (u *User) CancelSubscription(now time.Time) error {
+	subscription, err := u.subscription.cancel(now)
+	if err != nil {
+		return err
+	}
+	u.subscription = subscription
+	return nil
+}
+
+func (u *User) IsDeleted() bool {
+	return !u.deletedAt.IsZero()
+}
+
+// DeletedAt は論理削除した時刻を返す（削除されていない場合はゼロ値）
+func (u *User) DeletedAt() time.Time {
+	return u.deletedAt
+}
+
+// MarkDeleted は論理削除する（復元できる期間が過ぎるまで物理削除しない）
+func (u *User) MarkDeleted(now time.Time) error {
+	if u.IsDeleted() {
+		return AlreadyDeletedError{ID: u.id.Value()}
+	}
+	u.deletedAt = now
+	return nil
 }
 
-func (u *User) IsPremium() bool {
-	return u.isPremium
+// Restore は論理削除を取り消す（復元できる期間の判定は DeletionPolicy で行う）
+func (u *User) Restore() {
+	u.deletedAt = time.Time{}
 }
 
 func (u *User) Equals(other *User) bool {
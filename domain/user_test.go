@@ -2,6 +2,7 @@ package domain
 
 import (
 	"testing"
+	"time"
 )
 
 // UserID tests
@@ -111,7 +112,7 @@ func TestNewUser_ValidData_Success(t *testing.T) {
 	name, _ := NewFullName("太郎", "田中")
 	email, _ := NewEmail("taro@example.com")
 
-	user := NewUser(name, email, true)
+	user := NewUser(name, email, time.Now())
 
 	if user == nil {
 		t.Fatal("Expected User, but got nil")
@@ -125,8 +126,8 @@ func TestNewUser_ValidData_Success(t *testing.T) {
 	if !user.Email().Equals(email) {
 		t.Error("Expected user email to match input")
 	}
-	if !user.IsPremium() {
-		t.Error("Expected user to be premium")
+	if user.IsPremiumAt(time.Now()) {
+		t.Error("Expected new user to start on the free plan")
 	}
 }
 
@@ -149,7 +150,7 @@ func TestReconstructUser_ValidData_Success(t *testing.T) {
 	if !user.Email().Equals(email) {
 		t.Error("Expected user email to match input")
 	}
-	if user.IsPremium() {
+	if user.IsPremiumAt(time.Now()) {
 		t.Error("Expected user to not be premium")
 	}
 }
@@ -157,27 +158,30 @@ func TestReconstructUser_ValidData_Success(t *testing.T) {
 func TestUser_ChangeName_Success(t *testing.T) {
 	name, _ := NewFullName("太郎", "田中")
 	email, _ := NewEmail("taro@example.com")
-	user := NewUser(name, email, false)
+	user := NewUser(name, email, time.Now())
 
 	newName, _ := NewFullName("次郎", "田中")
-	user.ChangeName(newName)
+	user.ChangeName(newName, time.Now())
 
 	if !user.Name().Equals(newName) {
 		t.Error("Expected user name to be changed")
 	}
 }
 
-func TestUser_ChangeEmail_Success(t *testing.T) {
+func TestUser_RequestEmailChange_UnverifiedEmail_ReplacesImmediately(t *testing.T) {
 	name, _ := NewFullName("太郎", "田中")
 	email, _ := NewEmail("taro@example.com")
-	user := NewUser(name, email, false)
+	user := NewUser(name, email, time.Now())
 
 	newEmail, _ := NewEmail("taro.tanaka@example.com")
-	user.ChangeEmail(newEmail)
+	user.RequestEmailChange(newEmail, time.Now())
 
 	if !user.Email().Equals(newEmail) {
 		t.Error("Expected user email to be changed")
 	}
+	if user.IsEmailVerified() || user.PendingEmail() != nil {
+		t.Error("Expected the new email to await verification")
+	}
 }
 
 func TestUser_Equals(t *testing.T) {
@@ -223,11 +227,11 @@ func TestUserExistenceService_Exists_UserFound(t *testing.T) {
 	// テストユーザーを作成してリポジトリに保存
 	name, _ := NewFullName("太郎", "田中")
 	email, _ := NewEmail("taro@example.com")
-	user1 := NewUser(name, email, false)
+	user1 := NewUser(name, email, time.Now())
 	repo.users[user1.Name().String()] = user1
 
 	// 異なるIDで同じ名前のユーザーを作成
-	user2 := NewUser(name, email, false)
+	user2 := NewUser(name, email, time.Now())
 
 	service := NewUserExistenceService(repo)
 
@@ -247,7 +251,7 @@ func TestUserExistenceService_Exists_UserNotFound(t *testing.T) {
 
 	name, _ := NewFullName("花子", "佐藤")
 	email, _ := NewEmail("hanako@example.com")
-	user := NewUser(name, email, false)
+	user := NewUser(name, email, time.Now())
 
 	service := NewUserExistenceService(repo)
 
@@ -281,6 +285,23 @@ func (r *mockUserRepository) FindByName(name *FullName) (*User, error) {
 	return nil, nil
 }
 
+func (r *mockUserRepository) FindByEmail(email *Email) (*User, error) {
+	for _, user := range r.users {
+		if user.Email().Equals(email) {
+			return user, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *mockUserRepository) FindDeletedByID(id *UserID) (*User, error) {
+	return nil, nil
+}
+
+func (r *mockUserRepository) FindDeletedBefore(before time.Time) ([]*User, error) {
+	return nil, nil
+}
+
 func (r *mockUserRepository) Save(user *User) error {
 	r.users[user.Name().String()] = user
 	return nil
@@ -1,7 +1,6 @@
 package domain
 
 import (
-	"fmt"
 	"net/http"
 	"regexp"
 	"strings"
@@ -135,124 +134,6 @@ func (c *CircleName) String() string {
 	return c.value
 }
 
-// Money 値オブジェクト
-type Money struct {
-	amount   int64  // 最小単位で保存（例：JPYなら円、USDならcents）
-	currency string // 通貨コード（ISO 4217）
-}
-
-func NewMoney(amount int64, currency string) (*Money, error) {
-	if err := validateCurrency(currency); err != nil {
-		return nil, err
-	}
-
-	return &Money{
-		amount:   amount,
-		currency: strings.ToUpper(currency),
-	}, nil
-}
-
-func (m *Money) Amount() int64 {
-	return m.amount
-}
-
-func (m *Money) Currency() string {
-	return m.currency
-}
-
-func (m *Money) String() string {
-	switch m.currency {
-	case "JPY":
-		return fmt.Sprintf("¥%d", m.amount)
-	case "USD":
-		return fmt.Sprintf("$%.2f", float64(m.amount)/100)
-	case "EUR":
-		return fmt.Sprintf("€%.2f", float64(m.amount)/100)
-	default:
-		return fmt.Sprintf("%d %s", m.amount, m.currency)
-	}
-}
-
-func (m *Money) Equals(other *Money) bool {
-	if other == nil {
-		return false
-	}
-	return m.amount == other.amount && m.currency == other.currency
-}
-
-func (m *Money) Add(other *Money) (*Money, error) {
-	if err := m.validateSameCurrency(other); err != nil {
-		return nil, err
-	}
-
-	return &Money{
-		amount:   m.amount + other.amount,
-		currency: m.currency,
-	}, nil
-}
-
-func (m *Money) Subtract(other *Money) (*Money, error) {
-	if err := m.validateSameCurrency(other); err != nil {
-		return nil, err
-	}
-
-	return &Money{
-		amount:   m.amount - other.amount,
-		currency: m.currency,
-	}, nil
-}
-
-func (m *Money) Multiply(multiplier int64) *Money {
-	return &Money{
-		amount:   m.amount * multiplier,
-		currency: m.currency,
-	}
-}
-
-func (m *Money) IsPositive() bool {
-	return m.amount > 0
-}
-
-func (m *Money) IsNegative() bool {
-	return m.amount < 0
-}
-
-func (m *Money) IsZero() bool {
-	return m.amount == 0
-}
-
-func (m *Money) validateSameCurrency(other *Money) error {
-	if other == nil {
-		return EmptyFieldError{Field: "money"}
-	}
-	if m.currency != other.currency {
-		return CurrencyMismatchError{Currency1: m.currency, Currency2: other.currency}
-	}
-	return nil
-}
-
-func validateCurrency(currency string) error {
-	if currency == "" {
-		return EmptyFieldError{Field: "currency"}
-	}
-
-	currency = strings.ToUpper(currency)
-	validCurrencies := map[string]bool{
-		"JPY": true,
-		"USD": true,
-		"EUR": true,
-		"GBP": true,
-		"AUD": true,
-		"CAD": true,
-	}
-
-	if !validCurrencies[currency] {
-		return InvalidCurrencyError{Value: currency}
-	}
-
-	return nil
-}
-
 // Validation errors
 type EmptyFieldError struct {
 	Field string
@@ -0,0 +1,474 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookID struct {
+	value string
+}
+
+func NewWebhookID() *WebhookID {
+	return &WebhookID{value: uuid.New().String()}
+}
+
+func ReconstructWebhookID(value string) (*WebhookID, error) {
+	if value == "" {
+		return nil, EmptyFieldError{Field: "webhook ID"}
+	}
+	if _, err := uuid.Parse(value); err != nil {
+		return nil, InvalidWebhookError{Reason: "invalid webhook ID: " + value}
+	}
+	return &WebhookID{value: value}, nil
+}
+
+func (w *WebhookID) Value() string {
+	return w.value
+}
+
+func (w *WebhookID) Equals(other *WebhookID) bool {
+	if other == nil {
+		return false
+	}
+	return w.value == other.value
+}
+
+func (w *WebhookID) String() string {
+	return w.value
+}
+
+// webhookEventNames はWebhookで購読できるイベント
+var webhookEventNames = []string{
+	MemberJoined{}.EventName(),
+	MemberLeft{}.EventName(),
+}
+
+// ParseWebhookEvents は購読するイベント名を検証し、重複を取り除く
+func ParseWebhookEvents(names []string) ([]string, error) {
+	if len(names) == 0 {
+		return nil, InvalidWebhookError{Reason: "at least one event is required"}
+	}
+
+	seen := make(map[string]bool)
+	var events []string
+	for _, name := range names {
+		if !isWebhookEvent(name) {
+			return nil, InvalidWebhookError{Reason: "unsupported event: " + name}
+		}
+		if !seen[name] {
+			seen[name] = true
+			events = append(events, name)
+		}
+	}
+	return events, nil
+}
+
+func isWebhookEvent(name string) bool {
+	for _, supported := range webhookEventNames {
+		if name == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookSubscription - サークルのイベントを外部に通知する登録（集約ルート）
+// 登録できるのはサークルのオーナーのみ
+type WebhookSubscription struct {
+	id        *WebhookID
+	circleID  *CircleID
+	url       string
+	events    []string
+	secret    string // 署名用の共有シークレット
+	createdAt time.Time
+}
+
+func NewWebhookSubscription(circle *Circle, requestedBy *UserID, endpoint string, events []string, now time.Time) (*WebhookSubscription, error) {
+	if circle == nil {
+		return nil, EmptyFieldError{Field: "circle"}
+	}
+	if requestedBy == nil {
+		return nil, EmptyFieldError{Field: "requested by"}
+	}
+	if !circle.IsOwner(requestedBy) {
+		return nil, NotCircleOwnerError{UserID: requestedBy.Value()}
+	}
+	endpoint, err := validateWebhookURL(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := ParseWebhookEvents(events)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	return &WebhookSubscription{
+		id:        NewWebhookID(),
+		circleID:  circle.ID(),
+		url:       endpoint,
+		events:    parsed,
+		secret:    secret,
+		createdAt: now,
+	}, nil
+}
+
+func ReconstructWebhookSubscription(id *WebhookID, circleID *CircleID, endpoint string, events []string, secret string, createdAt time.Time) *WebhookSubscription {
+	return &WebhookSubscription{
+		id:        id,
+		circleID:  circleID,
+		url:       endpoint,
+		events:    events,
+		secret:    secret,
+		createdAt: createdAt,
+	}
+}
+
+func validateWebhookURL(endpoint string) (string, error) {
+	endpoint = strings.TrimSpace(endpoint)
+	if endpoint == "" {
+		return "", EmptyFieldError{Field: "webhook URL"}
+	}
+	parsed, err := url.Parse(endpoint)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return "", InvalidWebhookError{Reason: "URL must be an absolute http(s) URL: " + endpoint}
+	}
+	// サーバー自身や内部ネットワークへは送らない（名前で指定された宛先は送信時の接続先でも確かめる）
+	host := strings.ToLower(strings.TrimSuffix(parsed.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") || strings.HasSuffix(host, ".internal") || strings.HasSuffix(host, ".local") {
+		return "", InvalidWebhookError{Reason: "URL must not point to an internal host: " + endpoint}
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !IsPublicWebhookAddress(addr) {
+		return "", InvalidWebhookError{Reason: "URL must not point to a loopback, private or link-local address: " + endpoint}
+	}
+	return endpoint, nil
+}
+
+// sharedAddressSpace - キャリアグレード NAT の共有アドレス（RFC 6598）
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// IsPublicWebhookAddress は Webhook の送信先として接続してよいアドレスかを返す
+// ループバック・プライベート・リンクローカル（クラウドのメタデータを含む）などへは送らない
+func IsPublicWebhookAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() &&
+		addr.IsGlobalUnicast() &&
+		!addr.IsPrivate() &&
+		!sharedAddressSpace.Contains(addr)
+}
+
+func newWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func (w *WebhookSubscription) ID() *WebhookID {
+	return w.id
+}
+
+func (w *WebhookSubscription) CircleID() *CircleID {
+	return w.circleID
+}
+
+func (w *WebhookSubscription) URL() string {
+	return w.url
+}
+
+func (w *WebhookSubscription) Events() []string {
+	// 防御的コピーを返す
+	events := make([]string, len(w.events))
+	copy(events, w.events)
+	return events
+}
+
+func (w *WebhookSubscription) Secret() string {
+	return w.secret
+}
+
+func (w *WebhookSubscription) CreatedAt() time.Time {
+	return w.createdAt
+}
+
+// Subscribes はイベントを購読しているかを返す
+func (w *WebhookSubscription) Subscribes(eventName string) bool {
+	for _, event := range w.events {
+		if event == eventName {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookRetryPolicy は配信失敗時の再試行間隔（指数バックオフ）と上限回数
+type WebhookRetryPolicy struct {
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+}
+
+func NewWebhookRetryPolicy(maxAttempts int, baseDelay, maxDelay time.Duration) (*WebhookRetryPolicy, error) {
+	if maxAttempts <= 0 {
+		return nil, InvalidWebhookError{Reason: "max attempts must be positive"}
+	}
+	if baseDelay <= 0 || maxDelay < baseDelay {
+		return nil, InvalidWebhookError{Reason: "retry delays must be positive and max delay must not be below base delay"}
+	}
+	return &WebhookRetryPolicy{maxAttempts: maxAttempts, baseDelay: baseDelay, maxDelay: maxDelay}, nil
+}
+
+// DefaultWebhookRetryPolicy は 10秒, 20秒, 40秒 ... 最大1時間の間隔で8回まで試行する
+func DefaultWebhookRetryPolicy() *WebhookRetryPolicy {
+	return &WebhookRetryPolicy{maxAttempts: 8, baseDelay: 10 * time.Second, maxDelay: time.Hour}
+}
+
+func (p *WebhookRetryPolicy) MaxAttempts() int {
+	return p.maxAttempts
+}
+
+// DelayAfter は attempts 回失敗した後の待ち時間を返す
+func (p *WebhookRetryPolicy) DelayAfter(attempts int) time.Duration {
+	delay := p.baseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= p.maxDelay {
+			return p.maxDelay
+		}
+	}
+	return delay
+}
+
+// WebhookDeliveryStatus - 配信状況
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"   // 配信待ち（再試行中を含む）
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded" // 2xxの応答を受けた
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"    // 上限回数まで失敗した
+)
+
+func ParseWebhookDeliveryStatus(value string) (WebhookDeliveryStatus, error) {
+	status := WebhookDeliveryStatus(value)
+	switch status {
+	case WebhookDeliveryPending, WebhookDeliverySucceeded, WebhookDeliveryFailed:
+		return status, nil
+	}
+	return "", InvalidWebhookError{Reason: "unknown delivery status: " + value}
+}
+
+func (s WebhookDeliveryStatus) String() string {
+	return string(s)
+}
+
+// WebhookDelivery - 1つのイベントを1つのWebhookへ配信する記録（配信ログ）
+// 再試行しても署名が変わらないよう、本文は作成時に確定する
+type WebhookDelivery struct {
+	id             string
+	webhookID      *WebhookID
+	eventName      string
+	eventKey       string // 同じイベントを重複して配信しないためのキー
+	payload        []byte
+	status         WebhookDeliveryStatus
+	attempts       int
+	responseStatus int // 最後の応答のHTTPステータス（応答がない場合は0）
+	lastError      string
+	nextAttemptAt  time.Time
+	createdAt      time.Time
+	completedAt    time.Time
+}
+
+func NewWebhookDelivery(webhook *WebhookSubscription, eventName, eventKey string, payload []byte, now time.Time) (*WebhookDelivery, error) {
+	if webhook == nil {
+		return nil, EmptyFieldError{Field: "webhook"}
+	}
+	if !webhook.Subscribes(eventName) {
+		return nil, InvalidWebhookError{Reason: "webhook does not subscribe to " + eventName}
+	}
+	if eventKey == "" {
+		return nil, EmptyFieldError{Field: "event key"}
+	}
+	if len(payload) == 0 {
+		return nil, EmptyFieldError{Field: "payload"}
+	}
+
+	return &WebhookDelivery{
+		id:            uuid.New().String(),
+		webhookID:     webhook.ID(),
+		eventName:     eventName,
+		eventKey:      eventKey,
+		payload:       payload,
+		status:        WebhookDeliveryPending,
+		nextAttemptAt: now,
+		createdAt:     now,
+	}, nil
+}
+
+func ReconstructWebhookDelivery(
+	id string,
+	webhookID *WebhookID,
+	eventName string,
+	eventKey string,
+	payload []byte,
+	status WebhookDeliveryStatus,
+	attempts int,
+	responseStatus int,
+	lastError string,
+	nextAttemptAt time.Time,
+	createdAt time.Time,
+	completedAt time.Time,
+) *WebhookDelivery {
+	return &WebhookDelivery{
+		id:             id,
+		webhookID:      webhookID,
+		eventName:      eventName,
+		eventKey:       eventKey,
+		payload:        payload,
+		status:         status,
+		attempts:       attempts,
+		responseStatus: responseStatus,
+		lastError:      lastError,
+		nextAttemptAt:  nextAttemptAt,
+		createdAt:      createdAt,
+		completedAt:    completedAt,
+	}
+}
+
+func (d *WebhookDelivery) ID() string {
+	return d.id
+}
+
+func (d *WebhookDelivery) WebhookID() *WebhookID {
+	return d.webhookID
+}
+
+func (d *WebhookDelivery) EventName() string {
+	return d.eventName
+}
+
+func (d *WebhookDelivery) EventKey() string {
+	return d.eventKey
+}
+
+func (d *WebhookDelivery) Payload() []byte {
+	return d.payload
+}
+
+func (d *WebhookDelivery) Status() WebhookDeliveryStatus {
+	return d.status
+}
+
+func (d *WebhookDelivery) Attempts() int {
+	return d.attempts
+}
+
+func (d *WebhookDelivery) ResponseStatus() int {
+	return d.responseStatus
+}
+
+func (d *WebhookDelivery) LastError() string {
+	return d.lastError
+}
+
+// NextAttemptAt は配信待ちの場合の次回の配信時刻
+func (d *WebhookDelivery) NextAttemptAt() time.Time {
+	return d.nextAttemptAt
+}
+
+func (d *WebhookDelivery) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+func (d *WebhookDelivery) CompletedAt() time.Time {
+	return d.completedAt
+}
+
+// IsDueAt は指定時刻に配信を試みるべきかを返す
+func (d *WebhookDelivery) IsDueAt(now time.Time) bool {
+	return d.status == WebhookDeliveryPending && !d.nextAttemptAt.After(now)
+}
+
+// RecordSuccess は2xxの応答を受けたことを記録する
+func (d *WebhookDelivery) RecordSuccess(responseStatus int, now time.Time) error {
+	if d.status != WebhookDeliveryPending {
+		return InvalidWebhookError{Reason: "delivery is already " + d.status.String()}
+	}
+	d.attempts++
+	d.responseStatus = responseStatus
+	d.lastError = ""
+	d.status = WebhookDeliverySucceeded
+	d.completedAt = now
+	return nil
+}
+
+// RecordFailure は失敗を記録し、上限回数に達するまで次回の配信時刻を遅らせる
+func (d *WebhookDelivery) RecordFailure(responseStatus int, reason string, policy *WebhookRetryPolicy, now time.Time) error {
+	if d.status != WebhookDeliveryPending {
+		return InvalidWebhookError{Reason: "delivery is already " + d.status.String()}
+	}
+	d.attempts++
+	d.responseStatus = responseStatus
+	d.lastError = reason
+	if d.attempts >= policy.MaxAttempts() {
+		d.status = WebhookDeliveryFailed
+		d.completedAt = now
+		return nil
+	}
+	d.nextAttemptAt = now.Add(policy.DelayAfter(d.attempts))
+	return nil
+}
+
+// WebhookSender はWebhookの送信先（ポート）
+// 署名付きで本文を送信し、応答のHTTPステータスを返す（2xx以外はエラー）
+type WebhookSender interface {
+	Send(webhook *WebhookSubscription, delivery *WebhookDelivery) (int, error)
+}
+
+// Webhook related errors
+type InvalidWebhookError struct {
+	Reason string
+}
+
+func (e InvalidWebhookError) Error() string {
+	return "invalid webhook: " + e.Reason
+}
+
+func (e InvalidWebhookError) HTTPStatus() int {
+	return http.StatusBadRequest
+}
+
+type WebhookNotFoundError struct {
+	ID string
+}
+
+func (e WebhookNotFoundError) Error() string {
+	return "webhook not found: " + e.ID
+}
+
+func (e WebhookNotFoundError) HTTPStatus() int {
+	return http.StatusNotFound
+}
+
+type NotCircleOwnerError struct {
+	UserID string
+}
+
+func (e NotCircleOwnerError) Error() string {
+	return "user is not the circle owner: " + e.UserID
+}
+
+func (e NotCircleOwnerError) HTTPStatus() int {
+	return http.StatusForbidden
+}
@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+var testWebhookNow = time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)
+
+func newTestWebhook(t *testing.T) *WebhookSubscription {
+	t.Helper()
+
+	owner := newTestOwner(t, false)
+	circle := newTestCircle(t, owner, nil)
+	webhook, err := NewWebhookSubscription(circle, owner.ID(), "https://example.com/hooks", []string{"circle.member_joined"}, testWebhookNow)
+	if err != nil {
+		t.Fatalf("Failed to create webhook: %v", err)
+	}
+	return webhook
+}
+
+func TestNewWebhookSubscription_Validation(t *testing.T) {
+	owner := newTestOwner(t, false)
+	member := newTestMembers(t, 1, 0)[0]
+	circle := newTestCircle(t, owner, []*User{member})
+	joined, left := "circle.member_joined", "circle.member_left"
+	isNotOwner := func(err error) bool { var e NotCircleOwnerError; return errors.As(err, &e) }
+	isInvalid := func(err error) bool { var e InvalidWebhookError; return errors.As(err, &e) }
+	isEmpty := func(err error) bool { var e EmptyFieldError; return errors.As(err, &e) }
+
+	tests := []struct {
+		name           string
+		requestedBy    *UserID
+		url            string
+		events         []string
+		expectedEvents []string
+		isExpectedErr  func(error) bool // nil は成功
+	}{
+		{"オーナーが登録できる", owner.ID(), "https://example.com/hooks", []string{joined, left}, []string{joined, left}, nil},
+		{"重複したイベントはまとめる", owner.ID(), "https://example.com:9000/hooks", []string{left, left}, []string{left}, nil},
+		{"ループバックは登録できない", owner.ID(), "http://127.0.0.1:9000/hooks", []string{joined}, nil, isInvalid},
+		{"IPv6のループバックは登録できない", owner.ID(), "http://[::1]/hooks", []string{joined}, nil, isInvalid},
+		{"メタデータのアドレスは登録できない", owner.ID(), "http://169.254.169.254/latest/meta-data", []string{joined}, nil, isInvalid},
+		{"プライベートアドレスは登録できない", owner.ID(), "https://10.0.0.5/hooks", []string{joined}, nil, isInvalid},
+		{"localhostは登録できない", owner.ID(), "http://localhost:9000/hooks", []string{joined}, nil, isInvalid},
+		{"内部のホスト名は登録できない", owner.ID(), "http://billing.internal/hooks", []string{joined}, nil, isInvalid},
+		{"メンバーは登録できない", member.ID(), "https://example.com/hooks", []string{joined}, nil, isNotOwner},
+		{"相対URLは登録できない", owner.ID(), "/hooks", []string{joined}, nil, isInvalid},
+		{"http(s)以外は登録できない", owner.ID(), "ftp://example.com/hooks", []string{joined}, nil, isInvalid},
+		{"URLは必須", owner.ID(), " ", []string{joined}, nil, isEmpty},
+		{"イベントは必須", owner.ID(), "https://example.com/hooks", nil, nil, isInvalid},
+		{"未対応のイベントは登録できない", owner.ID(), "https://example.com/hooks", []string{"user.registered"}, nil, isInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			webhook, err := NewWebhookSubscription(circle, tt.requestedBy, tt.url, tt.events, testWebhookNow)
+
+			// Assert
+			if tt.isExpectedErr != nil {
+				if !tt.isExpectedErr(err) {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			events := webhook.Events()
+			if len(events) != len(tt.expectedEvents) || events[0] != tt.expectedEvents[0] {
+				t.Errorf("Expected events %v, but got %v", tt.expectedEvents, events)
+			}
+			if len(webhook.Secret()) != 64 {
+				t.Errorf("Expected a 32-byte hex secret, but got %q", webhook.Secret())
+			}
+		})
+	}
+}
+
+func TestWebhookRetryPolicy_DelayAfter(t *testing.T) {
+	policy := DefaultWebhookRetryPolicy()
+
+	tests := []struct {
+		name     string
+		attempts int
+		expected time.Duration
+	}{
+		{"1回目の失敗後は10秒", 1, 10 * time.Second},
+		{"2回目の失敗後は20秒", 2, 20 * time.Second},
+		{"4回目の失敗後は80秒", 4, 80 * time.Second},
+		{"上限は1時間", 20, time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			delay := policy.DelayAfter(tt.attempts)
+
+			// Assert
+			if delay != tt.expected {
+				t.Errorf("Expected %v, but got %v", tt.expected, delay)
+			}
+		})
+	}
+}
+
+func TestWebhookDelivery_RecordFailure_GivesUpAfterMaxAttempts(t *testing.T) {
+	// Arrange
+	webhook := newTestWebhook(t)
+	delivery, err := NewWebhookDelivery(webhook, "circle.member_joined", "event-key", []byte(`{}`), testWebhookNow)
+	if err != nil {
+		t.Fatalf("Failed to create delivery: %v", err)
+	}
+	policy, _ := NewWebhookRetryPolicy(3, time.Second, time.Minute)
+	now := testWebhookNow
+
+	// Act & Assert
+	for attempt := 1; attempt <= 3; attempt++ {
+		if !delivery.IsDueAt(now) {
+			t.Fatalf("Expected delivery to be due before attempt %d", attempt)
+		}
+		if err := delivery.RecordFailure(500, "server error", policy, now); err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		now = delivery.NextAttemptAt()
+	}
+	if delivery.Status() != WebhookDeliveryFailed || delivery.Attempts() != 3 {
+		t.Errorf("Expected failed after 3 attempts, but got %s after %d", delivery.Status(), delivery.Attempts())
+	}
+	if delivery.IsDueAt(now.Add(time.Hour)) {
+		t.Error("Expected a failed delivery never to be due again")
+	}
+	var invalid InvalidWebhookError
+	if err := delivery.RecordSuccess(200, now); !errors.As(err, &invalid) {
+		t.Errorf("Expected InvalidWebhookError for a completed delivery, but got %v", err)
+	}
+}
+
+func TestNewWebhookDelivery_UnsubscribedEvent_ReturnsError(t *testing.T) {
+	// Arrange
+	webhook := newTestWebhook(t)
+
+	// Act
+	_, err := NewWebhookDelivery(webhook, "circle.member_left", "event-key", []byte(`{}`), testWebhookNow)
+
+	// Assert
+	var invalid InvalidWebhookError
+	if !errors.As(err, &invalid) {
+		t.Errorf("Expected InvalidWebhookError, but got %v", err)
+	}
+}
@@ -3,11 +3,13 @@ module ddd-bottomup
 go 1.24
 
 require (
+	github.com/go-chi/chi/v5 v5.2.3
 	github.com/go-sql-driver/mysql v1.9.3
 	github.com/google/uuid v1.6.0
+	golang.org/x/crypto v0.41.0
 )
 
 require (
 	filippo.io/edwards25519 v1.1.0 // indirect
-	github.com/go-chi/chi/v5 v5.2.3 // indirect
+	golang.org/x/sys v0.35.0 // indirect
 )
@@ -0,0 +1,61 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"strings"
+	"testing"
+)
+
+func TestArgon2idPasswordHasher_HashAndVerify(t *testing.T) {
+	// Arrange
+	hasher := NewArgon2idPasswordHasher(Argon2idParams{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32})
+	password, _ := domain.NewPassword("correct horse battery")
+
+	// Act
+	hash, err := hasher.Hash(password)
+	if err != nil {
+		t.Fatalf("Failed to hash password: %v", err)
+	}
+	again, _ := hasher.Hash(password)
+
+	// Assert
+	if !strings.HasPrefix(hash, "$argon2id$v=19$m=64,t=1,p=1$") {
+		t.Errorf("Expected a PHC formatted argon2id hash, but got %s", hash)
+	}
+	if hash == again {
+		t.Error("Expected a random salt for each hash")
+	}
+	tests := []struct {
+		name     string
+		password string
+		expected bool
+	}{
+		{"正しいパスワード", "correct horse battery", true},
+		{"誤ったパスワード", "correct horse battery!", false},
+	}
+	for _, tt := range tests {
+		ok, err := hasher.Verify(hash, tt.password)
+		if err != nil || ok != tt.expected {
+			t.Errorf("%s: expected %v, but got %v (%v)", tt.name, tt.expected, ok, err)
+		}
+	}
+}
+
+func TestArgon2idPasswordHasher_Verify_UsesParamsFromHash(t *testing.T) {
+	// Arrange
+	weak := NewArgon2idPasswordHasher(Argon2idParams{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32})
+	strong := NewArgon2idPasswordHasher(Argon2idParams{Memory: 128, Iterations: 2, Parallelism: 1, SaltLength: 16, KeyLength: 32})
+	password, _ := domain.NewPassword("correct horse battery")
+	hash, _ := weak.Hash(password)
+
+	// Act
+	ok, err := strong.Verify(hash, "correct horse battery")
+
+	// Assert
+	if err != nil || !ok {
+		t.Errorf("Expected a hash made with older parameters to verify, but got %v (%v)", ok, err)
+	}
+	if _, err := strong.Verify("$2a$10$not-argon2", "correct horse battery"); err == nil {
+		t.Error("Expected an error for a hash in another format")
+	}
+}
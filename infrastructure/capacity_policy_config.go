@@ -0,0 +1,109 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// CapacityPolicyConfig は定員ポリシー設定ファイル（JSON）の構造
+//
+//	{
+//	  "default": {"type": "premium_threshold", "basicLimit": 30, "premiumLimit": 50, "threshold": 10},
+//	  "circles": {
+//	    "<circle-id>": {"type": "purchased", "seats": 20, "base": {"type": "flat", "limit": 30}}
+//	  }
+//	}
+type CapacityPolicyConfig struct {
+	Default *CapacityPolicySpec            `json:"default"`
+	Circles map[string]*CapacityPolicySpec `json:"circles"`
+}
+
+// CapacityPolicySpec は1つの定員ポリシーの設定
+// type は premium_threshold / flat / purchased / premium_owner_bonus のいずれか
+type CapacityPolicySpec struct {
+	Type         string              `json:"type"`
+	BasicLimit   int                 `json:"basicLimit,omitempty"`
+	PremiumLimit int                 `json:"premiumLimit,omitempty"`
+	Threshold    int                 `json:"threshold,omitempty"`
+	Limit        int                 `json:"limit,omitempty"`
+	Seats        int                 `json:"seats,omitempty"`
+	Bonus        int                 `json:"bonus,omitempty"`
+	Base         *CapacityPolicySpec `json:"base,omitempty"`
+}
+
+// LoadCapacityPolicyRegistry は設定ファイルから定員ポリシーを読み込む
+func LoadCapacityPolicyRegistry(path string) (*domain.CapacityPolicyRegistry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCapacityPolicyRegistry(data)
+}
+
+func ParseCapacityPolicyRegistry(data []byte) (*domain.CapacityPolicyRegistry, error) {
+	var config CapacityPolicyConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+
+	defaultPolicy := domain.DefaultCapacityPolicy()
+	if config.Default != nil {
+		policy, err := buildCapacityPolicy(config.Default)
+		if err != nil {
+			return nil, err
+		}
+		defaultPolicy = policy
+	}
+
+	registry := domain.NewCapacityPolicyRegistry(defaultPolicy)
+	for id, spec := range config.Circles {
+		circleID, err := domain.ReconstructCircleID(id)
+		if err != nil {
+			return nil, err
+		}
+		policy, err := buildCapacityPolicy(spec)
+		if err != nil {
+			return nil, fmt.Errorf("circle %s: %w", id, err)
+		}
+		registry.Assign(circleID, policy)
+	}
+
+	return registry, nil
+}
+
+func buildCapacityPolicy(spec *CapacityPolicySpec) (domain.CapacityPolicy, error) {
+	if spec == nil {
+		return nil, domain.InvalidCapacityPolicyError{Reason: "policy is not specified"}
+	}
+
+	switch spec.Type {
+	case "premium_threshold":
+		return domain.NewPremiumThresholdCapacityPolicy(spec.BasicLimit, spec.PremiumLimit, spec.Threshold)
+	case "flat":
+		return domain.NewFlatCapacityPolicy(spec.Limit)
+	case "purchased":
+		base, err := buildBaseCapacityPolicy(spec.Base)
+		if err != nil {
+			return nil, err
+		}
+		return domain.NewPurchasedCapacityPolicy(base, spec.Seats)
+	case "premium_owner_bonus":
+		base, err := buildBaseCapacityPolicy(spec.Base)
+		if err != nil {
+			return nil, err
+		}
+		return domain.NewPremiumOwnerBonusCapacityPolicy(base, spec.Bonus)
+	default:
+		return nil, domain.InvalidCapacityPolicyError{Reason: "unknown policy type: " + spec.Type}
+	}
+}
+
+// buildBaseCapacityPolicy は base 未指定の場合に既定ポリシーを使う
+func buildBaseCapacityPolicy(spec *CapacityPolicySpec) (domain.CapacityPolicy, error) {
+	if spec == nil {
+		return domain.DefaultCapacityPolicy(), nil
+	}
+	return buildCapacityPolicy(spec)
+}
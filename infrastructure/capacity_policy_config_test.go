@@ -0,0 +1,54 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"testing"
+	"time"
+)
+
+func TestParseCapacityPolicyRegistry_Success(t *testing.T) {
+	circleID := domain.NewCircleID()
+	data := []byte(`{
+		"default": {"type": "flat", "limit": 40},
+		"circles": {
+			"` + circleID.Value() + `": {"type": "purchased", "seats": 20, "base": {"type": "flat", "limit": 30}}
+		}
+	}`)
+
+	registry, err := ParseCapacityPolicyRegistry(data)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	members := domain.NewCircleMembers(nil, nil, time.Now())
+	if limit := registry.PolicyFor(circleID).MaxParticipants(members); limit != 50 {
+		t.Errorf("Expected circle policy limit 50, but got %d", limit)
+	}
+	if limit := registry.PolicyFor(domain.NewCircleID()).MaxParticipants(members); limit != 40 {
+		t.Errorf("Expected default policy limit 40, but got %d", limit)
+	}
+}
+
+func TestParseCapacityPolicyRegistry_InvalidConfig_ReturnsError(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"不正なJSON", `{`},
+		{"未知のポリシー種別", `{"default": {"type": "unknown"}}`},
+		{"不正な定員", `{"default": {"type": "flat", "limit": 0}}`},
+		{"不正なサークルID", `{"circles": {"invalid": {"type": "flat", "limit": 10}}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry, err := ParseCapacityPolicyRegistry([]byte(tt.data))
+			if err == nil {
+				t.Error("Expected error, but got nil")
+			}
+			if registry != nil {
+				t.Error("Expected nil registry")
+			}
+		})
+	}
+}
@@ -0,0 +1,305 @@
+package infrastructure
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"ddd-bottomup/domain"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	fakeOIDCKeyID            = "fake-oidc-key"
+	fakeAuthorizationCodeTTL = time.Minute
+	fakeIDTokenTTL           = 5 * time.Minute
+)
+
+// FakeOIDCProvider はテスト・ローカル実行用の最小限の OpenID プロバイダー
+// ログイン画面は持たず、認可エンドポイントは login_hint のメールアドレスの利用者を即座にログインさせる
+// （利用者が1人だけなら login_hint は省略できる）。ID トークンは RS256 で署名する
+// HTTPClient を使うと、ネットワークを介さずに同じプロセス内でやり取りできる
+type FakeOIDCProvider struct {
+	issuer       string
+	clientID     string
+	clientSecret string
+	key          *rsa.PrivateKey
+	clock        domain.Clock
+
+	mu    sync.Mutex
+	users []FakeOIDCUser
+	codes map[string]*fakeAuthorizationCode
+}
+
+// FakeOIDCUser はプロバイダー側の利用者
+type FakeOIDCUser struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	GivenName     string
+	FamilyName    string
+}
+
+type fakeAuthorizationCode struct {
+	user          FakeOIDCUser
+	redirectURI   string
+	nonce         string
+	codeChallenge string
+	expiresAt     time.Time
+}
+
+func NewFakeOIDCProvider(issuer, clientID, clientSecret string, clock domain.Clock) (*FakeOIDCProvider, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	return &FakeOIDCProvider{
+		issuer:       strings.TrimSuffix(issuer, "/"),
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		key:          key,
+		clock:        clock,
+		codes:        make(map[string]*fakeAuthorizationCode),
+	}, nil
+}
+
+func (p *FakeOIDCProvider) Issuer() string {
+	return p.issuer
+}
+
+func (p *FakeOIDCProvider) AddUser(user FakeOIDCUser) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.users = append(p.users, user)
+}
+
+// HTTPClient はリクエストをネットワークに出さず、このプロバイダーで直接処理するクライアントを返す
+// リダイレクトはたどらない（認可エンドポイントの応答からコードを取り出せるようにする）
+func (p *FakeOIDCProvider) HTTPClient() *http.Client {
+	return &http.Client{
+		Transport: fakeOIDCTransport{provider: p},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+type fakeOIDCTransport struct {
+	provider *FakeOIDCProvider
+}
+
+func (t fakeOIDCTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	recorder := httptest.NewRecorder()
+	t.provider.ServeHTTP(recorder, request.Clone(request.Context()))
+	response := recorder.Result()
+	response.Request = request
+	return response, nil
+}
+
+// ServeHTTP はパスの末尾でエンドポイントを振り分ける（任意のパスにマウントできる）
+func (p *FakeOIDCProvider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch path := r.URL.Path; {
+	case strings.HasSuffix(path, "/.well-known/openid-configuration"):
+		p.discovery(w)
+	case strings.HasSuffix(path, "/authorize"):
+		p.authorize(w, r)
+	case strings.HasSuffix(path, "/token") && r.Method == http.MethodPost:
+		p.token(w, r)
+	case strings.HasSuffix(path, "/jwks"):
+		p.jwks(w)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (p *FakeOIDCProvider) discovery(w http.ResponseWriter) {
+	writeFakeOIDCJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                p.issuer,
+		"authorization_endpoint":                p.issuer + "/authorize",
+		"token_endpoint":                        p.issuer + "/token",
+		"jwks_uri":                              p.issuer + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (p *FakeOIDCProvider) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	redirectURI, err := url.Parse(query.Get("redirect_uri"))
+	if query.Get("client_id") != p.clientID || err != nil || !redirectURI.IsAbs() {
+		// クライアントやリダイレクト先が不正な場合はリダイレクトせずにエラーを返す
+		http.Error(w, "invalid client_id or redirect_uri", http.StatusBadRequest)
+		return
+	}
+	redirect := func(params url.Values) {
+		params.Set("state", query.Get("state"))
+		target := *redirectURI
+		values := target.Query()
+		for key := range params {
+			values.Set(key, params.Get(key))
+		}
+		target.RawQuery = values.Encode()
+		http.Redirect(w, r, target.String(), http.StatusFound)
+	}
+
+	if query.Get("response_type") != "code" {
+		redirect(url.Values{"error": {"unsupported_response_type"}})
+		return
+	}
+	if query.Get("code_challenge_method") != "S256" || query.Get("code_challenge") == "" {
+		redirect(url.Values{"error": {"invalid_request"}, "error_description": {"PKCE with S256 is required"}})
+		return
+	}
+	user, ok := p.findUser(query.Get("login_hint"))
+	if !ok {
+		redirect(url.Values{"error": {"login_required"}})
+		return
+	}
+
+	code := fakeRandomString()
+	p.mu.Lock()
+	p.codes[code] = &fakeAuthorizationCode{
+		user:          user,
+		redirectURI:   redirectURI.String(),
+		nonce:         query.Get("nonce"),
+		codeChallenge: query.Get("code_challenge"),
+		expiresAt:     p.clock.Now().Add(fakeAuthorizationCodeTTL),
+	}
+	p.mu.Unlock()
+	redirect(url.Values{"code": {code}})
+}
+
+func (p *FakeOIDCProvider) findUser(loginHint string) (FakeOIDCUser, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if loginHint == "" && len(p.users) == 1 {
+		return p.users[0], true
+	}
+	for _, user := range p.users {
+		if loginHint != "" && strings.EqualFold(user.Email, loginHint) {
+			return user, true
+		}
+	}
+	return FakeOIDCUser{}, false
+}
+
+func (p *FakeOIDCProvider) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeFakeOIDCError(w, http.StatusBadRequest, "invalid_request")
+		return
+	}
+	clientID, clientSecret, ok := r.BasicAuth()
+	if ok {
+		clientID, _ = url.QueryUnescape(clientID)
+		clientSecret, _ = url.QueryUnescape(clientSecret)
+	} else {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != p.clientID || (p.clientSecret != "" && clientSecret != p.clientSecret) {
+		writeFakeOIDCError(w, http.StatusUnauthorized, "invalid_client")
+		return
+	}
+	if r.PostForm.Get("grant_type") != "authorization_code" {
+		writeFakeOIDCError(w, http.StatusBadRequest, "unsupported_grant_type")
+		return
+	}
+
+	// 認可コードは一度しか使えない
+	p.mu.Lock()
+	code, exists := p.codes[r.PostForm.Get("code")]
+	delete(p.codes, r.PostForm.Get("code"))
+	p.mu.Unlock()
+
+	now := p.clock.Now()
+	if !exists || !now.Before(code.expiresAt) ||
+		code.redirectURI != r.PostForm.Get("redirect_uri") ||
+		domain.PKCEChallenge(r.PostForm.Get("code_verifier")) != code.codeChallenge {
+		writeFakeOIDCError(w, http.StatusBadRequest, "invalid_grant")
+		return
+	}
+
+	idToken, err := p.signIDToken(map[string]any{
+		"iss":            p.issuer,
+		"sub":            code.user.Subject,
+		"aud":            p.clientID,
+		"iat":            now.Unix(),
+		"exp":            now.Add(fakeIDTokenTTL).Unix(),
+		"nonce":          code.nonce,
+		"email":          code.user.Email,
+		"email_verified": code.user.EmailVerified,
+		"given_name":     code.user.GivenName,
+		"family_name":    code.user.FamilyName,
+	})
+	if err != nil {
+		writeFakeOIDCError(w, http.StatusInternalServerError, "server_error")
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeFakeOIDCJSON(w, http.StatusOK, map[string]any{
+		"access_token": fakeRandomString(),
+		"token_type":   "Bearer",
+		"expires_in":   int(fakeIDTokenTTL.Seconds()),
+		"id_token":     idToken,
+	})
+}
+
+func (p *FakeOIDCProvider) jwks(w http.ResponseWriter) {
+	publicKey := p.key.PublicKey
+	writeFakeOIDCJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": fakeOIDCKeyID,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+		}},
+	})
+}
+
+func (p *FakeOIDCProvider) signIDToken(claims map[string]any) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": fakeOIDCKeyID})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	input := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(input))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, p.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func fakeRandomString() string {
+	raw := make([]byte, 16)
+	rand.Read(raw)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func writeFakeOIDCJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeFakeOIDCError(w http.ResponseWriter, status int, code string) {
+	writeFakeOIDCJSON(w, status, map[string]string{"error": code})
+}
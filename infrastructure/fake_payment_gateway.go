@@ -0,0 +1,291 @@
+package infrastructure
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"ddd-bottomup/domain"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// FakePaymentGateway はテスト・ローカル実行用の決定的な決済ゲートウェイ
+// 拒否・保留（遅延決済）・Webhookの重複送信をシミュレートできる
+type FakePaymentGateway struct {
+	secret []byte
+	clock  domain.Clock
+	sleep  func(time.Duration)
+
+	mu                sync.Mutex
+	seq               int
+	latency           time.Duration
+	declineReasons    []string // 先頭から順に次回以降のChargeを拒否する
+	delayCount        int      // 次回以降N件のChargeを保留にする
+	duplicateWebhooks bool
+	charges           map[string]*fakeCharge
+	chargeResults     map[string]*domain.ChargeResult // key: 冪等キー
+	refundResults     map[string]*domain.RefundResult // key: 冪等キー
+	webhooks          []FakeWebhook
+}
+
+type fakeCharge struct {
+	id       string
+	userID   *domain.UserID
+	amount   *domain.Money
+	refunded int64
+	status   domain.PaymentStatus
+}
+
+// FakeWebhook は送信待ちの署名付きWebhook
+type FakeWebhook struct {
+	Payload   []byte
+	Signature string
+}
+
+// fakeWebhookPayload はWebhookのJSON表現
+type fakeWebhookPayload struct {
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	ChargeID   string    `json:"chargeId"`
+	RefundID   string    `json:"refundId,omitempty"`
+	UserID     string    `json:"userId"`
+	Amount     int64     `json:"amount"`
+	Currency   string    `json:"currency"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+func NewFakePaymentGateway(secret string, clock domain.Clock) *FakePaymentGateway {
+	return &FakePaymentGateway{
+		secret:        []byte(secret),
+		clock:         clock,
+		sleep:         time.Sleep,
+		charges:       make(map[string]*fakeCharge),
+		chargeResults: make(map[string]*domain.ChargeResult),
+		refundResults: make(map[string]*domain.RefundResult),
+	}
+}
+
+// DeclineNext は次のChargeを指定の理由で拒否させる
+func (g *FakePaymentGateway) DeclineNext(reason string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.declineReasons = append(g.declineReasons, reason)
+}
+
+// DelayNext は次のChargeを保留にし、SettlePending まで結果を確定させない
+func (g *FakePaymentGateway) DelayNext() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.delayCount++
+}
+
+// SetDuplicateWebhooks は各Webhookを2回ずつ送信させる
+func (g *FakePaymentGateway) SetDuplicateWebhooks(duplicate bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.duplicateWebhooks = duplicate
+}
+
+// SetLatency は各API呼び出しの応答遅延を設定する
+func (g *FakePaymentGateway) SetLatency(latency time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.latency = latency
+}
+
+func (g *FakePaymentGateway) Charge(request domain.ChargeRequest) (*domain.ChargeResult, error) {
+	g.wait()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if request.Amount == nil || !request.Amount.IsPositive() {
+		return nil, domain.InvalidLedgerEntryError{Reason: "charge amount must be positive"}
+	}
+	if result, exists := g.chargeResults[request.IdempotencyKey]; exists && request.IdempotencyKey != "" {
+		copied := *result
+		return &copied, nil
+	}
+
+	charge := &fakeCharge{
+		id:     g.nextID("ch"),
+		userID: request.UserID,
+		amount: request.Amount,
+	}
+	result := &domain.ChargeResult{ChargeID: charge.id}
+
+	switch {
+	case len(g.declineReasons) > 0:
+		result.Status = domain.PaymentDeclined
+		result.DeclineReason = g.declineReasons[0]
+		g.declineReasons = g.declineReasons[1:]
+		g.enqueueWebhook(domain.WebhookChargeFailed, charge, "", charge.amount)
+	case g.delayCount > 0:
+		g.delayCount--
+		result.Status = domain.PaymentPending
+	default:
+		result.Status = domain.PaymentSucceeded
+		g.enqueueWebhook(domain.WebhookChargeSucceeded, charge, "", charge.amount)
+	}
+	charge.status = result.Status
+
+	g.charges[charge.id] = charge
+	if request.IdempotencyKey != "" {
+		g.chargeResults[request.IdempotencyKey] = result
+	}
+	copied := *result
+	return &copied, nil
+}
+
+// SettlePending は保留中のChargeを成功させ、成功通知のWebhookを送信待ちにする
+func (g *FakePaymentGateway) SettlePending() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, charge := range g.charges {
+		if charge.status == domain.PaymentPending {
+			charge.status = domain.PaymentSucceeded
+			g.enqueueWebhook(domain.WebhookChargeSucceeded, charge, "", charge.amount)
+		}
+	}
+}
+
+func (g *FakePaymentGateway) Refund(request domain.RefundRequest) (*domain.RefundResult, error) {
+	g.wait()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if result, exists := g.refundResults[request.IdempotencyKey]; exists && request.IdempotencyKey != "" {
+		copied := *result
+		return &copied, nil
+	}
+
+	charge, exists := g.charges[request.ChargeID]
+	if !exists || charge.status != domain.PaymentSucceeded {
+		return nil, domain.PaymentDeclinedError{Reason: "charge is not refundable: " + request.ChargeID}
+	}
+	if request.Amount == nil || !request.Amount.IsPositive() || request.Amount.Currency() != charge.amount.Currency() {
+		return nil, domain.InvalidLedgerEntryError{Reason: "invalid refund amount"}
+	}
+	if charge.refunded+request.Amount.Amount() > charge.amount.Amount() {
+		return nil, domain.PaymentDeclinedError{Reason: "refund exceeds charged amount"}
+	}
+
+	charge.refunded += request.Amount.Amount()
+	result := &domain.RefundResult{
+		RefundID: g.nextID("re"),
+		Status:   domain.PaymentSucceeded,
+	}
+	g.enqueueWebhook(domain.WebhookRefundSucceeded, charge, result.RefundID, request.Amount)
+
+	if request.IdempotencyKey != "" {
+		g.refundResults[request.IdempotencyKey] = result
+	}
+	copied := *result
+	return &copied, nil
+}
+
+func (g *FakePaymentGateway) VerifyWebhook(payload []byte, signature string) (*domain.PaymentWebhookEvent, error) {
+	expected, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, g.mac(payload)) {
+		return nil, domain.InvalidWebhookSignatureError{}
+	}
+
+	var body fakeWebhookPayload
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, err
+	}
+
+	userID, err := domain.ReconstructUserID(body.UserID)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := domain.NewMoney(body.Amount, body.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.PaymentWebhookEvent{
+		EventID:    body.ID,
+		Type:       domain.PaymentWebhookEventType(body.Type),
+		ChargeID:   body.ChargeID,
+		RefundID:   body.RefundID,
+		UserID:     userID,
+		Amount:     amount,
+		OccurredAt: body.OccurredAt,
+	}, nil
+}
+
+// Sign はペイロードの署名（HMAC-SHA256の16進表現）を返す
+func (g *FakePaymentGateway) Sign(payload []byte) string {
+	return hex.EncodeToString(g.mac(payload))
+}
+
+// DrainWebhooks は送信待ちのWebhookを取り出す
+func (g *FakePaymentGateway) DrainWebhooks() []FakeWebhook {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	webhooks := g.webhooks
+	g.webhooks = nil
+	return webhooks
+}
+
+// DeliverWebhooks は送信待ちのWebhookを順に deliver へ渡す
+// 失敗したWebhookは再送のため送信待ちに戻す
+func (g *FakePaymentGateway) DeliverWebhooks(deliver func(payload []byte, signature string) error) error {
+	var errs []error
+	for _, webhook := range g.DrainWebhooks() {
+		if err := deliver(webhook.Payload, webhook.Signature); err != nil {
+			errs = append(errs, err)
+			g.mu.Lock()
+			g.webhooks = append(g.webhooks, webhook)
+			g.mu.Unlock()
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (g *FakePaymentGateway) wait() {
+	g.mu.Lock()
+	latency := g.latency
+	g.mu.Unlock()
+
+	if latency > 0 {
+		g.sleep(latency)
+	}
+}
+
+func (g *FakePaymentGateway) nextID(prefix string) string {
+	g.seq++
+	return fmt.Sprintf("%s_%06d", prefix, g.seq)
+}
+
+func (g *FakePaymentGateway) enqueueWebhook(eventType domain.PaymentWebhookEventType, charge *fakeCharge, refundID string, amount *domain.Money) {
+	payload, _ := json.Marshal(fakeWebhookPayload{
+		ID:         g.nextID("evt"),
+		Type:       string(eventType),
+		ChargeID:   charge.id,
+		RefundID:   refundID,
+		UserID:     charge.userID.Value(),
+		Amount:     amount.Amount(),
+		Currency:   amount.Currency(),
+		OccurredAt: g.clock.Now(),
+	})
+	webhook := FakeWebhook{Payload: payload, Signature: hex.EncodeToString(g.mac(payload))}
+
+	g.webhooks = append(g.webhooks, webhook)
+	if g.duplicateWebhooks {
+		g.webhooks = append(g.webhooks, webhook)
+	}
+}
+
+func (g *FakePaymentGateway) mac(payload []byte) []byte {
+	mac := hmac.New(sha256.New, g.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,76 @@
+package infrastructure
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"ddd-bottomup/domain"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// HMACEmailVerificationTokenCodec は確認用トークンを「内容.署名」の形式で表す
+// 内容・署名とも URL で使える base64 で、署名は HMAC-SHA256
+// サーバー側に状態を持たないため、期限内であれば何度でも使える（確認済みなら何もしない）
+type HMACEmailVerificationTokenCodec struct {
+	secret []byte
+}
+
+func NewHMACEmailVerificationTokenCodec(secret string) *HMACEmailVerificationTokenCodec {
+	return &HMACEmailVerificationTokenCodec{secret: []byte(secret)}
+}
+
+type emailVerificationTokenPayload struct {
+	UserID    string `json:"sub"`
+	Email     string `json:"email"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+func (c *HMACEmailVerificationTokenCodec) Encode(token *domain.EmailVerificationToken) (string, error) {
+	payload, err := json.Marshal(emailVerificationTokenPayload{
+		UserID:    token.UserID().Value(),
+		Email:     token.Email().Value(),
+		ExpiresAt: token.ExpiresAt().Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(c.mac(encoded)), nil
+}
+
+func (c *HMACEmailVerificationTokenCodec) Decode(value string) (*domain.EmailVerificationToken, error) {
+	encoded, signature, ok := strings.Cut(value, ".")
+	if !ok {
+		return nil, domain.InvalidEmailVerificationTokenError{Reason: "malformed token"}
+	}
+	expected, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, c.mac(encoded)) {
+		return nil, domain.InvalidEmailVerificationTokenError{Reason: "signature mismatch"}
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, domain.InvalidEmailVerificationTokenError{Reason: "malformed token"}
+	}
+	var payload emailVerificationTokenPayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, domain.InvalidEmailVerificationTokenError{Reason: "malformed token"}
+	}
+	userID, err := domain.ReconstructUserID(payload.UserID)
+	if err != nil {
+		return nil, err
+	}
+	email, err := domain.NewEmail(payload.Email)
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewEmailVerificationToken(userID, email, time.Unix(payload.ExpiresAt, 0))
+}
+
+func (c *HMACEmailVerificationTokenCodec) mac(encoded string) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write([]byte(encoded))
+	return mac.Sum(nil)
+}
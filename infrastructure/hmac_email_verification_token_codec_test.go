@@ -0,0 +1,77 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHMACEmailVerificationTokenCodec_RoundTrip(t *testing.T) {
+	// Arrange
+	codec := NewHMACEmailVerificationTokenCodec("test-secret")
+	userID := domain.NewUserID()
+	email, _ := domain.NewEmail("taro@example.com")
+	expiresAt := time.Date(2025, 7, 2, 9, 0, 0, 0, time.UTC)
+	token, _ := domain.NewEmailVerificationToken(userID, email, expiresAt)
+
+	// Act
+	value, err := codec.Encode(token)
+	if err != nil {
+		t.Fatalf("Failed to encode token: %v", err)
+	}
+	decoded, err := codec.Decode(value)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if !decoded.UserID().Equals(userID) || !decoded.Email().Equals(email) || !decoded.ExpiresAt().Equal(expiresAt) {
+		t.Errorf("Expected %s/%s/%v, but got %s/%s/%v",
+			userID, email, expiresAt, decoded.UserID(), decoded.Email(), decoded.ExpiresAt())
+	}
+}
+
+func TestHMACEmailVerificationTokenCodec_Decode_RejectsTamperedTokens(t *testing.T) {
+	codec := NewHMACEmailVerificationTokenCodec("test-secret")
+	email, _ := domain.NewEmail("taro@example.com")
+	token, _ := domain.NewEmailVerificationToken(domain.NewUserID(), email, time.Now().Add(time.Hour))
+	value, _ := codec.Encode(token)
+	payload, signature, _ := strings.Cut(value, ".")
+	forged := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"` + domain.NewUserID().Value() + `","email":"taro@example.com","exp":4102444800}`))
+
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"署名なし", payload},
+		{"内容の書き換え", forged + "." + signature},
+		{"別のシークレットで署名", mustEncode(t, NewHMACEmailVerificationTokenCodec("other-secret"), token)},
+		{"不正な形式", "not-a-token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := codec.Decode(tt.value)
+
+			// Assert
+			var invalid domain.InvalidEmailVerificationTokenError
+			if !errors.As(err, &invalid) {
+				t.Errorf("Expected InvalidEmailVerificationTokenError, but got %v", err)
+			}
+		})
+	}
+}
+
+func mustEncode(t *testing.T, codec *HMACEmailVerificationTokenCodec, token *domain.EmailVerificationToken) string {
+	t.Helper()
+
+	value, err := codec.Encode(token)
+	if err != nil {
+		t.Fatalf("Failed to encode token: %v", err)
+	}
+	return value
+}
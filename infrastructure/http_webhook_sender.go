@@ -0,0 +1,103 @@
+package infrastructure
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"ddd-bottomup/domain"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// Webhookの送信ヘッダー
+const (
+	WebhookSignatureHeader = "X-Webhook-Signature" // 本文のHMAC-SHA256（16進数）
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery" // 再試行しても同じ値（受信側の重複排除用）
+)
+
+// HTTPWebhookSender は署名付きのJSONをPOSTする
+// 登録後に名前解決の結果が変わっても内部ネットワークへ送らないよう、接続するアドレスを検査する
+// リダイレクトには従わず、3xx は失敗として記録する
+type HTTPWebhookSender struct {
+	client  *http.Client
+	allowed []netip.Prefix
+}
+
+// NewHTTPWebhookSender の allowed は公開アドレス以外で接続を許すネットワーク（テストの受信サーバーなど）
+func NewHTTPWebhookSender(timeout time.Duration, allowed ...netip.Prefix) *HTTPWebhookSender {
+	s := &HTTPWebhookSender{allowed: allowed}
+	dialer := &net.Dialer{Timeout: timeout, Control: s.checkAddress}
+	s.client = &http.Client{
+		Timeout: timeout,
+		// プロキシを経由すると接続先の検査がプロキシのアドレスになるため使わない
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	return s
+}
+
+// checkAddress は名前解決後の接続先が公開アドレスか許可したネットワークであることを確かめる
+func (s *HTTPWebhookSender) checkAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if domain.IsPublicWebhookAddress(addr) {
+		return nil
+	}
+	for _, prefix := range s.allowed {
+		if prefix.Contains(addr.Unmap()) {
+			return nil
+		}
+	}
+	return fmt.Errorf("webhook endpoint resolved to a non-public address %s", addr)
+}
+
+func (s *HTTPWebhookSender) Send(webhook *domain.WebhookSubscription, delivery *domain.WebhookDelivery) (int, error) {
+	request, err := http.NewRequest(http.MethodPost, webhook.URL(), bytes.NewReader(delivery.Payload()))
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(WebhookSignatureHeader, SignWebhookPayload(webhook.Secret(), delivery.Payload()))
+	request.Header.Set(WebhookEventHeader, delivery.EventName())
+	request.Header.Set(WebhookDeliveryHeader, delivery.ID())
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	// 接続を再利用できるよう本文を読み捨てる
+	io.Copy(io.Discard, io.LimitReader(response.Body, 64<<10))
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return response.StatusCode, fmt.Errorf("webhook endpoint responded with %d", response.StatusCode)
+	}
+	return response.StatusCode, nil
+}
+
+// SignWebhookPayload は受信側が検証する署名を返す
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,71 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+var testWebhookSenderNow = time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)
+
+func newTestWebhookDelivery(t *testing.T, endpoint string) (*domain.WebhookSubscription, *domain.WebhookDelivery) {
+	t.Helper()
+
+	webhook := domain.ReconstructWebhookSubscription(domain.NewWebhookID(), domain.NewCircleID(), endpoint,
+		[]string{"circle.member_joined"}, "secret", testWebhookSenderNow)
+	delivery, err := domain.NewWebhookDelivery(webhook, "circle.member_joined", "key", []byte(`{}`), testWebhookSenderNow)
+	if err != nil {
+		t.Fatalf("Failed to create delivery: %v", err)
+	}
+	return webhook, delivery
+}
+
+func TestHTTPWebhookSender_Send_RejectsNonPublicAddress(t *testing.T) {
+	// Arrange
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+	webhook, delivery := newTestWebhookDelivery(t, server.URL)
+
+	// Act
+	status, err := NewHTTPWebhookSender(time.Second).Send(webhook, delivery)
+
+	// Assert
+	if err == nil || status != 0 {
+		t.Errorf("Expected the loopback endpoint to be refused, but got status %d and error %v", status, err)
+	}
+	if requests != 0 {
+		t.Errorf("Expected no request to reach the server, but got %d", requests)
+	}
+}
+
+func TestHTTPWebhookSender_Send_DoesNotFollowRedirects(t *testing.T) {
+	// Arrange
+	redirected := 0
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected++
+	}))
+	defer target.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer server.Close()
+	webhook, delivery := newTestWebhookDelivery(t, server.URL)
+	sender := NewHTTPWebhookSender(time.Second, netip.MustParsePrefix("127.0.0.0/8"))
+
+	// Act
+	status, err := sender.Send(webhook, delivery)
+
+	// Assert
+	if status != http.StatusTemporaryRedirect || err == nil {
+		t.Errorf("Expected the redirect to be recorded as a failure, but got status %d and error %v", status, err)
+	}
+	if redirected != 0 {
+		t.Errorf("Expected the redirect not to be followed, but got %d requests", redirected)
+	}
+}
@@ -0,0 +1,60 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"errors"
+	"sync"
+)
+
+// InProcessEventBus は同じプロセス内の購読者へドメインイベントを同期的に配信する
+// 購読者が失敗しても残りの購読者には配信し、失敗はまとめて返す（アウトボックスのリレーが再配信する）
+type InProcessEventBus struct {
+	mu       sync.RWMutex
+	handlers map[string][]func(domain.DomainEvent) error
+	all      []func(domain.DomainEvent) error
+}
+
+func NewInProcessEventBus() *InProcessEventBus {
+	return &InProcessEventBus{
+		handlers: make(map[string][]func(domain.DomainEvent) error),
+	}
+}
+
+// Subscribe は型 E のイベントだけを受け取る購読者を登録する
+func Subscribe[E domain.DomainEvent](bus *InProcessEventBus, handler func(E) error) {
+	var zero E
+	name := zero.EventName()
+
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+	bus.handlers[name] = append(bus.handlers[name], func(event domain.DomainEvent) error {
+		typed, ok := event.(E)
+		if !ok {
+			return nil
+		}
+		return handler(typed)
+	})
+}
+
+// SubscribeAll はすべてのイベントを受け取る購読者を登録する
+func (b *InProcessEventBus) SubscribeAll(handler func(domain.DomainEvent) error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.all = append(b.all, handler)
+}
+
+func (b *InProcessEventBus) Publish(events ...domain.DomainEvent) error {
+	var errs []error
+	for _, event := range events {
+		b.mu.RLock()
+		handlers := append(append([]func(domain.DomainEvent) error{}, b.handlers[event.EventName()]...), b.all...)
+		b.mu.RUnlock()
+
+		for _, handler := range handlers {
+			if err := handler(event); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
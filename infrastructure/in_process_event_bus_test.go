@@ -0,0 +1,73 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"errors"
+	"testing"
+	"time"
+)
+
+func newTestUser(t *testing.T) *domain.User {
+	t.Helper()
+
+	name, _ := domain.NewFullName("太郎", "山田")
+	email, _ := domain.NewEmail("taro@example.com")
+	return domain.NewUser(name, email, time.Now())
+}
+
+func TestInProcessEventBus_Publish_TypedSubscribers(t *testing.T) {
+	// Arrange
+	bus := NewInProcessEventBus()
+	var registered []*domain.UserID
+	var all []string
+	Subscribe(bus, func(event domain.UserRegistered) error {
+		registered = append(registered, event.UserID)
+		return nil
+	})
+	Subscribe(bus, func(domain.MemberJoined) error {
+		t.Error("MemberJoined subscriber must not receive user events")
+		return nil
+	})
+	bus.SubscribeAll(func(event domain.DomainEvent) error {
+		all = append(all, event.EventName())
+		return nil
+	})
+	user := newTestUser(t)
+
+	// Act
+	err := bus.Publish(user.PendingEvents()...)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if len(registered) != 1 || !registered[0].Equals(user.ID()) {
+		t.Errorf("Expected UserRegistered for %s, but got %v", user.ID(), registered)
+	}
+	if len(all) != 2 || all[0] != "user.registered" || all[1] != "user.email_verification_requested" {
+		t.Errorf("Expected catch-all subscriber to receive every user event, but got %v", all)
+	}
+}
+
+func TestInProcessEventBus_Publish_HandlerErrorDoesNotStopOthers(t *testing.T) {
+	// Arrange
+	bus := NewInProcessEventBus()
+	failure := errors.New("handler failed")
+	delivered := false
+	Subscribe(bus, func(domain.UserRegistered) error { return failure })
+	Subscribe(bus, func(domain.UserRegistered) error {
+		delivered = true
+		return nil
+	})
+
+	// Act
+	err := bus.Publish(newTestUser(t).PendingEvents()...)
+
+	// Assert
+	if !errors.Is(err, failure) {
+		t.Fatalf("Expected the handler error to be returned, but got %v", err)
+	}
+	if !delivered {
+		t.Error("Expected the second subscriber to receive the event")
+	}
+}
@@ -0,0 +1,240 @@
+package infrastructure
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"ddd-bottomup/domain"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	JWTAlgorithmEdDSA = "EdDSA"
+	JWTAlgorithmHS256 = "HS256"
+
+	minHMACKeyLength = 32
+)
+
+// JWTKey - アクセストークンの署名鍵
+// Ed25519 の鍵は公開鍵を JWKS で公開できる。HMAC の共有鍵はこのサーバーだけが検証に使う
+type JWTKey struct {
+	id         string
+	algorithm  string
+	privateKey ed25519.PrivateKey
+	secret     []byte
+}
+
+func NewEd25519JWTKey(id string, privateKey ed25519.PrivateKey) *JWTKey {
+	return &JWTKey{id: id, algorithm: JWTAlgorithmEdDSA, privateKey: privateKey}
+}
+
+func NewHMACJWTKey(id string, secret []byte) *JWTKey {
+	return &JWTKey{id: id, algorithm: JWTAlgorithmHS256, secret: secret}
+}
+
+// GenerateEd25519JWTKey は一時的な鍵を生成する（再起動すると発行済みのトークンは無効になる）
+func GenerateEd25519JWTKey(id string) (*JWTKey, error) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return NewEd25519JWTKey(id, privateKey), nil
+}
+
+// ParseJWTKeys は "kid:alg:鍵" をカンマで区切った設定を読み込む
+// 鍵は base64url で、EdDSA は 32 バイトのシード、HS256 は 32 バイト以上の共有鍵
+func ParseJWTKeys(spec string) ([]*JWTKey, error) {
+	var keys []*JWTKey
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, ":", 3)
+		if len(parts) != 3 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid JWT key %q: expected kid:alg:key", entry)
+		}
+		id, algorithm := parts[0], parts[1]
+		material, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[2], "="))
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWT key %q: %w", id, err)
+		}
+
+		switch algorithm {
+		case JWTAlgorithmEdDSA:
+			if len(material) != ed25519.SeedSize {
+				return nil, fmt.Errorf("invalid JWT key %q: EdDSA seed must be %d bytes", id, ed25519.SeedSize)
+			}
+			keys = append(keys, NewEd25519JWTKey(id, ed25519.NewKeyFromSeed(material)))
+		case JWTAlgorithmHS256:
+			if len(material) < minHMACKeyLength {
+				return nil, fmt.Errorf("invalid JWT key %q: HS256 secret must be at least %d bytes", id, minHMACKeyLength)
+			}
+			keys = append(keys, NewHMACJWTKey(id, material))
+		default:
+			return nil, fmt.Errorf("invalid JWT key %q: unsupported algorithm %q", id, algorithm)
+		}
+	}
+	return keys, nil
+}
+
+func (k *JWTKey) ID() string {
+	return k.id
+}
+
+func (k *JWTKey) Algorithm() string {
+	return k.algorithm
+}
+
+func (k *JWTKey) sign(input []byte) []byte {
+	if k.algorithm == JWTAlgorithmEdDSA {
+		return ed25519.Sign(k.privateKey, input)
+	}
+	mac := hmac.New(sha256.New, k.secret)
+	mac.Write(input)
+	return mac.Sum(nil)
+}
+
+func (k *JWTKey) verify(input, signature []byte) bool {
+	if k.algorithm == JWTAlgorithmEdDSA {
+		return ed25519.Verify(k.privateKey.Public().(ed25519.PublicKey), input, signature)
+	}
+	return hmac.Equal(signature, k.sign(input))
+}
+
+// JWTAccessTokenCodec はアクセストークンを JWS Compact 形式の JWT で表す
+// 先頭の鍵で署名し、すべての鍵で検証する。鍵を入れ替えるときは新しい鍵を先頭に加え、
+// 古い鍵で署名したトークンが期限切れになってから外す。先頭以外に加えた鍵は署名に使わず公開だけされるため、
+// クライアントに事前に配ってから署名に切り替えることもできる
+type JWTAccessTokenCodec struct {
+	issuer     string
+	signingKey *JWTKey
+	keys       []*JWTKey
+}
+
+func NewJWTAccessTokenCodec(issuer string, keys ...*JWTKey) (*JWTAccessTokenCodec, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("at least one JWT key is required")
+	}
+	seen := make(map[string]bool)
+	for _, key := range keys {
+		if seen[key.id] {
+			return nil, fmt.Errorf("duplicate JWT key ID %q", key.id)
+		}
+		seen[key.id] = true
+	}
+	return &JWTAccessTokenCodec{issuer: issuer, signingKey: keys[0], keys: keys}, nil
+}
+
+type jwtHeader struct {
+	Algorithm string `json:"alg"`
+	Type      string `json:"typ,omitempty"`
+	KeyID     string `json:"kid"`
+}
+
+type jwtClaims struct {
+	Issuer    string `json:"iss"`
+	Subject   string `json:"sub"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+	ID        string `json:"jti"`
+}
+
+func (c *JWTAccessTokenCodec) Encode(token *domain.AccessToken) (string, error) {
+	header, err := json.Marshal(jwtHeader{Algorithm: c.signingKey.algorithm, Type: "JWT", KeyID: c.signingKey.id})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(jwtClaims{
+		Issuer:    c.issuer,
+		Subject:   token.UserID().Value(),
+		IssuedAt:  token.IssuedAt().Unix(),
+		ExpiresAt: token.ExpiresAt().Unix(),
+		ID:        token.ID(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	signature := c.signingKey.sign([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func (c *JWTAccessTokenCodec) Decode(value string) (*domain.AccessToken, error) {
+	parts := strings.Split(value, ".")
+	if len(parts) != 3 {
+		return nil, domain.InvalidAccessTokenError{Reason: "malformed token"}
+	}
+
+	var header jwtHeader
+	if err := decodeJWTSegment(parts[0], &header); err != nil {
+		return nil, err
+	}
+	key := c.findKey(header.KeyID)
+	if key == nil {
+		return nil, domain.InvalidAccessTokenError{Reason: "unknown key"}
+	}
+	// ヘッダーの alg は鍵の種類と一致する場合だけ受け付ける（"none" や公開鍵を共有鍵として使う攻撃を防ぐ）
+	if header.Algorithm != key.algorithm {
+		return nil, domain.InvalidAccessTokenError{Reason: "algorithm mismatch"}
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !key.verify([]byte(parts[0]+"."+parts[1]), signature) {
+		return nil, domain.InvalidAccessTokenError{Reason: "signature mismatch"}
+	}
+
+	var claims jwtClaims
+	if err := decodeJWTSegment(parts[1], &claims); err != nil {
+		return nil, err
+	}
+	if claims.Issuer != c.issuer {
+		return nil, domain.InvalidAccessTokenError{Reason: "unexpected issuer"}
+	}
+	userID, err := domain.ReconstructUserID(claims.Subject)
+	if err != nil {
+		return nil, domain.InvalidAccessTokenError{Reason: "invalid subject"}
+	}
+	return domain.ReconstructAccessToken(claims.ID, userID, time.Unix(claims.IssuedAt, 0), time.Unix(claims.ExpiresAt, 0)), nil
+}
+
+func (c *JWTAccessTokenCodec) PublicKeys() []domain.PublicSigningKey {
+	var keys []domain.PublicSigningKey
+	for _, key := range c.keys {
+		if key.algorithm != JWTAlgorithmEdDSA {
+			continue
+		}
+		keys = append(keys, domain.PublicSigningKey{
+			KeyID:     key.id,
+			Algorithm: key.algorithm,
+			PublicKey: key.privateKey.Public().(ed25519.PublicKey),
+		})
+	}
+	return keys
+}
+
+func (c *JWTAccessTokenCodec) findKey(id string) *JWTKey {
+	for _, key := range c.keys {
+		if key.id == id {
+			return key
+		}
+	}
+	return nil
+}
+
+func decodeJWTSegment(segment string, v any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return domain.InvalidAccessTokenError{Reason: "malformed token"}
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return domain.InvalidAccessTokenError{Reason: "malformed token"}
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+package infrastructure
+
+import (
+	"crypto/ed25519"
+	"ddd-bottomup/domain"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testJWTSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func newTestEd25519JWTKey(t *testing.T, id string) *JWTKey {
+	t.Helper()
+
+	key, err := GenerateEd25519JWTKey(id)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	return key
+}
+
+func newTestAccessToken(t *testing.T) *domain.AccessToken {
+	t.Helper()
+
+	token, err := domain.NewAccessToken(domain.NewUserID(), time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC), 15*time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to create token: %v", err)
+	}
+	return token
+}
+
+func TestJWTAccessTokenCodec_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		key  *JWTKey
+	}{
+		{"Ed25519", newTestEd25519JWTKey(t, "ed-1")},
+		{"HMAC", NewHMACJWTKey("hs-1", testJWTSecret)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			codec, _ := NewJWTAccessTokenCodec("test-issuer", tt.key)
+			token := newTestAccessToken(t)
+
+			// Act
+			value, err := codec.Encode(token)
+			if err != nil {
+				t.Fatalf("Failed to encode token: %v", err)
+			}
+			decoded, err := codec.Decode(value)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if decoded.ID() != token.ID() || !decoded.UserID().Equals(token.UserID()) ||
+				!decoded.IssuedAt().Equal(token.IssuedAt()) || !decoded.ExpiresAt().Equal(token.ExpiresAt()) {
+				t.Errorf("Expected %+v, but got %+v", token, decoded)
+			}
+		})
+	}
+}
+
+func TestJWTAccessTokenCodec_Decode_AcceptsRotatedKeys(t *testing.T) {
+	// Arrange
+	oldKey := newTestEd25519JWTKey(t, "2025-01")
+	newKey := newTestEd25519JWTKey(t, "2025-07")
+	before, _ := NewJWTAccessTokenCodec("test-issuer", oldKey)
+	after, _ := NewJWTAccessTokenCodec("test-issuer", newKey, oldKey)
+	issuedBefore := mustEncodeJWT(t, before, newTestAccessToken(t))
+
+	// Act
+	_, err := after.Decode(issuedBefore)
+	issuedAfter := mustEncodeJWT(t, after, newTestAccessToken(t))
+
+	// Assert
+	if err != nil {
+		t.Errorf("Expected a token signed with the previous key to stay valid, but got: %v", err)
+	}
+	if kid := jwtHeaderKeyID(t, issuedAfter); kid != "2025-07" {
+		t.Errorf("Expected new tokens to be signed with 2025-07, but got %s", kid)
+	}
+	if keys := after.PublicKeys(); len(keys) != 2 || keys[0].KeyID != "2025-07" || keys[1].KeyID != "2025-01" {
+		t.Errorf("Expected both public keys, but got %+v", keys)
+	}
+}
+
+func TestJWTAccessTokenCodec_Decode_RejectsInvalidTokens(t *testing.T) {
+	edKey := newTestEd25519JWTKey(t, "ed-1")
+	hmacKey := NewHMACJWTKey("hs-1", testJWTSecret)
+	codec, _ := NewJWTAccessTokenCodec("test-issuer", edKey, hmacKey)
+	token := newTestAccessToken(t)
+	valid := mustEncodeJWT(t, codec, token)
+	header, claims, _ := strings.Cut(valid, ".")
+	claims, signature, _ := strings.Cut(claims, ".")
+
+	otherIssuer, _ := NewJWTAccessTokenCodec("other-issuer", edKey)
+	unknownKey, _ := NewJWTAccessTokenCodec("test-issuer", newTestEd25519JWTKey(t, "ed-2"))
+	forgedClaims := base64.RawURLEncoding.EncodeToString([]byte(
+		`{"iss":"test-issuer","sub":"` + domain.NewUserID().Value() + `","iat":0,"exp":4102444800,"jti":"x"}`))
+	// 公開鍵を HMAC の共有鍵として使った署名（alg の取り違えを狙う攻撃）
+	publicKey := edKey.privateKey.Public().(ed25519.PublicKey)
+	confused := encodeSignedJWT(`{"alg":"HS256","typ":"JWT","kid":"ed-1"}`, claims, NewHMACJWTKey("ed-1", publicKey))
+
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"不正な形式", "not-a-token"},
+		{"内容の書き換え", header + "." + forgedClaims + "." + signature},
+		{"署名なし", encodeUnsignedJWT(`{"alg":"none","kid":"ed-1"}`, claims)},
+		{"アルゴリズムの取り違え", confused},
+		{"知らない鍵", mustEncodeJWT(t, unknownKey, token)},
+		{"別の発行者", mustEncodeJWT(t, otherIssuer, token)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := codec.Decode(tt.value)
+
+			// Assert
+			var invalid domain.InvalidAccessTokenError
+			if !errors.As(err, &invalid) {
+				t.Errorf("Expected InvalidAccessTokenError, but got %v", err)
+			}
+		})
+	}
+}
+
+func TestParseJWTKeys(t *testing.T) {
+	seed := base64.RawURLEncoding.EncodeToString(make([]byte, ed25519.SeedSize))
+	secret := base64.RawURLEncoding.EncodeToString(testJWTSecret)
+
+	tests := []struct {
+		name          string
+		spec          string
+		expectedIDs   []string
+		expectedError bool
+	}{
+		{"複数の鍵", "a:EdDSA:" + seed + ", b:HS256:" + secret, []string{"a", "b"}, false},
+		{"空の設定", "", nil, false},
+		{"短すぎる共有鍵", "b:HS256:c2hvcnQ", nil, true},
+		{"未対応のアルゴリズム", "c:RS256:" + secret, nil, true},
+		{"区切りの不足", "a:" + seed, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			keys, err := ParseJWTKeys(tt.spec)
+
+			// Assert
+			if tt.expectedError {
+				if err == nil {
+					t.Error("Expected an error, but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if len(keys) != len(tt.expectedIDs) {
+				t.Fatalf("Expected %d keys, but got %d", len(tt.expectedIDs), len(keys))
+			}
+			for i, key := range keys {
+				if key.ID() != tt.expectedIDs[i] {
+					t.Errorf("Expected key %s, but got %s", tt.expectedIDs[i], key.ID())
+				}
+			}
+		})
+	}
+}
+
+func mustEncodeJWT(t *testing.T, codec *JWTAccessTokenCodec, token *domain.AccessToken) string {
+	t.Helper()
+
+	value, err := codec.Encode(token)
+	if err != nil {
+		t.Fatalf("Failed to encode token: %v", err)
+	}
+	return value
+}
+
+func jwtHeaderKeyID(t *testing.T, value string) string {
+	t.Helper()
+
+	var header jwtHeader
+	segment, _, _ := strings.Cut(value, ".")
+	if err := decodeJWTSegment(segment, &header); err != nil {
+		t.Fatalf("Failed to decode header: %v", err)
+	}
+	return header.KeyID
+}
+
+func encodeSignedJWT(header, claims string, key *JWTKey) string {
+	input := base64.RawURLEncoding.EncodeToString([]byte(header)) + "." + claims
+	return input + "." + base64.RawURLEncoding.EncodeToString(key.sign([]byte(input)))
+}
+
+func encodeUnsignedJWT(header, claims string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(header)) + "." + claims + "."
+}
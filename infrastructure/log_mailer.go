@@ -0,0 +1,27 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// LogMailer は開発用にメールを送信せず書き出す（標準出力やファイル）
+type LogMailer struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+func NewLogMailer(out io.Writer) *LogMailer {
+	return &LogMailer{out: out}
+}
+
+func (m *LogMailer) Send(message *domain.MailMessage) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, err := fmt.Fprintf(m.out, "To: %s\nSubject: %s\n\n%s\n-----\n",
+		message.To().Value(), message.Subject(), message.Body())
+	return err
+}
@@ -0,0 +1,82 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemoryAPIKeyRepository は保存したキーのコピーを返す（呼び出し側の変更は Save するまで反映しない）
+type MemoryAPIKeyRepository struct {
+	keys map[string]*domain.APIKey // キーは API キーの ID
+	mu   sync.RWMutex
+}
+
+func NewMemoryAPIKeyRepository() domain.APIKeyRepository {
+	return &MemoryAPIKeyRepository{
+		keys: make(map[string]*domain.APIKey),
+	}
+}
+
+func (r *MemoryAPIKeyRepository) FindByID(id string) (*domain.APIKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	key, exists := r.keys[id]
+	if !exists {
+		return nil, nil
+	}
+	return copyAPIKey(key, key.LastUsedAt()), nil
+}
+
+func (r *MemoryAPIKeyRepository) FindByPrefix(prefix string) (*domain.APIKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, key := range r.keys {
+		if key.Prefix() == prefix {
+			return copyAPIKey(key, key.LastUsedAt()), nil
+		}
+	}
+	return nil, nil
+}
+
+// FindAll は発行日時の順に返す
+func (r *MemoryAPIKeyRepository) FindAll() ([]*domain.APIKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	keys := make([]*domain.APIKey, 0, len(r.keys))
+	for _, key := range r.keys {
+		keys = append(keys, copyAPIKey(key, key.LastUsedAt()))
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keys[i].CreatedAt().Before(keys[j].CreatedAt())
+	})
+	return keys, nil
+}
+
+func (r *MemoryAPIKeyRepository) Save(key *domain.APIKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.keys[key.ID()] = copyAPIKey(key, key.LastUsedAt())
+	return nil
+}
+
+func (r *MemoryAPIKeyRepository) RecordUse(id string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if key, exists := r.keys[id]; exists {
+		r.keys[id] = copyAPIKey(key, &at)
+	}
+	return nil
+}
+
+// copyAPIKey は最終利用日時を lastUsedAt にしたコピーを返す
+func copyAPIKey(key *domain.APIKey, lastUsedAt *time.Time) *domain.APIKey {
+	return domain.ReconstructAPIKey(key.ID(), key.Name(), key.Prefix(), key.SecretHash(), key.Permissions(),
+		key.CreatedBy(), key.CreatedAt(), key.ExpiresAt(), lastUsedAt, key.RevokedAt())
+}
@@ -0,0 +1,39 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"sync"
+)
+
+type MemoryAuditRepository struct {
+	entries []*domain.AuditEntry // 追加順
+	mu      sync.RWMutex
+}
+
+func NewMemoryAuditRepository() domain.AuditRepository {
+	return &MemoryAuditRepository{}
+}
+
+func (r *MemoryAuditRepository) Append(entry *domain.AuditEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func (r *MemoryAuditRepository) Find(filter domain.AuditFilter) ([]*domain.AuditEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var entries []*domain.AuditEntry
+	for i := len(r.entries) - 1; i >= 0; i-- {
+		if filter.Limit > 0 && len(entries) >= filter.Limit {
+			break
+		}
+		if filter.Matches(r.entries[i]) {
+			entries = append(entries, r.entries[i])
+		}
+	}
+	return entries, nil
+}
@@ -0,0 +1,174 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"sync"
+)
+
+type MemoryCredentialRepository struct {
+	credentials map[string]*domain.Credential
+	mu          sync.RWMutex
+}
+
+func NewMemoryCredentialRepository() domain.CredentialRepository {
+	return &MemoryCredentialRepository{
+		credentials: make(map[string]*domain.Credential),
+	}
+}
+
+func (r *MemoryCredentialRepository) FindByUserID(userID *domain.UserID) (*domain.Credential, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	credential, exists := r.credentials[userID.Value()]
+	if !exists {
+		return nil, nil
+	}
+	return credential, nil
+}
+
+func (r *MemoryCredentialRepository) Save(credential *domain.Credential) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.credentials[credential.UserID().Value()] = credential
+	return nil
+}
+
+func (r *MemoryCredentialRepository) Delete(userID *domain.UserID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.credentials, userID.Value())
+	return nil
+}
+
+// MemorySessionRepository はトークンのハッシュでセッションを引く
+type MemorySessionRepository struct {
+	sessions map[string]*domain.Session // キーはトークンのハッシュ
+	mu       sync.RWMutex
+}
+
+func NewMemorySessionRepository() domain.SessionRepository {
+	return &MemorySessionRepository{
+		sessions: make(map[string]*domain.Session),
+	}
+}
+
+func (r *MemorySessionRepository) FindByTokenHash(tokenHash string) (*domain.Session, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	session, exists := r.sessions[tokenHash]
+	if !exists {
+		return nil, nil
+	}
+	return session, nil
+}
+
+func (r *MemorySessionRepository) Save(session *domain.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sessions[session.TokenHash()] = session
+	return nil
+}
+
+func (r *MemorySessionRepository) Delete(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for tokenHash, session := range r.sessions {
+		if session.ID() == id {
+			delete(r.sessions, tokenHash)
+		}
+	}
+	return nil
+}
+
+func (r *MemorySessionRepository) DeleteByUserID(userID *domain.UserID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for tokenHash, session := range r.sessions {
+		if session.UserID().Equals(userID) {
+			delete(r.sessions, tokenHash)
+		}
+	}
+	return nil
+}
+
+// MemoryRefreshTokenRepository はトークンのハッシュでリフレッシュトークンを引く
+// DB と同様に、保存するまで変更が他のリクエストから見えないよう複製を保持して返す
+type MemoryRefreshTokenRepository struct {
+	tokens map[string]*domain.RefreshToken // キーはトークンのハッシュ
+	mu     sync.RWMutex
+}
+
+func NewMemoryRefreshTokenRepository() domain.RefreshTokenRepository {
+	return &MemoryRefreshTokenRepository{
+		tokens: make(map[string]*domain.RefreshToken),
+	}
+}
+
+func (r *MemoryRefreshTokenRepository) FindByTokenHash(tokenHash string) (*domain.RefreshToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	token, exists := r.tokens[tokenHash]
+	if !exists {
+		return nil, nil
+	}
+	return copyRefreshToken(token), nil
+}
+
+func (r *MemoryRefreshTokenRepository) FindByFamilyID(familyID string) ([]*domain.RefreshToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var tokens []*domain.RefreshToken
+	for _, token := range r.tokens {
+		if token.FamilyID() == familyID {
+			tokens = append(tokens, copyRefreshToken(token))
+		}
+	}
+	return tokens, nil
+}
+
+func (r *MemoryRefreshTokenRepository) Save(token *domain.RefreshToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tokens[token.TokenHash()] = copyRefreshToken(token)
+	return nil
+}
+
+func (r *MemoryRefreshTokenRepository) SaveRotation(rotated, next *domain.RefreshToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.tokens[rotated.TokenHash()]
+	if !exists || stored.RotatedAt() != nil || stored.IsRevoked() {
+		return domain.RefreshTokenReusedError{FamilyID: rotated.FamilyID()}
+	}
+	r.tokens[rotated.TokenHash()] = copyRefreshToken(rotated)
+	r.tokens[next.TokenHash()] = copyRefreshToken(next)
+	return nil
+}
+
+func copyRefreshToken(token *domain.RefreshToken) *domain.RefreshToken {
+	return domain.ReconstructRefreshToken(token.ID(), token.FamilyID(), token.UserID(), token.TokenHash(),
+		token.IssuedAt(), token.ExpiresAt(), token.RotatedAt(), token.RevokedAt())
+}
+
+func (r *MemoryRefreshTokenRepository) DeleteByUserID(userID *domain.UserID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for tokenHash, token := range r.tokens {
+		if token.UserID().Equals(userID) {
+			delete(r.tokens, tokenHash)
+		}
+	}
+	return nil
+}
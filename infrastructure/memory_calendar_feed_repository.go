@@ -0,0 +1,44 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"sync"
+)
+
+type MemoryCalendarFeedTokenRepository struct {
+	tokens map[string]*domain.CalendarFeedToken // キーは UserID
+	mu     sync.RWMutex
+}
+
+func NewMemoryCalendarFeedTokenRepository() domain.CalendarFeedTokenRepository {
+	return &MemoryCalendarFeedTokenRepository{
+		tokens: make(map[string]*domain.CalendarFeedToken),
+	}
+}
+
+func (r *MemoryCalendarFeedTokenRepository) FindByUserID(userID *domain.UserID) (*domain.CalendarFeedToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	token, exists := r.tokens[userID.Value()]
+	if !exists {
+		return nil, nil
+	}
+	return token, nil
+}
+
+func (r *MemoryCalendarFeedTokenRepository) Save(token *domain.CalendarFeedToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tokens[token.UserID().Value()] = token
+	return nil
+}
+
+func (r *MemoryCalendarFeedTokenRepository) DeleteByUserID(userID *domain.UserID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.tokens, userID.Value())
+	return nil
+}
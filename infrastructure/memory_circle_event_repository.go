@@ -0,0 +1,54 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"sort"
+	"sync"
+)
+
+type MemoryCircleEventRepository struct {
+	events map[string]*domain.CircleEvent
+	mu     sync.RWMutex
+}
+
+func NewMemoryCircleEventRepository() domain.CircleEventRepository {
+	return &MemoryCircleEventRepository{
+		events: make(map[string]*domain.CircleEvent),
+	}
+}
+
+func (r *MemoryCircleEventRepository) FindByID(id *domain.CircleEventID) (*domain.CircleEvent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	event, exists := r.events[id.Value()]
+	if !exists {
+		return nil, nil
+	}
+	return event, nil
+}
+
+func (r *MemoryCircleEventRepository) FindByCircleID(circleID *domain.CircleID) ([]*domain.CircleEvent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var events []*domain.CircleEvent
+	for _, event := range r.events {
+		if event.CircleID().Equals(circleID) {
+			events = append(events, event)
+		}
+	}
+	// 開始時刻順に並べる
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Schedule().StartsAt().Before(events[j].Schedule().StartsAt())
+	})
+	return events, nil
+}
+
+func (r *MemoryCircleEventRepository) Save(event *domain.CircleEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events[event.ID().Value()] = event
+	return nil
+}
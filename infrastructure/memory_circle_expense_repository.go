@@ -0,0 +1,42 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"sync"
+)
+
+type MemoryCircleExpenseRepository struct {
+	expenses map[string][]*domain.CircleExpense // key: サークルID、記録順
+	mu       sync.RWMutex
+}
+
+func NewMemoryCircleExpenseRepository() domain.CircleExpenseRepository {
+	return &MemoryCircleExpenseRepository{
+		expenses: make(map[string][]*domain.CircleExpense),
+	}
+}
+
+func (r *MemoryCircleExpenseRepository) FindByCircleID(circleID *domain.CircleID) ([]*domain.CircleExpense, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored := r.expenses[circleID.Value()]
+	expenses := make([]*domain.CircleExpense, len(stored))
+	copy(expenses, stored)
+	return expenses, nil
+}
+
+func (r *MemoryCircleExpenseRepository) Save(expense *domain.CircleExpense) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := expense.CircleID().Value()
+	for i, stored := range r.expenses[key] {
+		if stored.ID().Equals(expense.ID()) {
+			r.expenses[key][i] = expense
+			return nil
+		}
+	}
+	r.expenses[key] = append(r.expenses[key], expense)
+	return nil
+}
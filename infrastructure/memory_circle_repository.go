@@ -3,8 +3,10 @@ package infrastructure
 import (
 	"ddd-bottomup/domain"
 	"sync"
+	"time"
 )
 
+// MemoryCircleRepository は論理削除したサークルも保持し、検索からは除く
 type MemoryCircleRepository struct {
 	circles map[string]*domain.Circle
 	mu      sync.RWMutex
@@ -21,7 +23,7 @@ func (r *MemoryCircleRepository) FindByID(id *domain.CircleID) (*domain.Circle,
 	defer r.mu.RUnlock()
 
 	circle, exists := r.circles[id.Value()]
-	if !exists {
+	if !exists || circle.IsDeleted() {
 		return nil, nil
 	}
 	return circle, nil
@@ -32,13 +34,49 @@ func (r *MemoryCircleRepository) FindByName(name *domain.CircleName) (*domain.Ci
 	defer r.mu.RUnlock()
 
 	for _, circle := range r.circles {
-		if circle.Name().Equals(name) {
+		if !circle.IsDeleted() && circle.Name().Equals(name) {
 			return circle, nil
 		}
 	}
 	return nil, nil
 }
 
+func (r *MemoryCircleRepository) FindDeletedByID(id *domain.CircleID) (*domain.Circle, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	circle, exists := r.circles[id.Value()]
+	if !exists || !circle.IsDeleted() {
+		return nil, nil
+	}
+	return circle, nil
+}
+
+func (r *MemoryCircleRepository) FindDeletedByOwnerID(ownerID *domain.UserID) ([]*domain.Circle, error) {
+	return r.findDeleted(func(circle *domain.Circle) bool {
+		return circle.IsOwner(ownerID)
+	}), nil
+}
+
+func (r *MemoryCircleRepository) FindDeletedBefore(before time.Time) ([]*domain.Circle, error) {
+	return r.findDeleted(func(circle *domain.Circle) bool {
+		return circle.DeletedAt().Before(before)
+	}), nil
+}
+
+func (r *MemoryCircleRepository) findDeleted(matches func(circle *domain.Circle) bool) []*domain.Circle {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var circles []*domain.Circle
+	for _, circle := range r.circles {
+		if circle.IsDeleted() && matches(circle) {
+			circles = append(circles, circle)
+		}
+	}
+	return circles
+}
+
 func (r *MemoryCircleRepository) Save(circle *domain.Circle) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -61,13 +99,23 @@ func (r *MemoryCircleRepository) FindAll() ([]*domain.Circle, error) {
 
 	circles := make([]*domain.Circle, 0, len(r.circles))
 	for _, circle := range r.circles {
-		circles = append(circles, circle)
+		if !circle.IsDeleted() {
+			circles = append(circles, circle)
+		}
 	}
 	return circles, nil
 }
 
+// Count は論理削除していないサークルの数を返す
 func (r *MemoryCircleRepository) Count() int {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	return len(r.circles)
+
+	count := 0
+	for _, circle := range r.circles {
+		if !circle.IsDeleted() {
+			count++
+		}
+	}
+	return count
 }
@@ -0,0 +1,46 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"sync"
+)
+
+type MemoryLedgerRepository struct {
+	entries         map[string][]*domain.LedgerEntry // key: ユーザーID
+	idempotencyKeys map[string]bool
+	mu              sync.RWMutex
+}
+
+func NewMemoryLedgerRepository() domain.LedgerRepository {
+	return &MemoryLedgerRepository{
+		entries:         make(map[string][]*domain.LedgerEntry),
+		idempotencyKeys: make(map[string]bool),
+	}
+}
+
+func (r *MemoryLedgerRepository) FindByUserID(userID *domain.UserID) (*domain.Ledger, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored := r.entries[userID.Value()]
+	entries := make([]*domain.LedgerEntry, len(stored))
+	copy(entries, stored)
+	return domain.ReconstructLedger(userID, entries), nil
+}
+
+func (r *MemoryLedgerRepository) Append(entry *domain.LedgerEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// 同時に処理された場合もDBの一意制約と同様に二重計上を防ぐ
+	if idempotencyKey := entry.IdempotencyKey(); idempotencyKey != "" {
+		if r.idempotencyKeys[idempotencyKey] {
+			return domain.DuplicateLedgerEntryError{Reference: entry.Reference()}
+		}
+		r.idempotencyKeys[idempotencyKey] = true
+	}
+
+	key := entry.UserID().Value()
+	r.entries[key] = append(r.entries[key], entry)
+	return nil
+}
@@ -0,0 +1,74 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"sort"
+	"sync"
+	"time"
+)
+
+type MemoryNotificationPreferencesRepository struct {
+	preferences map[string]*domain.NotificationPreferences
+	mu          sync.RWMutex
+}
+
+func NewMemoryNotificationPreferencesRepository() domain.NotificationPreferencesRepository {
+	return &MemoryNotificationPreferencesRepository{
+		preferences: make(map[string]*domain.NotificationPreferences),
+	}
+}
+
+func (r *MemoryNotificationPreferencesRepository) FindByUserID(userID *domain.UserID) (*domain.NotificationPreferences, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	preferences, exists := r.preferences[userID.Value()]
+	if !exists {
+		return nil, nil
+	}
+	return preferences, nil
+}
+
+func (r *MemoryNotificationPreferencesRepository) Save(preferences *domain.NotificationPreferences) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.preferences[preferences.UserID().Value()] = preferences
+	return nil
+}
+
+type MemoryPendingNotificationRepository struct {
+	notifications map[string]*domain.PendingNotification
+	mu            sync.RWMutex
+}
+
+func NewMemoryPendingNotificationRepository() domain.PendingNotificationRepository {
+	return &MemoryPendingNotificationRepository{
+		notifications: make(map[string]*domain.PendingNotification),
+	}
+}
+
+func (r *MemoryPendingNotificationRepository) FindDue(now time.Time) ([]*domain.PendingNotification, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var due []*domain.PendingNotification
+	for _, notification := range r.notifications {
+		if notification.IsDueAt(now) {
+			due = append(due, notification)
+		}
+	}
+	// 作成順に並べる
+	sort.SliceStable(due, func(i, j int) bool {
+		return due[i].CreatedAt().Before(due[j].CreatedAt())
+	})
+	return due, nil
+}
+
+func (r *MemoryPendingNotificationRepository) Save(notification *domain.PendingNotification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.notifications[notification.ID()] = notification
+	return nil
+}
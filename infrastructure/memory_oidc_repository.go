@@ -0,0 +1,78 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"sync"
+)
+
+type MemoryOIDCLoginRequestRepository struct {
+	requests map[string]*domain.OIDCLoginRequest // キーは state
+	mu       sync.RWMutex
+}
+
+func NewMemoryOIDCLoginRequestRepository() domain.OIDCLoginRequestRepository {
+	return &MemoryOIDCLoginRequestRepository{
+		requests: make(map[string]*domain.OIDCLoginRequest),
+	}
+}
+
+func (r *MemoryOIDCLoginRequestRepository) FindByState(state string) (*domain.OIDCLoginRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	request, exists := r.requests[state]
+	if !exists {
+		return nil, nil
+	}
+	return request, nil
+}
+
+func (r *MemoryOIDCLoginRequestRepository) Save(request *domain.OIDCLoginRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.requests[request.State()] = request
+	return nil
+}
+
+func (r *MemoryOIDCLoginRequestRepository) Delete(state string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.requests, state)
+	return nil
+}
+
+type MemoryExternalIdentityRepository struct {
+	identities map[string]*domain.ExternalIdentity // キーは発行者と subject
+	mu         sync.RWMutex
+}
+
+func NewMemoryExternalIdentityRepository() domain.ExternalIdentityRepository {
+	return &MemoryExternalIdentityRepository{
+		identities: make(map[string]*domain.ExternalIdentity),
+	}
+}
+
+func (r *MemoryExternalIdentityRepository) FindBySubject(issuer, subject string) (*domain.ExternalIdentity, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	identity, exists := r.identities[externalIdentityKey(issuer, subject)]
+	if !exists {
+		return nil, nil
+	}
+	return identity, nil
+}
+
+func (r *MemoryExternalIdentityRepository) Save(identity *domain.ExternalIdentity) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.identities[externalIdentityKey(identity.Issuer(), identity.Subject())] = identity
+	return nil
+}
+
+func externalIdentityKey(issuer, subject string) string {
+	return issuer + "\x00" + subject
+}
@@ -0,0 +1,94 @@
+package infrastructure
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// MemoryOutbox はテスト・ローカル実行用のアウトボックス
+type MemoryOutbox struct {
+	messages []*OutboxMessage // 追加順
+	mu       sync.Mutex
+}
+
+func NewMemoryOutbox() *MemoryOutbox {
+	return &MemoryOutbox{}
+}
+
+func (o *MemoryOutbox) Append(messages ...*OutboxMessage) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for _, message := range messages {
+		copied := *message
+		o.messages = append(o.messages, &copied)
+	}
+	return nil
+}
+
+func (o *MemoryOutbox) FetchDue(now time.Time, limit int) ([]*OutboxMessage, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	var due []*OutboxMessage
+	for _, message := range o.messages {
+		if len(due) >= limit {
+			break
+		}
+		if message.IsDispatched() || message.NextAttemptAt.After(now) {
+			continue
+		}
+		copied := *message
+		due = append(due, &copied)
+	}
+	return due, nil
+}
+
+func (o *MemoryOutbox) MarkDispatched(id string, dispatchedAt time.Time) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	message, err := o.find(id)
+	if err != nil {
+		return err
+	}
+	message.DispatchedAt = dispatchedAt
+	return nil
+}
+
+func (o *MemoryOutbox) MarkFailed(id string, attempts int, nextAttemptAt time.Time, lastError string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	message, err := o.find(id)
+	if err != nil {
+		return err
+	}
+	message.Attempts = attempts
+	message.NextAttemptAt = nextAttemptAt
+	message.LastError = lastError
+	return nil
+}
+
+// Messages は保存されているメッセージのコピーを返す
+func (o *MemoryOutbox) Messages() []*OutboxMessage {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	messages := make([]*OutboxMessage, 0, len(o.messages))
+	for _, message := range o.messages {
+		copied := *message
+		messages = append(messages, &copied)
+	}
+	return messages
+}
+
+func (o *MemoryOutbox) find(id string) (*OutboxMessage, error) {
+	for _, message := range o.messages {
+		if message.ID == id {
+			return message, nil
+		}
+	}
+	return nil, errors.New("outbox message not found: " + id)
+}
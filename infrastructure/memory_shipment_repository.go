@@ -0,0 +1,66 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"sort"
+	"sync"
+)
+
+type MemoryShipmentRepository struct {
+	shipments map[string]*domain.Shipment
+	mu        sync.RWMutex
+}
+
+func NewMemoryShipmentRepository() domain.ShipmentRepository {
+	return &MemoryShipmentRepository{
+		shipments: make(map[string]*domain.Shipment),
+	}
+}
+
+func (r *MemoryShipmentRepository) FindByID(id *domain.ShipmentID) (*domain.Shipment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	shipment, exists := r.shipments[id.Value()]
+	if !exists {
+		return nil, nil
+	}
+	return shipment, nil
+}
+
+func (r *MemoryShipmentRepository) FindByRecipientID(recipientID *domain.UserID) ([]*domain.Shipment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var shipments []*domain.Shipment
+	for _, shipment := range r.shipments {
+		if recipientID.Equals(shipment.RecipientID()) {
+			shipments = append(shipments, shipment)
+		}
+	}
+	// 作成順に並べる
+	sort.SliceStable(shipments, func(i, j int) bool {
+		return shipments[i].CreatedAt().Before(shipments[j].CreatedAt())
+	})
+	return shipments, nil
+}
+
+func (r *MemoryShipmentRepository) Save(shipment *domain.Shipment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.shipments[shipment.ID().Value()] = shipment
+	return nil
+}
+
+func (r *MemoryShipmentRepository) DetachRecipient(recipientID *domain.UserID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, shipment := range r.shipments {
+		if recipientID.Equals(shipment.RecipientID()) {
+			shipment.DetachRecipient()
+		}
+	}
+	return nil
+}
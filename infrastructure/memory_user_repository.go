@@ -3,8 +3,10 @@ package infrastructure
 import (
 	"ddd-bottomup/domain"
 	"sync"
+	"time"
 )
 
+// MemoryUserRepository は論理削除したユーザーも保持し、検索からは除く
 type MemoryUserRepository struct {
 	users map[string]*domain.User
 	mutex sync.RWMutex
@@ -21,7 +23,7 @@ func (r *MemoryUserRepository) FindByID(id *domain.UserID) (*domain.User, error)
 	defer r.mutex.RUnlock()
 
 	user, exists := r.users[id.Value()]
-	if !exists {
+	if !exists || user.IsDeleted() {
 		return nil, nil
 	}
 	return user, nil
@@ -32,13 +34,49 @@ func (r *MemoryUserRepository) FindByName(name *domain.FullName) (*domain.User,
 	defer r.mutex.RUnlock()
 
 	for _, user := range r.users {
-		if user.Name().Equals(name) {
+		if !user.IsDeleted() && user.Name().Equals(name) {
 			return user, nil
 		}
 	}
 	return nil, nil
 }
 
+func (r *MemoryUserRepository) FindByEmail(email *domain.Email) (*domain.User, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for _, user := range r.users {
+		if !user.IsDeleted() && user.Email().Equals(email) {
+			return user, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *MemoryUserRepository) FindDeletedByID(id *domain.UserID) (*domain.User, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	user, exists := r.users[id.Value()]
+	if !exists || !user.IsDeleted() {
+		return nil, nil
+	}
+	return user, nil
+}
+
+func (r *MemoryUserRepository) FindDeletedBefore(before time.Time) ([]*domain.User, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	var users []*domain.User
+	for _, user := range r.users {
+		if user.IsDeleted() && user.DeletedAt().Before(before) {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
 func (r *MemoryUserRepository) Save(user *domain.User) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
@@ -63,9 +101,16 @@ func (r *MemoryUserRepository) Clear() {
 	r.users = make(map[string]*domain.User)
 }
 
+// Count は論理削除していないユーザーの数を返す
 func (r *MemoryUserRepository) Count() int {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
-	return len(r.users)
+	count := 0
+	for _, user := range r.users {
+		if !user.IsDeleted() {
+			count++
+		}
+	}
+	return count
 }
@@ -0,0 +1,123 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"sort"
+	"sync"
+	"time"
+)
+
+type MemoryWebhookRepository struct {
+	webhooks map[string]*domain.WebhookSubscription
+	mu       sync.RWMutex
+}
+
+func NewMemoryWebhookRepository() domain.WebhookRepository {
+	return &MemoryWebhookRepository{
+		webhooks: make(map[string]*domain.WebhookSubscription),
+	}
+}
+
+func (r *MemoryWebhookRepository) FindByID(id *domain.WebhookID) (*domain.WebhookSubscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	webhook, exists := r.webhooks[id.Value()]
+	if !exists {
+		return nil, nil
+	}
+	return webhook, nil
+}
+
+func (r *MemoryWebhookRepository) FindByCircleID(circleID *domain.CircleID) ([]*domain.WebhookSubscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var webhooks []*domain.WebhookSubscription
+	for _, webhook := range r.webhooks {
+		if webhook.CircleID().Equals(circleID) {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+	// 登録順に並べる
+	sort.SliceStable(webhooks, func(i, j int) bool {
+		return webhooks[i].CreatedAt().Before(webhooks[j].CreatedAt())
+	})
+	return webhooks, nil
+}
+
+func (r *MemoryWebhookRepository) Save(webhook *domain.WebhookSubscription) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.webhooks[webhook.ID().Value()] = webhook
+	return nil
+}
+
+type MemoryWebhookDeliveryRepository struct {
+	deliveries map[string]*domain.WebhookDelivery
+	mu         sync.RWMutex
+}
+
+func NewMemoryWebhookDeliveryRepository() domain.WebhookDeliveryRepository {
+	return &MemoryWebhookDeliveryRepository{
+		deliveries: make(map[string]*domain.WebhookDelivery),
+	}
+}
+
+func (r *MemoryWebhookDeliveryRepository) FindByWebhookID(webhookID *domain.WebhookID) ([]*domain.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var deliveries []*domain.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if delivery.WebhookID().Equals(webhookID) {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+	// 新しい順に並べる
+	sort.SliceStable(deliveries, func(i, j int) bool {
+		return deliveries[i].CreatedAt().After(deliveries[j].CreatedAt())
+	})
+	return deliveries, nil
+}
+
+func (r *MemoryWebhookDeliveryRepository) FindByEventKey(webhookID *domain.WebhookID, eventKey string) (*domain.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, delivery := range r.deliveries {
+		if delivery.WebhookID().Equals(webhookID) && delivery.EventKey() == eventKey {
+			return delivery, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *MemoryWebhookDeliveryRepository) FindDue(now time.Time, limit int) ([]*domain.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var due []*domain.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if delivery.IsDueAt(now) {
+			due = append(due, delivery)
+		}
+	}
+	// 配信予定時刻の早い順に並べる
+	sort.SliceStable(due, func(i, j int) bool {
+		return due[i].NextAttemptAt().Before(due[j].NextAttemptAt())
+	})
+	if len(due) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
+
+func (r *MemoryWebhookDeliveryRepository) Save(delivery *domain.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.deliveries[delivery.ID()] = delivery
+	return nil
+}
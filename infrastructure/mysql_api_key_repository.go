@@ -0,0 +1,99 @@
+package infrastructure
+
+import (
+	"database/sql"
+	"ddd-bottomup/domain"
+	"encoding/json"
+	"time"
+)
+
+type MySQLAPIKeyRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLAPIKeyRepository(db *sql.DB) domain.APIKeyRepository {
+	return &MySQLAPIKeyRepository{db: db}
+}
+
+const apiKeyColumns = `id, name, prefix, secret_hash, permissions, created_by, created_at, expires_at, last_used_at, revoked_at`
+
+func (r *MySQLAPIKeyRepository) FindByID(id string) (*domain.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE id = ?`
+
+	key, err := scanAPIKey(r.db.QueryRow(query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return key, err
+}
+
+func (r *MySQLAPIKeyRepository) FindByPrefix(prefix string) (*domain.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE prefix = ?`
+
+	key, err := scanAPIKey(r.db.QueryRow(query, prefix))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return key, err
+}
+
+func (r *MySQLAPIKeyRepository) FindAll() ([]*domain.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys ORDER BY created_at`
+
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []*domain.APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+func (r *MySQLAPIKeyRepository) Save(key *domain.APIKey) error {
+	query := `
+		INSERT INTO api_keys (` + apiKeyColumns + `)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+		revoked_at = VALUES(revoked_at)
+	`
+
+	permissions, err := json.Marshal(key.Permissions())
+	if err != nil {
+		return err
+	}
+	_, err = r.db.Exec(query,
+		key.ID(), key.Name(), key.Prefix(), key.SecretHash(), permissions, key.CreatedBy(),
+		key.CreatedAt(), key.ExpiresAt(), key.LastUsedAt(), key.RevokedAt())
+	return err
+}
+
+func (r *MySQLAPIKeyRepository) RecordUse(id string, at time.Time) error {
+	_, err := r.db.Exec(`UPDATE api_keys SET last_used_at = ? WHERE id = ?`, at, id)
+	return err
+}
+
+func scanAPIKey(row rowScanner) (*domain.APIKey, error) {
+	var id, name, prefix, secretHash, createdBy string
+	var permissionsJSON []byte
+	var createdAt time.Time
+	var expiresAt, lastUsedAt, revokedAt sql.NullTime
+	if err := row.Scan(&id, &name, &prefix, &secretHash, &permissionsJSON, &createdBy,
+		&createdAt, &expiresAt, &lastUsedAt, &revokedAt); err != nil {
+		return nil, err
+	}
+
+	var permissions []domain.Permission
+	if err := json.Unmarshal(permissionsJSON, &permissions); err != nil {
+		return nil, err
+	}
+	return domain.ReconstructAPIKey(id, name, prefix, secretHash, permissions, createdBy,
+		createdAt, timePointer(expiresAt), timePointer(lastUsedAt), timePointer(revokedAt)), nil
+}
@@ -0,0 +1,120 @@
+package infrastructure
+
+import (
+	"database/sql"
+	"ddd-bottomup/domain"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+type MySQLAuditRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLAuditRepository(db *sql.DB) domain.AuditRepository {
+	return &MySQLAuditRepository{db: db}
+}
+
+const auditColumns = `id, actor_type, actor_id, action, target_type, target_id, changes, request_id, occurred_at`
+
+// auditChangeRecord は changes 列に保存する形式
+type auditChangeRecord struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+func (r *MySQLAuditRepository) Append(entry *domain.AuditEntry) error {
+	query := `INSERT INTO audit_log (` + auditColumns + `) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	records := make([]auditChangeRecord, len(entry.Changes()))
+	for i, change := range entry.Changes() {
+		records[i] = auditChangeRecord(change)
+	}
+	changes, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.Exec(query,
+		entry.ID(), entry.ActorType(), entry.ActorID(), entry.Action(), entry.Target().Type, entry.Target().ID,
+		changes, entry.RequestID(), entry.OccurredAt())
+	return err
+}
+
+func (r *MySQLAuditRepository) Find(filter domain.AuditFilter) ([]*domain.AuditEntry, error) {
+	var conditions []string
+	var args []interface{}
+	where := func(condition string, arg interface{}) {
+		conditions = append(conditions, condition)
+		args = append(args, arg)
+	}
+	if filter.ActorID != "" {
+		where("actor_id = ?", filter.ActorID)
+	}
+	if filter.Action != "" {
+		where("action = ?", filter.Action)
+	}
+	if filter.TargetType != "" {
+		where("target_type = ?", filter.TargetType)
+	}
+	if filter.TargetID != "" {
+		where("target_id = ?", filter.TargetID)
+	}
+	if filter.RequestID != "" {
+		where("request_id = ?", filter.RequestID)
+	}
+	if filter.Since != nil {
+		where("occurred_at >= ?", *filter.Since)
+	}
+	if filter.Until != nil {
+		where("occurred_at < ?", *filter.Until)
+	}
+
+	query := `SELECT ` + auditColumns + ` FROM audit_log`
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	query += ` ORDER BY seq DESC`
+	if filter.Limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, filter.Limit)
+	}
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*domain.AuditEntry
+	for rows.Next() {
+		entry, err := scanAuditEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+func scanAuditEntry(row rowScanner) (*domain.AuditEntry, error) {
+	var id, actorType, actorID, action, targetType, targetID, requestID string
+	var changesJSON []byte
+	var occurredAt time.Time
+	if err := row.Scan(&id, &actorType, &actorID, &action, &targetType, &targetID,
+		&changesJSON, &requestID, &occurredAt); err != nil {
+		return nil, err
+	}
+
+	var records []auditChangeRecord
+	if err := json.Unmarshal(changesJSON, &records); err != nil {
+		return nil, err
+	}
+	changes := make([]domain.AuditChange, len(records))
+	for i, record := range records {
+		changes[i] = domain.AuditChange(record)
+	}
+	return domain.ReconstructAuditEntry(id, domain.AuditActorType(actorType), actorID, domain.AuditAction(action),
+		domain.AuditTarget{Type: domain.AuditTargetType(targetType), ID: targetID}, changes, requestID, occurredAt), nil
+}
@@ -0,0 +1,221 @@
+package infrastructure
+
+import (
+	"database/sql"
+	"ddd-bottomup/domain"
+	"time"
+)
+
+type MySQLCredentialRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLCredentialRepository(db *sql.DB) domain.CredentialRepository {
+	return &MySQLCredentialRepository{db: db}
+}
+
+func (r *MySQLCredentialRepository) FindByUserID(userID *domain.UserID) (*domain.Credential, error) {
+	query := `
+		SELECT password_hash, updated_at
+		FROM user_credentials
+		WHERE user_id = ?
+	`
+
+	var passwordHash string
+	var updatedAt time.Time
+	err := r.db.QueryRow(query, userID.Value()).Scan(&passwordHash, &updatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return domain.ReconstructCredential(userID, passwordHash, updatedAt), nil
+}
+
+func (r *MySQLCredentialRepository) Save(credential *domain.Credential) error {
+	query := `
+		INSERT INTO user_credentials (user_id, password_hash, updated_at)
+		VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+		password_hash = VALUES(password_hash),
+		updated_at = VALUES(updated_at)
+	`
+
+	_, err := r.db.Exec(query, credential.UserID().Value(), credential.PasswordHash(), credential.UpdatedAt())
+	return err
+}
+
+func (r *MySQLCredentialRepository) Delete(userID *domain.UserID) error {
+	_, err := r.db.Exec(`DELETE FROM user_credentials WHERE user_id = ?`, userID.Value())
+	return err
+}
+
+type MySQLSessionRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLSessionRepository(db *sql.DB) domain.SessionRepository {
+	return &MySQLSessionRepository{db: db}
+}
+
+func (r *MySQLSessionRepository) FindByTokenHash(tokenHash string) (*domain.Session, error) {
+	query := `
+		SELECT id, user_id, created_at, expires_at
+		FROM sessions
+		WHERE token_hash = ?
+	`
+
+	var id, userIDValue string
+	var createdAt, expiresAt time.Time
+	err := r.db.QueryRow(query, tokenHash).Scan(&id, &userIDValue, &createdAt, &expiresAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := domain.ReconstructUserID(userIDValue)
+	if err != nil {
+		return nil, err
+	}
+	return domain.ReconstructSession(id, userID, tokenHash, createdAt, expiresAt), nil
+}
+
+func (r *MySQLSessionRepository) Save(session *domain.Session) error {
+	query := `
+		INSERT INTO sessions (id, user_id, token_hash, created_at, expires_at)
+		VALUES (?, ?, ?, ?, ?)
+	`
+
+	_, err := r.db.Exec(query,
+		session.ID(), session.UserID().Value(), session.TokenHash(), session.CreatedAt(), session.ExpiresAt())
+	return err
+}
+
+func (r *MySQLSessionRepository) Delete(id string) error {
+	_, err := r.db.Exec(`DELETE FROM sessions WHERE id = ?`, id)
+	return err
+}
+
+func (r *MySQLSessionRepository) DeleteByUserID(userID *domain.UserID) error {
+	_, err := r.db.Exec(`DELETE FROM sessions WHERE user_id = ?`, userID.Value())
+	return err
+}
+
+type MySQLRefreshTokenRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLRefreshTokenRepository(db *sql.DB) domain.RefreshTokenRepository {
+	return &MySQLRefreshTokenRepository{db: db}
+}
+
+const refreshTokenColumns = `id, family_id, user_id, token_hash, issued_at, expires_at, rotated_at, revoked_at`
+
+func (r *MySQLRefreshTokenRepository) FindByTokenHash(tokenHash string) (*domain.RefreshToken, error) {
+	query := `SELECT ` + refreshTokenColumns + ` FROM refresh_tokens WHERE token_hash = ?`
+
+	token, err := scanRefreshToken(r.db.QueryRow(query, tokenHash))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return token, err
+}
+
+func (r *MySQLRefreshTokenRepository) FindByFamilyID(familyID string) ([]*domain.RefreshToken, error) {
+	query := `SELECT ` + refreshTokenColumns + ` FROM refresh_tokens WHERE family_id = ? ORDER BY issued_at`
+
+	rows, err := r.db.Query(query, familyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tokens []*domain.RefreshToken
+	for rows.Next() {
+		token, err := scanRefreshToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, rows.Err()
+}
+
+func (r *MySQLRefreshTokenRepository) Save(token *domain.RefreshToken) error {
+	query := `
+		INSERT INTO refresh_tokens (` + refreshTokenColumns + `)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+		rotated_at = VALUES(rotated_at),
+		revoked_at = VALUES(revoked_at)
+	`
+
+	_, err := r.db.Exec(query,
+		token.ID(), token.FamilyID(), token.UserID().Value(), token.TokenHash(),
+		token.IssuedAt(), token.ExpiresAt(), token.RotatedAt(), token.RevokedAt())
+	return err
+}
+
+// SaveRotation は rotated_at が NULL の行だけを更新し、更新できた場合のみ next を保存する
+func (r *MySQLRefreshTokenRepository) SaveRotation(rotated, next *domain.RefreshToken) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`
+		UPDATE refresh_tokens SET rotated_at = ?
+		WHERE id = ? AND rotated_at IS NULL AND revoked_at IS NULL
+	`, rotated.RotatedAt(), rotated.ID())
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		// 別のリクエストが先に使用済みにした
+		return domain.RefreshTokenReusedError{FamilyID: rotated.FamilyID()}
+	}
+
+	_, err = tx.Exec(`INSERT INTO refresh_tokens (`+refreshTokenColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		next.ID(), next.FamilyID(), next.UserID().Value(), next.TokenHash(),
+		next.IssuedAt(), next.ExpiresAt(), next.RotatedAt(), next.RevokedAt())
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *MySQLRefreshTokenRepository) DeleteByUserID(userID *domain.UserID) error {
+	_, err := r.db.Exec(`DELETE FROM refresh_tokens WHERE user_id = ?`, userID.Value())
+	return err
+}
+
+func scanRefreshToken(row rowScanner) (*domain.RefreshToken, error) {
+	var id, familyID, userIDValue, tokenHash string
+	var issuedAt, expiresAt time.Time
+	var rotatedAt, revokedAt sql.NullTime
+	if err := row.Scan(&id, &familyID, &userIDValue, &tokenHash, &issuedAt, &expiresAt, &rotatedAt, &revokedAt); err != nil {
+		return nil, err
+	}
+
+	userID, err := domain.ReconstructUserID(userIDValue)
+	if err != nil {
+		return nil, err
+	}
+	return domain.ReconstructRefreshToken(id, familyID, userID, tokenHash, issuedAt, expiresAt,
+		timePointer(rotatedAt), timePointer(revokedAt)), nil
+}
+
+func timePointer(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
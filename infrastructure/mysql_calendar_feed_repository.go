@@ -0,0 +1,52 @@
+package infrastructure
+
+import (
+	"database/sql"
+	"ddd-bottomup/domain"
+	"time"
+)
+
+type MySQLCalendarFeedTokenRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLCalendarFeedTokenRepository(db *sql.DB) domain.CalendarFeedTokenRepository {
+	return &MySQLCalendarFeedTokenRepository{db: db}
+}
+
+func (r *MySQLCalendarFeedTokenRepository) FindByUserID(userID *domain.UserID) (*domain.CalendarFeedToken, error) {
+	query := `
+		SELECT token_hash, issued_at
+		FROM calendar_feed_tokens
+		WHERE user_id = ?
+	`
+
+	var tokenHash string
+	var issuedAt time.Time
+	err := r.db.QueryRow(query, userID.Value()).Scan(&tokenHash, &issuedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return domain.ReconstructCalendarFeedToken(userID, tokenHash, issuedAt), nil
+}
+
+func (r *MySQLCalendarFeedTokenRepository) Save(token *domain.CalendarFeedToken) error {
+	query := `
+		INSERT INTO calendar_feed_tokens (user_id, token_hash, issued_at)
+		VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+		token_hash = VALUES(token_hash),
+		issued_at = VALUES(issued_at)
+	`
+
+	_, err := r.db.Exec(query, token.UserID().Value(), token.TokenHash(), token.IssuedAt())
+	return err
+}
+
+func (r *MySQLCalendarFeedTokenRepository) DeleteByUserID(userID *domain.UserID) error {
+	_, err := r.db.Exec(`DELETE FROM calendar_feed_tokens WHERE user_id = ?`, userID.Value())
+	return err
+}
@@ -0,0 +1,215 @@
+package infrastructure
+
+import (
+	"database/sql"
+	"ddd-bottomup/domain"
+	"strings"
+	"time"
+)
+
+type MySQLCircleEventRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLCircleEventRepository(db *sql.DB) domain.CircleEventRepository {
+	return &MySQLCircleEventRepository{db: db}
+}
+
+const circleEventColumns = `
+		id, circle_id, title, starts_at, ends_at, location, capacity, created_at, cancelled_at
+`
+
+func (r *MySQLCircleEventRepository) FindByID(id *domain.CircleEventID) (*domain.CircleEvent, error) {
+	query := `
+		SELECT ` + circleEventColumns + `
+		FROM circle_events
+		WHERE id = ?
+	`
+
+	rows, err := r.db.Query(query, id.Value())
+	if err != nil {
+		return nil, err
+	}
+	events, err := r.scanEvents(rows)
+	if err != nil || len(events) == 0 {
+		return nil, err
+	}
+	return events[0], nil
+}
+
+func (r *MySQLCircleEventRepository) FindByCircleID(circleID *domain.CircleID) ([]*domain.CircleEvent, error) {
+	query := `
+		SELECT ` + circleEventColumns + `
+		FROM circle_events
+		WHERE circle_id = ?
+		ORDER BY starts_at
+	`
+
+	rows, err := r.db.Query(query, circleID.Value())
+	if err != nil {
+		return nil, err
+	}
+	return r.scanEvents(rows)
+}
+
+// circleEventRow はイベント1件分の列（出欠は別テーブルから取得する）
+type circleEventRow struct {
+	id, circleID, title, location string
+	startsAt, endsAt, createdAt   time.Time
+	capacity                      int
+	cancelledAt                   sql.NullTime
+}
+
+// scanEvents はイベントを読み込んで再構成する
+func (r *MySQLCircleEventRepository) scanEvents(rows *sql.Rows) ([]*domain.CircleEvent, error) {
+	defer rows.Close()
+
+	var scanned []circleEventRow
+	for rows.Next() {
+		var row circleEventRow
+		if err := rows.Scan(&row.id, &row.circleID, &row.title, &row.startsAt, &row.endsAt,
+			&row.location, &row.capacity, &row.createdAt, &row.cancelledAt); err != nil {
+			return nil, err
+		}
+		scanned = append(scanned, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// 出欠はイベントごとに取得する
+	events := make([]*domain.CircleEvent, 0, len(scanned))
+	for _, row := range scanned {
+		event, err := r.reconstructEvent(row)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (r *MySQLCircleEventRepository) reconstructEvent(row circleEventRow) (*domain.CircleEvent, error) {
+	// エンティティの再構成
+	eventID, err := domain.ReconstructCircleEventID(row.id)
+	if err != nil {
+		return nil, err
+	}
+	circleID, err := domain.ReconstructCircleID(row.circleID)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := domain.NewEventSchedule(row.startsAt, row.endsAt)
+	if err != nil {
+		return nil, err
+	}
+	rsvps, err := r.findRSVPs(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReconstructCircleEvent(
+		eventID, circleID, row.title, schedule, row.location, row.capacity,
+		rsvps, row.createdAt, row.cancelledAt.Time,
+	), nil
+}
+
+// findRSVPs はイベントの出欠を回答順に取得します
+func (r *MySQLCircleEventRepository) findRSVPs(eventID *domain.CircleEventID) ([]*domain.RSVP, error) {
+	query := `
+		SELECT user_id, status, responded_at
+		FROM circle_event_rsvps
+		WHERE event_id = ?
+		ORDER BY position
+	`
+
+	rows, err := r.db.Query(query, eventID.Value())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rsvps []*domain.RSVP
+	for rows.Next() {
+		var userID, status string
+		var respondedAt time.Time
+		if err := rows.Scan(&userID, &status, &respondedAt); err != nil {
+			return nil, err
+		}
+
+		user, err := domain.ReconstructUserID(userID)
+		if err != nil {
+			return nil, err
+		}
+		rsvpStatus, err := domain.ParseRSVPStatus(status)
+		if err != nil {
+			return nil, err
+		}
+		rsvps = append(rsvps, domain.ReconstructRSVP(user, rsvpStatus, respondedAt))
+	}
+
+	return rsvps, rows.Err()
+}
+
+func (r *MySQLCircleEventRepository) Save(event *domain.CircleEvent) error {
+	// トランザクション開始
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// イベント保存（UPSERT）
+	query := `
+		INSERT INTO circle_events (` + circleEventColumns + `)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+		title = VALUES(title),
+		starts_at = VALUES(starts_at),
+		ends_at = VALUES(ends_at),
+		location = VALUES(location),
+		capacity = VALUES(capacity),
+		cancelled_at = VALUES(cancelled_at)
+	`
+
+	schedule := event.Schedule()
+	_, err = tx.Exec(query,
+		event.ID().Value(),
+		event.CircleID().Value(),
+		event.Title(),
+		schedule.StartsAt(),
+		schedule.EndsAt(),
+		event.Location(),
+		event.Capacity(),
+		event.CreatedAt(),
+		nullTime(event.CancelledAt()))
+	if err != nil {
+		return err
+	}
+
+	// 既存の出欠を削除して入れ直す
+	_, err = tx.Exec("DELETE FROM circle_event_rsvps WHERE event_id = ?", event.ID().Value())
+	if err != nil {
+		return err
+	}
+
+	rsvps := event.RSVPs()
+	if len(rsvps) > 0 {
+		rsvpQuery := "INSERT INTO circle_event_rsvps (event_id, position, user_id, status, responded_at) VALUES "
+		values := make([]string, len(rsvps))
+		args := make([]interface{}, 0, len(rsvps)*5)
+
+		for i, rsvp := range rsvps {
+			values[i] = "(?, ?, ?, ?, ?)"
+			args = append(args, event.ID().Value(), i, rsvp.UserID().Value(), rsvp.Status().String(), rsvp.RespondedAt())
+		}
+
+		rsvpQuery += strings.Join(values, ", ")
+		_, err = tx.Exec(rsvpQuery, args...)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
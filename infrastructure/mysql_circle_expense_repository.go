@@ -0,0 +1,177 @@
+package infrastructure
+
+import (
+	"database/sql"
+	"ddd-bottomup/domain"
+	"strings"
+	"time"
+)
+
+type MySQLCircleExpenseRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLCircleExpenseRepository(db *sql.DB) domain.CircleExpenseRepository {
+	return &MySQLCircleExpenseRepository{db: db}
+}
+
+func (r *MySQLCircleExpenseRepository) FindByCircleID(circleID *domain.CircleID) ([]*domain.CircleExpense, error) {
+	query := `
+		SELECT id, payer_id, amount, currency, description, split_method, incurred_at
+		FROM circle_expenses
+		WHERE circle_id = ?
+		ORDER BY incurred_at, seq
+	`
+
+	rows, err := r.db.Query(query, circleID.Value())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var expenses []*domain.CircleExpense
+	for rows.Next() {
+		var id, payerID, currency, description, splitMethod string
+		var amount int64
+		var incurredAt time.Time
+		if err := rows.Scan(&id, &payerID, &amount, &currency, &description, &splitMethod, &incurredAt); err != nil {
+			return nil, err
+		}
+
+		// エンティティの再構成
+		expenseID, err := domain.ReconstructExpenseID(id)
+		if err != nil {
+			return nil, err
+		}
+		payer, err := domain.ReconstructUserID(payerID)
+		if err != nil {
+			return nil, err
+		}
+		money, err := domain.NewMoney(amount, currency)
+		if err != nil {
+			return nil, err
+		}
+		method, err := domain.ParseSplitMethod(splitMethod)
+		if err != nil {
+			return nil, err
+		}
+		expenses = append(expenses, domain.ReconstructCircleExpense(
+			expenseID, circleID, payer, money, description, method, nil, incurredAt,
+		))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// 負担額は費用ごとに取得して再構成する
+	for i, expense := range expenses {
+		shares, err := r.findShares(expense.ID(), expense.Amount().Currency())
+		if err != nil {
+			return nil, err
+		}
+		expenses[i] = domain.ReconstructCircleExpense(
+			expense.ID(), expense.CircleID(), expense.PayerID(), expense.Amount(),
+			expense.Description(), expense.SplitMethod(), shares, expense.IncurredAt(),
+		)
+	}
+
+	return expenses, nil
+}
+
+// findShares は費用の負担額を記録順に取得します
+func (r *MySQLCircleExpenseRepository) findShares(expenseID *domain.ExpenseID, currency string) ([]*domain.ExpenseShare, error) {
+	query := `
+		SELECT participant_id, amount
+		FROM circle_expense_shares
+		WHERE expense_id = ?
+		ORDER BY position
+	`
+
+	rows, err := r.db.Query(query, expenseID.Value())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var shares []*domain.ExpenseShare
+	for rows.Next() {
+		var participantID string
+		var amount int64
+		if err := rows.Scan(&participantID, &amount); err != nil {
+			return nil, err
+		}
+
+		participant, err := domain.ReconstructUserID(participantID)
+		if err != nil {
+			return nil, err
+		}
+		money, err := domain.NewMoney(amount, currency)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, domain.NewExpenseShare(participant, money))
+	}
+
+	return shares, rows.Err()
+}
+
+func (r *MySQLCircleExpenseRepository) Save(expense *domain.CircleExpense) error {
+	// トランザクション開始
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// 費用保存（UPSERT）
+	query := `
+		INSERT INTO circle_expenses (id, circle_id, payer_id, amount, currency, description, split_method, incurred_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+		payer_id = VALUES(payer_id),
+		amount = VALUES(amount),
+		currency = VALUES(currency),
+		description = VALUES(description),
+		split_method = VALUES(split_method),
+		incurred_at = VALUES(incurred_at)
+	`
+
+	_, err = tx.Exec(query,
+		expense.ID().Value(),
+		expense.CircleID().Value(),
+		expense.PayerID().Value(),
+		expense.Amount().Amount(),
+		expense.Amount().Currency(),
+		expense.Description(),
+		expense.SplitMethod().String(),
+		expense.IncurredAt())
+	if err != nil {
+		return err
+	}
+
+	// 既存の負担額を削除して入れ直す
+	_, err = tx.Exec("DELETE FROM circle_expense_shares WHERE expense_id = ?", expense.ID().Value())
+	if err != nil {
+		return err
+	}
+
+	shares := expense.Shares()
+	if len(shares) > 0 {
+		shareQuery := "INSERT INTO circle_expense_shares (expense_id, position, participant_id, amount) VALUES "
+		values := make([]string, len(shares))
+		args := make([]interface{}, 0, len(shares)*4)
+
+		for i, share := range shares {
+			values[i] = "(?, ?, ?, ?)"
+			args = append(args, expense.ID().Value(), i, share.ParticipantID().Value(), share.Amount().Amount())
+		}
+
+		shareQuery += strings.Join(values, ", ")
+		_, err = tx.Exec(shareQuery, args...)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
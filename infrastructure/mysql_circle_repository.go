@@ -19,80 +19,91 @@ func NewMySQLCircleRepository(db *sql.DB) domain.CircleRepository {
 	}
 }
 
+const circleColumns = `id, name, owner_id, dues_amount, dues_currency, created_at, deleted_at`
+
 func (r *MySQLCircleRepository) FindByID(id *domain.CircleID) (*domain.Circle, error) {
 	query := `
-		SELECT id, name, owner_id, created_at 
-		FROM circles 
-		WHERE id = ?
+		SELECT ` + circleColumns + `
+		FROM circles
+		WHERE id = ? AND deleted_at IS NULL
 	`
 
-	row := r.db.QueryRow(query, id.Value())
+	circle, err := r.scanCircle(r.db.QueryRow(query, id.Value()))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return circle, err
+}
 
-	var circleID, name, ownerID string
-	var createdAt time.Time
+func (r *MySQLCircleRepository) FindByName(name *domain.CircleName) (*domain.Circle, error) {
+	query := `
+		SELECT ` + circleColumns + `
+		FROM circles
+		WHERE name = ? AND deleted_at IS NULL
+	`
 
-	if err := row.Scan(&circleID, &name, &ownerID, &createdAt); err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil
-		}
-		return nil, err
+	circle, err := r.scanCircle(r.db.QueryRow(query, name.Value()))
+	if err == sql.ErrNoRows {
+		return nil, nil
 	}
+	return circle, err
+}
 
-	// エンティティの再構成
-	reconstructedID, _ := domain.ReconstructCircleID(circleID)
-	circleName, _ := domain.NewCircleName(name)
-	reconstructedOwnerID, _ := domain.ReconstructUserID(ownerID)
+func (r *MySQLCircleRepository) FindAll() ([]*domain.Circle, error) {
+	query := `
+		SELECT ` + circleColumns + `
+		FROM circles
+		WHERE deleted_at IS NULL
+		ORDER BY created_at DESC
+	`
 
-	// メンバーIDを取得
-	memberIDs, err := r.getMemberIDs(reconstructedID)
+	rows, err := r.db.Query(query)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
-	return domain.ReconstructCircle(reconstructedID, circleName, reconstructedOwnerID, memberIDs, createdAt), nil
+	return r.scanCircles(rows)
 }
 
-func (r *MySQLCircleRepository) FindByName(name *domain.CircleName) (*domain.Circle, error) {
+func (r *MySQLCircleRepository) FindDeletedByID(id *domain.CircleID) (*domain.Circle, error) {
 	query := `
-		SELECT id, name, owner_id, created_at 
-		FROM circles 
-		WHERE name = ?
+		SELECT ` + circleColumns + `
+		FROM circles
+		WHERE id = ? AND deleted_at IS NOT NULL
 	`
 
-	row := r.db.QueryRow(query, name.Value())
-
-	var circleID, circleName, ownerID string
-	var createdAt time.Time
-
-	if err := row.Scan(&circleID, &circleName, &ownerID, &createdAt); err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil
-		}
-		return nil, err
+	circle, err := r.scanCircle(r.db.QueryRow(query, id.Value()))
+	if err == sql.ErrNoRows {
+		return nil, nil
 	}
+	return circle, err
+}
 
-	// エンティティの再構成
-	reconstructedID, _ := domain.ReconstructCircleID(circleID)
-	reconstructedName, _ := domain.NewCircleName(circleName)
-	reconstructedOwnerID, _ := domain.ReconstructUserID(ownerID)
+func (r *MySQLCircleRepository) FindDeletedByOwnerID(ownerID *domain.UserID) ([]*domain.Circle, error) {
+	query := `
+		SELECT ` + circleColumns + `
+		FROM circles
+		WHERE owner_id = ? AND deleted_at IS NOT NULL
+	`
 
-	// メンバーIDを取得
-	memberIDs, err := r.getMemberIDs(reconstructedID)
+	rows, err := r.db.Query(query, ownerID.Value())
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
-	return domain.ReconstructCircle(reconstructedID, reconstructedName, reconstructedOwnerID, memberIDs, createdAt), nil
+	return r.scanCircles(rows)
 }
 
-func (r *MySQLCircleRepository) FindAll() ([]*domain.Circle, error) {
+func (r *MySQLCircleRepository) FindDeletedBefore(before time.Time) ([]*domain.Circle, error) {
 	query := `
-		SELECT id, name, owner_id, created_at 
-		FROM circles 
-		ORDER BY created_at DESC
+		SELECT ` + circleColumns + `
+		FROM circles
+		WHERE deleted_at IS NOT NULL AND deleted_at < ?
 	`
 
-	rows, err := r.db.Query(query)
+	rows, err := r.db.Query(query, before)
 	if err != nil {
 		return nil, err
 	}
@@ -111,20 +122,33 @@ func (r *MySQLCircleRepository) Save(circle *domain.Circle) error {
 
 	// サークル保存（UPSERT）
 	query := `
-		INSERT INTO circles (id, name, owner_id, created_at, member_count) 
-		VALUES (?, ?, ?, ?, ?)
+		INSERT INTO circles (id, name, owner_id, dues_amount, dues_currency, created_at, member_count, deleted_at) 
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 		ON DUPLICATE KEY UPDATE 
 		name = VALUES(name), 
 		owner_id = VALUES(owner_id),
-		member_count = VALUES(member_count)
+		dues_amount = VALUES(dues_amount),
+		dues_currency = VALUES(dues_currency),
+		member_count = VALUES(member_count),
+		deleted_at = VALUES(deleted_at)
 	`
 
+	var duesAmount sql.NullInt64
+	var duesCurrency sql.NullString
+	if dues := circle.MembershipDues(); dues != nil {
+		duesAmount = sql.NullInt64{Int64: dues.Amount(), Valid: true}
+		duesCurrency = sql.NullString{String: dues.Currency(), Valid: true}
+	}
+
 	_, err = tx.Exec(query,
 		circle.ID().Value(),
 		circle.Name().Value(),
 		circle.OwnerID().Value(),
+		duesAmount,
+		duesCurrency,
 		circle.CreatedAt(),
-		circle.GetMemberCount())
+		circle.GetMemberCount(),
+		nullTime(circle.DeletedAt()))
 	if err != nil {
 		return err
 	}
@@ -135,14 +159,20 @@ func (r *MySQLCircleRepository) Save(circle *domain.Circle) error {
 		return err
 	}
 
-	// 新しいメンバー関係を挿入
-	if len(circle.GetMemberIDs()) > 0 {
-		memberQuery := "INSERT INTO circle_members (circle_id, user_id) VALUES "
-		values := make([]string, len(circle.GetMemberIDs()))
-		args := make([]interface{}, 0, len(circle.GetMemberIDs())*2)
+	// 新しいメンバー関係を挿入（アカウントを論理削除したメンバーは detached として残す）
+	memberIDs := circle.GetMemberIDs()
+	detachedMemberIDs := circle.GetDetachedMemberIDs()
+	if len(memberIDs)+len(detachedMemberIDs) > 0 {
+		memberQuery := "INSERT INTO circle_members (circle_id, user_id, detached) VALUES "
+		values := make([]string, 0, len(memberIDs)+len(detachedMemberIDs))
+		args := make([]interface{}, 0, (len(memberIDs)+len(detachedMemberIDs))*3)
 
-		for i, memberID := range circle.GetMemberIDs() {
-			values[i] = "(?, ?)"
+		for _, memberID := range memberIDs {
+			values = append(values, "(?, ?, FALSE)")
+			args = append(args, circle.ID().Value(), memberID.Value())
+		}
+		for _, memberID := range detachedMemberIDs {
+			values = append(values, "(?, ?, TRUE)")
 			args = append(args, circle.ID().Value(), memberID.Value())
 		}
 
@@ -153,36 +183,51 @@ func (r *MySQLCircleRepository) Save(circle *domain.Circle) error {
 		}
 	}
 
-	return tx.Commit()
+	// 記録されたイベントを同じトランザクションでアウトボックスに書き込む
+	if err := appendOutboxEvents(tx, circle.PendingEvents()); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	circle.ClearEvents()
+	return nil
 }
 
+// Delete はサークルを物理削除する（関連するデータは外部キーで削除される）
 func (r *MySQLCircleRepository) Delete(id *domain.CircleID) error {
 	query := "DELETE FROM circles WHERE id = ?"
 	_, err := r.db.Exec(query, id.Value())
 	return err
 }
 
-// getMemberIDs はサークルのメンバーIDを取得します
-func (r *MySQLCircleRepository) getMemberIDs(circleID *domain.CircleID) ([]*domain.UserID, error) {
-	query := "SELECT user_id FROM circle_members WHERE circle_id = ?"
+// getMemberIDs はサークルのメンバーIDと、アカウントを論理削除して外したメンバーIDを取得します
+func (r *MySQLCircleRepository) getMemberIDs(circleID *domain.CircleID) ([]*domain.UserID, []*domain.UserID, error) {
+	query := "SELECT user_id, detached FROM circle_members WHERE circle_id = ?"
 	rows, err := r.db.Query(query, circleID.Value())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer rows.Close()
 
-	var memberIDs []*domain.UserID
+	var memberIDs, detachedMemberIDs []*domain.UserID
 	for rows.Next() {
 		var userID string
-		if err := rows.Scan(&userID); err != nil {
-			return nil, err
+		var detached bool
+		if err := rows.Scan(&userID, &detached); err != nil {
+			return nil, nil, err
 		}
 
 		memberID, _ := domain.ReconstructUserID(userID)
-		memberIDs = append(memberIDs, memberID)
+		if detached {
+			detachedMemberIDs = append(detachedMemberIDs, memberID)
+		} else {
+			memberIDs = append(memberIDs, memberID)
+		}
 	}
 
-	return memberIDs, rows.Err()
+	return memberIDs, detachedMemberIDs, rows.Err()
 }
 
 // scanCircles は複数のサークルをスキャンします
@@ -190,27 +235,54 @@ func (r *MySQLCircleRepository) scanCircles(rows *sql.Rows) ([]*domain.Circle, e
 	var circles []*domain.Circle
 
 	for rows.Next() {
-		var circleID, name, ownerID string
-		var createdAt time.Time
-
-		if err := rows.Scan(&circleID, &name, &ownerID, &createdAt); err != nil {
+		circle, err := r.scanCircle(rows)
+		if err != nil {
 			return nil, err
 		}
+		circles = append(circles, circle)
+	}
 
-		// エンティティの再構成
-		reconstructedID, _ := domain.ReconstructCircleID(circleID)
-		circleName, _ := domain.NewCircleName(name)
-		reconstructedOwnerID, _ := domain.ReconstructUserID(ownerID)
+	return circles, rows.Err()
+}
 
-		// メンバーIDを取得
-		memberIDs, err := r.getMemberIDs(reconstructedID)
+// rowScanner は *sql.Row と *sql.Rows の共通インターフェース
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanCircle は1行のサークルをスキャンしてエンティティを再構成します
+func (r *MySQLCircleRepository) scanCircle(row rowScanner) (*domain.Circle, error) {
+	var circleID, name, ownerID string
+	var duesAmount sql.NullInt64
+	var duesCurrency sql.NullString
+	var createdAt time.Time
+	var deletedAt sql.NullTime
+
+	if err := row.Scan(&circleID, &name, &ownerID, &duesAmount, &duesCurrency, &createdAt, &deletedAt); err != nil {
+		return nil, err
+	}
+
+	// エンティティの再構成
+	reconstructedID, _ := domain.ReconstructCircleID(circleID)
+	circleName, _ := domain.NewCircleName(name)
+	reconstructedOwnerID, _ := domain.ReconstructUserID(ownerID)
+
+	var dues *domain.Money
+	if duesAmount.Valid && duesCurrency.Valid {
+		money, err := domain.NewMoney(duesAmount.Int64, duesCurrency.String)
 		if err != nil {
 			return nil, err
 		}
+		dues = money
+	}
 
-		circle := domain.ReconstructCircle(reconstructedID, circleName, reconstructedOwnerID, memberIDs, createdAt)
-		circles = append(circles, circle)
+	// メンバーIDを取得
+	memberIDs, detachedMemberIDs, err := r.getMemberIDs(reconstructedID)
+	if err != nil {
+		return nil, err
 	}
 
-	return circles, rows.Err()
+	return domain.ReconstructCircleWithDeletion(
+		reconstructedID, circleName, reconstructedOwnerID, memberIDs, detachedMemberIDs, dues, createdAt, deletedAt.Time,
+	), nil
 }
@@ -0,0 +1,99 @@
+package infrastructure
+
+import (
+	"database/sql"
+	"ddd-bottomup/domain"
+	"errors"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+type MySQLLedgerRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLLedgerRepository(db *sql.DB) domain.LedgerRepository {
+	return &MySQLLedgerRepository{db: db}
+}
+
+func (r *MySQLLedgerRepository) FindByUserID(userID *domain.UserID) (*domain.Ledger, error) {
+	query := `
+		SELECT id, entry_type, amount, currency, description, reference, occurred_at
+		FROM ledger_entries
+		WHERE user_id = ?
+		ORDER BY occurred_at, seq
+	`
+
+	rows, err := r.db.Query(query, userID.Value())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*domain.LedgerEntry
+	for rows.Next() {
+		var id, entryType, currency, description, reference string
+		var amount int64
+		var occurredAt time.Time
+		if err := rows.Scan(&id, &entryType, &amount, &currency, &description, &reference, &occurredAt); err != nil {
+			return nil, err
+		}
+
+		// エンティティの再構成
+		entryID, err := domain.ReconstructLedgerEntryID(id)
+		if err != nil {
+			return nil, err
+		}
+		parsedType, err := domain.ParseLedgerEntryType(entryType)
+		if err != nil {
+			return nil, err
+		}
+		money, err := domain.NewMoney(amount, currency)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, domain.ReconstructLedgerEntry(entryID, userID, parsedType, money, description, reference, occurredAt))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return domain.ReconstructLedger(userID, entries), nil
+}
+
+// Append は記録を追加するのみで、既存の記録を更新しない
+func (r *MySQLLedgerRepository) Append(entry *domain.LedgerEntry) error {
+	query := `
+		INSERT INTO ledger_entries (id, user_id, entry_type, amount, currency, description, reference, idempotency_key, occurred_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	// 入金・返金と一度きりの請求は一意制約で二重計上を防ぐ（対象外はNULL）
+	var idempotencyKey sql.NullString
+	if key := entry.IdempotencyKey(); key != "" {
+		idempotencyKey = sql.NullString{String: key, Valid: true}
+	}
+
+	_, err := r.db.Exec(query,
+		entry.ID().Value(),
+		entry.UserID().Value(),
+		entry.Type().String(),
+		entry.Amount().Amount(),
+		entry.Amount().Currency(),
+		entry.Description(),
+		entry.Reference(),
+		idempotencyKey,
+		entry.OccurredAt(),
+	)
+	if isDuplicateKeyError(err) {
+		return domain.DuplicateLedgerEntryError{Reference: entry.Reference()}
+	}
+	return err
+}
+
+// isDuplicateKeyError は一意制約違反（ER_DUP_ENTRY）かを判定します
+func isDuplicateKeyError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062
+}
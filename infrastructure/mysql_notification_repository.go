@@ -0,0 +1,200 @@
+package infrastructure
+
+import (
+	"database/sql"
+	"ddd-bottomup/domain"
+	"encoding/json"
+	"time"
+)
+
+type MySQLNotificationPreferencesRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLNotificationPreferencesRepository(db *sql.DB) domain.NotificationPreferencesRepository {
+	return &MySQLNotificationPreferencesRepository{db: db}
+}
+
+func (r *MySQLNotificationPreferencesRepository) FindByUserID(userID *domain.UserID) (*domain.NotificationPreferences, error) {
+	query := `
+		SELECT locale, time_zone, channels, quiet_start_minute, quiet_end_minute, digest_minute, updated_at
+		FROM notification_preferences
+		WHERE user_id = ?
+	`
+
+	var localeValue, timeZoneName string
+	var channelsJSON []byte
+	var quietStart, quietEnd sql.NullInt64
+	var digestMinute int
+	var updatedAt time.Time
+	err := r.db.QueryRow(query, userID.Value()).Scan(
+		&localeValue, &timeZoneName, &channelsJSON, &quietStart, &quietEnd, &digestMinute, &updatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// エンティティの再構成
+	locale, err := domain.ParseLocale(localeValue)
+	if err != nil {
+		return nil, err
+	}
+	timeZone, err := time.LoadLocation(timeZoneName)
+	if err != nil {
+		return nil, err
+	}
+	var stored map[string]string
+	if err := json.Unmarshal(channelsJSON, &stored); err != nil {
+		return nil, err
+	}
+	channels := make(map[domain.NotificationKind]domain.NotificationChannel, len(stored))
+	for kindValue, channelValue := range stored {
+		kind, err := domain.ParseNotificationKind(kindValue)
+		if err != nil {
+			return nil, err
+		}
+		channel, err := domain.ParseNotificationChannel(channelValue)
+		if err != nil {
+			return nil, err
+		}
+		channels[kind] = channel
+	}
+	var quietHours *domain.QuietHours
+	if quietStart.Valid && quietEnd.Valid {
+		quietHours, err = domain.NewQuietHours(
+			domain.ReconstructTimeOfDay(int(quietStart.Int64)),
+			domain.ReconstructTimeOfDay(int(quietEnd.Int64)),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return domain.ReconstructNotificationPreferences(
+		userID, locale, timeZone, channels, quietHours, domain.ReconstructTimeOfDay(digestMinute), updatedAt,
+	), nil
+}
+
+func (r *MySQLNotificationPreferencesRepository) Save(preferences *domain.NotificationPreferences) error {
+	channels := make(map[string]string)
+	for kind, channel := range preferences.Channels() {
+		channels[kind.String()] = channel.String()
+	}
+	channelsJSON, err := json.Marshal(channels)
+	if err != nil {
+		return err
+	}
+	var quietStart, quietEnd sql.NullInt64
+	if quietHours := preferences.QuietHours(); quietHours != nil {
+		quietStart = sql.NullInt64{Int64: int64(quietHours.Start().Minutes()), Valid: true}
+		quietEnd = sql.NullInt64{Int64: int64(quietHours.End().Minutes()), Valid: true}
+	}
+
+	query := `
+		INSERT INTO notification_preferences
+		(user_id, locale, time_zone, channels, quiet_start_minute, quiet_end_minute, digest_minute, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+		locale = VALUES(locale),
+		time_zone = VALUES(time_zone),
+		channels = VALUES(channels),
+		quiet_start_minute = VALUES(quiet_start_minute),
+		quiet_end_minute = VALUES(quiet_end_minute),
+		digest_minute = VALUES(digest_minute),
+		updated_at = VALUES(updated_at)
+	`
+
+	_, err = r.db.Exec(query,
+		preferences.UserID().Value(),
+		preferences.Locale().String(),
+		preferences.TimeZone().String(),
+		channelsJSON,
+		quietStart,
+		quietEnd,
+		preferences.DigestAt().Minutes(),
+		preferences.UpdatedAt())
+	return err
+}
+
+type MySQLPendingNotificationRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLPendingNotificationRepository(db *sql.DB) domain.PendingNotificationRepository {
+	return &MySQLPendingNotificationRepository{db: db}
+}
+
+func (r *MySQLPendingNotificationRepository) FindDue(now time.Time) ([]*domain.PendingNotification, error) {
+	query := `
+		SELECT id, user_id, kind, recipient, subject, body, digest, deliver_after, created_at
+		FROM pending_notifications
+		WHERE sent_at IS NULL AND deliver_after <= ?
+		ORDER BY created_at
+	`
+
+	rows, err := r.db.Query(query, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []*domain.PendingNotification
+	for rows.Next() {
+		var id, userIDValue, kindValue, recipient, subject, body string
+		var digest bool
+		var deliverAfter, createdAt time.Time
+		if err := rows.Scan(&id, &userIDValue, &kindValue, &recipient, &subject, &body,
+			&digest, &deliverAfter, &createdAt); err != nil {
+			return nil, err
+		}
+
+		// エンティティの再構成
+		userID, err := domain.ReconstructUserID(userIDValue)
+		if err != nil {
+			return nil, err
+		}
+		kind, err := domain.ParseNotificationKind(kindValue)
+		if err != nil {
+			return nil, err
+		}
+		to, err := domain.NewEmail(recipient)
+		if err != nil {
+			return nil, err
+		}
+		message, err := domain.NewMailMessage(to, subject, body)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, domain.ReconstructPendingNotification(
+			id, userID, kind, message, digest, deliverAfter, createdAt, time.Time{},
+		))
+	}
+
+	return notifications, rows.Err()
+}
+
+func (r *MySQLPendingNotificationRepository) Save(notification *domain.PendingNotification) error {
+	query := `
+		INSERT INTO pending_notifications
+		(id, user_id, kind, recipient, subject, body, digest, deliver_after, created_at, sent_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+		sent_at = VALUES(sent_at)
+	`
+
+	message := notification.Message()
+	_, err := r.db.Exec(query,
+		notification.ID(),
+		notification.UserID().Value(),
+		notification.Kind().String(),
+		message.To().Value(),
+		message.Subject(),
+		message.Body(),
+		notification.IsDigest(),
+		notification.DeliverAfter(),
+		notification.CreatedAt(),
+		nullTime(notification.SentAt()))
+	return err
+}
@@ -0,0 +1,108 @@
+package infrastructure
+
+import (
+	"database/sql"
+	"ddd-bottomup/domain"
+	"time"
+)
+
+type MySQLOIDCLoginRequestRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLOIDCLoginRequestRepository(db *sql.DB) domain.OIDCLoginRequestRepository {
+	return &MySQLOIDCLoginRequestRepository{db: db}
+}
+
+func (r *MySQLOIDCLoginRequestRepository) FindByState(state string) (*domain.OIDCLoginRequest, error) {
+	query := `
+		SELECT nonce, code_verifier, link_user_id, created_at, expires_at
+		FROM oidc_login_requests
+		WHERE state = ?
+	`
+
+	var nonce, codeVerifier string
+	var linkUserIDValue sql.NullString
+	var createdAt, expiresAt time.Time
+	err := r.db.QueryRow(query, state).Scan(&nonce, &codeVerifier, &linkUserIDValue, &createdAt, &expiresAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var linkUserID *domain.UserID
+	if linkUserIDValue.Valid {
+		linkUserID, err = domain.ReconstructUserID(linkUserIDValue.String)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return domain.ReconstructOIDCLoginRequest(state, nonce, codeVerifier, linkUserID, createdAt, expiresAt), nil
+}
+
+func (r *MySQLOIDCLoginRequestRepository) Save(request *domain.OIDCLoginRequest) error {
+	query := `
+		INSERT INTO oidc_login_requests (state, nonce, code_verifier, link_user_id, created_at, expires_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	var linkUserID sql.NullString
+	if request.LinkUserID() != nil {
+		linkUserID = sql.NullString{String: request.LinkUserID().Value(), Valid: true}
+	}
+	_, err := r.db.Exec(query,
+		request.State(), request.Nonce(), request.CodeVerifier(), linkUserID, request.CreatedAt(), request.ExpiresAt())
+	return err
+}
+
+func (r *MySQLOIDCLoginRequestRepository) Delete(state string) error {
+	_, err := r.db.Exec(`DELETE FROM oidc_login_requests WHERE state = ?`, state)
+	return err
+}
+
+type MySQLExternalIdentityRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLExternalIdentityRepository(db *sql.DB) domain.ExternalIdentityRepository {
+	return &MySQLExternalIdentityRepository{db: db}
+}
+
+func (r *MySQLExternalIdentityRepository) FindBySubject(issuer, subject string) (*domain.ExternalIdentity, error) {
+	query := `
+		SELECT user_id, linked_at
+		FROM external_identities
+		WHERE issuer = ? AND subject = ?
+	`
+
+	var userIDValue string
+	var linkedAt time.Time
+	err := r.db.QueryRow(query, issuer, subject).Scan(&userIDValue, &linkedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := domain.ReconstructUserID(userIDValue)
+	if err != nil {
+		return nil, err
+	}
+	return domain.ReconstructExternalIdentity(issuer, subject, userID, linkedAt), nil
+}
+
+func (r *MySQLExternalIdentityRepository) Save(identity *domain.ExternalIdentity) error {
+	query := `
+		INSERT INTO external_identities (issuer, subject, user_id, linked_at)
+		VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+		user_id = VALUES(user_id),
+		linked_at = VALUES(linked_at)
+	`
+
+	_, err := r.db.Exec(query, identity.Issuer(), identity.Subject(), identity.UserID().Value(), identity.LinkedAt())
+	return err
+}
@@ -0,0 +1,94 @@
+package infrastructure
+
+import (
+	"database/sql"
+	"ddd-bottomup/domain"
+	"strings"
+	"time"
+)
+
+// MySQLOutbox はリレーが読み書きするアウトボックス
+// 集約のリポジトリは appendOutboxEvents で同じトランザクション内に書き込む
+type MySQLOutbox struct {
+	db *sql.DB
+}
+
+func NewMySQLOutbox(db *sql.DB) *MySQLOutbox {
+	return &MySQLOutbox{db: db}
+}
+
+// sqlExecer は *sql.DB と *sql.Tx の共通部分
+type sqlExecer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+// appendOutboxEvents は集約が記録したイベントをアウトボックスに書き込む
+func appendOutboxEvents(exec sqlExecer, events []domain.DomainEvent) error {
+	messages, err := NewOutboxMessages(events)
+	if err != nil {
+		return err
+	}
+	return insertOutboxMessages(exec, messages)
+}
+
+func insertOutboxMessages(exec sqlExecer, messages []*OutboxMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	query := "INSERT INTO outbox (id, event_name, payload, occurred_at, attempts, next_attempt_at) VALUES "
+	values := make([]string, len(messages))
+	args := make([]interface{}, 0, len(messages)*6)
+	for i, message := range messages {
+		values[i] = "(?, ?, ?, ?, ?, ?)"
+		args = append(args, message.ID, message.EventName, message.Payload,
+			message.OccurredAt, message.Attempts, message.NextAttemptAt)
+	}
+
+	_, err := exec.Exec(query+strings.Join(values, ", "), args...)
+	return err
+}
+
+func (o *MySQLOutbox) Append(messages ...*OutboxMessage) error {
+	return insertOutboxMessages(o.db, messages)
+}
+
+func (o *MySQLOutbox) FetchDue(now time.Time, limit int) ([]*OutboxMessage, error) {
+	query := `
+		SELECT id, event_name, payload, occurred_at, attempts, next_attempt_at, last_error
+		FROM outbox
+		WHERE dispatched_at IS NULL AND next_attempt_at <= ?
+		ORDER BY seq
+		LIMIT ?
+	`
+
+	rows, err := o.db.Query(query, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []*OutboxMessage
+	for rows.Next() {
+		var message OutboxMessage
+		if err := rows.Scan(&message.ID, &message.EventName, &message.Payload, &message.OccurredAt,
+			&message.Attempts, &message.NextAttemptAt, &message.LastError); err != nil {
+			return nil, err
+		}
+		messages = append(messages, &message)
+	}
+
+	return messages, rows.Err()
+}
+
+func (o *MySQLOutbox) MarkDispatched(id string, dispatchedAt time.Time) error {
+	_, err := o.db.Exec("UPDATE outbox SET dispatched_at = ? WHERE id = ?", dispatchedAt, id)
+	return err
+}
+
+func (o *MySQLOutbox) MarkFailed(id string, attempts int, nextAttemptAt time.Time, lastError string) error {
+	_, err := o.db.Exec(
+		"UPDATE outbox SET attempts = ?, next_attempt_at = ?, last_error = ? WHERE id = ?",
+		attempts, nextAttemptAt, lastError, id)
+	return err
+}
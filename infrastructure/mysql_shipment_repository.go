@@ -0,0 +1,309 @@
+package infrastructure
+
+import (
+	"database/sql"
+	"ddd-bottomup/domain"
+	"strings"
+	"time"
+)
+
+type MySQLShipmentRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLShipmentRepository(db *sql.DB) domain.ShipmentRepository {
+	return &MySQLShipmentRepository{db: db}
+}
+
+const shipmentColumns = `
+		id, recipient_id, destination_zone, fee_amount, fee_currency,
+		actual_weight_grams, volumetric_weight_grams, chargeable_weight_grams,
+		status, tracking_number, created_at, shipped_at, completed_at
+`
+
+func (r *MySQLShipmentRepository) FindByID(id *domain.ShipmentID) (*domain.Shipment, error) {
+	query := `
+		SELECT ` + shipmentColumns + `
+		FROM shipments
+		WHERE id = ?
+	`
+
+	rows, err := r.db.Query(query, id.Value())
+	if err != nil {
+		return nil, err
+	}
+	shipments, err := r.scanShipments(rows)
+	if err != nil || len(shipments) == 0 {
+		return nil, err
+	}
+	return shipments[0], nil
+}
+
+func (r *MySQLShipmentRepository) FindByRecipientID(recipientID *domain.UserID) ([]*domain.Shipment, error) {
+	query := `
+		SELECT ` + shipmentColumns + `
+		FROM shipments
+		WHERE recipient_id = ?
+		ORDER BY created_at
+	`
+
+	rows, err := r.db.Query(query, recipientID.Value())
+	if err != nil {
+		return nil, err
+	}
+	return r.scanShipments(rows)
+}
+
+// shipmentRow は発送1件分の列（荷物・送料明細は別テーブルから取得する）
+type shipmentRow struct {
+	id, zone, currency, status, trackingNumber    string
+	recipientID                                   sql.NullString // 受取人を物理削除した発送は NULL
+	actualGrams, volumetricGrams, chargeableGrams int64
+	createdAt                                     time.Time
+	shippedAt, completedAt                        sql.NullTime
+}
+
+// scanShipments は発送を読み込んで再構成する
+func (r *MySQLShipmentRepository) scanShipments(rows *sql.Rows) ([]*domain.Shipment, error) {
+	defer rows.Close()
+
+	var scanned []shipmentRow
+	for rows.Next() {
+		var row shipmentRow
+		var feeAmount int64
+		if err := rows.Scan(&row.id, &row.recipientID, &row.zone, &feeAmount, &row.currency,
+			&row.actualGrams, &row.volumetricGrams, &row.chargeableGrams,
+			&row.status, &row.trackingNumber, &row.createdAt, &row.shippedAt, &row.completedAt); err != nil {
+			return nil, err
+		}
+		scanned = append(scanned, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// 荷物と送料明細は発送ごとに取得する
+	shipments := make([]*domain.Shipment, 0, len(scanned))
+	for _, row := range scanned {
+		shipment, err := r.reconstructShipment(row)
+		if err != nil {
+			return nil, err
+		}
+		shipments = append(shipments, shipment)
+	}
+	return shipments, nil
+}
+
+func (r *MySQLShipmentRepository) reconstructShipment(row shipmentRow) (*domain.Shipment, error) {
+	// エンティティの再構成
+	shipmentID, err := domain.ReconstructShipmentID(row.id)
+	if err != nil {
+		return nil, err
+	}
+	var recipient *domain.UserID
+	if row.recipientID.Valid {
+		recipient, err = domain.ReconstructUserID(row.recipientID.String)
+		if err != nil {
+			return nil, err
+		}
+	}
+	status, err := domain.ParseShipmentStatus(row.status)
+	if err != nil {
+		return nil, err
+	}
+	baggage, err := r.findBaggage(shipmentID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := r.findFeeItems(shipmentID, row.currency)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := domain.ReconstructShippingFee(row.zone, row.actualGrams, row.volumetricGrams, row.chargeableGrams, items)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReconstructShipment(
+		shipmentID, recipient, baggage, fee, status, row.trackingNumber,
+		row.createdAt, row.shippedAt.Time, row.completedAt.Time,
+	), nil
+}
+
+// findBaggage は発送の荷物を登録順に取得します
+func (r *MySQLShipmentRepository) findBaggage(shipmentID *domain.ShipmentID) ([]*domain.Baggage, error) {
+	query := `
+		SELECT description, weight_grams, length_cm, width_cm, height_cm
+		FROM shipment_baggage
+		WHERE shipment_id = ?
+		ORDER BY position
+	`
+
+	rows, err := r.db.Query(query, shipmentID.Value())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var baggage []*domain.Baggage
+	for rows.Next() {
+		var description string
+		var weightGrams, lengthCm, widthCm, heightCm int64
+		if err := rows.Scan(&description, &weightGrams, &lengthCm, &widthCm, &heightCm); err != nil {
+			return nil, err
+		}
+
+		dimensions, err := domain.NewDimensions(lengthCm, widthCm, heightCm)
+		if err != nil {
+			return nil, err
+		}
+		item, err := domain.NewBaggage(description, weightGrams, dimensions)
+		if err != nil {
+			return nil, err
+		}
+		baggage = append(baggage, item)
+	}
+
+	return baggage, rows.Err()
+}
+
+// findFeeItems は送料明細を記録順に取得します
+func (r *MySQLShipmentRepository) findFeeItems(shipmentID *domain.ShipmentID, currency string) ([]*domain.ShippingFeeItem, error) {
+	query := `
+		SELECT code, description, amount
+		FROM shipment_fee_items
+		WHERE shipment_id = ?
+		ORDER BY position
+	`
+
+	rows, err := r.db.Query(query, shipmentID.Value())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*domain.ShippingFeeItem
+	for rows.Next() {
+		var code, description string
+		var amount int64
+		if err := rows.Scan(&code, &description, &amount); err != nil {
+			return nil, err
+		}
+
+		itemCode, err := domain.ParseShippingFeeItemCode(code)
+		if err != nil {
+			return nil, err
+		}
+		money, err := domain.NewMoney(amount, currency)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, domain.NewShippingFeeItem(itemCode, description, money))
+	}
+
+	return items, rows.Err()
+}
+
+func (r *MySQLShipmentRepository) Save(shipment *domain.Shipment) error {
+	// トランザクション開始
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// 発送保存（UPSERT）
+	query := `
+		INSERT INTO shipments (` + shipmentColumns + `)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+		destination_zone = VALUES(destination_zone),
+		fee_amount = VALUES(fee_amount),
+		fee_currency = VALUES(fee_currency),
+		actual_weight_grams = VALUES(actual_weight_grams),
+		volumetric_weight_grams = VALUES(volumetric_weight_grams),
+		chargeable_weight_grams = VALUES(chargeable_weight_grams),
+		status = VALUES(status),
+		tracking_number = VALUES(tracking_number),
+		shipped_at = VALUES(shipped_at),
+		completed_at = VALUES(completed_at)
+	`
+
+	var recipientID sql.NullString
+	if shipment.RecipientID() != nil {
+		recipientID = sql.NullString{String: shipment.RecipientID().Value(), Valid: true}
+	}
+	fee := shipment.FeeBreakdown()
+	_, err = tx.Exec(query,
+		shipment.ID().Value(),
+		recipientID,
+		fee.Zone(),
+		fee.Total().Amount(),
+		fee.Total().Currency(),
+		fee.ActualWeightGrams(),
+		fee.VolumetricWeightGrams(),
+		fee.ChargeableWeightGrams(),
+		shipment.Status().String(),
+		shipment.TrackingNumber(),
+		shipment.CreatedAt(),
+		nullTime(shipment.ShippedAt()),
+		nullTime(shipment.CompletedAt()))
+	if err != nil {
+		return err
+	}
+
+	// 既存の荷物を削除して入れ直す
+	_, err = tx.Exec("DELETE FROM shipment_baggage WHERE shipment_id = ?", shipment.ID().Value())
+	if err != nil {
+		return err
+	}
+
+	baggage := shipment.Baggage()
+	if len(baggage) > 0 {
+		baggageQuery := "INSERT INTO shipment_baggage (shipment_id, position, description, weight_grams, length_cm, width_cm, height_cm) VALUES "
+		values := make([]string, len(baggage))
+		args := make([]interface{}, 0, len(baggage)*7)
+
+		for i, item := range baggage {
+			values[i] = "(?, ?, ?, ?, ?, ?, ?)"
+			dimensions := item.Dimensions()
+			args = append(args, shipment.ID().Value(), i, item.Description(), item.WeightGrams(),
+				dimensions.LengthCm(), dimensions.WidthCm(), dimensions.HeightCm())
+		}
+
+		baggageQuery += strings.Join(values, ", ")
+		_, err = tx.Exec(baggageQuery, args...)
+		if err != nil {
+			return err
+		}
+	}
+
+	// 送料明細も同様に入れ直す
+	_, err = tx.Exec("DELETE FROM shipment_fee_items WHERE shipment_id = ?", shipment.ID().Value())
+	if err != nil {
+		return err
+	}
+
+	items := fee.Items()
+	itemQuery := "INSERT INTO shipment_fee_items (shipment_id, position, code, description, amount) VALUES "
+	itemValues := make([]string, len(items))
+	itemArgs := make([]interface{}, 0, len(items)*5)
+	for i, item := range items {
+		itemValues[i] = "(?, ?, ?, ?, ?)"
+		itemArgs = append(itemArgs, shipment.ID().Value(), i, item.Code().String(), item.Description(), item.Amount().Amount())
+	}
+
+	itemQuery += strings.Join(itemValues, ", ")
+	_, err = tx.Exec(itemQuery, itemArgs...)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DetachRecipient は受取人を NULL にする（発送・荷物・送料明細は残す）
+func (r *MySQLShipmentRepository) DetachRecipient(recipientID *domain.UserID) error {
+	_, err := r.db.Exec(`UPDATE shipments SET recipient_id = NULL WHERE recipient_id = ?`, recipientID.Value())
+	return err
+}
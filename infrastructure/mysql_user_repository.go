@@ -3,97 +3,213 @@ package infrastructure
 import (
 	"database/sql"
 	"ddd-bottomup/domain"
+	"time"
 )
 
 type MySQLUserRepository struct {
-	db *sql.DB
+	db    *sql.DB
+	clock domain.Clock
 }
 
-func NewMySQLUserRepository(db *sql.DB) domain.UserRepository {
-	return &MySQLUserRepository{db: db}
+func NewMySQLUserRepository(db *sql.DB, clock domain.Clock) domain.UserRepository {
+	return &MySQLUserRepository{db: db, clock: clock}
 }
 
+const userColumns = `
+		id, first_name, last_name, email, email_verified, pending_email,
+		subscription_plan, subscription_started_at, subscription_expires_at,
+		subscription_trial, subscription_trial_used, subscription_cancelled_at,
+		deleted_at
+`
+
 func (r *MySQLUserRepository) FindByID(id *domain.UserID) (*domain.User, error) {
 	query := `
-		SELECT id, first_name, last_name, email, is_premium 
-		FROM users 
-		WHERE id = ?
+		SELECT ` + userColumns + `
+		FROM users
+		WHERE id = ? AND deleted_at IS NULL
 	`
 
-	var userID, firstName, lastName, email string
-	var isPremium bool
-	err := r.db.QueryRow(query, id.Value()).Scan(&userID, &firstName, &lastName, &email, &isPremium)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil
-		}
-		return nil, err
-	}
+	return r.scanUser(r.db.QueryRow(query, id.Value()))
+}
 
-	// エンティティを再構成
-	reconstructedID, _ := domain.ReconstructUserID(userID)
-	fullName, _ := domain.NewFullName(firstName, lastName)
-	emailValue, _ := domain.NewEmail(email)
-	user := domain.ReconstructUser(reconstructedID, fullName, emailValue, isPremium)
+func (r *MySQLUserRepository) FindByName(name *domain.FullName) (*domain.User, error) {
+	query := `
+		SELECT ` + userColumns + `
+		FROM users
+		WHERE first_name = ? AND last_name = ? AND deleted_at IS NULL
+	`
 
-	return user, nil
+	return r.scanUser(r.db.QueryRow(query, name.FirstName(), name.LastName()))
 }
 
-func (r *MySQLUserRepository) FindByName(name *domain.FullName) (*domain.User, error) {
+func (r *MySQLUserRepository) FindByEmail(email *domain.Email) (*domain.User, error) {
 	query := `
-		SELECT id, first_name, last_name, email, is_premium 
-		FROM users 
-		WHERE first_name = ? AND last_name = ?
+		SELECT ` + userColumns + `
+		FROM users
+		WHERE email = ? AND deleted_at IS NULL
 	`
 
-	var userID, firstName, lastName, email string
-	var isPremium bool
-	err := r.db.QueryRow(query, name.FirstName(), name.LastName()).Scan(&userID, &firstName, &lastName, &email, &isPremium)
+	return r.scanUser(r.db.QueryRow(query, email.Value()))
+}
+
+func (r *MySQLUserRepository) FindDeletedByID(id *domain.UserID) (*domain.User, error) {
+	query := `
+		SELECT ` + userColumns + `
+		FROM users
+		WHERE id = ? AND deleted_at IS NOT NULL
+	`
+
+	return r.scanUser(r.db.QueryRow(query, id.Value()))
+}
+
+func (r *MySQLUserRepository) FindDeletedBefore(before time.Time) ([]*domain.User, error) {
+	query := `
+		SELECT ` + userColumns + `
+		FROM users
+		WHERE deleted_at IS NOT NULL AND deleted_at < ?
+	`
+
+	rows, err := r.db.Query(query, before)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil
-		}
 		return nil, err
 	}
+	defer rows.Close()
 
-	// エンティティを再構成
-	reconstructedID, _ := domain.ReconstructUserID(userID)
-	fullName, _ := domain.NewFullName(firstName, lastName)
-	emailValue, _ := domain.NewEmail(email)
-	user := domain.ReconstructUser(reconstructedID, fullName, emailValue, isPremium)
-
-	return user, nil
+	var users []*domain.User
+	for rows.Next() {
+		user, err := r.scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, rows.Err()
 }
 
 func (r *MySQLUserRepository) Save(user *domain.User) error {
 	query := `
-		INSERT INTO users (id, first_name, last_name, email, is_premium, created_at, updated_at) 
-		VALUES (?, ?, ?, ?, ?, NOW(), NOW())
-		ON DUPLICATE KEY UPDATE 
-		first_name = VALUES(first_name), 
-		last_name = VALUES(last_name), 
+		INSERT INTO users (
+			id, first_name, last_name, email, email_verified, pending_email, is_premium,
+			subscription_plan, subscription_started_at, subscription_expires_at,
+			subscription_trial, subscription_trial_used, subscription_cancelled_at,
+			deleted_at, created_at, updated_at
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())
+		ON DUPLICATE KEY UPDATE
+		first_name = VALUES(first_name),
+		last_name = VALUES(last_name),
 		email = VALUES(email),
+		email_verified = VALUES(email_verified),
+		pending_email = VALUES(pending_email),
 		is_premium = VALUES(is_premium),
+		subscription_plan = VALUES(subscription_plan),
+		subscription_started_at = VALUES(subscription_started_at),
+		subscription_expires_at = VALUES(subscription_expires_at),
+		subscription_trial = VALUES(subscription_trial),
+		subscription_trial_used = VALUES(subscription_trial_used),
+		subscription_cancelled_at = VALUES(subscription_cancelled_at),
+		deleted_at = VALUES(deleted_at),
 		updated_at = NOW()
 	`
 
-	_, err := r.db.Exec(query,
+	// トランザクション開始（イベントをアウトボックスに書き込むため）
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var pendingEmail sql.NullString
+	if user.PendingEmail() != nil {
+		pendingEmail = sql.NullString{String: user.PendingEmail().Value(), Valid: true}
+	}
+
+	subscription := user.Subscription()
+	_, err = tx.Exec(query,
 		user.ID().Value(),
 		user.Name().FirstName(),
 		user.Name().LastName(),
 		user.Email().Value(),
-		user.IsPremium(),
+		user.IsEmailVerified(),
+		pendingEmail,
+		user.IsPremiumAt(r.clock.Now()), // 検索用のスナップショット（判定には契約期限を使う）
+		subscription.Plan().String(),
+		nullTime(subscription.StartedAt()),
+		nullTime(subscription.ExpiresAt()),
+		subscription.IsTrial(),
+		subscription.TrialUsed(),
+		nullTime(subscription.CancelledAt()),
+		nullTime(user.DeletedAt()),
 	)
+	if err != nil {
+		return err
+	}
 
-	return err
+	if err := appendOutboxEvents(tx, user.PendingEvents()); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	user.ClearEvents()
+	return nil
 }
 
+// Delete はユーザーを物理削除する（関連するデータは外部キーで削除される）
 func (r *MySQLUserRepository) Delete(id *domain.UserID) error {
 	query := `DELETE FROM users WHERE id = ?`
 	_, err := r.db.Exec(query, id.Value())
 	return err
 }
 
+// scanUser は1行のユーザーをスキャンしてエンティティを再構成します
+func (r *MySQLUserRepository) scanUser(row rowScanner) (*domain.User, error) {
+	var userID, firstName, lastName, email, plan string
+	var pendingEmail sql.NullString
+	var startedAt, expiresAt, cancelledAt, deletedAt sql.NullTime
+	var emailVerified, isTrial, trialUsed bool
+	err := row.Scan(&userID, &firstName, &lastName, &email, &emailVerified, &pendingEmail,
+		&plan, &startedAt, &expiresAt, &isTrial, &trialUsed, &cancelledAt, &deletedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	subscription, err := domain.ReconstructSubscription(
+		domain.SubscriptionPlan(plan),
+		startedAt.Time,
+		expiresAt.Time,
+		isTrial,
+		trialUsed,
+		cancelledAt.Time,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// エンティティを再構成
+	reconstructedID, _ := domain.ReconstructUserID(userID)
+	fullName, _ := domain.NewFullName(firstName, lastName)
+	emailValue, _ := domain.NewEmail(email)
+	var pendingEmailValue *domain.Email
+	if pendingEmail.Valid {
+		pendingEmailValue, _ = domain.NewEmail(pendingEmail.String)
+	}
+	user := domain.ReconstructUserWithDeletion(
+		reconstructedID, fullName, emailValue, emailVerified, pendingEmailValue, subscription, deletedAt.Time,
+	)
+
+	return user, nil
+}
+
+// nullTime はゼロ値の時刻を NULL として扱います
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
 // テーブル作成用SQL（参考）
 /*
 CREATE TABLE users (
@@ -101,7 +217,16 @@ CREATE TABLE users (
     first_name VARCHAR(50) NOT NULL,
     last_name VARCHAR(50) NOT NULL,
     email VARCHAR(255) NOT NULL,
+    email_verified BOOLEAN NOT NULL DEFAULT FALSE,
+    pending_email VARCHAR(255) NULL,
     is_premium BOOLEAN NOT NULL DEFAULT FALSE,
+    subscription_plan VARCHAR(32) NOT NULL DEFAULT 'free',
+    subscription_started_at DATETIME NULL,
+    subscription_expires_at DATETIME NULL,
+    subscription_trial BOOLEAN NOT NULL DEFAULT FALSE,
+    subscription_trial_used BOOLEAN NOT NULL DEFAULT FALSE,
+    subscription_cancelled_at DATETIME NULL,
+    deleted_at DATETIME(6) NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
 );
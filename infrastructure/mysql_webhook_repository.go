@@ -0,0 +1,232 @@
+package infrastructure
+
+import (
+	"database/sql"
+	"ddd-bottomup/domain"
+	"encoding/json"
+	"time"
+)
+
+type MySQLWebhookRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLWebhookRepository(db *sql.DB) domain.WebhookRepository {
+	return &MySQLWebhookRepository{db: db}
+}
+
+const webhookColumns = `
+		id, circle_id, url, events, secret, created_at
+`
+
+func (r *MySQLWebhookRepository) FindByID(id *domain.WebhookID) (*domain.WebhookSubscription, error) {
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM circle_webhooks
+		WHERE id = ?
+	`
+
+	rows, err := r.db.Query(query, id.Value())
+	if err != nil {
+		return nil, err
+	}
+	webhooks, err := scanWebhooks(rows)
+	if err != nil || len(webhooks) == 0 {
+		return nil, err
+	}
+	return webhooks[0], nil
+}
+
+func (r *MySQLWebhookRepository) FindByCircleID(circleID *domain.CircleID) ([]*domain.WebhookSubscription, error) {
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM circle_webhooks
+		WHERE circle_id = ?
+		ORDER BY created_at
+	`
+
+	rows, err := r.db.Query(query, circleID.Value())
+	if err != nil {
+		return nil, err
+	}
+	return scanWebhooks(rows)
+}
+
+func scanWebhooks(rows *sql.Rows) ([]*domain.WebhookSubscription, error) {
+	defer rows.Close()
+
+	var webhooks []*domain.WebhookSubscription
+	for rows.Next() {
+		var id, circleID, url, secret string
+		var events []byte
+		var createdAt time.Time
+		if err := rows.Scan(&id, &circleID, &url, &events, &secret, &createdAt); err != nil {
+			return nil, err
+		}
+
+		// エンティティの再構成
+		webhookID, err := domain.ReconstructWebhookID(id)
+		if err != nil {
+			return nil, err
+		}
+		circle, err := domain.ReconstructCircleID(circleID)
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		if err := json.Unmarshal(events, &names); err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, domain.ReconstructWebhookSubscription(webhookID, circle, url, names, secret, createdAt))
+	}
+
+	return webhooks, rows.Err()
+}
+
+func (r *MySQLWebhookRepository) Save(webhook *domain.WebhookSubscription) error {
+	events, err := json.Marshal(webhook.Events())
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO circle_webhooks (` + webhookColumns + `)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+		url = VALUES(url),
+		events = VALUES(events)
+	`
+
+	_, err = r.db.Exec(query,
+		webhook.ID().Value(),
+		webhook.CircleID().Value(),
+		webhook.URL(),
+		events,
+		webhook.Secret(),
+		webhook.CreatedAt())
+	return err
+}
+
+type MySQLWebhookDeliveryRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLWebhookDeliveryRepository(db *sql.DB) domain.WebhookDeliveryRepository {
+	return &MySQLWebhookDeliveryRepository{db: db}
+}
+
+const webhookDeliveryColumns = `
+		id, webhook_id, event_name, event_key, payload, status, attempts,
+		response_status, last_error, next_attempt_at, created_at, completed_at
+`
+
+func (r *MySQLWebhookDeliveryRepository) FindByWebhookID(webhookID *domain.WebhookID) ([]*domain.WebhookDelivery, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		WHERE webhook_id = ?
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.Query(query, webhookID.Value())
+	if err != nil {
+		return nil, err
+	}
+	return scanWebhookDeliveries(rows)
+}
+
+func (r *MySQLWebhookDeliveryRepository) FindByEventKey(webhookID *domain.WebhookID, eventKey string) (*domain.WebhookDelivery, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		WHERE webhook_id = ? AND event_key = ?
+	`
+
+	rows, err := r.db.Query(query, webhookID.Value(), eventKey)
+	if err != nil {
+		return nil, err
+	}
+	deliveries, err := scanWebhookDeliveries(rows)
+	if err != nil || len(deliveries) == 0 {
+		return nil, err
+	}
+	return deliveries[0], nil
+}
+
+func (r *MySQLWebhookDeliveryRepository) FindDue(now time.Time, limit int) ([]*domain.WebhookDelivery, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		WHERE status = ? AND next_attempt_at <= ?
+		ORDER BY next_attempt_at
+		LIMIT ?
+	`
+
+	rows, err := r.db.Query(query, domain.WebhookDeliveryPending.String(), now, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanWebhookDeliveries(rows)
+}
+
+func scanWebhookDeliveries(rows *sql.Rows) ([]*domain.WebhookDelivery, error) {
+	defer rows.Close()
+
+	var deliveries []*domain.WebhookDelivery
+	for rows.Next() {
+		var id, webhookID, eventName, eventKey, status, lastError string
+		var payload []byte
+		var attempts, responseStatus int
+		var nextAttemptAt, createdAt time.Time
+		var completedAt sql.NullTime
+		if err := rows.Scan(&id, &webhookID, &eventName, &eventKey, &payload, &status, &attempts,
+			&responseStatus, &lastError, &nextAttemptAt, &createdAt, &completedAt); err != nil {
+			return nil, err
+		}
+
+		// エンティティの再構成
+		webhook, err := domain.ReconstructWebhookID(webhookID)
+		if err != nil {
+			return nil, err
+		}
+		deliveryStatus, err := domain.ParseWebhookDeliveryStatus(status)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, domain.ReconstructWebhookDelivery(
+			id, webhook, eventName, eventKey, payload, deliveryStatus, attempts,
+			responseStatus, lastError, nextAttemptAt, createdAt, completedAt.Time,
+		))
+	}
+
+	return deliveries, rows.Err()
+}
+
+func (r *MySQLWebhookDeliveryRepository) Save(delivery *domain.WebhookDelivery) error {
+	query := `
+		INSERT INTO webhook_deliveries (` + webhookDeliveryColumns + `)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+		status = VALUES(status),
+		attempts = VALUES(attempts),
+		response_status = VALUES(response_status),
+		last_error = VALUES(last_error),
+		next_attempt_at = VALUES(next_attempt_at),
+		completed_at = VALUES(completed_at)
+	`
+
+	_, err := r.db.Exec(query,
+		delivery.ID(),
+		delivery.WebhookID().Value(),
+		delivery.EventName(),
+		delivery.EventKey(),
+		delivery.Payload(),
+		delivery.Status().String(),
+		delivery.Attempts(),
+		delivery.ResponseStatus(),
+		delivery.LastError(),
+		delivery.NextAttemptAt(),
+		delivery.CreatedAt(),
+		nullTime(delivery.CompletedAt()))
+	return err
+}
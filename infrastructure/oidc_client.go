@@ -0,0 +1,363 @@
+package infrastructure
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"ddd-bottomup/domain"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OIDCClientConfig は OpenID プロバイダーに登録したクライアントの設定
+type OIDCClientConfig struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string // 空の場合は公開クライアントとして PKCE だけで認可コードを交換する
+	RedirectURL  string
+	Scopes       []string // 空の場合は openid, email, profile
+}
+
+// OIDCClient は認可コードフロー（PKCE）で OpenID プロバイダーとやり取りする
+// エンドポイントはディスカバリー（/.well-known/openid-configuration）で初回に取得する
+type OIDCClient struct {
+	config     OIDCClientConfig
+	httpClient *http.Client
+
+	mu            sync.Mutex
+	metadata      *oidcProviderMetadata
+	keys          map[string]crypto.PublicKey // キーは kid
+	keysFetchedAt time.Time
+}
+
+// minKeyRefreshInterval は知らない kid のトークンで JWKS を取り直す間隔の下限
+const minKeyRefreshInterval = time.Minute
+
+type oidcProviderMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+func NewOIDCClient(config OIDCClientConfig, httpClient *http.Client) *OIDCClient {
+	if len(config.Scopes) == 0 {
+		config.Scopes = []string{"openid", "email", "profile"}
+	}
+	return &OIDCClient{config: config, httpClient: httpClient}
+}
+
+func (c *OIDCClient) Issuer() string {
+	return c.config.Issuer
+}
+
+func (c *OIDCClient) AuthorizationURL(state, nonce, codeChallenge, loginHint string) (string, error) {
+	metadata, err := c.discover()
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {c.config.ClientID},
+		"redirect_uri":          {c.config.RedirectURL},
+		"scope":                 {strings.Join(c.config.Scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {codeChallenge},
+		"code_challenge_method": {"S256"},
+	}
+	if loginHint != "" {
+		query.Set("login_hint", loginHint)
+	}
+	separator := "?"
+	if strings.Contains(metadata.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return metadata.AuthorizationEndpoint + separator + query.Encode(), nil
+}
+
+type oidcTokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func (c *OIDCClient) ExchangeCode(code, codeVerifier string) (*domain.IDTokenClaims, error) {
+	metadata, err := c.discover()
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {c.config.RedirectURL},
+		"code_verifier": {codeVerifier},
+	}
+	if c.config.ClientSecret == "" {
+		form.Set("client_id", c.config.ClientID)
+	}
+	request, err := http.NewRequest(http.MethodPost, metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+	if c.config.ClientSecret != "" {
+		request.SetBasicAuth(url.QueryEscape(c.config.ClientID), url.QueryEscape(c.config.ClientSecret))
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var token oidcTokenResponse
+	if err := json.NewDecoder(response.Body).Decode(&token); err != nil {
+		return nil, fmt.Errorf("failed to decode token response: %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		// 認可コードの誤り・期限切れ・PKCE の不一致はクライアントの誤りとして扱う
+		if token.Error != "" {
+			return nil, domain.InvalidOIDCLoginError{Reason: "token exchange failed: " + token.Error}
+		}
+		return nil, fmt.Errorf("token endpoint returned status %d", response.StatusCode)
+	}
+	if token.IDToken == "" {
+		return nil, domain.InvalidIDTokenError{Reason: "token response has no ID token"}
+	}
+	return c.verifyIDToken(token.IDToken)
+}
+
+type idTokenHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+}
+
+type idTokenPayload struct {
+	Issuer          string   `json:"iss"`
+	Subject         string   `json:"sub"`
+	Audience        audience `json:"aud"`
+	AuthorizedParty string   `json:"azp"`
+	IssuedAt        int64    `json:"iat"`
+	ExpiresAt       int64    `json:"exp"`
+	Nonce           string   `json:"nonce"`
+	Email           string   `json:"email"`
+	EmailVerified   bool     `json:"email_verified"`
+	GivenName       string   `json:"given_name"`
+	FamilyName      string   `json:"family_name"`
+}
+
+// audience は aud クレームの文字列と配列の両方の形式を受け付ける
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*a = multiple
+	return nil
+}
+
+// verifyIDToken は署名・発行者・対象者を確かめる。期限と nonce はドメインで確かめる
+func (c *OIDCClient) verifyIDToken(raw string) (*domain.IDTokenClaims, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, domain.InvalidIDTokenError{Reason: "malformed token"}
+	}
+	var header idTokenHeader
+	if err := decodeIDTokenSegment(parts[0], &header); err != nil {
+		return nil, err
+	}
+	key, err := c.findKey(header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, domain.InvalidIDTokenError{Reason: "malformed signature"}
+	}
+	if err := verifyIDTokenSignature(header.Algorithm, key, []byte(parts[0]+"."+parts[1]), signature); err != nil {
+		return nil, err
+	}
+
+	var payload idTokenPayload
+	if err := decodeIDTokenSegment(parts[1], &payload); err != nil {
+		return nil, err
+	}
+	if payload.Issuer != c.config.Issuer {
+		return nil, domain.InvalidIDTokenError{Reason: "unexpected issuer"}
+	}
+	if !slices.Contains(payload.Audience, c.config.ClientID) {
+		return nil, domain.InvalidIDTokenError{Reason: "token is not intended for this client"}
+	}
+	if len(payload.Audience) > 1 && payload.AuthorizedParty != c.config.ClientID {
+		return nil, domain.InvalidIDTokenError{Reason: "unexpected authorized party"}
+	}
+
+	return &domain.IDTokenClaims{
+		Issuer:        payload.Issuer,
+		Subject:       payload.Subject,
+		IssuedAt:      time.Unix(payload.IssuedAt, 0),
+		ExpiresAt:     time.Unix(payload.ExpiresAt, 0),
+		Nonce:         payload.Nonce,
+		Email:         payload.Email,
+		EmailVerified: payload.EmailVerified,
+		GivenName:     payload.GivenName,
+		FamilyName:    payload.FamilyName,
+	}, nil
+}
+
+// verifyIDTokenSignature は鍵の種類に合うアルゴリズムの署名だけを受け付ける
+func verifyIDTokenSignature(algorithm string, key crypto.PublicKey, input, signature []byte) error {
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		if algorithm != "RS256" {
+			break
+		}
+		digest := sha256.Sum256(input)
+		if rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) != nil {
+			return domain.InvalidIDTokenError{Reason: "signature mismatch"}
+		}
+		return nil
+	case ed25519.PublicKey:
+		if algorithm != "EdDSA" {
+			break
+		}
+		if !ed25519.Verify(key, input, signature) {
+			return domain.InvalidIDTokenError{Reason: "signature mismatch"}
+		}
+		return nil
+	}
+	return domain.InvalidIDTokenError{Reason: "unsupported algorithm " + algorithm}
+}
+
+func decodeIDTokenSegment(segment string, v any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return domain.InvalidIDTokenError{Reason: "malformed token"}
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return domain.InvalidIDTokenError{Reason: "malformed token"}
+	}
+	return nil
+}
+
+func (c *OIDCClient) discover() (*oidcProviderMetadata, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.metadata != nil {
+		return c.metadata, nil
+	}
+	var metadata oidcProviderMetadata
+	if err := c.getJSON(strings.TrimSuffix(c.config.Issuer, "/")+"/.well-known/openid-configuration", &metadata); err != nil {
+		return nil, fmt.Errorf("OpenID provider discovery failed: %w", err)
+	}
+	if metadata.Issuer != c.config.Issuer {
+		return nil, fmt.Errorf("OpenID provider discovery returned issuer %q, expected %q", metadata.Issuer, c.config.Issuer)
+	}
+	c.metadata = &metadata
+	return c.metadata, nil
+}
+
+// findKey は kid の公開鍵を返す。知らない kid の場合はプロバイダーが鍵を入れ替えたとみなし、JWKS を取り直す
+// 偽のトークンで何度も取り直させられないよう、取り直しは minKeyRefreshInterval に一度まで
+func (c *OIDCClient) findKey(keyID string) (crypto.PublicKey, error) {
+	metadata, err := c.discover()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if key, ok := c.keys[keyID]; ok {
+		return key, nil
+	}
+	if c.keys == nil || time.Since(c.keysFetchedAt) >= minKeyRefreshInterval {
+		keys, err := c.fetchKeys(metadata.JWKSURI)
+		if err != nil {
+			return nil, err
+		}
+		c.keys = keys
+		c.keysFetchedAt = time.Now()
+	}
+	if key, ok := c.keys[keyID]; ok {
+		return key, nil
+	}
+	return nil, domain.InvalidIDTokenError{Reason: "unknown key"}
+}
+
+type jsonWebKeySet struct {
+	Keys []struct {
+		KeyType string `json:"kty"`
+		KeyID   string `json:"kid"`
+		Use     string `json:"use"`
+		N       string `json:"n"`
+		E       string `json:"e"`
+		Curve   string `json:"crv"`
+		X       string `json:"x"`
+	} `json:"keys"`
+}
+
+// fetchKeys は署名用の RSA と Ed25519 の鍵を読み込み、それ以外は無視する
+func (c *OIDCClient) fetchKeys(jwksURI string) (map[string]crypto.PublicKey, error) {
+	var set jsonWebKeySet
+	if err := c.getJSON(jwksURI, &set); err != nil {
+		return nil, fmt.Errorf("failed to fetch OpenID provider keys: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey)
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		switch {
+		case jwk.KeyType == "RSA":
+			n, errN := base64.RawURLEncoding.DecodeString(jwk.N)
+			e, errE := base64.RawURLEncoding.DecodeString(jwk.E)
+			if errN != nil || errE != nil {
+				continue
+			}
+			keys[jwk.KeyID] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		case jwk.KeyType == "OKP" && jwk.Curve == "Ed25519":
+			x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+			if err != nil || len(x) != ed25519.PublicKeySize {
+				continue
+			}
+			keys[jwk.KeyID] = ed25519.PublicKey(x)
+		}
+	}
+	return keys, nil
+}
+
+func (c *OIDCClient) getJSON(url string, v any) error {
+	response, err := c.httpClient.Get(url)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s returned status %d", url, response.StatusCode)
+	}
+	return json.NewDecoder(response.Body).Decode(v)
+}
@@ -0,0 +1,188 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+const (
+	testOIDCIssuer      = "https://idp.example.test"
+	testOIDCClientID    = "ddd-bottomup"
+	testOIDCRedirectURL = "https://app.example.test/auth/oidc/callback"
+)
+
+var testOIDCNow = time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)
+
+func newTestOIDCPair(t *testing.T) (*FakeOIDCProvider, *OIDCClient) {
+	t.Helper()
+
+	provider, err := NewFakeOIDCProvider(testOIDCIssuer, testOIDCClientID, "client-secret", domain.NewFixedClock(testOIDCNow))
+	if err != nil {
+		t.Fatalf("Failed to create provider: %v", err)
+	}
+	provider.AddUser(FakeOIDCUser{
+		Subject: "sso-001", Email: "taro@example.com", EmailVerified: true, GivenName: "太郎", FamilyName: "山田",
+	})
+	client := NewOIDCClient(OIDCClientConfig{
+		Issuer:       testOIDCIssuer,
+		ClientID:     testOIDCClientID,
+		ClientSecret: "client-secret",
+		RedirectURL:  testOIDCRedirectURL,
+	}, provider.HTTPClient())
+	return provider, client
+}
+
+// authorizeForTest は認可エンドポイントにアクセスし、リダイレクト先のクエリを返す
+func authorizeForTest(t *testing.T, provider *FakeOIDCProvider, authorizationURL string) url.Values {
+	t.Helper()
+
+	response, err := provider.HTTPClient().Get(authorizationURL)
+	if err != nil {
+		t.Fatalf("Failed to authorize: %v", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusFound {
+		t.Fatalf("Expected a redirect, but got status %d", response.StatusCode)
+	}
+	location, err := url.Parse(response.Header.Get("Location"))
+	if err != nil {
+		t.Fatalf("Invalid redirect: %v", err)
+	}
+	return location.Query()
+}
+
+func TestOIDCClient_ExchangeCode_AuthorizationCodeWithPKCE(t *testing.T) {
+	// Arrange
+	provider, client := newTestOIDCPair(t)
+	verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	authorizationURL, err := client.AuthorizationURL("state-1", "nonce-1", domain.PKCEChallenge(verifier), "")
+	if err != nil {
+		t.Fatalf("Failed to build authorization URL: %v", err)
+	}
+	callback := authorizeForTest(t, provider, authorizationURL)
+
+	// Act
+	claims, err := client.ExchangeCode(callback.Get("code"), verifier)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if callback.Get("state") != "state-1" {
+		t.Errorf("Expected state to be returned, but got %q", callback.Get("state"))
+	}
+	if claims.Issuer != testOIDCIssuer || claims.Subject != "sso-001" || claims.Nonce != "nonce-1" ||
+		claims.Email != "taro@example.com" || !claims.EmailVerified || claims.GivenName != "太郎" || claims.FamilyName != "山田" {
+		t.Errorf("Unexpected claims: %+v", claims)
+	}
+	if !claims.ExpiresAt.Equal(testOIDCNow.Add(fakeIDTokenTTL)) {
+		t.Errorf("Expected expiry %v, but got %v", testOIDCNow.Add(fakeIDTokenTTL), claims.ExpiresAt)
+	}
+}
+
+func TestOIDCClient_ExchangeCode_RejectsInvalidGrants(t *testing.T) {
+	tests := []struct {
+		name     string
+		verifier string
+		reuse    bool
+	}{
+		{"code_verifier の不一致", "another-verifier", false},
+		{"認可コードの再利用", "verifier", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			provider, client := newTestOIDCPair(t)
+			authorizationURL, _ := client.AuthorizationURL("state", "nonce", domain.PKCEChallenge("verifier"), "taro@example.com")
+			code := authorizeForTest(t, provider, authorizationURL).Get("code")
+			if tt.reuse {
+				if _, err := client.ExchangeCode(code, "verifier"); err != nil {
+					t.Fatalf("Failed to exchange code: %v", err)
+				}
+			}
+
+			// Act
+			_, err := client.ExchangeCode(code, tt.verifier)
+
+			// Assert
+			var invalid domain.InvalidOIDCLoginError
+			if !errors.As(err, &invalid) {
+				t.Errorf("Expected InvalidOIDCLoginError, but got %v", err)
+			}
+		})
+	}
+}
+
+func TestOIDCClient_VerifyIDToken_RejectsInvalidTokens(t *testing.T) {
+	provider, client := newTestOIDCPair(t)
+	claims := func(overrides map[string]any) map[string]any {
+		values := map[string]any{
+			"iss": testOIDCIssuer, "sub": "sso-001", "aud": testOIDCClientID,
+			"iat": testOIDCNow.Unix(), "exp": testOIDCNow.Add(time.Minute).Unix(), "nonce": "nonce",
+		}
+		for key, value := range overrides {
+			values[key] = value
+		}
+		return values
+	}
+	sign := func(values map[string]any) string {
+		token, err := provider.signIDToken(values)
+		if err != nil {
+			t.Fatalf("Failed to sign token: %v", err)
+		}
+		return token
+	}
+	valid := sign(claims(nil))
+	otherProvider, _ := NewFakeOIDCProvider(testOIDCIssuer, testOIDCClientID, "", domain.NewFixedClock(testOIDCNow))
+	signedByOther, _ := otherProvider.signIDToken(claims(nil))
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"別の発行者", sign(claims(map[string]any{"iss": "https://evil.example.test"}))},
+		{"別のクライアント向け", sign(claims(map[string]any{"aud": "other-client"}))},
+		{"複数の対象者で azp が異なる", sign(claims(map[string]any{"aud": []string{testOIDCClientID, "other"}, "azp": "other"}))},
+		{"署名の改ざん", tamperSignature(valid)},
+		{"別の鍵で署名", signedByOther},
+		{"不正な形式", "not-a-token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := client.verifyIDToken(tt.token)
+
+			// Assert
+			var invalid domain.InvalidIDTokenError
+			if !errors.As(err, &invalid) {
+				t.Errorf("Expected InvalidIDTokenError, but got %v", err)
+			}
+		})
+	}
+
+	t.Run("複数の対象者で azp が一致", func(t *testing.T) {
+		// Act
+		_, err := client.verifyIDToken(sign(claims(map[string]any{"aud": []string{testOIDCClientID, "other"}, "azp": testOIDCClientID})))
+
+		// Assert
+		if err != nil {
+			t.Errorf("Expected no error, but got: %v", err)
+		}
+	})
+}
+
+// tamperSignature は署名の途中の1文字を別の文字に置き換える
+func tamperSignature(token string) string {
+	i := len(token) - 10
+	replacement := "A"
+	if token[i] == 'A' {
+		replacement = "B"
+	}
+	return token[:i] + replacement + token[i+1:]
+}
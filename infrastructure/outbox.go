@@ -0,0 +1,270 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxMessage はアウトボックスに保存された配信待ちのドメインイベント
+type OutboxMessage struct {
+	ID            string
+	EventName     string
+	Payload       []byte // イベントのJSON
+	OccurredAt    time.Time
+	Attempts      int
+	NextAttemptAt time.Time // この時刻以降に配信を試みる
+	DispatchedAt  time.Time // ゼロ値は未配信
+	LastError     string
+}
+
+func (m *OutboxMessage) IsDispatched() bool {
+	return !m.DispatchedAt.IsZero()
+}
+
+// OutboxStore はアウトボックスの保存先
+// 集約と同じトランザクションで Append し、リレーが配信済みに更新する
+type OutboxStore interface {
+	Append(messages ...*OutboxMessage) error
+	FetchDue(now time.Time, limit int) ([]*OutboxMessage, error)
+	MarkDispatched(id string, dispatchedAt time.Time) error
+	MarkFailed(id string, attempts int, nextAttemptAt time.Time, lastError string) error
+}
+
+// NewOutboxMessages はドメインイベントを即時配信対象のメッセージに変換する
+func NewOutboxMessages(events []domain.DomainEvent) ([]*OutboxMessage, error) {
+	messages := make([]*OutboxMessage, 0, len(events))
+	for _, event := range events {
+		payload, err := encodeDomainEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, &OutboxMessage{
+			ID:            uuid.New().String(),
+			EventName:     event.EventName(),
+			Payload:       payload,
+			OccurredAt:    event.OccurredAt(),
+			NextAttemptAt: event.OccurredAt(),
+		})
+	}
+	return messages, nil
+}
+
+// イベントのJSON表現
+type fullNamePayload struct {
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+}
+
+type userRegisteredPayload struct {
+	UserID string          `json:"userId"`
+	Name   fullNamePayload `json:"name"`
+	Email  string          `json:"email"`
+}
+
+type userRenamedPayload struct {
+	UserID  string          `json:"userId"`
+	OldName fullNamePayload `json:"oldName"`
+	NewName fullNamePayload `json:"newName"`
+}
+
+type userEmailChangedPayload struct {
+	UserID   string `json:"userId"`
+	OldEmail string `json:"oldEmail"`
+	NewEmail string `json:"newEmail"`
+}
+
+type userEmailPayload struct {
+	UserID string `json:"userId"`
+	Email  string `json:"email"`
+}
+
+type circleCreatedPayload struct {
+	CircleID string `json:"circleId"`
+	Name     string `json:"name"`
+	OwnerID  string `json:"ownerId"`
+}
+
+type circleMembershipPayload struct {
+	CircleID string `json:"circleId"`
+	UserID   string `json:"userId"`
+}
+
+type circleFilledPayload struct {
+	CircleID        string `json:"circleId"`
+	OwnerID         string `json:"ownerId"`
+	MaxParticipants int    `json:"maxParticipants"`
+}
+
+func newFullNamePayload(name *domain.FullName) fullNamePayload {
+	return fullNamePayload{FirstName: name.FirstName(), LastName: name.LastName()}
+}
+
+func (p fullNamePayload) fullName() (*domain.FullName, error) {
+	return domain.NewFullName(p.FirstName, p.LastName)
+}
+
+func encodeDomainEvent(event domain.DomainEvent) ([]byte, error) {
+	var payload interface{}
+	switch e := event.(type) {
+	case domain.UserRegistered:
+		payload = userRegisteredPayload{UserID: e.UserID.Value(), Name: newFullNamePayload(e.Name), Email: e.Email.Value()}
+	case domain.UserRenamed:
+		payload = userRenamedPayload{UserID: e.UserID.Value(), OldName: newFullNamePayload(e.OldName), NewName: newFullNamePayload(e.NewName)}
+	case domain.UserEmailChanged:
+		payload = userEmailChangedPayload{UserID: e.UserID.Value(), OldEmail: e.OldEmail.Value(), NewEmail: e.NewEmail.Value()}
+	case domain.EmailVerificationRequested:
+		payload = userEmailPayload{UserID: e.UserID.Value(), Email: e.Email.Value()}
+	case domain.UserEmailVerified:
+		payload = userEmailPayload{UserID: e.UserID.Value(), Email: e.Email.Value()}
+	case domain.CircleCreated:
+		payload = circleCreatedPayload{CircleID: e.CircleID.Value(), Name: e.Name.Value(), OwnerID: e.OwnerID.Value()}
+	case domain.MemberJoined:
+		payload = circleMembershipPayload{CircleID: e.CircleID.Value(), UserID: e.UserID.Value()}
+	case domain.MemberLeft:
+		payload = circleMembershipPayload{CircleID: e.CircleID.Value(), UserID: e.UserID.Value()}
+	case domain.CircleFilled:
+		payload = circleFilledPayload{CircleID: e.CircleID.Value(), OwnerID: e.OwnerID.Value(), MaxParticipants: e.MaxParticipants}
+	default:
+		return nil, errors.New("unsupported domain event: " + event.EventName())
+	}
+	return json.Marshal(payload)
+}
+
+// DecodeOutboxMessage はメッセージをドメインイベントに戻す
+func DecodeOutboxMessage(message *OutboxMessage) (domain.DomainEvent, error) {
+	at := message.OccurredAt
+	switch message.EventName {
+	case domain.UserRegistered{}.EventName():
+		var p userRegisteredPayload
+		if err := json.Unmarshal(message.Payload, &p); err != nil {
+			return nil, err
+		}
+		userID, err := domain.ReconstructUserID(p.UserID)
+		if err != nil {
+			return nil, err
+		}
+		name, err := p.Name.fullName()
+		if err != nil {
+			return nil, err
+		}
+		email, err := domain.NewEmail(p.Email)
+		if err != nil {
+			return nil, err
+		}
+		return domain.NewUserRegistered(userID, name, email, at), nil
+
+	case domain.UserRenamed{}.EventName():
+		var p userRenamedPayload
+		if err := json.Unmarshal(message.Payload, &p); err != nil {
+			return nil, err
+		}
+		userID, err := domain.ReconstructUserID(p.UserID)
+		if err != nil {
+			return nil, err
+		}
+		oldName, err := p.OldName.fullName()
+		if err != nil {
+			return nil, err
+		}
+		newName, err := p.NewName.fullName()
+		if err != nil {
+			return nil, err
+		}
+		return domain.NewUserRenamed(userID, oldName, newName, at), nil
+
+	case domain.UserEmailChanged{}.EventName():
+		var p userEmailChangedPayload
+		if err := json.Unmarshal(message.Payload, &p); err != nil {
+			return nil, err
+		}
+		userID, err := domain.ReconstructUserID(p.UserID)
+		if err != nil {
+			return nil, err
+		}
+		oldEmail, err := domain.NewEmail(p.OldEmail)
+		if err != nil {
+			return nil, err
+		}
+		newEmail, err := domain.NewEmail(p.NewEmail)
+		if err != nil {
+			return nil, err
+		}
+		return domain.NewUserEmailChanged(userID, oldEmail, newEmail, at), nil
+
+	case domain.EmailVerificationRequested{}.EventName(), domain.UserEmailVerified{}.EventName():
+		var p userEmailPayload
+		if err := json.Unmarshal(message.Payload, &p); err != nil {
+			return nil, err
+		}
+		userID, err := domain.ReconstructUserID(p.UserID)
+		if err != nil {
+			return nil, err
+		}
+		email, err := domain.NewEmail(p.Email)
+		if err != nil {
+			return nil, err
+		}
+		if message.EventName == (domain.UserEmailVerified{}).EventName() {
+			return domain.NewUserEmailVerified(userID, email, at), nil
+		}
+		return domain.NewEmailVerificationRequested(userID, email, at), nil
+
+	case domain.CircleCreated{}.EventName():
+		var p circleCreatedPayload
+		if err := json.Unmarshal(message.Payload, &p); err != nil {
+			return nil, err
+		}
+		circleID, err := domain.ReconstructCircleID(p.CircleID)
+		if err != nil {
+			return nil, err
+		}
+		name, err := domain.NewCircleName(p.Name)
+		if err != nil {
+			return nil, err
+		}
+		ownerID, err := domain.ReconstructUserID(p.OwnerID)
+		if err != nil {
+			return nil, err
+		}
+		return domain.NewCircleCreated(circleID, name, ownerID, at), nil
+
+	case domain.MemberJoined{}.EventName(), domain.MemberLeft{}.EventName():
+		var p circleMembershipPayload
+		if err := json.Unmarshal(message.Payload, &p); err != nil {
+			return nil, err
+		}
+		circleID, err := domain.ReconstructCircleID(p.CircleID)
+		if err != nil {
+			return nil, err
+		}
+		userID, err := domain.ReconstructUserID(p.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if message.EventName == (domain.MemberLeft{}).EventName() {
+			return domain.NewMemberLeft(circleID, userID, at), nil
+		}
+		return domain.NewMemberJoined(circleID, userID, at), nil
+
+	case domain.CircleFilled{}.EventName():
+		var p circleFilledPayload
+		if err := json.Unmarshal(message.Payload, &p); err != nil {
+			return nil, err
+		}
+		circleID, err := domain.ReconstructCircleID(p.CircleID)
+		if err != nil {
+			return nil, err
+		}
+		ownerID, err := domain.ReconstructUserID(p.OwnerID)
+		if err != nil {
+			return nil, err
+		}
+		return domain.NewCircleFilled(circleID, ownerID, p.MaxParticipants, at), nil
+	}
+
+	return nil, errors.New("unknown outbox event: " + message.EventName)
+}
@@ -0,0 +1,81 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+const (
+	defaultOutboxBatchSize   = 100
+	defaultOutboxBaseBackoff = time.Second
+	defaultOutboxMaxBackoff  = 5 * time.Minute
+)
+
+// OutboxRelay はアウトボックスの未配信メッセージを配信し、配信済みに更新する
+// 配信後・更新前に停止した場合は再配信されるため、配信は少なくとも1回（at-least-once）
+type OutboxRelay struct {
+	store       OutboxStore
+	publisher   domain.EventPublisher
+	clock       domain.Clock
+	batchSize   int
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+}
+
+func NewOutboxRelay(store OutboxStore, publisher domain.EventPublisher, clock domain.Clock) *OutboxRelay {
+	return &OutboxRelay{
+		store:       store,
+		publisher:   publisher,
+		clock:       clock,
+		batchSize:   defaultOutboxBatchSize,
+		baseBackoff: defaultOutboxBaseBackoff,
+		maxBackoff:  defaultOutboxMaxBackoff,
+	}
+}
+
+// RunOnce は配信時刻を過ぎたメッセージを1回分配信し、配信できた件数を返す
+// 配信に失敗したメッセージは指数バックオフで次回の配信時刻を遅らせる
+func (r *OutboxRelay) RunOnce() (int, error) {
+	now := r.clock.Now()
+	messages, err := r.store.FetchDue(now, r.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	dispatched := 0
+	for _, message := range messages {
+		if err := r.publish(message); err != nil {
+			attempts := message.Attempts + 1
+			if err := r.store.MarkFailed(message.ID, attempts, now.Add(r.backoff(attempts)), err.Error()); err != nil {
+				return dispatched, err
+			}
+			continue
+		}
+
+		if err := r.store.MarkDispatched(message.ID, now); err != nil {
+			return dispatched, err
+		}
+		dispatched++
+	}
+	return dispatched, nil
+}
+
+func (r *OutboxRelay) publish(message *OutboxMessage) error {
+	event, err := DecodeOutboxMessage(message)
+	if err != nil {
+		return err
+	}
+	return r.publisher.Publish(event)
+}
+
+// backoff は失敗回数に応じた待ち時間（1, 2, 4, ... 秒、上限あり）
+func (r *OutboxRelay) backoff(attempts int) time.Duration {
+	delay := r.baseBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= r.maxBackoff {
+			return r.maxBackoff
+		}
+	}
+	return delay
+}
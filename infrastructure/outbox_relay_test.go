@@ -0,0 +1,233 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"errors"
+	"testing"
+	"time"
+)
+
+var testOutboxNow = time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)
+
+// crashingOutbox は配信後・配信済みへの更新前にプロセスが停止した状況を再現する
+type crashingOutbox struct {
+	*MemoryOutbox
+	crashes int
+}
+
+func (o *crashingOutbox) MarkDispatched(id string, dispatchedAt time.Time) error {
+	if o.crashes > 0 {
+		o.crashes--
+		return errors.New("process crashed")
+	}
+	return o.MemoryOutbox.MarkDispatched(id, dispatchedAt)
+}
+
+type failingUserRepository struct {
+	domain.UserRepository
+}
+
+func (failingUserRepository) Save(*domain.User) error {
+	return errors.New("save failed")
+}
+
+func saveUserWithOutbox(t *testing.T, outbox OutboxStore) *domain.User {
+	t.Helper()
+
+	user := newTestUser(t)
+	if err := NewOutboxUserRepository(NewMemoryUserRepository(), outbox).Save(user); err != nil {
+		t.Fatalf("Failed to save user: %v", err)
+	}
+	return user
+}
+
+func TestDecodeOutboxMessage_RoundTrip(t *testing.T) {
+	userID, circleID := domain.NewUserID(), domain.NewCircleID()
+	oldName, _ := domain.NewFullName("太郎", "山田")
+	newName, _ := domain.NewFullName("花子", "山田")
+	oldEmail, _ := domain.NewEmail("taro@example.com")
+	newEmail, _ := domain.NewEmail("hanako@example.com")
+	circleName, _ := domain.NewCircleName("テニス部")
+
+	tests := []struct {
+		name  string
+		event domain.DomainEvent
+	}{
+		{"ユーザー登録", domain.NewUserRegistered(userID, oldName, oldEmail, testOutboxNow)},
+		{"名前の変更", domain.NewUserRenamed(userID, oldName, newName, testOutboxNow)},
+		{"メールアドレスの変更", domain.NewUserEmailChanged(userID, oldEmail, newEmail, testOutboxNow)},
+		{"メールアドレスの確認依頼", domain.NewEmailVerificationRequested(userID, newEmail, testOutboxNow)},
+		{"メールアドレスの確認", domain.NewUserEmailVerified(userID, newEmail, testOutboxNow)},
+		{"サークル作成", domain.NewCircleCreated(circleID, circleName, userID, testOutboxNow)},
+		{"メンバー参加", domain.NewMemberJoined(circleID, userID, testOutboxNow)},
+		{"メンバー脱退", domain.NewMemberLeft(circleID, userID, testOutboxNow)},
+		{"定員到達", domain.NewCircleFilled(circleID, userID, 30, testOutboxNow)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			messages, err := NewOutboxMessages([]domain.DomainEvent{tt.event})
+			if err != nil {
+				t.Fatalf("Failed to encode event: %v", err)
+			}
+
+			// Act
+			decoded, err := DecodeOutboxMessage(messages[0])
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			// 値オブジェクトのポインタを含むため、再エンコードした内容で比較する
+			reencoded, err := NewOutboxMessages([]domain.DomainEvent{decoded})
+			if err != nil {
+				t.Fatalf("Failed to re-encode event: %v", err)
+			}
+			if decoded.EventName() != tt.event.EventName() || !decoded.OccurredAt().Equal(tt.event.OccurredAt()) ||
+				string(reencoded[0].Payload) != string(messages[0].Payload) {
+				t.Errorf("Expected %s, but got %s", messages[0].Payload, reencoded[0].Payload)
+			}
+		})
+	}
+}
+
+func TestOutboxRelay_RunOnce_DeliversOnce(t *testing.T) {
+	// Arrange
+	outbox := NewMemoryOutbox()
+	user := saveUserWithOutbox(t, outbox)
+	bus := NewInProcessEventBus()
+	var received []domain.UserRegistered
+	Subscribe(bus, func(event domain.UserRegistered) error {
+		received = append(received, event)
+		return nil
+	})
+	relay := NewOutboxRelay(outbox, bus, domain.NewFixedClock(time.Now().Add(time.Minute)))
+
+	// Act
+	first, err := relay.RunOnce()
+	second, _ := relay.RunOnce()
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	// 登録とメールアドレスの確認依頼の2件
+	if first != 2 || second != 0 {
+		t.Errorf("Expected 2 then 0 dispatched, but got %d then %d", first, second)
+	}
+	if len(received) != 1 || !received[0].UserID.Equals(user.ID()) || !received[0].Email.Equals(user.Email()) {
+		t.Errorf("Expected UserRegistered for %s, but got %+v", user.ID(), received)
+	}
+	if len(user.PendingEvents()) != 0 {
+		t.Error("Expected pending events to be cleared after save")
+	}
+}
+
+func TestOutboxRelay_RunOnce_RetriesWithBackoff(t *testing.T) {
+	// Arrange
+	outbox := NewMemoryOutbox()
+	messages, _ := NewOutboxMessages([]domain.DomainEvent{
+		domain.NewMemberJoined(domain.NewCircleID(), domain.NewUserID(), testOutboxNow),
+	})
+	outbox.Append(messages...)
+	bus := NewInProcessEventBus()
+	failures := 2
+	Subscribe(bus, func(domain.MemberJoined) error {
+		if failures > 0 {
+			failures--
+			return errors.New("subscriber unavailable")
+		}
+		return nil
+	})
+	clock := domain.NewFixedClock(testOutboxNow)
+	relay := NewOutboxRelay(outbox, bus, clock)
+
+	steps := []struct {
+		name               string
+		advance            time.Duration
+		expectedDispatched int
+		expectedAttempts   int
+		expectedNext       time.Duration // testOutboxNow からの経過
+	}{
+		{"1回目は失敗して1秒後に再試行", 0, 0, 1, time.Second},
+		{"再試行時刻前は配信しない", 500 * time.Millisecond, 0, 1, time.Second},
+		{"2回目も失敗して2秒後に再試行", 500 * time.Millisecond, 0, 2, 3 * time.Second},
+		{"3回目で配信できる", 2 * time.Second, 1, 2, 3 * time.Second},
+	}
+
+	for _, step := range steps {
+		// Act
+		clock.Advance(step.advance)
+		dispatched, err := relay.RunOnce()
+
+		// Assert
+		if err != nil {
+			t.Fatalf("%s: expected no error, but got: %v", step.name, err)
+		}
+		message := outbox.Messages()[0]
+		if dispatched != step.expectedDispatched || message.Attempts != step.expectedAttempts {
+			t.Errorf("%s: expected %d dispatched / %d attempts, but got %d / %d",
+				step.name, step.expectedDispatched, step.expectedAttempts, dispatched, message.Attempts)
+		}
+		if !message.NextAttemptAt.Equal(testOutboxNow.Add(step.expectedNext)) {
+			t.Errorf("%s: expected next attempt at +%v, but got %v", step.name, step.expectedNext, message.NextAttemptAt)
+		}
+	}
+	if !outbox.Messages()[0].IsDispatched() {
+		t.Error("Expected the message to be dispatched")
+	}
+}
+
+func TestOutboxRelay_CrashBeforeMarking_RedeliversAtLeastOnce(t *testing.T) {
+	// Arrange
+	outbox := &crashingOutbox{MemoryOutbox: NewMemoryOutbox(), crashes: 1}
+	saveUserWithOutbox(t, outbox)
+	bus := NewInProcessEventBus()
+	deliveries := 0
+	Subscribe(bus, func(domain.UserRegistered) error {
+		deliveries++
+		return nil
+	})
+	clock := domain.NewFixedClock(time.Now().Add(time.Minute))
+
+	// Act
+	_, crashErr := NewOutboxRelay(outbox, bus, clock).RunOnce() // 配信後に停止
+	restarted, err := NewOutboxRelay(outbox, bus, clock).RunOnce()
+
+	// Assert
+	if crashErr == nil {
+		t.Fatal("Expected the simulated crash to surface as an error")
+	}
+	if err != nil {
+		t.Fatalf("Expected no error after restart, but got: %v", err)
+	}
+	// 再起動後は配信済みにできなかった登録と、未配信の確認依頼を配信する
+	if deliveries != 2 || restarted != 2 {
+		t.Errorf("Expected the event to be redelivered after restart (2 deliveries), but got %d", deliveries)
+	}
+	if !outbox.Messages()[0].IsDispatched() {
+		t.Error("Expected the message to be dispatched after restart")
+	}
+}
+
+func TestOutboxUserRepository_Save_FailureKeepsEventsPending(t *testing.T) {
+	// Arrange
+	outbox := NewMemoryOutbox()
+	repo := NewOutboxUserRepository(failingUserRepository{}, outbox)
+	user := newTestUser(t)
+
+	// Act
+	err := repo.Save(user)
+
+	// Assert
+	if err == nil {
+		t.Fatal("Expected error, but got none")
+	}
+	if len(outbox.Messages()) != 0 {
+		t.Errorf("Expected no outbox messages, but got %d", len(outbox.Messages()))
+	}
+	if len(user.PendingEvents()) != 2 {
+		t.Errorf("Expected events to remain pending, but got %d", len(user.PendingEvents()))
+	}
+}
@@ -0,0 +1,56 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+)
+
+// メモリのリポジトリにアウトボックスへの書き込みを加えるデコレーター
+// MySQLのリポジトリは同じトランザクション内で書き込むため不要
+
+type OutboxUserRepository struct {
+	domain.UserRepository
+	outbox OutboxStore
+}
+
+func NewOutboxUserRepository(inner domain.UserRepository, outbox OutboxStore) domain.UserRepository {
+	return &OutboxUserRepository{UserRepository: inner, outbox: outbox}
+}
+
+func (r *OutboxUserRepository) Save(user *domain.User) error {
+	messages, err := NewOutboxMessages(user.PendingEvents())
+	if err != nil {
+		return err
+	}
+	if err := r.UserRepository.Save(user); err != nil {
+		return err
+	}
+	if err := r.outbox.Append(messages...); err != nil {
+		return err
+	}
+	user.ClearEvents()
+	return nil
+}
+
+type OutboxCircleRepository struct {
+	domain.CircleRepository
+	outbox OutboxStore
+}
+
+func NewOutboxCircleRepository(inner domain.CircleRepository, outbox OutboxStore) domain.CircleRepository {
+	return &OutboxCircleRepository{CircleRepository: inner, outbox: outbox}
+}
+
+func (r *OutboxCircleRepository) Save(circle *domain.Circle) error {
+	messages, err := NewOutboxMessages(circle.PendingEvents())
+	if err != nil {
+		return err
+	}
+	if err := r.CircleRepository.Save(circle); err != nil {
+		return err
+	}
+	if err := r.outbox.Append(messages...); err != nil {
+		return err
+	}
+	circle.ClearEvents()
+	return nil
+}
@@ -0,0 +1,96 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// ShippingRateConfig は送料の料金表ファイル（JSON）の構造
+// 金額は currency の最小単位で指定する
+//
+//	{
+//	  "currency": "JPY",
+//	  "volumetricDivisor": 5000,
+//	  "premiumDiscountPercent": 10,
+//	  "zones": [
+//	    {"zone": "domestic", "brackets": [{"maxGrams": 1000, "fee": 700}], "overweightFeePerKg": 150}
+//	  ]
+//	}
+type ShippingRateConfig struct {
+	Currency               string                   `json:"currency"`
+	VolumetricDivisor      int64                    `json:"volumetricDivisor,omitempty"`
+	PremiumDiscountPercent int64                    `json:"premiumDiscountPercent"`
+	Zones                  []ShippingZoneRateConfig `json:"zones"`
+}
+
+// ShippingZoneRateConfig は配送地域1つ分の料金（overweightFeePerKg 未指定の場合は最大重量を超える荷物を受け付けない）
+type ShippingZoneRateConfig struct {
+	Zone               string                `json:"zone"`
+	Brackets           []WeightBracketConfig `json:"brackets"`
+	OverweightFeePerKg *int64                `json:"overweightFeePerKg,omitempty"`
+}
+
+type WeightBracketConfig struct {
+	MaxGrams int64 `json:"maxGrams"`
+	Fee      int64 `json:"fee"`
+}
+
+// LoadShippingRateTable は設定ファイルから送料の料金表を読み込む
+func LoadShippingRateTable(path string) (*domain.ShippingRateTable, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseShippingRateTable(data)
+}
+
+func ParseShippingRateTable(data []byte) (*domain.ShippingRateTable, error) {
+	var config ShippingRateConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+
+	divisor := config.VolumetricDivisor
+	if divisor == 0 {
+		divisor = domain.DefaultVolumetricDivisor
+	}
+
+	zones := make([]*domain.ShippingZoneRate, 0, len(config.Zones))
+	for _, zoneConfig := range config.Zones {
+		zone, err := buildShippingZoneRate(config.Currency, zoneConfig)
+		if err != nil {
+			return nil, fmt.Errorf("zone %s: %w", zoneConfig.Zone, err)
+		}
+		zones = append(zones, zone)
+	}
+
+	return domain.NewShippingRateTable(divisor, config.PremiumDiscountPercent, zones)
+}
+
+func buildShippingZoneRate(currency string, config ShippingZoneRateConfig) (*domain.ShippingZoneRate, error) {
+	brackets := make([]*domain.WeightBracket, 0, len(config.Brackets))
+	for _, bracketConfig := range config.Brackets {
+		fee, err := domain.NewMoney(bracketConfig.Fee, currency)
+		if err != nil {
+			return nil, err
+		}
+		bracket, err := domain.NewWeightBracket(bracketConfig.MaxGrams, fee)
+		if err != nil {
+			return nil, err
+		}
+		brackets = append(brackets, bracket)
+	}
+
+	var overweightFeePerKg *domain.Money
+	if config.OverweightFeePerKg != nil {
+		fee, err := domain.NewMoney(*config.OverweightFeePerKg, currency)
+		if err != nil {
+			return nil, err
+		}
+		overweightFeePerKg = fee
+	}
+
+	return domain.NewShippingZoneRate(config.Zone, brackets, overweightFeePerKg)
+}
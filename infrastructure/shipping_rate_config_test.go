@@ -0,0 +1,75 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"testing"
+)
+
+func TestParseShippingRateTable_Success(t *testing.T) {
+	data := []byte(`{
+		"currency": "USD",
+		"volumetricDivisor": 6000,
+		"premiumDiscountPercent": 20,
+		"zones": [
+			{"zone": "international", "brackets": [{"maxGrams": 2000, "fee": 2500}, {"maxGrams": 500, "fee": 1500}], "overweightFeePerKg": 800}
+		]
+	}`)
+
+	table, err := ParseShippingRateTable(data)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if table.VolumetricDivisor() != 6000 || table.PremiumDiscountPercent() != 20 {
+		t.Errorf("Expected divisor 6000 and discount 20%%, but got %d and %d%%", table.VolumetricDivisor(), table.PremiumDiscountPercent())
+	}
+
+	dimensions, _ := domain.NewDimensions(10, 10, 10)
+	baggage, _ := domain.NewBaggage("Poster", 400, dimensions)
+	fee, err := domain.NewShippingFeeCalculator(table).Calculate([]*domain.Baggage{baggage}, "international", true)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	// 500gまでの $15.00 から20%引き
+	if fee.Total().String() != "$12.00" {
+		t.Errorf("Expected $12.00, but got %s", fee.Total())
+	}
+}
+
+func TestParseShippingRateTable_DefaultDivisor(t *testing.T) {
+	table, err := ParseShippingRateTable([]byte(`{"currency": "JPY", "zones": [{"zone": "local", "brackets": [{"maxGrams": 1000, "fee": 500}]}]}`))
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if table.VolumetricDivisor() != domain.DefaultVolumetricDivisor {
+		t.Errorf("Expected default divisor %d, but got %d", domain.DefaultVolumetricDivisor, table.VolumetricDivisor())
+	}
+}
+
+func TestParseShippingRateTable_InvalidConfig_ReturnsError(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"不正なJSON", `{`},
+		{"未知の通貨", `{"currency": "XXX", "zones": [{"zone": "local", "brackets": [{"maxGrams": 1000, "fee": 500}]}]}`},
+		{"配送地域なし", `{"currency": "JPY", "zones": []}`},
+		{"重量帯なし", `{"currency": "JPY", "zones": [{"zone": "local", "brackets": []}]}`},
+		{"不正な重量", `{"currency": "JPY", "zones": [{"zone": "local", "brackets": [{"maxGrams": 0, "fee": 500}]}]}`},
+		{"不正な超過料金", `{"currency": "JPY", "zones": [{"zone": "local", "brackets": [{"maxGrams": 1000, "fee": 500}], "overweightFeePerKg": 0}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			table, err := ParseShippingRateTable([]byte(tt.data))
+
+			// Assert
+			if err == nil {
+				t.Error("Expected error, but got nil")
+			}
+			if table != nil {
+				t.Error("Expected nil table")
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package infrastructure
+
+import (
+	"bytes"
+	"ddd-bottomup/domain"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SMTPConfig はSMTPサーバーへの接続設定
+// Username が空の場合は認証しない（ローカルのSMTPサーバー向け）
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+// SMTPMailer はSMTPでメールを送信する
+type SMTPMailer struct {
+	config SMTPConfig
+	clock  domain.Clock
+}
+
+func NewSMTPMailer(config SMTPConfig, clock domain.Clock) (*SMTPMailer, error) {
+	if config.Host == "" || config.Port <= 0 {
+		return nil, fmt.Errorf("smtp: host and port are required")
+	}
+	if _, err := mail.ParseAddress(config.From); err != nil {
+		return nil, fmt.Errorf("smtp: invalid from address %q: %w", config.From, err)
+	}
+	return &SMTPMailer{config: config, clock: clock}, nil
+}
+
+func (m *SMTPMailer) Send(message *domain.MailMessage) error {
+	var auth smtp.Auth
+	if m.config.Username != "" {
+		auth = smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)
+	}
+
+	from, _ := mail.ParseAddress(m.config.From)
+	addr := net.JoinHostPort(m.config.Host, strconv.Itoa(m.config.Port))
+	return smtp.SendMail(addr, auth, from.Address, []string{message.To().Value()}, m.compose(from, message))
+}
+
+// compose はUTF-8のプレーンテキストメールを組み立てる（改行はCRLF）
+func (m *SMTPMailer) compose(from *mail.Address, message *domain.MailMessage) []byte {
+	var buf bytes.Buffer
+	header := func(name, value string) {
+		buf.WriteString(name + ": " + value + "\r\n")
+	}
+	header("From", from.String())
+	header("To", message.To().Value())
+	header("Subject", mime.BEncoding.Encode("UTF-8", message.Subject()))
+	header("Date", m.clock.Now().Format(time.RFC1123Z))
+	header("Message-ID", "<"+uuid.New().String()+"@ddd-bottomup>")
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=UTF-8")
+	header("Content-Transfer-Encoding", "base64")
+	buf.WriteString("\r\n")
+
+	// base64は76文字で折り返す
+	encoded := base64.StdEncoding.EncodeToString([]byte(message.Body()))
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded + "\r\n")
+	return buf.Bytes()
+}
@@ -0,0 +1,152 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"encoding/base64"
+	"io"
+	"mime"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+)
+
+// smtpStandIn は1通だけ受け取るSMTPサーバーの代役
+type smtpStandIn struct {
+	listener net.Listener
+	received chan smtpEnvelope
+}
+
+type smtpEnvelope struct {
+	from string
+	to   []string
+	data string
+}
+
+func startSMTPStandIn(t *testing.T) *smtpStandIn {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	server := &smtpStandIn{listener: listener, received: make(chan smtpEnvelope, 1)}
+	t.Cleanup(func() { listener.Close() })
+	go server.serve()
+	return server
+}
+
+func (s *smtpStandIn) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpStandIn) serve() {
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	text := textproto.NewConn(conn)
+	text.PrintfLine("220 localhost ESMTP stand-in")
+	var envelope smtpEnvelope
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			text.PrintfLine("250 localhost")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			envelope.from = strings.Trim(line[len("MAIL FROM:"):], "<> ")
+			text.PrintfLine("250 OK")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			envelope.to = append(envelope.to, strings.Trim(line[len("RCPT TO:"):], "<> "))
+			text.PrintfLine("250 OK")
+		case command == "DATA":
+			text.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			data, err := io.ReadAll(text.DotReader())
+			if err != nil {
+				return
+			}
+			envelope.data = string(data)
+			text.PrintfLine("250 OK")
+			s.received <- envelope
+		case command == "QUIT":
+			text.PrintfLine("221 Bye")
+			return
+		default:
+			text.PrintfLine("502 Command not implemented")
+		}
+	}
+}
+
+func TestSMTPMailer_Send_DeliversUTF8Message(t *testing.T) {
+	// Arrange
+	server := startSMTPStandIn(t)
+	clock := domain.NewFixedClock(time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC))
+	mailer, err := NewSMTPMailer(SMTPConfig{
+		Host: "127.0.0.1",
+		Port: server.port(),
+		From: "サークル運営 <noreply@example.com>",
+	}, clock)
+	if err != nil {
+		t.Fatalf("Failed to create mailer: %v", err)
+	}
+	to, _ := domain.NewEmail("taro@example.com")
+	message, _ := domain.NewMailMessage(to, "「テニス部」に参加しました", "山田 太郎 さん\n\nようこそ！\n")
+
+	// Act
+	err = mailer.Send(message)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	envelope := <-server.received
+	if envelope.from != "noreply@example.com" || len(envelope.to) != 1 || envelope.to[0] != "taro@example.com" {
+		t.Errorf("Unexpected envelope: from=%s to=%v", envelope.from, envelope.to)
+	}
+	parsed, err := mail.ReadMessage(strings.NewReader(envelope.data))
+	if err != nil {
+		t.Fatalf("Failed to parse message: %v", err)
+	}
+	subject, _ := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	if subject != message.Subject() {
+		t.Errorf("Expected subject %q, but got %q", message.Subject(), subject)
+	}
+	body, _ := io.ReadAll(base64.NewDecoder(base64.StdEncoding, parsed.Body))
+	if string(body) != message.Body() {
+		t.Errorf("Expected body %q, but got %q", message.Body(), body)
+	}
+	if parsed.Header.Get("Content-Type") != "text/plain; charset=UTF-8" {
+		t.Errorf("Unexpected Content-Type: %s", parsed.Header.Get("Content-Type"))
+	}
+}
+
+func TestNewSMTPMailer_InvalidConfig_ReturnsError(t *testing.T) {
+	tests := []struct {
+		name   string
+		config SMTPConfig
+	}{
+		{"ホストなし", SMTPConfig{Port: 25, From: "noreply@example.com"}},
+		{"ポートなし", SMTPConfig{Host: "localhost", From: "noreply@example.com"}},
+		{"送信元が不正", SMTPConfig{Host: "localhost", Port: 25, From: "not an address"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := NewSMTPMailer(tt.config, domain.SystemClock{})
+
+			// Assert
+			if err == nil {
+				t.Error("Expected error, but got none")
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// StaticExchangeRateProvider は登録済みのレート履歴から換算レートを返す
+// 直接のレートと逆方向のレートのうち新しい方を使う（同じ日付なら直接のレート）
+type StaticExchangeRateProvider struct {
+	mu    sync.RWMutex
+	rates map[string][]*domain.ExchangeRate // key: "FROM/TO"、日付の昇順
+}
+
+func NewStaticExchangeRateProvider() *StaticExchangeRateProvider {
+	return &StaticExchangeRateProvider{
+		rates: make(map[string][]*domain.ExchangeRate),
+	}
+}
+
+// Add はレートを履歴に追加する
+func (p *StaticExchangeRateProvider) Add(rate *domain.ExchangeRate) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := ratePairKey(rate.From(), rate.To())
+	history := append(p.rates[key], rate)
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].AsOf().Before(history[j].AsOf())
+	})
+	p.rates[key] = history
+}
+
+func (p *StaticExchangeRateProvider) RateAt(from, to string, at time.Time) (*domain.ExchangeRate, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	direct := latestRateAt(p.rates[ratePairKey(from, to)], at)
+	inverse := latestRateAt(p.rates[ratePairKey(to, from)], at)
+	switch {
+	case inverse != nil && (direct == nil || inverse.AsOf().After(direct.AsOf())):
+		return inverse.Inverse(), nil
+	case direct != nil:
+		return direct, nil
+	}
+	return nil, domain.ExchangeRateNotFoundError{From: from, To: to, At: at}
+}
+
+// latestRateAt は at 以前で最も新しいレートを返す
+func latestRateAt(history []*domain.ExchangeRate, at time.Time) *domain.ExchangeRate {
+	index := sort.Search(len(history), func(i int) bool {
+		return history[i].AsOf().After(at)
+	})
+	if index == 0 {
+		return nil
+	}
+	return history[index-1]
+}
+
+func ratePairKey(from, to string) string {
+	return from + "/" + to
+}
+
+// ExchangeRateConfig は為替レート設定ファイル（JSON）の構造
+//
+//	{
+//	  "source": "ecb",
+//	  "rates": [
+//	    {"from": "USD", "to": "JPY", "rate": "151.23", "asOf": "2025-04-01T00:00:00Z"}
+//	  ]
+//	}
+type ExchangeRateConfig struct {
+	Source string              `json:"source"`
+	Rates  []ExchangeRateEntry `json:"rates"`
+}
+
+// ExchangeRateEntry は1件のレート（source 未指定の場合はファイル全体の source を使う）
+type ExchangeRateEntry struct {
+	From   string    `json:"from"`
+	To     string    `json:"to"`
+	Rate   string    `json:"rate"`
+	AsOf   time.Time `json:"asOf"`
+	Source string    `json:"source,omitempty"`
+}
+
+// LoadExchangeRateProvider は設定ファイルから為替レートを読み込む
+func LoadExchangeRateProvider(path string) (*StaticExchangeRateProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExchangeRateProvider(data)
+}
+
+func ParseExchangeRateProvider(data []byte) (*StaticExchangeRateProvider, error) {
+	var config ExchangeRateConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+
+	provider := NewStaticExchangeRateProvider()
+	for i, entry := range config.Rates {
+		source := entry.Source
+		if source == "" {
+			source = config.Source
+		}
+		rate, err := domain.NewExchangeRate(entry.From, entry.To, entry.Rate, entry.AsOf, source)
+		if err != nil {
+			return nil, fmt.Errorf("rate %d: %w", i, err)
+		}
+		provider.Add(rate)
+	}
+	return provider, nil
+}
@@ -0,0 +1,81 @@
+package infrastructure
+
+import (
+	"ddd-bottomup/domain"
+	"testing"
+	"time"
+)
+
+func TestStaticExchangeRateProvider_RateAt_HistoricalLookup(t *testing.T) {
+	// Arrange
+	provider, err := ParseExchangeRateProvider([]byte(`{
+		"source": "ecb",
+		"rates": [
+			{"from": "USD", "to": "JPY", "rate": "152", "asOf": "2025-04-02T00:00:00Z"},
+			{"from": "USD", "to": "JPY", "rate": "150", "asOf": "2025-04-01T00:00:00Z"},
+			{"from": "EUR", "to": "USD", "rate": "1.25", "asOf": "2025-04-01T00:00:00Z", "source": "manual"},
+			{"from": "JPY", "to": "USD", "rate": "0.005", "asOf": "2025-04-02T00:00:00Z", "source": "manual"},
+			{"from": "JPY", "to": "USD", "rate": "0.008", "asOf": "2025-04-05T00:00:00Z", "source": "manual"}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("Failed to parse rates: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		at       time.Time
+		expected string
+		source   string
+	}{
+		{"当日のレート", "USD", "JPY", time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC), "150", "ecb"},
+		{"更新後のレート", "USD", "JPY", time.Date(2025, 4, 3, 0, 0, 0, 0, time.UTC), "152", "ecb"},
+		{"逆方向のレート", "USD", "EUR", time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), "0.8", "manual"},
+		{"同じ日付なら直接のレート", "USD", "JPY", time.Date(2025, 4, 4, 0, 0, 0, 0, time.UTC), "152", "ecb"},
+		{"逆方向のレートの方が新しい", "USD", "JPY", time.Date(2025, 4, 6, 0, 0, 0, 0, time.UTC), "125", "manual"},
+		{"直接のレートの方が新しい", "JPY", "USD", time.Date(2025, 4, 6, 0, 0, 0, 0, time.UTC), "0.008", "manual"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			rate, err := provider.RateAt(tt.from, tt.to, tt.at)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if rate.Rate() != tt.expected || rate.Source() != tt.source {
+				t.Errorf("Expected %s from %s, but got %s from %s", tt.expected, tt.source, rate.Rate(), rate.Source())
+			}
+		})
+	}
+
+	// 最初のレートより前の日付は見つからない
+	_, err = provider.RateAt("USD", "JPY", time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC))
+	if _, ok := err.(domain.ExchangeRateNotFoundError); !ok {
+		t.Errorf("Expected ExchangeRateNotFoundError, but got %T", err)
+	}
+}
+
+func TestParseExchangeRateProvider_InvalidConfig_ReturnsError(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"不正なJSON", `{`},
+		{"未知の通貨", `{"source": "ecb", "rates": [{"from": "XXX", "to": "JPY", "rate": "1", "asOf": "2025-04-01T00:00:00Z"}]}`},
+		{"不正なレート", `{"source": "ecb", "rates": [{"from": "USD", "to": "JPY", "rate": "abc", "asOf": "2025-04-01T00:00:00Z"}]}`},
+		{"取得元なし", `{"rates": [{"from": "USD", "to": "JPY", "rate": "150", "asOf": "2025-04-01T00:00:00Z"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseExchangeRateProvider([]byte(tt.data)); err == nil {
+				t.Error("Expected error, but got nil")
+			}
+		})
+	}
+}
@@ -5,15 +5,80 @@ import (
 	"ddd-bottomup/infrastructure"
 	"ddd-bottomup/presentation"
 	"ddd-bottomup/usecase"
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // タイムゾーンのデータを持たない環境でも通知設定のタイムゾーンを解決する
 )
 
 type Application struct {
-	CreateUserUseCase *usecase.CreateUserUseCase
-	GetUserUseCase    *usecase.GetUserUseCase
-	UpdateUserUseCase *usecase.UpdateUserUseCase
-	DeleteUserUseCase *usecase.DeleteUserUseCase
+	LoginUseCase                      *usecase.LoginUseCase
+	LogoutUseCase                     *usecase.LogoutUseCase
+	TokenLoginUseCase                 *usecase.TokenLoginUseCase
+	RefreshAccessTokenUseCase         *usecase.RefreshAccessTokenUseCase
+	RevokeRefreshTokenUseCase         *usecase.RevokeRefreshTokenUseCase
+	GetPublicSigningKeysUseCase       *usecase.GetPublicSigningKeysUseCase
+	StartOIDCLoginUseCase             *usecase.StartOIDCLoginUseCase    // OIDC が無効の場合は nil
+	CompleteOIDCLoginUseCase          *usecase.CompleteOIDCLoginUseCase // OIDC が無効の場合は nil
+	FakeOIDCProvider                  http.Handler                      // OIDC_FAKE_IDP が true の場合のみ
+	AuthenticateUseCase               *usecase.AuthenticateUseCase
+	AuthenticateAPIKeyUseCase         *usecase.AuthenticateAPIKeyUseCase
+	CreateAPIKeyUseCase               *usecase.CreateAPIKeyUseCase
+	ListAPIKeysUseCase                *usecase.ListAPIKeysUseCase
+	RevokeAPIKeyUseCase               *usecase.RevokeAPIKeyUseCase
+	ListAuditEntriesUseCase           *usecase.ListAuditEntriesUseCase
+	CreateUserUseCase                 *usecase.CreateUserUseCase
+	GetUserUseCase                    *usecase.GetUserUseCase
+	UpdateUserUseCase                 *usecase.UpdateUserUseCase
+	DeleteUserUseCase                 *usecase.DeleteUserUseCase
+	RestoreUserUseCase                *usecase.RestoreUserUseCase
+	VerifyEmailUseCase                *usecase.VerifyEmailUseCase
+	GetSubscriptionUseCase            *usecase.GetSubscriptionUseCase
+	UpgradeSubscriptionUseCase        *usecase.UpgradeSubscriptionUseCase
+	DowngradeSubscriptionUseCase      *usecase.DowngradeSubscriptionUseCase
+	CancelSubscriptionUseCase         *usecase.CancelSubscriptionUseCase
+	GrantSubscriptionUseCase          *usecase.GrantComplimentarySubscriptionUseCase
+	GetLedgerUseCase                  *usecase.GetLedgerUseCase
+	RecordPaymentUseCase              *usecase.RecordPaymentUseCase
+	RecordRefundUseCase               *usecase.RecordRefundUseCase
+	PayBalanceUseCase                 *usecase.PayBalanceUseCase
+	RefundPaymentUseCase              *usecase.RefundPaymentUseCase
+	HandlePaymentWebhookUseCase       *usecase.HandlePaymentWebhookUseCase
+	CreateCircleUseCase               *usecase.CreateCircleUseCase
+	GetCircleUseCase                  *usecase.GetCircleUseCase
+	AddMemberUseCase                  *usecase.AddMemberUseCase
+	RemoveMemberUseCase               *usecase.RemoveMemberUseCase
+	DeleteCircleUseCase               *usecase.DeleteCircleUseCase
+	RestoreCircleUseCase              *usecase.RestoreCircleUseCase
+	ChangeCircleDuesUseCase           *usecase.ChangeCircleDuesUseCase
+	RecordCircleExpenseUseCase        *usecase.RecordCircleExpenseUseCase
+	ListCircleExpensesUseCase         *usecase.ListCircleExpensesUseCase
+	GetCircleSettlementUseCase        *usecase.GetCircleSettlementUseCase
+	CreateShipmentUseCase             *usecase.CreateShipmentUseCase
+	GetShipmentUseCase                *usecase.GetShipmentUseCase
+	ListUserShipmentsUseCase          *usecase.ListUserShipmentsUseCase
+	UpdateShipmentStatusUseCase       *usecase.UpdateShipmentStatusUseCase
+	CancelShipmentUseCase             *usecase.CancelShipmentUseCase
+	QuoteShippingFeeUseCase           *usecase.QuoteShippingFeeUseCase
+	CreateCircleEventUseCase          *usecase.CreateCircleEventUseCase
+	GetCircleEventUseCase             *usecase.GetCircleEventUseCase
+	ListCircleEventsUseCase           *usecase.ListCircleEventsUseCase
+	UpdateCircleEventUseCase          *usecase.UpdateCircleEventUseCase
+	CancelCircleEventUseCase          *usecase.CancelCircleEventUseCase
+	RespondCircleEventRSVPUseCase     *usecase.RespondCircleEventRSVPUseCase
+	ExportCircleCalendarUseCase       *usecase.ExportCircleCalendarUseCase
+	ExportUserCalendarUseCase         *usecase.ExportUserCalendarUseCase
+	IssueCalendarFeedTokenUseCase     *usecase.IssueCalendarFeedTokenUseCase
+	RegisterCircleWebhookUseCase      *usecase.RegisterCircleWebhookUseCase
+	ListCircleWebhooksUseCase         *usecase.ListCircleWebhooksUseCase
+	ListWebhookDeliveriesUseCase      *usecase.ListWebhookDeliveriesUseCase
+	GetNotificationSettingsUseCase    *usecase.GetNotificationSettingsUseCase
+	UpdateNotificationSettingsUseCase *usecase.UpdateNotificationSettingsUseCase
 }
 
 func main() {
@@ -26,22 +91,168 @@ func main() {
 
 	log.Println("Application setup completed successfully!")
 
-	// HTTPルーターの設定
-	mux := presentation.NewRouter(
-		app.CreateUserUseCase,
-		app.GetUserUseCase,
-		app.UpdateUserUseCase,
-		app.DeleteUserUseCase,
-	)
+	// HTTPルーターの設定（OIDC が無効の場合はログインのルートを公開しない）
+	var oidcHandler *presentation.OIDCHandler
+	if app.StartOIDCLoginUseCase != nil {
+		oidcHandler = presentation.NewOIDCHandler(app.StartOIDCLoginUseCase, app.CompleteOIDCLoginUseCase)
+	}
+	mux := presentation.NewRouter(presentation.Handlers{
+		Auth: presentation.NewAuthHandler(
+			app.LoginUseCase,
+			app.LogoutUseCase,
+			app.TokenLoginUseCase,
+			app.RefreshAccessTokenUseCase,
+			app.RevokeRefreshTokenUseCase,
+			app.GetPublicSigningKeysUseCase,
+			app.AuthenticateUseCase,
+			app.AuthenticateAPIKeyUseCase,
+		),
+		User: presentation.NewUserHandler(
+			app.CreateUserUseCase,
+			app.GetUserUseCase,
+			app.UpdateUserUseCase,
+			app.DeleteUserUseCase,
+			app.RestoreUserUseCase,
+			app.VerifyEmailUseCase,
+		),
+		Subscription: presentation.NewSubscriptionHandler(
+			app.GetSubscriptionUseCase,
+			app.UpgradeSubscriptionUseCase,
+			app.DowngradeSubscriptionUseCase,
+			app.CancelSubscriptionUseCase,
+			app.GrantSubscriptionUseCase,
+		),
+		Ledger: presentation.NewLedgerHandler(
+			app.GetLedgerUseCase,
+			app.RecordPaymentUseCase,
+			app.RecordRefundUseCase,
+		),
+		Payment: presentation.NewPaymentHandler(
+			app.PayBalanceUseCase,
+			app.RefundPaymentUseCase,
+			app.HandlePaymentWebhookUseCase,
+		),
+		Circle: presentation.NewCircleHandler(
+			app.CreateCircleUseCase,
+			app.GetCircleUseCase,
+			app.AddMemberUseCase,
+			app.RemoveMemberUseCase,
+			app.DeleteCircleUseCase,
+			app.RestoreCircleUseCase,
+			app.ChangeCircleDuesUseCase,
+		),
+		Expense: presentation.NewExpenseHandler(
+			app.RecordCircleExpenseUseCase,
+			app.ListCircleExpensesUseCase,
+			app.GetCircleSettlementUseCase,
+		),
+		Shipment: presentation.NewShipmentHandler(
+			app.CreateShipmentUseCase,
+			app.GetShipmentUseCase,
+			app.ListUserShipmentsUseCase,
+			app.UpdateShipmentStatusUseCase,
+			app.CancelShipmentUseCase,
+			app.QuoteShippingFeeUseCase,
+		),
+		CircleEvent: presentation.NewCircleEventHandler(
+			app.CreateCircleEventUseCase,
+			app.GetCircleEventUseCase,
+			app.ListCircleEventsUseCase,
+			app.UpdateCircleEventUseCase,
+			app.CancelCircleEventUseCase,
+			app.RespondCircleEventRSVPUseCase,
+			app.ExportCircleCalendarUseCase,
+			app.ExportUserCalendarUseCase,
+			app.IssueCalendarFeedTokenUseCase,
+		),
+		Webhook: presentation.NewWebhookHandler(
+			app.RegisterCircleWebhookUseCase,
+			app.ListCircleWebhooksUseCase,
+			app.ListWebhookDeliveriesUseCase,
+		),
+		Notification: presentation.NewNotificationSettingsHandler(
+			app.GetNotificationSettingsUseCase,
+			app.UpdateNotificationSettingsUseCase,
+		),
+		OIDC: oidcHandler,
+		APIKey: presentation.NewAPIKeyHandler(
+			app.CreateAPIKeyUseCase,
+			app.ListAPIKeysUseCase,
+			app.RevokeAPIKeyUseCase,
+		),
+		Audit: presentation.NewAuditHandler(
+			app.ListAuditEntriesUseCase,
+		),
+		FakeOIDCProvider: app.FakeOIDCProvider,
+	})
 
 	// HTTPサーバー起動
 	port := ":8080"
 	log.Printf("Starting HTTP server on port %s", port)
 	log.Println("Available endpoints:")
+	log.Println("  POST   /auth/login - Log in with email and password")
+	log.Println("  POST   /auth/logout - Log out (Bearer token)")
+	log.Println("  POST   /auth/token - Issue access and refresh tokens")
+	log.Println("  POST   /auth/token/refresh - Exchange a refresh token for new tokens")
+	log.Println("  POST   /auth/token/revoke - Revoke a refresh token and its successors")
+	log.Println("  GET    /.well-known/jwks.json - Public keys for access tokens")
+	if oidcHandler != nil {
+		log.Println("  GET    /auth/oidc/login - Log in with OpenID Connect (redirect)")
+		log.Println("  POST   /auth/oidc/link - Link an OpenID Connect account (Bearer token)")
+		log.Println("  GET    /auth/oidc/callback - OpenID Connect redirect target")
+	}
+	if app.FakeOIDCProvider != nil {
+		log.Println("  *      /fake-idp/*  - Local stand-in OpenID provider")
+	}
+	log.Println("  GET    /admin/api-keys - List API keys (api_keys:manage)")
+	log.Println("  POST   /admin/api-keys - Mint an API key (api_keys:manage)")
+	log.Println("  POST   /admin/api-keys/{id}/revoke - Revoke an API key (api_keys:manage)")
+	log.Println("  GET    /audit - Search the audit log (audit:read)")
 	log.Println("  POST   /users      - Create user")
 	log.Println("  GET    /users/{id} - Get user")
-	log.Println("  PUT    /users/{id} - Update user")
-	log.Println("  DELETE /users/{id} - Delete user")
+	log.Println("  PUT    /users/{id} - Update user (self or users:write)")
+	log.Println("  DELETE /users/{id} - Delete user (self or users:write)")
+	log.Println("  POST   /users/{id}/restore                - Restore a deleted user (users:write)")
+	log.Println("  POST   /users/{id}/email/verify           - Verify email address")
+	log.Println("  GET    /users/{id}/subscription           - Get subscription")
+	log.Println("  POST   /users/{id}/subscription/upgrade   - Upgrade subscription")
+	log.Println("  POST   /users/{id}/subscription/downgrade - Downgrade subscription")
+	log.Println("  POST   /users/{id}/subscription/cancel    - Cancel subscription")
+	log.Println("  POST   /users/{id}/subscription/complimentary - Grant complimentary premium")
+	log.Println("  GET    /users/{id}/ledger                 - Get billing ledger")
+	log.Println("  POST   /users/{id}/ledger/payments        - Record payment")
+	log.Println("  POST   /users/{id}/ledger/refunds         - Record refund")
+	log.Println("  POST   /users/{id}/ledger/checkout        - Pay outstanding balance")
+	log.Println("  POST   /users/{id}/payments/{chargeId}/refund - Refund payment")
+	log.Println("  POST   /payments/webhook                  - Payment provider webhook")
+	log.Println("  POST   /circles                           - Create circle")
+	log.Println("  GET    /circles/{id}                      - Get circle")
+	log.Println("  DELETE /circles/{id}                      - Delete circle (owner or circles:write)")
+	log.Println("  POST   /circles/{id}/restore              - Restore a deleted circle (owner or circles:write)")
+	log.Println("  POST   /circles/{id}/members              - Add member")
+	log.Println("  GET    /circles/{id}/expenses             - List circle expenses")
+	log.Println("  POST   /circles/{id}/expenses             - Record circle expense")
+	log.Println("  GET    /circles/{id}/settlement           - Who owes whom")
+	log.Println("  GET    /circles/{id}/events?when=upcoming|past - List circle events")
+	log.Println("  POST   /circles/{id}/events               - Create circle event")
+	log.Println("  GET    /circles/{id}/events/{eventId}     - Get circle event")
+	log.Println("  PUT    /circles/{id}/events/{eventId}     - Update circle event")
+	log.Println("  POST   /circles/{id}/events/{eventId}/cancel - Cancel circle event")
+	log.Println("  PUT    /circles/{id}/events/{eventId}/rsvp   - RSVP to circle event")
+	log.Println("  GET    /circles/{id}/events.ics           - Circle calendar feed")
+	log.Println("  GET    /users/{id}/calendar.ics           - User calendar feed (?token=)")
+	log.Println("  POST   /users/{id}/calendar-token         - Issue user calendar feed token")
+	log.Println("  GET    /circles/{id}/webhooks             - List circle webhooks")
+	log.Println("  POST   /circles/{id}/webhooks             - Register circle webhook")
+	log.Println("  GET    /circles/{id}/webhooks/{hookId}/deliveries - Webhook delivery log")
+	log.Println("  GET    /users/{id}/notification-settings  - Get notification settings")
+	log.Println("  PUT    /users/{id}/notification-settings  - Update notification settings")
+	log.Println("  GET    /users/{id}/shipments              - List user shipments")
+	log.Println("  POST   /shipments                         - Create shipment")
+	log.Println("  POST   /shipments/quote                   - Quote shipping fee")
+	log.Println("  GET    /shipments/{id}                    - Track shipment")
+	log.Println("  POST   /shipments/{id}/status             - Update shipment status")
+	log.Println("  POST   /shipments/{id}/cancel             - Cancel shipment")
 	log.Println("  GET    /health     - Health check")
 
 	if err := http.ListenAndServe(port, mux); err != nil {
@@ -54,27 +265,629 @@ func setupApplication() (*Application, error) {
 
 	// 1. リポジトリ層の初期化
 	log.Println("Initializing repositories...")
-	userRepo := infrastructure.NewMemoryUserRepository()
+	outbox := infrastructure.NewMemoryOutbox()
+	userRepo := infrastructure.NewOutboxUserRepository(infrastructure.NewMemoryUserRepository(), outbox)
+	ledgerRepo := infrastructure.NewMemoryLedgerRepository()
+	circleRepo := infrastructure.NewOutboxCircleRepository(infrastructure.NewMemoryCircleRepository(), outbox)
+	expenseRepo := infrastructure.NewMemoryCircleExpenseRepository()
+	shipmentRepo := infrastructure.NewMemoryShipmentRepository()
+	eventRepo := infrastructure.NewMemoryCircleEventRepository()
+	webhookRepo := infrastructure.NewMemoryWebhookRepository()
+	webhookDeliveryRepo := infrastructure.NewMemoryWebhookDeliveryRepository()
+	notificationPreferencesRepo := infrastructure.NewMemoryNotificationPreferencesRepository()
+	pendingNotificationRepo := infrastructure.NewMemoryPendingNotificationRepository()
+	credentialRepo := infrastructure.NewMemoryCredentialRepository()
+	sessionRepo := infrastructure.NewMemorySessionRepository()
+	refreshTokenRepo := infrastructure.NewMemoryRefreshTokenRepository()
+	calendarFeedTokenRepo := infrastructure.NewMemoryCalendarFeedTokenRepository()
+	oidcLoginRequestRepo := infrastructure.NewMemoryOIDCLoginRequestRepository()
+	externalIdentityRepo := infrastructure.NewMemoryExternalIdentityRepository()
+	apiKeyRepo := infrastructure.NewMemoryAPIKeyRepository()
+	auditRepo := infrastructure.NewMemoryAuditRepository()
+	passwordHasher := infrastructure.NewArgon2idPasswordHasher(infrastructure.DefaultArgon2idParams())
+	clock := domain.SystemClock{}
+	paymentGateway := infrastructure.NewFakePaymentGateway(paymentWebhookSecret(), clock)
+	exchangeRates, err := loadExchangeRates()
+	if err != nil {
+		return nil, err
+	}
+	capacityPolicies, err := loadCapacityPolicies()
+	if err != nil {
+		return nil, err
+	}
+	shippingRates, err := loadShippingRates()
+	if err != nil {
+		return nil, err
+	}
+	mailer, err := newMailer(clock)
+	if err != nil {
+		return nil, err
+	}
+	locale, err := mailLocale()
+	if err != nil {
+		return nil, err
+	}
+	requireVerifiedEmail, err := requireVerifiedEmailForMembership()
+	if err != nil {
+		return nil, err
+	}
+	verificationTokenCodec := infrastructure.NewHMACEmailVerificationTokenCodec(emailVerificationSecret())
+	accessTokenCodec, err := newAccessTokenCodec()
+	if err != nil {
+		return nil, err
+	}
+	oidcProvider, fakeOIDCProvider, err := newOIDCProvider(clock)
+	if err != nil {
+		return nil, err
+	}
+	administrators, err := loadAdministrators()
+	if err != nil {
+		return nil, err
+	}
+	deletionPolicy, err := loadDeletionPolicy()
+	if err != nil {
+		return nil, err
+	}
+	if err := bootstrapAPIKey(apiKeyRepo, clock); err != nil {
+		return nil, err
+	}
 
 	// 2. ドメインサービス層の初期化
 	log.Println("Initializing domain services...")
 	userExistenceService := domain.NewUserExistenceService(userRepo)
+	converter := domain.NewCurrencyConverter(exchangeRates, domain.RoundHalfEven)
+	circleExistenceService := domain.NewCircleExistenceService(circleRepo)
+	circleMemberService := domain.NewCircleMemberService(capacityPolicies)
+	settlementService := domain.NewSettlementService()
+	shippingFeeCalculator := domain.NewShippingFeeCalculator(shippingRates)
 
 	// 3. ユースケース層の初期化
 	log.Println("Initializing use cases...")
-	createUserUseCase := usecase.NewCreateUserUseCase(userRepo, userExistenceService)
-	getUserUseCase := usecase.NewGetUserUseCase(userRepo)
-	updateUserUseCase := usecase.NewUpdateUserUseCase(userRepo, userExistenceService)
-	deleteUserUseCase := usecase.NewDeleteUserUseCase(userRepo)
+	auditLog := usecase.NewAuditLog(auditRepo, clock)
+	loginUseCase := usecase.NewLoginUseCase(userRepo, credentialRepo, sessionRepo, passwordHasher, domain.DefaultSessionTTL, clock)
+	logoutUseCase := usecase.NewLogoutUseCase(sessionRepo)
+	tokenLoginUseCase := usecase.NewTokenLoginUseCase(userRepo, credentialRepo, refreshTokenRepo, passwordHasher,
+		accessTokenCodec, domain.DefaultAccessTokenTTL, domain.DefaultRefreshTokenTTL, clock)
+	refreshAccessTokenUseCase := usecase.NewRefreshAccessTokenUseCase(refreshTokenRepo, userRepo,
+		accessTokenCodec, domain.DefaultAccessTokenTTL, domain.DefaultRefreshTokenTTL, clock)
+	revokeRefreshTokenUseCase := usecase.NewRevokeRefreshTokenUseCase(refreshTokenRepo, clock)
+	getPublicSigningKeysUseCase := usecase.NewGetPublicSigningKeysUseCase(accessTokenCodec)
+	authenticateUseCase := usecase.NewAuthenticateUseCase(sessionRepo, accessTokenCodec, userRepo, administrators, clock)
+	authenticateAPIKeyUseCase := usecase.NewAuthenticateAPIKeyUseCase(apiKeyRepo, clock)
+	createAPIKeyUseCase := usecase.NewCreateAPIKeyUseCase(apiKeyRepo, clock, auditLog)
+	listAPIKeysUseCase := usecase.NewListAPIKeysUseCase(apiKeyRepo)
+	revokeAPIKeyUseCase := usecase.NewRevokeAPIKeyUseCase(apiKeyRepo, clock, auditLog)
+	listAuditEntriesUseCase := usecase.NewListAuditEntriesUseCase(auditRepo)
+	createUserUseCase := usecase.NewCreateUserUseCase(userRepo, userExistenceService, credentialRepo, passwordHasher, clock, auditLog)
+	getUserUseCase := usecase.NewGetUserUseCase(userRepo, clock)
+	updateUserUseCase := usecase.NewUpdateUserUseCase(userRepo, userExistenceService, clock, auditLog)
+	deleteUserUseCase := usecase.NewDeleteUserUseCase(userRepo, circleRepo, sessionRepo, refreshTokenRepo, clock, auditLog)
+	restoreUserUseCase := usecase.NewRestoreUserUseCase(userRepo, circleRepo, userExistenceService, circleMemberService, deletionPolicy, clock, auditLog)
+	purgeDeletedRecordsUseCase := usecase.NewPurgeDeletedRecordsUseCase(userRepo, circleRepo, credentialRepo, shipmentRepo, deletionPolicy, clock, auditLog)
+	verifyEmailUseCase := usecase.NewVerifyEmailUseCase(userRepo, verificationTokenCodec, clock, auditLog)
+	var startOIDCLoginUseCase *usecase.StartOIDCLoginUseCase
+	var completeOIDCLoginUseCase *usecase.CompleteOIDCLoginUseCase
+	if oidcProvider != nil {
+		startOIDCLoginUseCase = usecase.NewStartOIDCLoginUseCase(oidcLoginRequestRepo, oidcProvider, domain.DefaultOIDCLoginTTL, clock)
+		completeOIDCLoginUseCase = usecase.NewCompleteOIDCLoginUseCase(oidcLoginRequestRepo, externalIdentityRepo, userRepo, sessionRepo,
+			oidcProvider, createUserUseCase, domain.DefaultSessionTTL, clock)
+	}
+	getSubscriptionUseCase := usecase.NewGetSubscriptionUseCase(userRepo, clock)
+	upgradeSubscriptionUseCase := usecase.NewUpgradeSubscriptionUseCase(userRepo, ledgerRepo, domain.DefaultPlanPriceList(), clock, auditLog)
+	downgradeSubscriptionUseCase := usecase.NewDowngradeSubscriptionUseCase(userRepo, clock, auditLog)
+	cancelSubscriptionUseCase := usecase.NewCancelSubscriptionUseCase(userRepo, clock, auditLog)
+	grantSubscriptionUseCase := usecase.NewGrantComplimentarySubscriptionUseCase(userRepo, clock, auditLog)
+	getLedgerUseCase := usecase.NewGetLedgerUseCase(userRepo, ledgerRepo, converter)
+	recordPaymentUseCase := usecase.NewRecordPaymentUseCase(userRepo, ledgerRepo, clock, auditLog)
+	recordRefundUseCase := usecase.NewRecordRefundUseCase(userRepo, ledgerRepo, clock, auditLog)
+	payBalanceUseCase := usecase.NewPayBalanceUseCase(userRepo, ledgerRepo, paymentGateway, clock, auditLog)
+	refundPaymentUseCase := usecase.NewRefundPaymentUseCase(userRepo, ledgerRepo, paymentGateway, clock, auditLog)
+	handlePaymentWebhookUseCase := usecase.NewHandlePaymentWebhookUseCase(paymentGateway, ledgerRepo)
+	createCircleUseCase := usecase.NewCreateCircleUseCase(circleRepo, userRepo, circleExistenceService, auditLog)
+	getCircleUseCase := usecase.NewGetCircleUseCase(circleRepo, userRepo, circleMemberService, clock)
+	addMemberUseCase := usecase.NewAddMemberUseCase(circleRepo, userRepo, ledgerRepo, circleMemberService, requireVerifiedEmail, clock, auditLog)
+	removeMemberUseCase := usecase.NewRemoveMemberUseCase(circleRepo, clock, auditLog)
+	deleteCircleUseCase := usecase.NewDeleteCircleUseCase(circleRepo, clock, auditLog)
+	restoreCircleUseCase := usecase.NewRestoreCircleUseCase(circleRepo, userRepo, deletionPolicy, clock, auditLog)
+	changeCircleDuesUseCase := usecase.NewChangeCircleDuesUseCase(circleRepo, auditLog)
+	recordCircleExpenseUseCase := usecase.NewRecordCircleExpenseUseCase(circleRepo, expenseRepo, clock, auditLog)
+	listCircleExpensesUseCase := usecase.NewListCircleExpensesUseCase(circleRepo, expenseRepo)
+	getCircleSettlementUseCase := usecase.NewGetCircleSettlementUseCase(circleRepo, expenseRepo, settlementService)
+	createShipmentUseCase := usecase.NewCreateShipmentUseCase(shipmentRepo, userRepo, shippingFeeCalculator, clock, auditLog)
+	getShipmentUseCase := usecase.NewGetShipmentUseCase(shipmentRepo)
+	listUserShipmentsUseCase := usecase.NewListUserShipmentsUseCase(shipmentRepo, userRepo)
+	updateShipmentStatusUseCase := usecase.NewUpdateShipmentStatusUseCase(shipmentRepo, clock, auditLog)
+	cancelShipmentUseCase := usecase.NewCancelShipmentUseCase(shipmentRepo, clock, auditLog)
+	quoteShippingFeeUseCase := usecase.NewQuoteShippingFeeUseCase(userRepo, shippingFeeCalculator, clock)
+	createCircleEventUseCase := usecase.NewCreateCircleEventUseCase(circleRepo, eventRepo, clock, auditLog)
+	getCircleEventUseCase := usecase.NewGetCircleEventUseCase(circleRepo, eventRepo)
+	listCircleEventsUseCase := usecase.NewListCircleEventsUseCase(circleRepo, eventRepo, clock)
+	updateCircleEventUseCase := usecase.NewUpdateCircleEventUseCase(circleRepo, eventRepo, clock, auditLog)
+	cancelCircleEventUseCase := usecase.NewCancelCircleEventUseCase(circleRepo, eventRepo, clock, auditLog)
+	respondCircleEventRSVPUseCase := usecase.NewRespondCircleEventRSVPUseCase(circleRepo, eventRepo, clock, auditLog)
+	exportCircleCalendarUseCase := usecase.NewExportCircleCalendarUseCase(circleRepo, eventRepo, clock)
+	exportUserCalendarUseCase := usecase.NewExportUserCalendarUseCase(userRepo, circleRepo, eventRepo, calendarFeedTokenRepo, clock)
+	issueCalendarFeedTokenUseCase := usecase.NewIssueCalendarFeedTokenUseCase(userRepo, calendarFeedTokenRepo, clock, auditLog)
+	registerCircleWebhookUseCase := usecase.NewRegisterCircleWebhookUseCase(circleRepo, webhookRepo, clock, auditLog)
+	listCircleWebhooksUseCase := usecase.NewListCircleWebhooksUseCase(circleRepo, webhookRepo)
+	listWebhookDeliveriesUseCase := usecase.NewListWebhookDeliveriesUseCase(circleRepo, webhookRepo, webhookDeliveryRepo)
+	enqueueWebhookDeliveriesUseCase := usecase.NewEnqueueWebhookDeliveriesUseCase(webhookRepo, webhookDeliveryRepo, clock)
+	deliverWebhooksUseCase := usecase.NewDeliverWebhooksUseCase(
+		webhookRepo, webhookDeliveryRepo, infrastructure.NewHTTPWebhookSender(10*time.Second),
+		domain.DefaultWebhookRetryPolicy(), clock,
+	)
+	notificationDispatcher := usecase.NewNotificationDispatcher(notificationPreferencesRepo, pendingNotificationRepo, mailer, locale, clock)
+	notifyMemberJoinedUseCase := usecase.NewNotifyMemberJoinedUseCase(userRepo, circleRepo, notificationDispatcher)
+	notifyCircleFilledUseCase := usecase.NewNotifyCircleFilledUseCase(userRepo, circleRepo, notificationDispatcher)
+	notifyEmailChangedUseCase := usecase.NewNotifyEmailChangedUseCase(userRepo, notificationDispatcher)
+	sendEmailVerificationUseCase := usecase.NewSendEmailVerificationUseCase(
+		userRepo, verificationTokenCodec, notificationDispatcher, domain.DefaultEmailVerificationTTL,
+	)
+	sendNotificationDigestsUseCase := usecase.NewSendNotificationDigestsUseCase(pendingNotificationRepo, notificationDispatcher, mailer, clock)
+	getNotificationSettingsUseCase := usecase.NewGetNotificationSettingsUseCase(userRepo, notificationPreferencesRepo, locale, clock)
+	updateNotificationSettingsUseCase := usecase.NewUpdateNotificationSettingsUseCase(userRepo, notificationPreferencesRepo, locale, clock, auditLog)
+
+	// 4. ローカル決済ゲートウェイのWebhook配信
+	go deliverFakeWebhooks(paymentGateway, handlePaymentWebhookUseCase)
+
+	// 5. アウトボックスのイベント配信（Webhook・メール通知の購読）
+	eventBus := newEventBus()
+	subscribeCircleWebhooks(eventBus, enqueueWebhookDeliveriesUseCase)
+	subscribeMailNotifications(eventBus, notifyMemberJoinedUseCase, notifyCircleFilledUseCase, notifyEmailChangedUseCase, sendEmailVerificationUseCase)
+	go relayOutbox(infrastructure.NewOutboxRelay(outbox, eventBus, clock))
+
+	// 6. サークルのWebhook配信
+	go deliverCircleWebhooks(deliverWebhooksUseCase)
+
+	// 7. まとめて送る・おやすみ時間明けに送る通知
+	go sendNotificationDigests(sendNotificationDigestsUseCase)
+
+	// 8. 保持期間を過ぎた論理削除済みのユーザー・サークルの物理削除
+	go purgeDeletedRecords(purgeDeletedRecordsUseCase)
 
 	return &Application{
-		CreateUserUseCase: createUserUseCase,
-		GetUserUseCase:    getUserUseCase,
-		UpdateUserUseCase: updateUserUseCase,
-		DeleteUserUseCase: deleteUserUseCase,
+		LoginUseCase:                      loginUseCase,
+		LogoutUseCase:                     logoutUseCase,
+		TokenLoginUseCase:                 tokenLoginUseCase,
+		RefreshAccessTokenUseCase:         refreshAccessTokenUseCase,
+		RevokeRefreshTokenUseCase:         revokeRefreshTokenUseCase,
+		GetPublicSigningKeysUseCase:       getPublicSigningKeysUseCase,
+		StartOIDCLoginUseCase:             startOIDCLoginUseCase,
+		CompleteOIDCLoginUseCase:          completeOIDCLoginUseCase,
+		FakeOIDCProvider:                  fakeOIDCProvider,
+		AuthenticateUseCase:               authenticateUseCase,
+		AuthenticateAPIKeyUseCase:         authenticateAPIKeyUseCase,
+		CreateAPIKeyUseCase:               createAPIKeyUseCase,
+		ListAPIKeysUseCase:                listAPIKeysUseCase,
+		RevokeAPIKeyUseCase:               revokeAPIKeyUseCase,
+		ListAuditEntriesUseCase:           listAuditEntriesUseCase,
+		CreateUserUseCase:                 createUserUseCase,
+		GetUserUseCase:                    getUserUseCase,
+		UpdateUserUseCase:                 updateUserUseCase,
+		DeleteUserUseCase:                 deleteUserUseCase,
+		RestoreUserUseCase:                restoreUserUseCase,
+		VerifyEmailUseCase:                verifyEmailUseCase,
+		GetSubscriptionUseCase:            getSubscriptionUseCase,
+		UpgradeSubscriptionUseCase:        upgradeSubscriptionUseCase,
+		DowngradeSubscriptionUseCase:      downgradeSubscriptionUseCase,
+		CancelSubscriptionUseCase:         cancelSubscriptionUseCase,
+		GrantSubscriptionUseCase:          grantSubscriptionUseCase,
+		GetLedgerUseCase:                  getLedgerUseCase,
+		RecordPaymentUseCase:              recordPaymentUseCase,
+		RecordRefundUseCase:               recordRefundUseCase,
+		PayBalanceUseCase:                 payBalanceUseCase,
+		RefundPaymentUseCase:              refundPaymentUseCase,
+		HandlePaymentWebhookUseCase:       handlePaymentWebhookUseCase,
+		CreateCircleUseCase:               createCircleUseCase,
+		GetCircleUseCase:                  getCircleUseCase,
+		AddMemberUseCase:                  addMemberUseCase,
+		RemoveMemberUseCase:               removeMemberUseCase,
+		DeleteCircleUseCase:               deleteCircleUseCase,
+		RestoreCircleUseCase:              restoreCircleUseCase,
+		ChangeCircleDuesUseCase:           changeCircleDuesUseCase,
+		RecordCircleExpenseUseCase:        recordCircleExpenseUseCase,
+		ListCircleExpensesUseCase:         listCircleExpensesUseCase,
+		GetCircleSettlementUseCase:        getCircleSettlementUseCase,
+		CreateShipmentUseCase:             createShipmentUseCase,
+		GetShipmentUseCase:                getShipmentUseCase,
+		ListUserShipmentsUseCase:          listUserShipmentsUseCase,
+		UpdateShipmentStatusUseCase:       updateShipmentStatusUseCase,
+		CancelShipmentUseCase:             cancelShipmentUseCase,
+		QuoteShippingFeeUseCase:           quoteShippingFeeUseCase,
+		CreateCircleEventUseCase:          createCircleEventUseCase,
+		GetCircleEventUseCase:             getCircleEventUseCase,
+		ListCircleEventsUseCase:           listCircleEventsUseCase,
+		UpdateCircleEventUseCase:          updateCircleEventUseCase,
+		CancelCircleEventUseCase:          cancelCircleEventUseCase,
+		RespondCircleEventRSVPUseCase:     respondCircleEventRSVPUseCase,
+		ExportCircleCalendarUseCase:       exportCircleCalendarUseCase,
+		ExportUserCalendarUseCase:         exportUserCalendarUseCase,
+		IssueCalendarFeedTokenUseCase:     issueCalendarFeedTokenUseCase,
+		RegisterCircleWebhookUseCase:      registerCircleWebhookUseCase,
+		ListCircleWebhooksUseCase:         listCircleWebhooksUseCase,
+		ListWebhookDeliveriesUseCase:      listWebhookDeliveriesUseCase,
+		GetNotificationSettingsUseCase:    getNotificationSettingsUseCase,
+		UpdateNotificationSettingsUseCase: updateNotificationSettingsUseCase,
 	}, nil
 }
 
+// newEventBus はドメインイベントのバスを作成し、すべてのイベントをログに出す
+func newEventBus() *infrastructure.InProcessEventBus {
+	bus := infrastructure.NewInProcessEventBus()
+	bus.SubscribeAll(func(event domain.DomainEvent) error {
+		log.Printf("domain event: %s", event.EventName())
+		return nil
+	})
+	return bus
+}
+
+// subscribeCircleWebhooks はメンバーの参加・脱退をサークルのWebhookの配信待ちにする
+func subscribeCircleWebhooks(bus *infrastructure.InProcessEventBus, enqueueWebhooks *usecase.EnqueueWebhookDeliveriesUseCase) {
+	infrastructure.Subscribe(bus, func(event domain.MemberJoined) error {
+		_, err := enqueueWebhooks.Execute(usecase.EnqueueWebhookDeliveriesInput{
+			EventName:  event.EventName(),
+			CircleID:   event.CircleID.Value(),
+			UserID:     event.UserID.Value(),
+			OccurredAt: event.OccurredAt(),
+		})
+		return err
+	})
+	infrastructure.Subscribe(bus, func(event domain.MemberLeft) error {
+		_, err := enqueueWebhooks.Execute(usecase.EnqueueWebhookDeliveriesInput{
+			EventName:  event.EventName(),
+			CircleID:   event.CircleID.Value(),
+			UserID:     event.UserID.Value(),
+			OccurredAt: event.OccurredAt(),
+		})
+		return err
+	})
+}
+
+// subscribeMailNotifications はユーザー・サークルのイベントをメールで通知する
+func subscribeMailNotifications(
+	bus *infrastructure.InProcessEventBus,
+	notifyMemberJoined *usecase.NotifyMemberJoinedUseCase,
+	notifyCircleFilled *usecase.NotifyCircleFilledUseCase,
+	notifyEmailChanged *usecase.NotifyEmailChangedUseCase,
+	sendEmailVerification *usecase.SendEmailVerificationUseCase,
+) {
+	infrastructure.Subscribe(bus, func(event domain.MemberJoined) error {
+		return notifyMemberJoined.Execute(usecase.NotifyMemberJoinedInput{
+			CircleID: event.CircleID.Value(),
+			UserID:   event.UserID.Value(),
+		})
+	})
+	infrastructure.Subscribe(bus, func(event domain.CircleFilled) error {
+		return notifyCircleFilled.Execute(usecase.NotifyCircleFilledInput{
+			CircleID:        event.CircleID.Value(),
+			MaxParticipants: event.MaxParticipants,
+		})
+	})
+	infrastructure.Subscribe(bus, func(event domain.UserEmailChanged) error {
+		return notifyEmailChanged.Execute(usecase.NotifyEmailChangedInput{
+			UserID:   event.UserID.Value(),
+			OldEmail: event.OldEmail.Value(),
+			NewEmail: event.NewEmail.Value(),
+		})
+	})
+	infrastructure.Subscribe(bus, func(event domain.EmailVerificationRequested) error {
+		return sendEmailVerification.Execute(usecase.SendEmailVerificationInput{
+			UserID:      event.UserID.Value(),
+			Email:       event.Email.Value(),
+			RequestedAt: event.OccurredAt(),
+		})
+	})
+}
+
+// newMailer は SMTP_HOST が指定されていればSMTPで送信し、なければメールをログに書き出す
+// MAIL_LOG_FILE を指定するとログの代わりにファイルへ追記する
+func newMailer(clock domain.Clock) (domain.Mailer, error) {
+	if host := os.Getenv("SMTP_HOST"); host != "" {
+		port := 25
+		if value := os.Getenv("SMTP_PORT"); value != "" {
+			parsed, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, err
+			}
+			port = parsed
+		}
+		from := os.Getenv("MAIL_FROM")
+		if from == "" {
+			from = "noreply@localhost"
+		}
+		return infrastructure.NewSMTPMailer(infrastructure.SMTPConfig{
+			Host:     host,
+			Port:     port,
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     from,
+		}, clock)
+	}
+
+	if path := os.Getenv("MAIL_LOG_FILE"); path != "" {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		return infrastructure.NewLogMailer(file), nil
+	}
+	return infrastructure.NewLogMailer(log.Writer()), nil
+}
+
+// mailLocale は MAIL_LOCALE（ja / en）から通知の言語を決める
+func mailLocale() (domain.Locale, error) {
+	if value := os.Getenv("MAIL_LOCALE"); value != "" {
+		return domain.ParseLocale(value)
+	}
+	return domain.LocaleJapanese, nil
+}
+
+// emailVerificationSecret はメールアドレス確認用トークンの署名鍵を環境変数から取得する
+func emailVerificationSecret() string {
+	if secret := os.Getenv("EMAIL_VERIFICATION_SECRET"); secret != "" {
+		return secret
+	}
+	return "local-email-verification-secret"
+}
+
+// newAccessTokenCodec は JWT_KEYS の鍵でアクセストークンを署名する（先頭の鍵で署名し、すべての鍵で検証する）
+// 未設定の場合は起動ごとに Ed25519 の鍵を生成するため、再起動すると発行済みのトークンは無効になる
+func newAccessTokenCodec() (*infrastructure.JWTAccessTokenCodec, error) {
+	issuer := os.Getenv("JWT_ISSUER")
+	if issuer == "" {
+		issuer = "ddd-bottomup"
+	}
+	keys, err := infrastructure.ParseJWTKeys(os.Getenv("JWT_KEYS"))
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		log.Println("JWT_KEYS is not set; generating an ephemeral signing key")
+		key, err := infrastructure.GenerateEd25519JWTKey("ephemeral")
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return infrastructure.NewJWTAccessTokenCodec(issuer, keys...)
+}
+
+// newOIDCProvider は OIDC_ISSUER が指定されていれば外部の OpenID プロバイダーを使う
+// 未指定で OIDC_FAKE_IDP が true の場合のみ /fake-idp に偽のプロバイダーを公開し、ネットワークなしで一連の流れを試せるようにする
+// どちらもない場合は nil を返し、OIDC でのログインを無効にする
+func newOIDCProvider(clock domain.Clock) (domain.OIDCProvider, http.Handler, error) {
+	redirectURL := os.Getenv("OIDC_REDIRECT_URL")
+	if redirectURL == "" {
+		redirectURL = "http://localhost:8080/auth/oidc/callback"
+	}
+
+	if issuer := os.Getenv("OIDC_ISSUER"); issuer != "" {
+		client := infrastructure.NewOIDCClient(infrastructure.OIDCClientConfig{
+			Issuer:       issuer,
+			ClientID:     os.Getenv("OIDC_CLIENT_ID"),
+			ClientSecret: os.Getenv("OIDC_CLIENT_SECRET"),
+			RedirectURL:  redirectURL,
+		}, &http.Client{Timeout: 10 * time.Second})
+		return client, nil, nil
+	}
+
+	useFake, err := fakeOIDCProviderEnabled()
+	if err != nil || !useFake {
+		return nil, nil, err
+	}
+	log.Println("OIDC_FAKE_IDP is set; serving a stand-in OpenID provider for local development")
+	fake, err := infrastructure.NewFakeOIDCProvider("http://localhost:8080/fake-idp", "ddd-bottomup", "local-oidc-secret", clock)
+	if err != nil {
+		return nil, nil, err
+	}
+	fake.AddUser(infrastructure.FakeOIDCUser{
+		Subject:       "fake-user-1",
+		Email:         "sso.user@example.com",
+		EmailVerified: true,
+		GivenName:     "Sso",
+		FamilyName:    "User",
+	})
+	client := infrastructure.NewOIDCClient(infrastructure.OIDCClientConfig{
+		Issuer:       fake.Issuer(),
+		ClientID:     "ddd-bottomup",
+		ClientSecret: "local-oidc-secret",
+		RedirectURL:  redirectURL,
+	}, fake.HTTPClient())
+	return client, fake, nil
+}
+
+// fakeOIDCProviderEnabled は OIDC_FAKE_IDP が true の場合に偽の OpenID プロバイダーを使う（ローカル開発専用）
+func fakeOIDCProviderEnabled() (bool, error) {
+	value := os.Getenv("OIDC_FAKE_IDP")
+	if value == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(value)
+}
+
+// loadAdministrators は ADMIN_USER_IDS（カンマ区切りの UserID）のユーザーを管理者として扱う
+func loadAdministrators() (*domain.Administrators, error) {
+	var userIDs []*domain.UserID
+	for _, value := range strings.Split(os.Getenv("ADMIN_USER_IDS"), ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		userID, err := domain.ReconstructUserID(value)
+		if err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+	return domain.NewAdministrators(userIDs...), nil
+}
+
+// bootstrapAPIKey は BOOTSTRAP_API_KEY が true の場合のみ、すべての権限を持つ API キーを発行して端末に表示する
+// メモリ上のリポジトリでは再起動のたびにユーザーが消えるため、ローカルで管理用の API を試すのに使う
+// キーはログに残さないよう、標準出力が端末でなければ発行しない
+func bootstrapAPIKey(repository domain.APIKeyRepository, clock domain.Clock) error {
+	value := os.Getenv("BOOTSTRAP_API_KEY")
+	if value == "" {
+		return nil
+	}
+	enabled, err := strconv.ParseBool(value)
+	if err != nil || !enabled {
+		return err
+	}
+	if !isTerminal(os.Stdout) {
+		return errors.New("BOOTSTRAP_API_KEY requires stdout to be a terminal")
+	}
+
+	key, secret, err := domain.NewAPIKey("bootstrap", domain.AllPermissions(), "bootstrap", clock.Now(), nil)
+	if err != nil {
+		return err
+	}
+	if err := repository.Save(key); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stdout, "Bootstrap API key (shown once): %s\n", secret)
+	return nil
+}
+
+// isTerminal は file が端末（キャラクタデバイス）かどうかを返す
+func isTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// requireVerifiedEmailForMembership は REQUIRE_VERIFIED_EMAIL が true の場合、サークルへの参加にメールアドレスの確認を求める
+func requireVerifiedEmailForMembership() (bool, error) {
+	value := os.Getenv("REQUIRE_VERIFIED_EMAIL")
+	if value == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(value)
+}
+
+// loadDeletionPolicy は DELETION_GRACE_PERIOD・DELETION_RETENTION（例: 720h）が指定されていれば
+// 論理削除したデータを復元できる期間と物理削除するまでの保持期間を上書きする
+func loadDeletionPolicy() (domain.DeletionPolicy, error) {
+	gracePeriod, retention := domain.DefaultDeletionGracePeriod, domain.DefaultDeletionRetention
+	if value := os.Getenv("DELETION_GRACE_PERIOD"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return domain.DeletionPolicy{}, err
+		}
+		gracePeriod = parsed
+	}
+	if value := os.Getenv("DELETION_RETENTION"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return domain.DeletionPolicy{}, err
+		}
+		retention = parsed
+	}
+	return domain.NewDeletionPolicy(gracePeriod, retention)
+}
+
+// paymentWebhookSecret はWebhook署名の共有シークレットを環境変数から取得する
+func paymentWebhookSecret() string {
+	if secret := os.Getenv("PAYMENT_WEBHOOK_SECRET"); secret != "" {
+		return secret
+	}
+	return "local-webhook-secret"
+}
+
+// loadExchangeRates は EXCHANGE_RATES_FILE が指定されていれば為替レートを読み込む
+func loadExchangeRates() (*infrastructure.StaticExchangeRateProvider, error) {
+	path := os.Getenv("EXCHANGE_RATES_FILE")
+	if path == "" {
+		return infrastructure.NewStaticExchangeRateProvider(), nil
+	}
+	return infrastructure.LoadExchangeRateProvider(path)
+}
+
+// loadCapacityPolicies は CAPACITY_POLICY_FILE が指定されていればサークルの定員ポリシーを読み込む
+func loadCapacityPolicies() (*domain.CapacityPolicyRegistry, error) {
+	path := os.Getenv("CAPACITY_POLICY_FILE")
+	if path == "" {
+		return nil, nil
+	}
+	return infrastructure.LoadCapacityPolicyRegistry(path)
+}
+
+// loadShippingRates は SHIPPING_RATES_FILE が指定されていれば送料の料金表を読み込む
+func loadShippingRates() (*domain.ShippingRateTable, error) {
+	path := os.Getenv("SHIPPING_RATES_FILE")
+	if path == "" {
+		return domain.DefaultShippingRateTable(), nil
+	}
+	return infrastructure.LoadShippingRateTable(path)
+}
+
+// deliverFakeWebhooks はフェイクゲートウェイが発行したWebhookを定期的に処理する
+func deliverFakeWebhooks(gateway *infrastructure.FakePaymentGateway, useCase *usecase.HandlePaymentWebhookUseCase) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		err := gateway.DeliverWebhooks(func(payload []byte, signature string) error {
+			_, err := useCase.Execute(usecase.HandlePaymentWebhookInput{Payload: payload, Signature: signature})
+			return err
+		})
+		if err != nil {
+			log.Printf("Failed to deliver payment webhooks: %v", err)
+		}
+	}
+}
+
+// relayOutbox はアウトボックスを定期的に確認し、未配信のイベントを配信する
+func relayOutbox(relay *infrastructure.OutboxRelay) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if _, err := relay.RunOnce(); err != nil {
+			log.Printf("Failed to relay outbox: %v", err)
+		}
+	}
+}
+
+// deliverCircleWebhooks は配信時刻を迎えたサークルのWebhookを定期的に送信する
+func deliverCircleWebhooks(useCase *usecase.DeliverWebhooksUseCase) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if _, err := useCase.Execute(); err != nil {
+			log.Printf("Failed to deliver circle webhooks: %v", err)
+		}
+	}
+}
+
+// sendNotificationDigests は送る時刻を迎えた通知を毎分確認して送る
+func sendNotificationDigests(useCase *usecase.SendNotificationDigestsUseCase) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if _, err := useCase.Execute(); err != nil {
+			log.Printf("Failed to send notification digests: %v", err)
+		}
+	}
+}
+
+// purgeDeletedRecords は保持期間を過ぎた論理削除済みのデータを毎時確認して物理削除する
+func purgeDeletedRecords(useCase *usecase.PurgeDeletedRecordsUseCase) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		output, err := useCase.Execute()
+		if err != nil {
+			log.Printf("Failed to purge deleted records: %v", err)
+		}
+		if output != nil && output.PurgedUsers+output.PurgedCircles > 0 {
+			log.Printf("Purged %d users and %d circles", output.PurgedUsers, output.PurgedCircles)
+		}
+	}
+}
+
 func testApplication(app *Application) error {
 	log.Println("Running application tests...")
 
@@ -93,10 +906,15 @@ func testApplication(app *Application) error {
 
 	userID := createOutput.UserID
 	log.Printf("✓ User created successfully: ID=%s", userID)
+	createdID, err := domain.ReconstructUserID(userID)
+	if err != nil {
+		return err
+	}
+	actor := domain.NewUserPrincipal(createdID, false)
 
 	// テスト2: ユーザー取得
 	log.Println("Test 2: Getting user...")
-	getInput := usecase.GetUserInput{UserID: userID}
+	getInput := usecase.GetUserInput{Actor: actor, UserID: userID}
 	getOutput, err := app.GetUserUseCase.Execute(getInput)
 	if err != nil {
 		return err
@@ -110,6 +928,7 @@ func testApplication(app *Application) error {
 	firstName := "次郎"
 	email := "jiro@example.com"
 	updateInput := usecase.UpdateUserInput{
+		Actor:     actor,
 		UserID:    userID,
 		FirstName: &firstName,
 		Email:     &email,
@@ -140,7 +959,7 @@ func testApplication(app *Application) error {
 
 	// テスト5: ユーザー削除
 	log.Println("Test 5: Deleting user...")
-	deleteInput := usecase.DeleteUserInput{UserID: userID}
+	deleteInput := usecase.DeleteUserInput{Actor: actor, UserID: userID}
 	err = app.DeleteUserUseCase.Execute(deleteInput)
 	if err != nil {
 		return err
@@ -0,0 +1,15 @@
+-- ユーザーの契約（サブスクリプション）情報
+
+ALTER TABLE users
+    ADD COLUMN subscription_plan VARCHAR(32) NOT NULL DEFAULT 'free' AFTER is_premium,
+    ADD COLUMN subscription_started_at DATETIME NULL AFTER subscription_plan,
+    ADD COLUMN subscription_expires_at DATETIME NULL AFTER subscription_started_at,
+    ADD COLUMN subscription_trial BOOLEAN NOT NULL DEFAULT FALSE AFTER subscription_expires_at,
+    ADD COLUMN subscription_trial_used BOOLEAN NOT NULL DEFAULT FALSE AFTER subscription_trial,
+    ADD COLUMN subscription_cancelled_at DATETIME NULL AFTER subscription_trial_used,
+    ADD INDEX idx_subscription_expires_at (subscription_expires_at);
+
+-- 既存のプレミアム会員は期限なしのプレミアム契約として移行
+UPDATE users
+SET subscription_plan = 'premium_monthly'
+WHERE is_premium = TRUE;
@@ -0,0 +1,24 @@
+-- 課金台帳とサークル会費
+
+-- サークル会費（NULLの場合は会費なし）
+ALTER TABLE circles
+    ADD COLUMN dues_amount BIGINT NULL AFTER owner_id,
+    ADD COLUMN dues_currency CHAR(3) NULL AFTER dues_amount;
+
+-- 追記専用の台帳（更新・削除しない）
+-- 退会後も請求履歴を保持するため users への外部キーは設定しない
+CREATE TABLE ledger_entries (
+    seq BIGINT AUTO_INCREMENT UNIQUE,
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    entry_type VARCHAR(16) NOT NULL,
+    amount BIGINT NOT NULL,
+    currency CHAR(3) NOT NULL,
+    description VARCHAR(255) NOT NULL,
+    reference VARCHAR(255) NOT NULL DEFAULT '',
+    occurred_at DATETIME(6) NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_ledger_user_occurred (user_id, occurred_at),
+    INDEX idx_ledger_reference (reference),
+    CONSTRAINT chk_ledger_amount_positive CHECK (amount > 0)
+);
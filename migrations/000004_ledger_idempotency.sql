@@ -0,0 +1,5 @@
+-- 決済Webhookの再送による二重計上を防ぐ一意キー
+
+ALTER TABLE ledger_entries
+    ADD COLUMN idempotency_key VARCHAR(300) NULL AFTER reference,
+    ADD UNIQUE INDEX uq_ledger_idempotency_key (idempotency_key);
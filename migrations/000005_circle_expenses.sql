@@ -0,0 +1,27 @@
+-- サークルの立て替え費用と負担額
+
+CREATE TABLE circle_expenses (
+    seq BIGINT AUTO_INCREMENT UNIQUE,
+    id VARCHAR(36) PRIMARY KEY,
+    circle_id VARCHAR(36) NOT NULL,
+    payer_id VARCHAR(36) NOT NULL,
+    amount BIGINT NOT NULL,
+    currency CHAR(3) NOT NULL,
+    description VARCHAR(255) NOT NULL,
+    split_method VARCHAR(16) NOT NULL,
+    incurred_at DATETIME(6) NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_circle_expenses_circle (circle_id, incurred_at),
+    FOREIGN KEY (circle_id) REFERENCES circles(id) ON DELETE CASCADE,
+    CONSTRAINT chk_circle_expense_amount_positive CHECK (amount > 0)
+);
+
+-- 参加者ごとの負担額（通貨は費用と同じ）
+CREATE TABLE circle_expense_shares (
+    expense_id VARCHAR(36) NOT NULL,
+    position INT NOT NULL,
+    participant_id VARCHAR(36) NOT NULL,
+    amount BIGINT NOT NULL,
+    PRIMARY KEY (expense_id, position),
+    FOREIGN KEY (expense_id) REFERENCES circle_expenses(id) ON DELETE CASCADE
+);
@@ -0,0 +1,28 @@
+-- サークルメンバーへのグッズ発送と荷物
+
+CREATE TABLE shipments (
+    id VARCHAR(36) PRIMARY KEY,
+    recipient_id VARCHAR(36) NOT NULL,
+    fee_amount BIGINT NOT NULL,
+    fee_currency CHAR(3) NOT NULL,
+    status VARCHAR(16) NOT NULL,
+    tracking_number VARCHAR(64) NOT NULL DEFAULT '',
+    created_at DATETIME(6) NOT NULL,
+    shipped_at DATETIME(6) NULL,
+    completed_at DATETIME(6) NULL,
+    INDEX idx_shipments_recipient (recipient_id, created_at),
+    FOREIGN KEY (recipient_id) REFERENCES users(id),
+    CONSTRAINT chk_shipment_fee_not_negative CHECK (fee_amount >= 0)
+);
+
+CREATE TABLE shipment_baggage (
+    shipment_id VARCHAR(36) NOT NULL,
+    position INT NOT NULL,
+    description VARCHAR(255) NOT NULL,
+    weight_grams BIGINT NOT NULL,
+    length_cm BIGINT NOT NULL,
+    width_cm BIGINT NOT NULL,
+    height_cm BIGINT NOT NULL,
+    PRIMARY KEY (shipment_id, position),
+    FOREIGN KEY (shipment_id) REFERENCES shipments(id) ON DELETE CASCADE
+);
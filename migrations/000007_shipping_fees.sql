@@ -0,0 +1,18 @@
+-- 送料の計算根拠（配送地域・重量）と明細
+
+ALTER TABLE shipments
+    ADD COLUMN destination_zone VARCHAR(32) NOT NULL DEFAULT '' AFTER recipient_id,
+    ADD COLUMN actual_weight_grams BIGINT NOT NULL DEFAULT 0 AFTER fee_currency,
+    ADD COLUMN volumetric_weight_grams BIGINT NOT NULL DEFAULT 0 AFTER actual_weight_grams,
+    ADD COLUMN chargeable_weight_grams BIGINT NOT NULL DEFAULT 0 AFTER volumetric_weight_grams;
+
+-- 明細の通貨は shipments.fee_currency と同じ
+CREATE TABLE shipment_fee_items (
+    shipment_id VARCHAR(36) NOT NULL,
+    position INT NOT NULL,
+    code VARCHAR(32) NOT NULL,
+    description VARCHAR(255) NOT NULL,
+    amount BIGINT NOT NULL,
+    PRIMARY KEY (shipment_id, position),
+    FOREIGN KEY (shipment_id) REFERENCES shipments(id) ON DELETE CASCADE
+);
@@ -0,0 +1,29 @@
+-- サークルのイベントと出欠
+
+CREATE TABLE circle_events (
+    id VARCHAR(36) PRIMARY KEY,
+    circle_id VARCHAR(36) NOT NULL,
+    title VARCHAR(100) NOT NULL,
+    starts_at DATETIME(6) NOT NULL,
+    ends_at DATETIME(6) NOT NULL,
+    location VARCHAR(255) NOT NULL DEFAULT '',
+    capacity INT NOT NULL DEFAULT 0,
+    created_at DATETIME(6) NOT NULL,
+    cancelled_at DATETIME(6) NULL,
+    INDEX idx_circle_events_circle (circle_id, starts_at),
+    FOREIGN KEY (circle_id) REFERENCES circles(id) ON DELETE CASCADE,
+    CONSTRAINT chk_circle_event_period CHECK (ends_at > starts_at),
+    CONSTRAINT chk_circle_event_capacity CHECK (capacity >= 0)
+);
+
+-- 参加者ごとの出欠（1人1件、回答の変更は上書き）
+CREATE TABLE circle_event_rsvps (
+    event_id VARCHAR(36) NOT NULL,
+    position INT NOT NULL,
+    user_id VARCHAR(36) NOT NULL,
+    status VARCHAR(16) NOT NULL,
+    responded_at DATETIME(6) NOT NULL,
+    PRIMARY KEY (event_id, position),
+    UNIQUE KEY uq_circle_event_rsvps_user (event_id, user_id),
+    FOREIGN KEY (event_id) REFERENCES circle_events(id) ON DELETE CASCADE
+);
@@ -0,0 +1,15 @@
+-- ドメインイベントのアウトボックス（集約と同じトランザクションで書き込み、リレーが配信する）
+
+CREATE TABLE outbox (
+    seq BIGINT AUTO_INCREMENT UNIQUE,
+    id VARCHAR(36) PRIMARY KEY,
+    event_name VARCHAR(64) NOT NULL,
+    payload JSON NOT NULL,
+    occurred_at DATETIME(6) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at DATETIME(6) NOT NULL,
+    last_error TEXT NOT NULL DEFAULT (''),
+    dispatched_at DATETIME(6) NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_outbox_due (dispatched_at, next_attempt_at)
+);
@@ -0,0 +1,32 @@
+-- サークルのWebhook登録と配信ログ
+
+CREATE TABLE circle_webhooks (
+    id VARCHAR(36) PRIMARY KEY,
+    circle_id VARCHAR(36) NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    events JSON NOT NULL,
+    secret VARCHAR(64) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    INDEX idx_circle_webhooks_circle (circle_id, created_at),
+    FOREIGN KEY (circle_id) REFERENCES circles(id) ON DELETE CASCADE
+);
+
+-- 1イベント・1Webhookにつき1件（再試行は同じ行を更新する）
+CREATE TABLE webhook_deliveries (
+    id VARCHAR(36) PRIMARY KEY,
+    webhook_id VARCHAR(36) NOT NULL,
+    event_name VARCHAR(64) NOT NULL,
+    event_key VARCHAR(36) NOT NULL,
+    payload JSON NOT NULL,
+    status VARCHAR(16) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    response_status INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT (''),
+    next_attempt_at DATETIME(6) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    completed_at DATETIME(6) NULL,
+    UNIQUE KEY uq_webhook_deliveries_event (webhook_id, event_key),
+    INDEX idx_webhook_deliveries_due (status, next_attempt_at),
+    INDEX idx_webhook_deliveries_log (webhook_id, created_at),
+    FOREIGN KEY (webhook_id) REFERENCES circle_webhooks(id) ON DELETE CASCADE
+);
@@ -0,0 +1,30 @@
+-- ユーザーごとの通知設定と、まとめて送る・遅らせて送る通知
+
+CREATE TABLE notification_preferences (
+    user_id VARCHAR(36) PRIMARY KEY,
+    locale VARCHAR(8) NOT NULL,
+    time_zone VARCHAR(64) NOT NULL,
+    channels JSON NOT NULL,
+    quiet_start_minute INT NULL,
+    quiet_end_minute INT NULL,
+    digest_minute INT NOT NULL,
+    updated_at DATETIME(6) NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT chk_notification_digest_minute CHECK (digest_minute BETWEEN 0 AND 1439)
+);
+
+-- 作成時の言語で描画した件名・本文を保持する
+CREATE TABLE pending_notifications (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    kind VARCHAR(32) NOT NULL,
+    recipient VARCHAR(255) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    digest BOOLEAN NOT NULL,
+    deliver_after DATETIME(6) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    sent_at DATETIME(6) NULL,
+    INDEX idx_pending_notifications_due (sent_at, deliver_after),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
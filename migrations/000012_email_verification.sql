@@ -0,0 +1,8 @@
+-- メールアドレスの確認状態
+-- 既存のユーザーは確認の仕組みができる前に登録したため、確認済みとして扱う
+
+ALTER TABLE users
+    ADD COLUMN email_verified BOOLEAN NOT NULL DEFAULT FALSE AFTER email,
+    ADD COLUMN pending_email VARCHAR(255) NULL AFTER email_verified;
+
+UPDATE users SET email_verified = TRUE;
@@ -0,0 +1,20 @@
+-- パスワードによる認証情報とログインセッション
+
+CREATE TABLE user_credentials (
+    user_id VARCHAR(36) PRIMARY KEY,
+    password_hash VARCHAR(255) NOT NULL, -- argon2id の PHC 形式（ソルト・パラメーターを含む）
+    updated_at DATETIME(6) NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- トークンそのものは保存せず、SHA-256 のハッシュで引く
+CREATE TABLE sessions (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    created_at DATETIME(6) NOT NULL,
+    expires_at DATETIME(6) NOT NULL,
+    INDEX idx_sessions_user_id (user_id),
+    INDEX idx_sessions_expires_at (expires_at),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
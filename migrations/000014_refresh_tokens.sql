@@ -0,0 +1,16 @@
+-- モバイルクライアント向けのリフレッシュトークン
+-- 使用済み（rotated_at）・失効（revoked_at）のトークンも残し、再利用の検知に使う
+
+CREATE TABLE refresh_tokens (
+    id VARCHAR(36) PRIMARY KEY,
+    family_id VARCHAR(36) NOT NULL, -- 1回のログインから続くトークンの系列
+    user_id VARCHAR(36) NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    issued_at DATETIME(6) NOT NULL,
+    expires_at DATETIME(6) NOT NULL,
+    rotated_at DATETIME(6) NULL,
+    revoked_at DATETIME(6) NULL,
+    INDEX idx_refresh_tokens_family_id (family_id),
+    INDEX idx_refresh_tokens_user_id (user_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
@@ -0,0 +1,23 @@
+-- OpenID Connect によるログイン
+
+-- 認可エンドポイントに送り出したログインの途中状態（コールバックで一度だけ使う）
+CREATE TABLE oidc_login_requests (
+    state VARCHAR(64) PRIMARY KEY,
+    nonce VARCHAR(64) NOT NULL,
+    code_verifier VARCHAR(64) NOT NULL,
+    link_user_id VARCHAR(36) NULL, -- ログイン中のユーザーが紐付ける場合
+    created_at DATETIME(6) NOT NULL,
+    expires_at DATETIME(6) NOT NULL,
+    INDEX idx_oidc_login_requests_expires_at (expires_at)
+);
+
+-- 外部のアカウント（発行者と subject の組）とユーザーの紐付け
+CREATE TABLE external_identities (
+    issuer VARCHAR(255) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    user_id VARCHAR(36) NOT NULL,
+    linked_at DATETIME(6) NOT NULL,
+    PRIMARY KEY (issuer, subject),
+    INDEX idx_external_identities_user_id (user_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
@@ -0,0 +1,16 @@
+-- サービス間の呼び出しに使う API キー
+
+-- キーそのものは保存せず、secret のハッシュのみを持つ（prefix で検索する）
+CREATE TABLE api_keys (
+    id VARCHAR(36) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    prefix VARCHAR(16) NOT NULL,
+    secret_hash CHAR(64) NOT NULL,
+    permissions JSON NOT NULL,
+    created_by VARCHAR(36) NOT NULL, -- 発行した利用者の UserID または API キーの ID
+    created_at DATETIME(6) NOT NULL,
+    expires_at DATETIME(6) NULL,
+    last_used_at DATETIME(6) NULL,
+    revoked_at DATETIME(6) NULL,
+    UNIQUE KEY uk_api_keys_prefix (prefix)
+);
@@ -0,0 +1,19 @@
+-- 状態を変更した操作の監査ログ（追記のみ。アプリケーションは UPDATE・DELETE しない）
+
+CREATE TABLE audit_log (
+    seq BIGINT AUTO_INCREMENT UNIQUE, -- 記録した順
+    id VARCHAR(36) PRIMARY KEY,
+    actor_type VARCHAR(16) NOT NULL,  -- anonymous / user / service
+    actor_id VARCHAR(36) NOT NULL,    -- 利用者の UserID または API キーの ID（匿名の場合は空）
+    action VARCHAR(64) NOT NULL,
+    target_type VARCHAR(32) NOT NULL,
+    target_id VARCHAR(36) NOT NULL,
+    changes JSON NOT NULL,            -- [{"field", "before", "after"}]
+    request_id VARCHAR(128) NOT NULL,
+    occurred_at DATETIME(6) NOT NULL,
+    INDEX idx_audit_log_actor (actor_id, seq),
+    INDEX idx_audit_log_target (target_type, target_id, seq),
+    INDEX idx_audit_log_action (action, seq),
+    INDEX idx_audit_log_request (request_id),
+    INDEX idx_audit_log_occurred_at (occurred_at)
+);
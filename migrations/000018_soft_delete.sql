@@ -0,0 +1,48 @@
+-- ユーザー・サークルの論理削除
+-- 削除した行は deleted_at を設定して残し、保持期間を過ぎたら物理削除ジョブが DELETE する
+
+ALTER TABLE users
+    ADD COLUMN deleted_at DATETIME(6) NULL,
+    -- メールアドレスの重複は削除していないユーザーの間でのみ禁止する
+    ADD COLUMN active_email VARCHAR(255) AS (IF(deleted_at IS NULL, email, NULL)) STORED,
+    DROP INDEX email,
+    ADD UNIQUE INDEX uq_users_active_email (active_email),
+    ADD INDEX idx_users_deleted_at (deleted_at);
+
+ALTER TABLE circles
+    ADD COLUMN deleted_at DATETIME(6) NULL,
+    ADD INDEX idx_circles_deleted_at (deleted_at);
+
+-- アカウントを論理削除したメンバー（復元時にサークルへ戻す）
+ALTER TABLE circle_members
+    ADD COLUMN detached BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- メンバー数は外したメンバーを数えない
+DROP TRIGGER IF EXISTS update_member_count_after_insert;
+DROP TRIGGER IF EXISTS update_member_count_after_delete;
+
+DELIMITER $$
+
+CREATE TRIGGER update_member_count_after_insert
+AFTER INSERT ON circle_members
+FOR EACH ROW
+BEGIN
+    UPDATE circles
+    SET member_count = (
+        SELECT COUNT(*) FROM circle_members WHERE circle_id = NEW.circle_id AND detached = FALSE
+    )
+    WHERE id = NEW.circle_id;
+END$$
+
+CREATE TRIGGER update_member_count_after_delete
+AFTER DELETE ON circle_members
+FOR EACH ROW
+BEGIN
+    UPDATE circles
+    SET member_count = (
+        SELECT COUNT(*) FROM circle_members WHERE circle_id = OLD.circle_id AND detached = FALSE
+    )
+    WHERE id = OLD.circle_id;
+END$$
+
+DELIMITER ;
@@ -0,0 +1,9 @@
+-- カレンダーフィード（.ics）の購読用トークン
+-- ユーザーごとに1行で、発行し直すと以前のトークンを置き換える
+
+CREATE TABLE calendar_feed_tokens (
+    user_id VARCHAR(36) PRIMARY KEY,
+    token_hash CHAR(64) NOT NULL,
+    issued_at DATETIME(6) NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
@@ -0,0 +1,10 @@
+-- 受取人を物理削除しても発送と送料の記録は残す
+-- 物理削除したユーザーの発送は recipient_id を NULL にする
+
+ALTER TABLE shipments
+    DROP FOREIGN KEY shipments_ibfk_1,
+    MODIFY recipient_id VARCHAR(36) NULL;
+
+ALTER TABLE shipments
+    ADD CONSTRAINT fk_shipments_recipient
+        FOREIGN KEY (recipient_id) REFERENCES users(id) ON DELETE SET NULL;
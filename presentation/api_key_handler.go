@@ -0,0 +1,121 @@
+package presentation
+
+import (
+	"ddd-bottomup/usecase"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// APIKeyHandler は API キーを発行・失効させる管理用のエンドポイント
+type APIKeyHandler struct {
+	createAPIKeyUseCase *usecase.CreateAPIKeyUseCase
+	listAPIKeysUseCase  *usecase.ListAPIKeysUseCase
+	revokeAPIKeyUseCase *usecase.RevokeAPIKeyUseCase
+}
+
+func NewAPIKeyHandler(
+	createAPIKeyUseCase *usecase.CreateAPIKeyUseCase,
+	listAPIKeysUseCase *usecase.ListAPIKeysUseCase,
+	revokeAPIKeyUseCase *usecase.RevokeAPIKeyUseCase,
+) *APIKeyHandler {
+	return &APIKeyHandler{
+		createAPIKeyUseCase: createAPIKeyUseCase,
+		listAPIKeysUseCase:  listAPIKeysUseCase,
+		revokeAPIKeyUseCase: revokeAPIKeyUseCase,
+	}
+}
+
+type CreateAPIKeyRequest struct {
+	Name        string     `json:"name"`
+	Permissions []string   `json:"permissions"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+}
+
+type APIKeyResponse struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Prefix      string     `json:"prefix"`
+	Permissions []string   `json:"permissions"`
+	Key         string     `json:"key,omitempty"` // 発行時のみ
+	CreatedBy   string     `json:"createdBy"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+	LastUsedAt  *time.Time `json:"lastUsedAt,omitempty"`
+	RevokedAt   *time.Time `json:"revokedAt,omitempty"`
+}
+
+func NewAPIKeyResponse(output *usecase.APIKeyOutput) APIKeyResponse {
+	return APIKeyResponse{
+		ID:          output.ID,
+		Name:        output.Name,
+		Prefix:      output.Prefix,
+		Permissions: output.Permissions,
+		CreatedBy:   output.CreatedBy,
+		CreatedAt:   output.CreatedAt,
+		ExpiresAt:   output.ExpiresAt,
+		LastUsedAt:  output.LastUsedAt,
+		RevokedAt:   output.RevokedAt,
+	}
+}
+
+type ListAPIKeysResponse struct {
+	APIKeys []APIKeyResponse `json:"apiKeys"`
+}
+
+func (h *APIKeyHandler) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	var req CreateAPIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.createAPIKeyUseCase.Execute(usecase.CreateAPIKeyInput{
+		Actor:       AuthenticatedPrincipal(r.Context()),
+		RequestID:   requestID(r),
+		Name:        req.Name,
+		Permissions: req.Permissions,
+		ExpiresAt:   req.ExpiresAt,
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	response := NewAPIKeyResponse(&output.APIKeyOutput)
+	response.Key = output.Key
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusCreated, response)
+}
+
+func (h *APIKeyHandler) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	outputs, err := h.listAPIKeysUseCase.Execute(usecase.ListAPIKeysInput{
+		Actor: AuthenticatedPrincipal(r.Context()),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	response := ListAPIKeysResponse{APIKeys: make([]APIKeyResponse, len(outputs))}
+	for i, output := range outputs {
+		response.APIKeys[i] = NewAPIKeyResponse(output)
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (h *APIKeyHandler) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	output, err := h.revokeAPIKeyUseCase.Execute(usecase.RevokeAPIKeyInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		RequestID: requestID(r),
+		APIKeyID:  chi.URLParam(r, "keyID"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, NewAPIKeyResponse(output))
+}
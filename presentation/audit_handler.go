@@ -0,0 +1,117 @@
+package presentation
+
+import (
+	"ddd-bottomup/usecase"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// AuditHandler は監査ログを検索する管理用のエンドポイント
+type AuditHandler struct {
+	listAuditEntriesUseCase *usecase.ListAuditEntriesUseCase
+}
+
+func NewAuditHandler(listAuditEntriesUseCase *usecase.ListAuditEntriesUseCase) *AuditHandler {
+	return &AuditHandler{
+		listAuditEntriesUseCase: listAuditEntriesUseCase,
+	}
+}
+
+type AuditChangeResponse struct {
+	Field  string `json:"field"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+type AuditEntryResponse struct {
+	ID         string                `json:"id"`
+	ActorType  string                `json:"actorType"`
+	ActorID    string                `json:"actorId,omitempty"`
+	Action     string                `json:"action"`
+	TargetType string                `json:"targetType"`
+	TargetID   string                `json:"targetId"`
+	Changes    []AuditChangeResponse `json:"changes"`
+	RequestID  string                `json:"requestId,omitempty"`
+	OccurredAt time.Time             `json:"occurredAt"`
+}
+
+type ListAuditEntriesResponse struct {
+	Entries []AuditEntryResponse `json:"entries"`
+}
+
+func NewAuditEntryResponse(output *usecase.AuditEntryOutput) AuditEntryResponse {
+	changes := make([]AuditChangeResponse, len(output.Changes))
+	for i, change := range output.Changes {
+		changes[i] = AuditChangeResponse(change)
+	}
+	return AuditEntryResponse{
+		ID:         output.ID,
+		ActorType:  output.ActorType,
+		ActorID:    output.ActorID,
+		Action:     output.Action,
+		TargetType: output.TargetType,
+		TargetID:   output.TargetID,
+		Changes:    changes,
+		RequestID:  output.RequestID,
+		OccurredAt: output.OccurredAt,
+	}
+}
+
+// ListAuditEntries は GET /audit?actorId=&action=&targetType=&targetId=&requestId=&since=&until=&limit=
+// since / until は RFC 3339 形式
+func (h *AuditHandler) ListAuditEntries(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	since, err := parseTimeQuery(query.Get("since"))
+	if err != nil {
+		writeError(w, "Invalid since: must be RFC 3339", http.StatusBadRequest)
+		return
+	}
+	until, err := parseTimeQuery(query.Get("until"))
+	if err != nil {
+		writeError(w, "Invalid until: must be RFC 3339", http.StatusBadRequest)
+		return
+	}
+	limit := 0
+	if value := query.Get("limit"); value != "" {
+		limit, err = strconv.Atoi(value)
+		if err != nil {
+			writeError(w, "Invalid limit", http.StatusBadRequest)
+			return
+		}
+	}
+
+	outputs, err := h.listAuditEntriesUseCase.Execute(usecase.ListAuditEntriesInput{
+		Actor:      AuthenticatedPrincipal(r.Context()),
+		ActorID:    query.Get("actorId"),
+		Action:     query.Get("action"),
+		TargetType: query.Get("targetType"),
+		TargetID:   query.Get("targetId"),
+		RequestID:  query.Get("requestId"),
+		Since:      since,
+		Until:      until,
+		Limit:      limit,
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	response := ListAuditEntriesResponse{Entries: make([]AuditEntryResponse, len(outputs))}
+	for i, output := range outputs {
+		response.Entries[i] = NewAuditEntryResponse(output)
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func parseTimeQuery(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
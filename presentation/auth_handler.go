@@ -0,0 +1,204 @@
+package presentation
+
+import (
+	"ddd-bottomup/usecase"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+type AuthHandler struct {
+	loginUseCase                *usecase.LoginUseCase
+	logoutUseCase               *usecase.LogoutUseCase
+	tokenLoginUseCase           *usecase.TokenLoginUseCase
+	refreshAccessTokenUseCase   *usecase.RefreshAccessTokenUseCase
+	revokeRefreshTokenUseCase   *usecase.RevokeRefreshTokenUseCase
+	getPublicSigningKeysUseCase *usecase.GetPublicSigningKeysUseCase
+	authenticateUseCase         *usecase.AuthenticateUseCase
+	authenticateAPIKeyUseCase   *usecase.AuthenticateAPIKeyUseCase
+}
+
+func NewAuthHandler(
+	loginUseCase *usecase.LoginUseCase,
+	logoutUseCase *usecase.LogoutUseCase,
+	tokenLoginUseCase *usecase.TokenLoginUseCase,
+	refreshAccessTokenUseCase *usecase.RefreshAccessTokenUseCase,
+	revokeRefreshTokenUseCase *usecase.RevokeRefreshTokenUseCase,
+	getPublicSigningKeysUseCase *usecase.GetPublicSigningKeysUseCase,
+	authenticateUseCase *usecase.AuthenticateUseCase,
+	authenticateAPIKeyUseCase *usecase.AuthenticateAPIKeyUseCase,
+) *AuthHandler {
+	return &AuthHandler{
+		loginUseCase:                loginUseCase,
+		logoutUseCase:               logoutUseCase,
+		tokenLoginUseCase:           tokenLoginUseCase,
+		refreshAccessTokenUseCase:   refreshAccessTokenUseCase,
+		revokeRefreshTokenUseCase:   revokeRefreshTokenUseCase,
+		getPublicSigningKeysUseCase: getPublicSigningKeysUseCase,
+		authenticateUseCase:         authenticateUseCase,
+		authenticateAPIKeyUseCase:   authenticateAPIKeyUseCase,
+	}
+}
+
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+type LoginResponse struct {
+	Token     string    `json:"token"`
+	TokenType string    `json:"tokenType"`
+	UserID    string    `json:"userId"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
+	var req LoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.loginUseCase.Execute(usecase.LoginInput{
+		Email:    req.Email,
+		Password: req.Password,
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, LoginResponse{
+		Token:     output.Token,
+		TokenType: "Bearer",
+		UserID:    output.UserID,
+		ExpiresAt: output.ExpiresAt,
+	})
+}
+
+// Logout はリクエストのトークンのセッションを終了する
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	token, _ := bearerToken(r)
+	if err := h.logoutUseCase.Execute(usecase.LogoutInput{Token: token}); err != nil {
+		handleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+type TokenResponse struct {
+	AccessToken           string    `json:"accessToken"`
+	TokenType             string    `json:"tokenType"`
+	ExpiresAt             time.Time `json:"expiresAt"`
+	RefreshToken          string    `json:"refreshToken"`
+	RefreshTokenExpiresAt time.Time `json:"refreshTokenExpiresAt"`
+	UserID                string    `json:"userId"`
+}
+
+// IssueToken はメールアドレスとパスワードでアクセストークンとリフレッシュトークンを発行する
+func (h *AuthHandler) IssueToken(w http.ResponseWriter, r *http.Request) {
+	var req LoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.tokenLoginUseCase.Execute(usecase.TokenLoginInput{
+		Email:    req.Email,
+		Password: req.Password,
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeTokenResponse(w, output)
+}
+
+func (h *AuthHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	var req RefreshTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.refreshAccessTokenUseCase.Execute(usecase.RefreshAccessTokenInput{RefreshToken: req.RefreshToken})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeTokenResponse(w, output)
+}
+
+// RevokeToken はリフレッシュトークンと同じログインから続くトークンをすべて失効させる
+func (h *AuthHandler) RevokeToken(w http.ResponseWriter, r *http.Request) {
+	var req RefreshTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.revokeRefreshTokenUseCase.Execute(usecase.RevokeRefreshTokenInput{RefreshToken: req.RefreshToken}); err != nil {
+		handleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeTokenResponse(w http.ResponseWriter, output *usecase.TokenPairOutput) {
+	// トークンを含む応答はキャッシュさせない（RFC 6749 5.1）
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, TokenResponse{
+		AccessToken:           output.AccessToken,
+		TokenType:             "Bearer",
+		ExpiresAt:             output.AccessTokenExpiresAt,
+		RefreshToken:          output.RefreshToken,
+		RefreshTokenExpiresAt: output.RefreshTokenExpiresAt,
+		UserID:                output.UserID,
+	})
+}
+
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+}
+
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// JWKS はアクセストークンを検証するための公開鍵を JWK Set（RFC 7517）で返す
+func (h *AuthHandler) JWKS(w http.ResponseWriter, r *http.Request) {
+	output, err := h.getPublicSigningKeysUseCase.Execute()
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	keys := make([]JSONWebKey, 0, len(output.Keys))
+	for _, key := range output.Keys {
+		keys = append(keys, JSONWebKey{
+			KeyType:   "OKP",
+			Curve:     "Ed25519",
+			X:         base64.RawURLEncoding.EncodeToString(key.PublicKey),
+			KeyID:     key.KeyID,
+			Algorithm: key.Algorithm,
+			Use:       "sig",
+		})
+	}
+
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	writeJSON(w, http.StatusOK, JSONWebKeySet{Keys: keys})
+}
@@ -0,0 +1,93 @@
+package presentation
+
+import (
+	"context"
+	"ddd-bottomup/domain"
+	"ddd-bottomup/usecase"
+	"net/http"
+	"strings"
+)
+
+type contextKey string
+
+const authenticatedPrincipalKey contextKey = "authenticatedPrincipal"
+
+// apiKeyHeader はサービスが API キーを渡すヘッダー
+const apiKeyHeader = "X-API-Key"
+
+// Authenticate は呼び出した主体を特定し、コンテキストに入れる
+// 利用者は Authorization: Bearer <token> でセッションまたはアクセストークンを、サービスは X-API-Key で API キーを渡す
+// どちらもなければ匿名のまま次へ進み、無効であれば 401 を返す
+func (h *AuthHandler) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization := r.Header.Get("Authorization")
+		apiKey := r.Header.Get(apiKeyHeader)
+		if authorization == "" && apiKey == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if authorization != "" && apiKey != "" {
+			writeUnauthenticated(w, domain.UnauthenticatedError{Reason: "send either a bearer token or an API key"})
+			return
+		}
+
+		principal, err := h.authenticate(r, apiKey)
+		if err != nil {
+			writeUnauthenticated(w, err)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), authenticatedPrincipalKey, principal)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func (h *AuthHandler) authenticate(r *http.Request, apiKey string) (*domain.Principal, error) {
+	if apiKey != "" {
+		output, err := h.authenticateAPIKeyUseCase.Execute(usecase.AuthenticateAPIKeyInput{Key: apiKey})
+		if err != nil {
+			return nil, err
+		}
+		return output.Principal, nil
+	}
+
+	token, ok := bearerToken(r)
+	if !ok {
+		return nil, domain.UnauthenticatedError{Reason: "expected a bearer token"}
+	}
+	output, err := h.authenticateUseCase.Execute(usecase.AuthenticateInput{Token: token})
+	if err != nil {
+		return nil, err
+	}
+	return output.Principal, nil
+}
+
+// RequireAuthentication は認証されていないリクエストに 401 を返す
+func RequireAuthentication(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if AuthenticatedPrincipal(r.Context()) == nil {
+			writeUnauthenticated(w, domain.UnauthenticatedError{})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// AuthenticatedPrincipal は認証済みの主体（利用者またはサービス）を返す（匿名の場合は nil）
+func AuthenticatedPrincipal(ctx context.Context) *domain.Principal {
+	principal, _ := ctx.Value(authenticatedPrincipalKey).(*domain.Principal)
+	return principal
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+	return token, true
+}
+
+func writeUnauthenticated(w http.ResponseWriter, err error) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="ddd-bottomup"`)
+	handleError(w, err)
+}
@@ -0,0 +1,294 @@
+package presentation
+
+import (
+	"ddd-bottomup/usecase"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type CircleEventHandler struct {
+	createCircleEventUseCase      *usecase.CreateCircleEventUseCase
+	getCircleEventUseCase         *usecase.GetCircleEventUseCase
+	listCircleEventsUseCase       *usecase.ListCircleEventsUseCase
+	updateCircleEventUseCase      *usecase.UpdateCircleEventUseCase
+	cancelCircleEventUseCase      *usecase.CancelCircleEventUseCase
+	respondCircleEventRSVPUseCase *usecase.RespondCircleEventRSVPUseCase
+	exportCircleCalendarUseCase   *usecase.ExportCircleCalendarUseCase
+	exportUserCalendarUseCase     *usecase.ExportUserCalendarUseCase
+	issueCalendarTokenUseCase     *usecase.IssueCalendarFeedTokenUseCase
+}
+
+func NewCircleEventHandler(
+	createCircleEventUseCase *usecase.CreateCircleEventUseCase,
+	getCircleEventUseCase *usecase.GetCircleEventUseCase,
+	listCircleEventsUseCase *usecase.ListCircleEventsUseCase,
+	updateCircleEventUseCase *usecase.UpdateCircleEventUseCase,
+	cancelCircleEventUseCase *usecase.CancelCircleEventUseCase,
+	respondCircleEventRSVPUseCase *usecase.RespondCircleEventRSVPUseCase,
+	exportCircleCalendarUseCase *usecase.ExportCircleCalendarUseCase,
+	exportUserCalendarUseCase *usecase.ExportUserCalendarUseCase,
+	issueCalendarTokenUseCase *usecase.IssueCalendarFeedTokenUseCase,
+) *CircleEventHandler {
+	return &CircleEventHandler{
+		createCircleEventUseCase:      createCircleEventUseCase,
+		getCircleEventUseCase:         getCircleEventUseCase,
+		listCircleEventsUseCase:       listCircleEventsUseCase,
+		updateCircleEventUseCase:      updateCircleEventUseCase,
+		cancelCircleEventUseCase:      cancelCircleEventUseCase,
+		respondCircleEventRSVPUseCase: respondCircleEventRSVPUseCase,
+		exportCircleCalendarUseCase:   exportCircleCalendarUseCase,
+		exportUserCalendarUseCase:     exportUserCalendarUseCase,
+		issueCalendarTokenUseCase:     issueCalendarTokenUseCase,
+	}
+}
+
+// CircleEventRequest はイベントの作成と更新で共通（時刻はRFC 3339）
+type CircleEventRequest struct {
+	Title    string    `json:"title"`
+	StartsAt time.Time `json:"startsAt"`
+	EndsAt   time.Time `json:"endsAt"`
+	Location string    `json:"location"`
+	Capacity int       `json:"capacity"`
+}
+
+type RSVPRequest struct {
+	UserID string `json:"userId"`
+	Status string `json:"status"`
+}
+
+type RSVPResponse struct {
+	UserID      string    `json:"userId"`
+	Status      string    `json:"status"`
+	RespondedAt time.Time `json:"respondedAt"`
+}
+
+type CalendarFeedTokenResponse struct {
+	UserID   string    `json:"userId"`
+	Token    string    `json:"token"`
+	FeedURL  string    `json:"feedUrl"`
+	IssuedAt time.Time `json:"issuedAt"`
+}
+
+type CircleEventResponse struct {
+	EventID     string         `json:"eventId"`
+	CircleID    string         `json:"circleId"`
+	Title       string         `json:"title"`
+	StartsAt    time.Time      `json:"startsAt"`
+	EndsAt      time.Time      `json:"endsAt"`
+	Location    string         `json:"location,omitempty"`
+	Capacity    int            `json:"capacity"`
+	GoingCount  int            `json:"goingCount"`
+	RSVPs       []RSVPResponse `json:"rsvps"`
+	Cancelled   bool           `json:"cancelled"`
+	CreatedAt   time.Time      `json:"createdAt"`
+	CancelledAt *time.Time     `json:"cancelledAt,omitempty"`
+}
+
+func NewCircleEventResponse(output *usecase.CircleEventOutput) CircleEventResponse {
+	rsvps := make([]RSVPResponse, 0, len(output.RSVPs))
+	for _, rsvp := range output.RSVPs {
+		rsvps = append(rsvps, RSVPResponse{
+			UserID:      rsvp.UserID,
+			Status:      rsvp.Status,
+			RespondedAt: rsvp.RespondedAt,
+		})
+	}
+	return CircleEventResponse{
+		EventID:     output.EventID,
+		CircleID:    output.CircleID,
+		Title:       output.Title,
+		StartsAt:    output.StartsAt,
+		EndsAt:      output.EndsAt,
+		Location:    output.Location,
+		Capacity:    output.Capacity,
+		GoingCount:  output.GoingCount,
+		RSVPs:       rsvps,
+		Cancelled:   output.Cancelled,
+		CreatedAt:   output.CreatedAt,
+		CancelledAt: output.CancelledAt,
+	}
+}
+
+type ListCircleEventsResponse struct {
+	CircleID string                `json:"circleId"`
+	Events   []CircleEventResponse `json:"events"`
+}
+
+func (h *CircleEventHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
+	var req CircleEventRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.createCircleEventUseCase.Execute(usecase.CreateCircleEventInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		RequestID: requestID(r),
+		CircleID:  chi.URLParam(r, "circleID"),
+		Title:     req.Title,
+		StartsAt:  req.StartsAt,
+		EndsAt:    req.EndsAt,
+		Location:  req.Location,
+		Capacity:  req.Capacity,
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, NewCircleEventResponse(output))
+}
+
+func (h *CircleEventHandler) GetEvent(w http.ResponseWriter, r *http.Request) {
+	output, err := h.getCircleEventUseCase.Execute(usecase.GetCircleEventInput{
+		Actor:    AuthenticatedPrincipal(r.Context()),
+		CircleID: chi.URLParam(r, "circleID"),
+		EventID:  chi.URLParam(r, "eventID"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, NewCircleEventResponse(output))
+}
+
+// ListEvents は ?when=upcoming|past で絞り込む（省略時はすべて）
+func (h *CircleEventHandler) ListEvents(w http.ResponseWriter, r *http.Request) {
+	output, err := h.listCircleEventsUseCase.Execute(usecase.ListCircleEventsInput{
+		Actor:    AuthenticatedPrincipal(r.Context()),
+		CircleID: chi.URLParam(r, "circleID"),
+		Filter:   r.URL.Query().Get("when"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	events := make([]CircleEventResponse, 0, len(output.Events))
+	for _, event := range output.Events {
+		events = append(events, NewCircleEventResponse(event))
+	}
+	writeJSON(w, http.StatusOK, ListCircleEventsResponse{
+		CircleID: output.CircleID,
+		Events:   events,
+	})
+}
+
+func (h *CircleEventHandler) UpdateEvent(w http.ResponseWriter, r *http.Request) {
+	var req CircleEventRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.updateCircleEventUseCase.Execute(usecase.UpdateCircleEventInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		RequestID: requestID(r),
+		CircleID:  chi.URLParam(r, "circleID"),
+		EventID:   chi.URLParam(r, "eventID"),
+		Title:     req.Title,
+		StartsAt:  req.StartsAt,
+		EndsAt:    req.EndsAt,
+		Location:  req.Location,
+		Capacity:  req.Capacity,
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, NewCircleEventResponse(output))
+}
+
+func (h *CircleEventHandler) CancelEvent(w http.ResponseWriter, r *http.Request) {
+	output, err := h.cancelCircleEventUseCase.Execute(usecase.CancelCircleEventInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		RequestID: requestID(r),
+		CircleID:  chi.URLParam(r, "circleID"),
+		EventID:   chi.URLParam(r, "eventID"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, NewCircleEventResponse(output))
+}
+
+func (h *CircleEventHandler) RespondRSVP(w http.ResponseWriter, r *http.Request) {
+	var req RSVPRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.respondCircleEventRSVPUseCase.Execute(usecase.RespondCircleEventRSVPInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		RequestID: requestID(r),
+		CircleID:  chi.URLParam(r, "circleID"),
+		EventID:   chi.URLParam(r, "eventID"),
+		UserID:    req.UserID,
+		Status:    req.Status,
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, NewCircleEventResponse(output))
+}
+
+// ExportCircleCalendar はサークルのイベントをiCalendar形式で返す
+func (h *CircleEventHandler) ExportCircleCalendar(w http.ResponseWriter, r *http.Request) {
+	output, err := h.exportCircleCalendarUseCase.Execute(usecase.ExportCircleCalendarInput{
+		Actor:    AuthenticatedPrincipal(r.Context()),
+		CircleID: chi.URLParam(r, "circleID"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeICalendar(w, output)
+}
+
+// ExportUserCalendar はユーザーが参加する全サークルのイベントをiCalendar形式で返す
+func (h *CircleEventHandler) ExportUserCalendar(w http.ResponseWriter, r *http.Request) {
+	output, err := h.exportUserCalendarUseCase.Execute(usecase.ExportUserCalendarInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		UserID:    chi.URLParam(r, "userID"),
+		FeedToken: r.URL.Query().Get("token"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeICalendar(w, output)
+}
+
+// IssueCalendarFeedToken はカレンダーアプリで購読するためのトークンを発行する（以前のトークンは使えなくなる）
+func (h *CircleEventHandler) IssueCalendarFeedToken(w http.ResponseWriter, r *http.Request) {
+	output, err := h.issueCalendarTokenUseCase.Execute(usecase.IssueCalendarFeedTokenInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		RequestID: requestID(r),
+		UserID:    chi.URLParam(r, "userID"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusCreated, CalendarFeedTokenResponse{
+		UserID:   output.UserID,
+		Token:    output.Token,
+		FeedURL:  "/users/" + output.UserID + "/calendar.ics?token=" + url.QueryEscape(output.Token),
+		IssuedAt: output.IssuedAt,
+	})
+}
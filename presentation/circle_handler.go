@@ -0,0 +1,206 @@
+package presentation
+
+import (
+	"ddd-bottomup/usecase"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type CircleHandler struct {
+	createCircleUseCase  *usecase.CreateCircleUseCase
+	getCircleUseCase     *usecase.GetCircleUseCase
+	addMemberUseCase     *usecase.AddMemberUseCase
+	removeMemberUseCase  *usecase.RemoveMemberUseCase
+	deleteCircleUseCase  *usecase.DeleteCircleUseCase
+	restoreCircleUseCase *usecase.RestoreCircleUseCase
+	changeDuesUseCase    *usecase.ChangeCircleDuesUseCase
+}
+
+func NewCircleHandler(
+	createCircleUseCase *usecase.CreateCircleUseCase,
+	getCircleUseCase *usecase.GetCircleUseCase,
+	addMemberUseCase *usecase.AddMemberUseCase,
+	removeMemberUseCase *usecase.RemoveMemberUseCase,
+	deleteCircleUseCase *usecase.DeleteCircleUseCase,
+	restoreCircleUseCase *usecase.RestoreCircleUseCase,
+	changeDuesUseCase *usecase.ChangeCircleDuesUseCase,
+) *CircleHandler {
+	return &CircleHandler{
+		createCircleUseCase:  createCircleUseCase,
+		getCircleUseCase:     getCircleUseCase,
+		addMemberUseCase:     addMemberUseCase,
+		removeMemberUseCase:  removeMemberUseCase,
+		deleteCircleUseCase:  deleteCircleUseCase,
+		restoreCircleUseCase: restoreCircleUseCase,
+		changeDuesUseCase:    changeDuesUseCase,
+	}
+}
+
+type CreateCircleRequest struct {
+	Name    string `json:"name"`
+	OwnerID string `json:"ownerId"`
+}
+
+type CreateCircleResponse struct {
+	CircleID string `json:"circleId"`
+}
+
+type GetCircleResponse struct {
+	CircleID       string   `json:"circleId"`
+	Name           string   `json:"name"`
+	OwnerID        string   `json:"ownerId"`
+	MemberIDs      []string `json:"memberIds"`
+	TotalMembers   int      `json:"totalMembers"`
+	AvailableSlots int      `json:"availableSlots"`
+}
+
+type AddMemberRequest struct {
+	UserID string `json:"userId"`
+}
+
+type ChangeCircleDuesRequest struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+func (h *CircleHandler) CreateCircle(w http.ResponseWriter, r *http.Request) {
+	var req CreateCircleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.createCircleUseCase.Execute(usecase.CreateCircleInput{
+		Actor:      AuthenticatedPrincipal(r.Context()),
+		RequestID:  requestID(r),
+		CircleName: req.Name,
+		OwnerID:    req.OwnerID,
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, CreateCircleResponse{CircleID: output.CircleID})
+}
+
+func (h *CircleHandler) GetCircle(w http.ResponseWriter, r *http.Request) {
+	output, err := h.getCircleUseCase.Execute(usecase.GetCircleInput{
+		Actor:    AuthenticatedPrincipal(r.Context()),
+		CircleID: chi.URLParam(r, "circleID"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, GetCircleResponse{
+		CircleID:       output.CircleID,
+		Name:           output.CircleName,
+		OwnerID:        output.OwnerID,
+		MemberIDs:      output.MemberIDs,
+		TotalMembers:   output.TotalMembers,
+		AvailableSlots: output.AvailableSlots,
+	})
+}
+
+func (h *CircleHandler) AddMember(w http.ResponseWriter, r *http.Request) {
+	var req AddMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	err := h.addMemberUseCase.Execute(usecase.AddMemberInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		RequestID: requestID(r),
+		CircleID:  chi.URLParam(r, "circleID"),
+		UserID:    req.UserID,
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CircleHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	err := h.removeMemberUseCase.Execute(usecase.RemoveMemberInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		RequestID: requestID(r),
+		CircleID:  chi.URLParam(r, "circleID"),
+		UserID:    chi.URLParam(r, "userID"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CircleHandler) DeleteCircle(w http.ResponseWriter, r *http.Request) {
+	err := h.deleteCircleUseCase.Execute(usecase.DeleteCircleInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		RequestID: requestID(r),
+		CircleID:  chi.URLParam(r, "circleID"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CircleHandler) RestoreCircle(w http.ResponseWriter, r *http.Request) {
+	err := h.restoreCircleUseCase.Execute(usecase.RestoreCircleInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		RequestID: requestID(r),
+		CircleID:  chi.URLParam(r, "circleID"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CircleHandler) ChangeDues(w http.ResponseWriter, r *http.Request) {
+	var req ChangeCircleDuesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if req.Currency == "" {
+		writeError(w, "currency is required", http.StatusBadRequest)
+		return
+	}
+
+	h.changeDues(w, r, req)
+}
+
+// RemoveDues はサークルを会費なしに戻す
+func (h *CircleHandler) RemoveDues(w http.ResponseWriter, r *http.Request) {
+	h.changeDues(w, r, ChangeCircleDuesRequest{})
+}
+
+func (h *CircleHandler) changeDues(w http.ResponseWriter, r *http.Request, req ChangeCircleDuesRequest) {
+	err := h.changeDuesUseCase.Execute(usecase.ChangeCircleDuesInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		RequestID: requestID(r),
+		CircleID:  chi.URLParam(r, "circleID"),
+		Amount:    req.Amount,
+		Currency:  req.Currency,
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
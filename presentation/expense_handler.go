@@ -0,0 +1,191 @@
+package presentation
+
+import (
+	"ddd-bottomup/usecase"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type ExpenseHandler struct {
+	recordCircleExpenseUseCase *usecase.RecordCircleExpenseUseCase
+	listCircleExpensesUseCase  *usecase.ListCircleExpensesUseCase
+	getCircleSettlementUseCase *usecase.GetCircleSettlementUseCase
+}
+
+func NewExpenseHandler(
+	recordCircleExpenseUseCase *usecase.RecordCircleExpenseUseCase,
+	listCircleExpensesUseCase *usecase.ListCircleExpensesUseCase,
+	getCircleSettlementUseCase *usecase.GetCircleSettlementUseCase,
+) *ExpenseHandler {
+	return &ExpenseHandler{
+		recordCircleExpenseUseCase: recordCircleExpenseUseCase,
+		listCircleExpensesUseCase:  listCircleExpensesUseCase,
+		getCircleSettlementUseCase: getCircleSettlementUseCase,
+	}
+}
+
+type ExpenseParticipantRequest struct {
+	UserID string `json:"userId"`
+	Shares int64  `json:"shares,omitempty"`
+	Amount int64  `json:"amount,omitempty"`
+}
+
+type RecordExpenseRequest struct {
+	PayerID      string                      `json:"payerId"`
+	Amount       int64                       `json:"amount"`
+	Currency     string                      `json:"currency"`
+	Description  string                      `json:"description"`
+	SplitMethod  string                      `json:"splitMethod"`
+	Participants []ExpenseParticipantRequest `json:"participants"`
+}
+
+type ExpenseShareResponse struct {
+	UserID string         `json:"userId"`
+	Amount *MoneyResponse `json:"amount"`
+}
+
+type ExpenseResponse struct {
+	ExpenseID   string                 `json:"expenseId"`
+	PayerID     string                 `json:"payerId"`
+	Amount      *MoneyResponse         `json:"amount"`
+	Description string                 `json:"description"`
+	SplitMethod string                 `json:"splitMethod"`
+	Shares      []ExpenseShareResponse `json:"shares"`
+	IncurredAt  time.Time              `json:"incurredAt"`
+}
+
+func NewExpenseResponse(output *usecase.CircleExpenseOutput) ExpenseResponse {
+	shares := make([]ExpenseShareResponse, 0, len(output.Shares))
+	for _, share := range output.Shares {
+		shares = append(shares, ExpenseShareResponse{
+			UserID: share.UserID,
+			Amount: NewMoneyResponse(share.Amount),
+		})
+	}
+	return ExpenseResponse{
+		ExpenseID:   output.ExpenseID,
+		PayerID:     output.PayerID,
+		Amount:      NewMoneyResponse(output.Amount),
+		Description: output.Description,
+		SplitMethod: output.SplitMethod,
+		Shares:      shares,
+		IncurredAt:  output.IncurredAt,
+	}
+}
+
+type ListExpensesResponse struct {
+	CircleID string            `json:"circleId"`
+	Expenses []ExpenseResponse `json:"expenses"`
+}
+
+type MemberBalanceResponse struct {
+	UserID string         `json:"userId"`
+	Net    *MoneyResponse `json:"net"`
+}
+
+type SettlementTransferResponse struct {
+	From   string         `json:"from"`
+	To     string         `json:"to"`
+	Amount *MoneyResponse `json:"amount"`
+}
+
+type SettlementResponse struct {
+	CircleID  string                       `json:"circleId"`
+	Balances  []MemberBalanceResponse      `json:"balances"`
+	Transfers []SettlementTransferResponse `json:"transfers"`
+	IsSettled bool                         `json:"isSettled"`
+}
+
+func (h *ExpenseHandler) RecordExpense(w http.ResponseWriter, r *http.Request) {
+	var req RecordExpenseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	participants := make([]usecase.ExpenseParticipantInput, 0, len(req.Participants))
+	for _, participant := range req.Participants {
+		participants = append(participants, usecase.ExpenseParticipantInput{
+			UserID: participant.UserID,
+			Shares: participant.Shares,
+			Amount: participant.Amount,
+		})
+	}
+
+	output, err := h.recordCircleExpenseUseCase.Execute(usecase.RecordCircleExpenseInput{
+		Actor:        AuthenticatedPrincipal(r.Context()),
+		RequestID:    requestID(r),
+		CircleID:     chi.URLParam(r, "circleID"),
+		PayerID:      req.PayerID,
+		Amount:       req.Amount,
+		Currency:     req.Currency,
+		Description:  req.Description,
+		SplitMethod:  req.SplitMethod,
+		Participants: participants,
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, NewExpenseResponse(output))
+}
+
+func (h *ExpenseHandler) ListExpenses(w http.ResponseWriter, r *http.Request) {
+	output, err := h.listCircleExpensesUseCase.Execute(usecase.ListCircleExpensesInput{
+		Actor:    AuthenticatedPrincipal(r.Context()),
+		CircleID: chi.URLParam(r, "circleID"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	expenses := make([]ExpenseResponse, 0, len(output.Expenses))
+	for _, expense := range output.Expenses {
+		expenses = append(expenses, NewExpenseResponse(expense))
+	}
+
+	writeJSON(w, http.StatusOK, ListExpensesResponse{
+		CircleID: output.CircleID,
+		Expenses: expenses,
+	})
+}
+
+func (h *ExpenseHandler) GetSettlement(w http.ResponseWriter, r *http.Request) {
+	output, err := h.getCircleSettlementUseCase.Execute(usecase.GetCircleSettlementInput{
+		Actor:    AuthenticatedPrincipal(r.Context()),
+		CircleID: chi.URLParam(r, "circleID"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	balances := make([]MemberBalanceResponse, 0, len(output.Balances))
+	for _, balance := range output.Balances {
+		balances = append(balances, MemberBalanceResponse{
+			UserID: balance.UserID,
+			Net:    NewMoneyResponse(balance.Net),
+		})
+	}
+
+	transfers := make([]SettlementTransferResponse, 0, len(output.Transfers))
+	for _, transfer := range output.Transfers {
+		transfers = append(transfers, SettlementTransferResponse{
+			From:   transfer.FromUserID,
+			To:     transfer.ToUserID,
+			Amount: NewMoneyResponse(transfer.Amount),
+		})
+	}
+
+	writeJSON(w, http.StatusOK, SettlementResponse{
+		CircleID:  output.CircleID,
+		Balances:  balances,
+		Transfers: transfers,
+		IsSettled: output.IsSettled,
+	})
+}
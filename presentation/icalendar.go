@@ -0,0 +1,118 @@
+package presentation
+
+import (
+	"ddd-bottomup/usecase"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// iCalendar（RFC 5545）の出力
+
+const (
+	icalProdID    = "-//ddd-bottomup//Circle Events//JA"
+	icalUIDDomain = "ddd-bottomup"
+	icalMaxOctets = 75 // 1行の最大オクテット数（改行を除く）
+)
+
+// 日時はすべてUTC（末尾Z）で出力し、表示時の変換はカレンダー側に任せる
+const icalUTCFormat = "20060102T150405Z"
+
+// icalWriter はコンテンツ行を折り返しとCRLF付きで書き出す
+type icalWriter struct {
+	b strings.Builder
+}
+
+func (w *icalWriter) prop(name, value string) {
+	w.fold(name + ":" + value)
+}
+
+func (w *icalWriter) text(name, value string) {
+	w.prop(name, escapeICalText(value))
+}
+
+func (w *icalWriter) time(name string, t time.Time) {
+	w.prop(name, t.UTC().Format(icalUTCFormat))
+}
+
+// fold は75オクテットを超える行を折り返す（UTF-8の文字の途中では分割しない）
+func (w *icalWriter) fold(line string) {
+	limit := icalMaxOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.b.WriteString(line[:cut])
+		w.b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = icalMaxOctets - 1 // 継続行は先頭の空白を含めて75オクテット
+	}
+	w.b.WriteString(line)
+	w.b.WriteString("\r\n")
+}
+
+// escapeICalText はTEXT値の特殊文字をエスケープする
+func escapeICalText(value string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", "",
+	)
+	return replacer.Replace(value)
+}
+
+// NewICalendar はカレンダー配信の内容をiCalendar形式に変換する
+// UIDはイベントIDから作るため、再取得しても同じイベントとして扱われる
+func NewICalendar(output *usecase.CalendarOutput) string {
+	w := &icalWriter{}
+	w.prop("BEGIN", "VCALENDAR")
+	w.prop("VERSION", "2.0")
+	w.prop("PRODID", icalProdID)
+	w.prop("CALSCALE", "GREGORIAN")
+	w.prop("METHOD", "PUBLISH")
+	w.text("X-WR-CALNAME", output.Name)
+
+	for _, entry := range output.Entries {
+		event := entry.Event
+		w.prop("BEGIN", "VEVENT")
+		w.prop("UID", event.EventID+"@"+icalUIDDomain)
+		w.time("DTSTAMP", output.GeneratedAt)
+		w.time("CREATED", event.CreatedAt)
+		w.time("DTSTART", event.StartsAt)
+		w.time("DTEND", event.EndsAt)
+		w.text("SUMMARY", event.Title)
+		if event.Location != "" {
+			w.text("LOCATION", event.Location)
+		}
+		w.text("DESCRIPTION", icalDescription(entry))
+		if event.Cancelled {
+			w.prop("STATUS", "CANCELLED")
+		} else {
+			w.prop("STATUS", "CONFIRMED")
+		}
+		w.prop("END", "VEVENT")
+	}
+
+	w.prop("END", "VCALENDAR")
+	return w.b.String()
+}
+
+func icalDescription(entry *usecase.CalendarEntryOutput) string {
+	going := "Going: " + strconv.Itoa(entry.Event.GoingCount)
+	if entry.Event.Capacity > 0 {
+		going += " / " + strconv.Itoa(entry.Event.Capacity)
+	}
+	return "Circle: " + entry.CircleName + "\n" + going
+}
+
+func writeICalendar(w http.ResponseWriter, output *usecase.CalendarOutput) {
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(NewICalendar(output)))
+}
@@ -0,0 +1,172 @@
+package presentation
+
+import (
+	"ddd-bottomup/usecase"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type LedgerHandler struct {
+	getLedgerUseCase     *usecase.GetLedgerUseCase
+	recordPaymentUseCase *usecase.RecordPaymentUseCase
+	recordRefundUseCase  *usecase.RecordRefundUseCase
+}
+
+func NewLedgerHandler(
+	getLedgerUseCase *usecase.GetLedgerUseCase,
+	recordPaymentUseCase *usecase.RecordPaymentUseCase,
+	recordRefundUseCase *usecase.RecordRefundUseCase,
+) *LedgerHandler {
+	return &LedgerHandler{
+		getLedgerUseCase:     getLedgerUseCase,
+		recordPaymentUseCase: recordPaymentUseCase,
+		recordRefundUseCase:  recordRefundUseCase,
+	}
+}
+
+type LedgerEntryRequest struct {
+	Amount      int64  `json:"amount"`
+	Currency    string `json:"currency"`
+	Description string `json:"description"`
+	Reference   string `json:"reference"`
+}
+
+type MoneyResponse struct {
+	Amount    int64  `json:"amount"`
+	Currency  string `json:"currency"`
+	Formatted string `json:"formatted"`
+}
+
+func NewMoneyResponse(output *usecase.MoneyOutput) *MoneyResponse {
+	if output == nil {
+		return nil
+	}
+	return &MoneyResponse{
+		Amount:    output.Amount,
+		Currency:  output.Currency,
+		Formatted: output.Formatted,
+	}
+}
+
+type ConvertedMoneyResponse struct {
+	Amount     *MoneyResponse `json:"amount"`
+	Rate       string         `json:"rate"`
+	RateSource string         `json:"rateSource"`
+	RateAsOf   time.Time      `json:"rateAsOf"`
+}
+
+func NewConvertedMoneyResponse(output *usecase.ConvertedMoneyOutput) *ConvertedMoneyResponse {
+	if output == nil {
+		return nil
+	}
+	return &ConvertedMoneyResponse{
+		Amount:     NewMoneyResponse(output.Amount),
+		Rate:       output.Rate,
+		RateSource: output.RateSource,
+		RateAsOf:   output.RateAsOf,
+	}
+}
+
+type LedgerEntryResponse struct {
+	EntryID     string                  `json:"entryId"`
+	Type        string                  `json:"type"`
+	Amount      *MoneyResponse          `json:"amount"`
+	Converted   *ConvertedMoneyResponse `json:"converted,omitempty"`
+	Description string                  `json:"description"`
+	Reference   string                  `json:"reference,omitempty"`
+	OccurredAt  time.Time               `json:"occurredAt"`
+}
+
+func NewLedgerEntryResponse(output *usecase.LedgerEntryOutput) LedgerEntryResponse {
+	return LedgerEntryResponse{
+		EntryID:     output.EntryID,
+		Type:        output.Type,
+		Amount:      NewMoneyResponse(output.Amount),
+		Converted:   NewConvertedMoneyResponse(output.Converted),
+		Description: output.Description,
+		Reference:   output.Reference,
+		OccurredAt:  output.OccurredAt,
+	}
+}
+
+type GetLedgerResponse struct {
+	UserID        string                `json:"userId"`
+	Entries       []LedgerEntryResponse `json:"entries"`
+	Balance       *MoneyResponse        `json:"balance"`
+	ReportBalance *MoneyResponse        `json:"reportBalance,omitempty"`
+}
+
+func (h *LedgerHandler) GetLedger(w http.ResponseWriter, r *http.Request) {
+	output, err := h.getLedgerUseCase.Execute(usecase.GetLedgerInput{
+		Actor:          AuthenticatedPrincipal(r.Context()),
+		UserID:         chi.URLParam(r, "userID"),
+		ReportCurrency: r.URL.Query().Get("currency"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	entries := make([]LedgerEntryResponse, 0, len(output.Entries))
+	for _, entry := range output.Entries {
+		entries = append(entries, NewLedgerEntryResponse(entry))
+	}
+
+	writeJSON(w, http.StatusOK, GetLedgerResponse{
+		UserID:        output.UserID,
+		Entries:       entries,
+		Balance:       NewMoneyResponse(output.Balance),
+		ReportBalance: NewMoneyResponse(output.ReportBalance),
+	})
+}
+
+func (h *LedgerHandler) RecordPayment(w http.ResponseWriter, r *http.Request) {
+	var req LedgerEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.recordPaymentUseCase.Execute(usecase.RecordPaymentInput{
+		Actor:       AuthenticatedPrincipal(r.Context()),
+		RequestID:   requestID(r),
+		UserID:      chi.URLParam(r, "userID"),
+		Amount:      req.Amount,
+		Currency:    req.Currency,
+		Description: req.Description,
+		Reference:   req.Reference,
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, NewLedgerEntryResponse(output))
+}
+
+func (h *LedgerHandler) RecordRefund(w http.ResponseWriter, r *http.Request) {
+	var req LedgerEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.recordRefundUseCase.Execute(usecase.RecordRefundInput{
+		Actor:       AuthenticatedPrincipal(r.Context()),
+		RequestID:   requestID(r),
+		UserID:      chi.URLParam(r, "userID"),
+		Amount:      req.Amount,
+		Currency:    req.Currency,
+		Description: req.Description,
+		Reference:   req.Reference,
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, NewLedgerEntryResponse(output))
+}
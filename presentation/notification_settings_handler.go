@@ -0,0 +1,109 @@
+package presentation
+
+import (
+	"ddd-bottomup/usecase"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type NotificationSettingsHandler struct {
+	getNotificationSettingsUseCase    *usecase.GetNotificationSettingsUseCase
+	updateNotificationSettingsUseCase *usecase.UpdateNotificationSettingsUseCase
+}
+
+func NewNotificationSettingsHandler(
+	getNotificationSettingsUseCase *usecase.GetNotificationSettingsUseCase,
+	updateNotificationSettingsUseCase *usecase.UpdateNotificationSettingsUseCase,
+) *NotificationSettingsHandler {
+	return &NotificationSettingsHandler{
+		getNotificationSettingsUseCase:    getNotificationSettingsUseCase,
+		updateNotificationSettingsUseCase: updateNotificationSettingsUseCase,
+	}
+}
+
+type QuietHoursJSON struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// UpdateNotificationSettingsRequest は指定した項目だけ変更する
+// quietHours に {} を指定するとおやすみ時間を解除する
+type UpdateNotificationSettingsRequest struct {
+	Locale     *string           `json:"locale"`
+	TimeZone   *string           `json:"timeZone"`
+	Channels   map[string]string `json:"channels"`
+	QuietHours *QuietHoursJSON   `json:"quietHours"`
+	DigestTime *string           `json:"digestTime"`
+}
+
+type NotificationSettingsResponse struct {
+	UserID       string            `json:"userId"`
+	Locale       string            `json:"locale"`
+	TimeZone     string            `json:"timeZone"`
+	Channels     map[string]string `json:"channels"`
+	QuietHours   *QuietHoursJSON   `json:"quietHours"`
+	DigestTime   string            `json:"digestTime"`
+	NextDigestAt time.Time         `json:"nextDigestAt"`
+	UpdatedAt    *time.Time        `json:"updatedAt,omitempty"`
+}
+
+func NewNotificationSettingsResponse(output *usecase.NotificationSettingsOutput) NotificationSettingsResponse {
+	response := NotificationSettingsResponse{
+		UserID:       output.UserID,
+		Locale:       output.Locale,
+		TimeZone:     output.TimeZone,
+		Channels:     output.Channels,
+		DigestTime:   output.DigestTime,
+		NextDigestAt: output.NextDigestAt,
+		UpdatedAt:    output.UpdatedAt,
+	}
+	if output.QuietHours != nil {
+		response.QuietHours = &QuietHoursJSON{Start: output.QuietHours.Start, End: output.QuietHours.End}
+	}
+	return response
+}
+
+func (h *NotificationSettingsHandler) GetSettings(w http.ResponseWriter, r *http.Request) {
+	output, err := h.getNotificationSettingsUseCase.Execute(usecase.GetNotificationSettingsInput{
+		Actor:  AuthenticatedPrincipal(r.Context()),
+		UserID: chi.URLParam(r, "userID"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, NewNotificationSettingsResponse(output))
+}
+
+func (h *NotificationSettingsHandler) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	var req UpdateNotificationSettingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	input := usecase.UpdateNotificationSettingsInput{
+		Actor:      AuthenticatedPrincipal(r.Context()),
+		RequestID:  requestID(r),
+		UserID:     chi.URLParam(r, "userID"),
+		Locale:     req.Locale,
+		TimeZone:   req.TimeZone,
+		Channels:   req.Channels,
+		DigestTime: req.DigestTime,
+	}
+	if req.QuietHours != nil {
+		input.QuietHours = &usecase.QuietHoursInput{Start: req.QuietHours.Start, End: req.QuietHours.End}
+	}
+
+	output, err := h.updateNotificationSettingsUseCase.Execute(input)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, NewNotificationSettingsResponse(output))
+}
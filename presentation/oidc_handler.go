@@ -0,0 +1,88 @@
+package presentation
+
+import (
+	"ddd-bottomup/usecase"
+	"net/http"
+	"time"
+)
+
+type OIDCHandler struct {
+	startOIDCLoginUseCase    *usecase.StartOIDCLoginUseCase
+	completeOIDCLoginUseCase *usecase.CompleteOIDCLoginUseCase
+}
+
+func NewOIDCHandler(
+	startOIDCLoginUseCase *usecase.StartOIDCLoginUseCase,
+	completeOIDCLoginUseCase *usecase.CompleteOIDCLoginUseCase,
+) *OIDCHandler {
+	return &OIDCHandler{
+		startOIDCLoginUseCase:    startOIDCLoginUseCase,
+		completeOIDCLoginUseCase: completeOIDCLoginUseCase,
+	}
+}
+
+type OIDCAuthorizationResponse struct {
+	AuthorizationURL string    `json:"authorizationUrl"`
+	ExpiresAt        time.Time `json:"expiresAt"`
+}
+
+type OIDCLoginResponse struct {
+	Token       string    `json:"token"`
+	TokenType   string    `json:"tokenType"`
+	UserID      string    `json:"userId"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+	UserCreated bool      `json:"userCreated"`
+}
+
+// Login はブラウザを OpenID プロバイダーの認可エンドポイントへリダイレクトする
+func (h *OIDCHandler) Login(w http.ResponseWriter, r *http.Request) {
+	output, err := h.startOIDCLoginUseCase.Execute(usecase.StartOIDCLoginInput{
+		LoginHint: r.URL.Query().Get("login_hint"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, output.AuthorizationURL, http.StatusFound)
+}
+
+// Link はログイン中のユーザーに外部のアカウントを紐付けるための認可 URL を返す
+func (h *OIDCHandler) Link(w http.ResponseWriter, r *http.Request) {
+	output, err := h.startOIDCLoginUseCase.Execute(usecase.StartOIDCLoginInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		LoginHint: r.URL.Query().Get("login_hint"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, OIDCAuthorizationResponse{
+		AuthorizationURL: output.AuthorizationURL,
+		ExpiresAt:        output.ExpiresAt,
+	})
+}
+
+// Callback はプロバイダーから戻ってきた認可コードでログインを完了し、セッションのトークンを返す
+func (h *OIDCHandler) Callback(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	output, err := h.completeOIDCLoginUseCase.Execute(usecase.CompleteOIDCLoginInput{
+		State: query.Get("state"),
+		Code:  query.Get("code"),
+		Error: query.Get("error"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, OIDCLoginResponse{
+		Token:       output.Token,
+		TokenType:   "Bearer",
+		UserID:      output.UserID,
+		ExpiresAt:   output.ExpiresAt,
+		UserCreated: output.UserCreated,
+	})
+}
@@ -0,0 +1,149 @@
+package presentation
+
+import (
+	"ddd-bottomup/usecase"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// PaymentSignatureHeader は決済事業者がWebhookの署名を載せるヘッダー
+const PaymentSignatureHeader = "X-Payment-Signature"
+
+type PaymentHandler struct {
+	payBalanceUseCase           *usecase.PayBalanceUseCase
+	refundPaymentUseCase        *usecase.RefundPaymentUseCase
+	handlePaymentWebhookUseCase *usecase.HandlePaymentWebhookUseCase
+}
+
+func NewPaymentHandler(
+	payBalanceUseCase *usecase.PayBalanceUseCase,
+	refundPaymentUseCase *usecase.RefundPaymentUseCase,
+	handlePaymentWebhookUseCase *usecase.HandlePaymentWebhookUseCase,
+) *PaymentHandler {
+	return &PaymentHandler{
+		payBalanceUseCase:           payBalanceUseCase,
+		refundPaymentUseCase:        refundPaymentUseCase,
+		handlePaymentWebhookUseCase: handlePaymentWebhookUseCase,
+	}
+}
+
+type PayBalanceRequest struct {
+	IdempotencyKey string `json:"idempotencyKey"`
+}
+
+type PayBalanceResponse struct {
+	ChargeID string               `json:"chargeId"`
+	Status   string               `json:"status"`
+	Amount   *MoneyResponse       `json:"amount"`
+	Entry    *LedgerEntryResponse `json:"entry,omitempty"`
+}
+
+type RefundPaymentRequest struct {
+	Amount         int64  `json:"amount"`
+	Currency       string `json:"currency"`
+	IdempotencyKey string `json:"idempotencyKey"`
+}
+
+type RefundPaymentResponse struct {
+	RefundID string         `json:"refundId"`
+	Status   string         `json:"status"`
+	Amount   *MoneyResponse `json:"amount"`
+}
+
+type PaymentWebhookResponse struct {
+	EventID string `json:"eventId"`
+	Type    string `json:"type"`
+	Applied bool   `json:"applied"`
+}
+
+func (h *PaymentHandler) PayBalance(w http.ResponseWriter, r *http.Request) {
+	var req PayBalanceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.payBalanceUseCase.Execute(usecase.PayBalanceInput{
+		Actor:          AuthenticatedPrincipal(r.Context()),
+		RequestID:      requestID(r),
+		UserID:         chi.URLParam(r, "userID"),
+		IdempotencyKey: req.IdempotencyKey,
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	response := PayBalanceResponse{
+		ChargeID: output.ChargeID,
+		Status:   output.Status,
+		Amount:   NewMoneyResponse(output.Amount),
+	}
+	if output.Entry != nil {
+		entry := NewLedgerEntryResponse(output.Entry)
+		response.Entry = &entry
+	}
+
+	// 保留中の決済は受付のみ（結果はWebhookで反映）
+	status := http.StatusCreated
+	if output.Entry == nil {
+		status = http.StatusAccepted
+	}
+	writeJSON(w, status, response)
+}
+
+func (h *PaymentHandler) RefundPayment(w http.ResponseWriter, r *http.Request) {
+	var req RefundPaymentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.refundPaymentUseCase.Execute(usecase.RefundPaymentInput{
+		Actor:          AuthenticatedPrincipal(r.Context()),
+		RequestID:      requestID(r),
+		UserID:         chi.URLParam(r, "userID"),
+		ChargeID:       chi.URLParam(r, "chargeID"),
+		Amount:         req.Amount,
+		Currency:       req.Currency,
+		IdempotencyKey: req.IdempotencyKey,
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, RefundPaymentResponse{
+		RefundID: output.RefundID,
+		Status:   output.Status,
+		Amount:   NewMoneyResponse(output.Amount),
+	})
+}
+
+func (h *PaymentHandler) HandleWebhook(w http.ResponseWriter, r *http.Request) {
+	// 署名は生のボディに対して検証するため、デコード前に読み込む
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.handlePaymentWebhookUseCase.Execute(usecase.HandlePaymentWebhookInput{
+		Payload:   payload,
+		Signature: r.Header.Get(PaymentSignatureHeader),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	// 重複したイベントも200を返し、決済事業者に再送を止めさせる
+	writeJSON(w, http.StatusOK, PaymentWebhookResponse{
+		EventID: output.EventID,
+		Type:    output.Type,
+		Applied: output.Applied,
+	})
+}
@@ -0,0 +1,32 @@
+package presentation
+
+import (
+	"ddd-bottomup/domain"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func handleError(w http.ResponseWriter, err error) {
+	var domainErr domain.DomainError
+	if errors.As(err, &domainErr) {
+		// ドメインエラーの場合、適切なHTTPステータスを使用
+		writeError(w, err.Error(), domainErr.HTTPStatus())
+	} else {
+		// その他のエラーは内部サーバーエラー
+		writeError(w, "Internal server error", http.StatusInternalServerError)
+	}
+}
+
+func writeError(w http.ResponseWriter, message string, status int) {
+	writeJSON(w, status, ErrorResponse{Error: message})
+}
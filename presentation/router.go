@@ -1,7 +1,6 @@
 package presentation
 
 import (
-	"ddd-bottomup/usecase"
 	"net/http"
 	"time"
 
@@ -9,26 +8,37 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 )
 
-func NewRouter(
-	createUserUseCase *usecase.CreateUserUseCase,
-	getUserUseCase *usecase.GetUserUseCase,
-	updateUserUseCase *usecase.UpdateUserUseCase,
-	deleteUserUseCase *usecase.DeleteUserUseCase,
-) *chi.Mux {
+// Handlers はルーターに登録するハンドラー一式
+type Handlers struct {
+	Auth         *AuthHandler
+	User         *UserHandler
+	Subscription *SubscriptionHandler
+	Ledger       *LedgerHandler
+	Payment      *PaymentHandler
+	Circle       *CircleHandler
+	Expense      *ExpenseHandler
+	Shipment     *ShipmentHandler
+	CircleEvent  *CircleEventHandler
+	Webhook      *WebhookHandler
+	Notification *NotificationSettingsHandler
+	OIDC         *OIDCHandler // nil の場合は OIDC でのログインを公開しない
+	APIKey       *APIKeyHandler
+	Audit        *AuditHandler
+
+	// FakeOIDCProvider はローカル実行用の偽の OpenID プロバイダー（nil の場合は公開しない）
+	FakeOIDCProvider http.Handler
+}
+
+func NewRouter(handlers Handlers) *chi.Mux {
 	r := chi.NewRouter()
 
 	// Middleware
+	r.Use(middleware.RequestID)
 	r.Use(middleware.Logger)
 	r.Use(middleware.Recoverer)
 	r.Use(middleware.Timeout(60 * time.Second))
 	r.Use(middleware.SetHeader("Content-Type", "application/json"))
-
-	userHandler := NewUserHandler(
-		createUserUseCase,
-		getUserUseCase,
-		updateUserUseCase,
-		deleteUserUseCase,
-	)
+	r.Use(handlers.Auth.Authenticate)
 
 	// Health check endpoint
 	r.Get("/health", func(w http.ResponseWriter, r *http.Request) {
@@ -36,15 +46,137 @@ func NewRouter(
 		w.Write([]byte(`{"status": "ok"}`))
 	})
 
+	// Auth routes
+	r.Route("/auth", func(r chi.Router) {
+		r.Post("/login", handlers.Auth.Login)
+		r.With(RequireAuthentication).Post("/logout", handlers.Auth.Logout)
+		r.Post("/token", handlers.Auth.IssueToken)
+		r.Post("/token/refresh", handlers.Auth.RefreshToken)
+		r.Post("/token/revoke", handlers.Auth.RevokeToken)
+
+		// OpenID Connect
+		if handlers.OIDC != nil {
+			r.Get("/oidc/login", handlers.OIDC.Login)
+			r.With(RequireAuthentication).Post("/oidc/link", handlers.OIDC.Link)
+			r.Get("/oidc/callback", handlers.OIDC.Callback)
+		}
+	})
+	r.Get("/.well-known/jwks.json", handlers.Auth.JWKS)
+	if handlers.FakeOIDCProvider != nil {
+		r.Mount("/fake-idp", handlers.FakeOIDCProvider)
+	}
+
+	// Admin routes
+	r.Route("/admin", func(r chi.Router) {
+		r.Use(RequireAuthentication)
+		r.Get("/api-keys", handlers.APIKey.ListAPIKeys)
+		r.Post("/api-keys", handlers.APIKey.CreateAPIKey)
+		r.Post("/api-keys/{keyID}/revoke", handlers.APIKey.RevokeAPIKey)
+	})
+
+	// Audit log
+	r.With(RequireAuthentication).Get("/audit", handlers.Audit.ListAuditEntries)
+
 	// User routes
 	r.Route("/users", func(r chi.Router) {
-		r.Post("/", userHandler.CreateUser)
+		r.Post("/", handlers.User.CreateUser)
 		r.Route("/{userID}", func(r chi.Router) {
-			r.Get("/", userHandler.GetUser)
-			r.Put("/", userHandler.UpdateUser)
-			r.Delete("/", userHandler.DeleteUser)
+			r.Get("/", handlers.User.GetUser)
+			r.With(RequireAuthentication).Put("/", handlers.User.UpdateUser)
+			r.With(RequireAuthentication).Delete("/", handlers.User.DeleteUser)
+			r.With(RequireAuthentication).Post("/restore", handlers.User.RestoreUser)
+			r.Post("/email/verify", handlers.User.VerifyEmail)
+
+			// Subscription routes
+			r.Route("/subscription", func(r chi.Router) {
+				r.Get("/", handlers.Subscription.GetSubscription)
+				r.Post("/upgrade", handlers.Subscription.UpgradeSubscription)
+				r.Post("/downgrade", handlers.Subscription.DowngradeSubscription)
+				r.Post("/cancel", handlers.Subscription.CancelSubscription)
+				r.With(RequireAuthentication).Post("/complimentary", handlers.Subscription.GrantComplimentarySubscription)
+			})
+
+			// Ledger routes
+			r.Route("/ledger", func(r chi.Router) {
+				r.Get("/", handlers.Ledger.GetLedger)
+				r.Post("/payments", handlers.Ledger.RecordPayment)
+				r.Post("/refunds", handlers.Ledger.RecordRefund)
+				r.Post("/checkout", handlers.Payment.PayBalance)
+			})
+
+			// Payment routes
+			r.Post("/payments/{chargeID}/refund", handlers.Payment.RefundPayment)
+
+			// Shipment routes
+			r.Get("/shipments", handlers.Shipment.ListUserShipments)
+
+			// Calendar feed
+			r.Get("/calendar.ics", handlers.CircleEvent.ExportUserCalendar)
+			r.Post("/calendar-token", handlers.CircleEvent.IssueCalendarFeedToken)
+
+			// Notification settings
+			r.Get("/notification-settings", handlers.Notification.GetSettings)
+			r.Put("/notification-settings", handlers.Notification.UpdateSettings)
+		})
+	})
+
+	// Circle routes
+	r.Route("/circles", func(r chi.Router) {
+		r.Post("/", handlers.Circle.CreateCircle)
+		r.Route("/{circleID}", func(r chi.Router) {
+			r.Get("/", handlers.Circle.GetCircle)
+			r.Delete("/", handlers.Circle.DeleteCircle)
+			r.Post("/restore", handlers.Circle.RestoreCircle)
+			r.Post("/members", handlers.Circle.AddMember)
+			r.Delete("/members/{userID}", handlers.Circle.RemoveMember)
+			r.Put("/dues", handlers.Circle.ChangeDues)
+			r.Delete("/dues", handlers.Circle.RemoveDues)
+
+			// Expense routes
+			r.Get("/expenses", handlers.Expense.ListExpenses)
+			r.Post("/expenses", handlers.Expense.RecordExpense)
+			r.Get("/settlement", handlers.Expense.GetSettlement)
+
+			// Event routes
+			r.Get("/events.ics", handlers.CircleEvent.ExportCircleCalendar)
+			r.Route("/events", func(r chi.Router) {
+				r.Get("/", handlers.CircleEvent.ListEvents)
+				r.Post("/", handlers.CircleEvent.CreateEvent)
+				r.Route("/{eventID}", func(r chi.Router) {
+					r.Get("/", handlers.CircleEvent.GetEvent)
+					r.Put("/", handlers.CircleEvent.UpdateEvent)
+					r.Post("/cancel", handlers.CircleEvent.CancelEvent)
+					r.Put("/rsvp", handlers.CircleEvent.RespondRSVP)
+				})
+			})
+
+			// Webhook routes
+			r.Route("/webhooks", func(r chi.Router) {
+				r.Get("/", handlers.Webhook.ListWebhooks)
+				r.Post("/", handlers.Webhook.RegisterWebhook)
+				r.Get("/{hookID}/deliveries", handlers.Webhook.ListDeliveries)
+			})
+		})
+	})
+
+	// Shipment routes
+	r.Route("/shipments", func(r chi.Router) {
+		r.Post("/", handlers.Shipment.CreateShipment)
+		r.Post("/quote", handlers.Shipment.QuoteShippingFee)
+		r.Route("/{shipmentID}", func(r chi.Router) {
+			r.Get("/", handlers.Shipment.GetShipment)
+			r.Post("/status", handlers.Shipment.UpdateShipmentStatus)
+			r.Post("/cancel", handlers.Shipment.CancelShipment)
 		})
 	})
 
+	// Payment provider webhook
+	r.Post("/payments/webhook", handlers.Payment.HandleWebhook)
+
 	return r
 }
+
+// requestID は監査ログに記録するリクエストID（X-Request-Id または自動採番）を返す
+func requestID(r *http.Request) string {
+	return middleware.GetReqID(r.Context())
+}
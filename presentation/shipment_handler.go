@@ -0,0 +1,270 @@
+package presentation
+
+import (
+	"ddd-bottomup/usecase"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type ShipmentHandler struct {
+	createShipmentUseCase       *usecase.CreateShipmentUseCase
+	getShipmentUseCase          *usecase.GetShipmentUseCase
+	listUserShipmentsUseCase    *usecase.ListUserShipmentsUseCase
+	updateShipmentStatusUseCase *usecase.UpdateShipmentStatusUseCase
+	cancelShipmentUseCase       *usecase.CancelShipmentUseCase
+	quoteShippingFeeUseCase     *usecase.QuoteShippingFeeUseCase
+}
+
+func NewShipmentHandler(
+	createShipmentUseCase *usecase.CreateShipmentUseCase,
+	getShipmentUseCase *usecase.GetShipmentUseCase,
+	listUserShipmentsUseCase *usecase.ListUserShipmentsUseCase,
+	updateShipmentStatusUseCase *usecase.UpdateShipmentStatusUseCase,
+	cancelShipmentUseCase *usecase.CancelShipmentUseCase,
+	quoteShippingFeeUseCase *usecase.QuoteShippingFeeUseCase,
+) *ShipmentHandler {
+	return &ShipmentHandler{
+		createShipmentUseCase:       createShipmentUseCase,
+		getShipmentUseCase:          getShipmentUseCase,
+		listUserShipmentsUseCase:    listUserShipmentsUseCase,
+		updateShipmentStatusUseCase: updateShipmentStatusUseCase,
+		cancelShipmentUseCase:       cancelShipmentUseCase,
+		quoteShippingFeeUseCase:     quoteShippingFeeUseCase,
+	}
+}
+
+type BaggageRequest struct {
+	Description string `json:"description"`
+	WeightGrams int64  `json:"weightGrams"`
+	LengthCm    int64  `json:"lengthCm"`
+	WidthCm     int64  `json:"widthCm"`
+	HeightCm    int64  `json:"heightCm"`
+}
+
+// CreateShipmentRequest は発送の作成と送料の見積もりで共通
+type CreateShipmentRequest struct {
+	RecipientID     string           `json:"recipientId"`
+	DestinationZone string           `json:"destinationZone"`
+	Baggage         []BaggageRequest `json:"baggage"`
+}
+
+func (req CreateShipmentRequest) baggageInputs() []usecase.BaggageInput {
+	baggage := make([]usecase.BaggageInput, 0, len(req.Baggage))
+	for _, item := range req.Baggage {
+		baggage = append(baggage, usecase.BaggageInput{
+			Description: item.Description,
+			WeightGrams: item.WeightGrams,
+			LengthCm:    item.LengthCm,
+			WidthCm:     item.WidthCm,
+			HeightCm:    item.HeightCm,
+		})
+	}
+	return baggage
+}
+
+type UpdateShipmentStatusRequest struct {
+	Status         string `json:"status"`
+	TrackingNumber string `json:"trackingNumber,omitempty"`
+}
+
+type BaggageResponse struct {
+	Description string `json:"description"`
+	WeightGrams int64  `json:"weightGrams"`
+	LengthCm    int64  `json:"lengthCm"`
+	WidthCm     int64  `json:"widthCm"`
+	HeightCm    int64  `json:"heightCm"`
+}
+
+type ShippingFeeItemResponse struct {
+	Code        string         `json:"code"`
+	Description string         `json:"description"`
+	Amount      *MoneyResponse `json:"amount"`
+}
+
+type ShippingFeeResponse struct {
+	DestinationZone       string                    `json:"destinationZone"`
+	ActualWeightGrams     int64                     `json:"actualWeightGrams"`
+	VolumetricWeightGrams int64                     `json:"volumetricWeightGrams"`
+	ChargeableWeightGrams int64                     `json:"chargeableWeightGrams"`
+	Items                 []ShippingFeeItemResponse `json:"items"`
+	Total                 *MoneyResponse            `json:"total"`
+}
+
+func NewShippingFeeResponse(output *usecase.ShippingFeeOutput) ShippingFeeResponse {
+	items := make([]ShippingFeeItemResponse, 0, len(output.Items))
+	for _, item := range output.Items {
+		items = append(items, ShippingFeeItemResponse{
+			Code:        item.Code,
+			Description: item.Description,
+			Amount:      NewMoneyResponse(item.Amount),
+		})
+	}
+	return ShippingFeeResponse{
+		DestinationZone:       output.DestinationZone,
+		ActualWeightGrams:     output.ActualWeightGrams,
+		VolumetricWeightGrams: output.VolumetricWeightGrams,
+		ChargeableWeightGrams: output.ChargeableWeightGrams,
+		Items:                 items,
+		Total:                 NewMoneyResponse(output.Total),
+	}
+}
+
+type ShipmentResponse struct {
+	ShipmentID       string              `json:"shipmentId"`
+	RecipientID      string              `json:"recipientId"`
+	DestinationZone  string              `json:"destinationZone"`
+	Baggage          []BaggageResponse   `json:"baggage"`
+	TotalWeightGrams int64               `json:"totalWeightGrams"`
+	Fee              ShippingFeeResponse `json:"fee"`
+	Status           string              `json:"status"`
+	TrackingNumber   string              `json:"trackingNumber,omitempty"`
+	CreatedAt        time.Time           `json:"createdAt"`
+	ShippedAt        *time.Time          `json:"shippedAt,omitempty"`
+	CompletedAt      *time.Time          `json:"completedAt,omitempty"`
+}
+
+func NewShipmentResponse(output *usecase.ShipmentOutput) ShipmentResponse {
+	baggage := make([]BaggageResponse, 0, len(output.Baggage))
+	for _, item := range output.Baggage {
+		baggage = append(baggage, BaggageResponse{
+			Description: item.Description,
+			WeightGrams: item.WeightGrams,
+			LengthCm:    item.LengthCm,
+			WidthCm:     item.WidthCm,
+			HeightCm:    item.HeightCm,
+		})
+	}
+	return ShipmentResponse{
+		ShipmentID:       output.ShipmentID,
+		RecipientID:      output.RecipientID,
+		DestinationZone:  output.DestinationZone,
+		Baggage:          baggage,
+		TotalWeightGrams: output.TotalWeightGrams,
+		Fee:              NewShippingFeeResponse(output.Fee),
+		Status:           output.Status,
+		TrackingNumber:   output.TrackingNumber,
+		CreatedAt:        output.CreatedAt,
+		ShippedAt:        output.ShippedAt,
+		CompletedAt:      output.CompletedAt,
+	}
+}
+
+type UserShipmentsResponse struct {
+	UserID    string             `json:"userId"`
+	Shipments []ShipmentResponse `json:"shipments"`
+}
+
+func (h *ShipmentHandler) CreateShipment(w http.ResponseWriter, r *http.Request) {
+	var req CreateShipmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.createShipmentUseCase.Execute(usecase.CreateShipmentInput{
+		Actor:           AuthenticatedPrincipal(r.Context()),
+		RequestID:       requestID(r),
+		RecipientID:     req.RecipientID,
+		DestinationZone: req.DestinationZone,
+		Baggage:         req.baggageInputs(),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, NewShipmentResponse(output))
+}
+
+func (h *ShipmentHandler) QuoteShippingFee(w http.ResponseWriter, r *http.Request) {
+	var req CreateShipmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.quoteShippingFeeUseCase.Execute(usecase.QuoteShippingFeeInput{
+		Actor:           AuthenticatedPrincipal(r.Context()),
+		RecipientID:     req.RecipientID,
+		DestinationZone: req.DestinationZone,
+		Baggage:         req.baggageInputs(),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, NewShippingFeeResponse(output))
+}
+
+func (h *ShipmentHandler) GetShipment(w http.ResponseWriter, r *http.Request) {
+	output, err := h.getShipmentUseCase.Execute(usecase.GetShipmentInput{
+		Actor:      AuthenticatedPrincipal(r.Context()),
+		ShipmentID: chi.URLParam(r, "shipmentID"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, NewShipmentResponse(output))
+}
+
+func (h *ShipmentHandler) ListUserShipments(w http.ResponseWriter, r *http.Request) {
+	output, err := h.listUserShipmentsUseCase.Execute(usecase.ListUserShipmentsInput{
+		Actor:  AuthenticatedPrincipal(r.Context()),
+		UserID: chi.URLParam(r, "userID"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	shipments := make([]ShipmentResponse, 0, len(output.Shipments))
+	for _, shipment := range output.Shipments {
+		shipments = append(shipments, NewShipmentResponse(shipment))
+	}
+	writeJSON(w, http.StatusOK, UserShipmentsResponse{
+		UserID:    output.UserID,
+		Shipments: shipments,
+	})
+}
+
+func (h *ShipmentHandler) UpdateShipmentStatus(w http.ResponseWriter, r *http.Request) {
+	var req UpdateShipmentStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.updateShipmentStatusUseCase.Execute(usecase.UpdateShipmentStatusInput{
+		Actor:          AuthenticatedPrincipal(r.Context()),
+		RequestID:      requestID(r),
+		ShipmentID:     chi.URLParam(r, "shipmentID"),
+		Status:         req.Status,
+		TrackingNumber: req.TrackingNumber,
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, NewShipmentResponse(output))
+}
+
+func (h *ShipmentHandler) CancelShipment(w http.ResponseWriter, r *http.Request) {
+	output, err := h.cancelShipmentUseCase.Execute(usecase.CancelShipmentInput{
+		Actor:      AuthenticatedPrincipal(r.Context()),
+		RequestID:  requestID(r),
+		ShipmentID: chi.URLParam(r, "shipmentID"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, NewShipmentResponse(output))
+}
@@ -0,0 +1,149 @@
+package presentation
+
+import (
+	"ddd-bottomup/usecase"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type SubscriptionHandler struct {
+	getSubscriptionUseCase       *usecase.GetSubscriptionUseCase
+	upgradeSubscriptionUseCase   *usecase.UpgradeSubscriptionUseCase
+	downgradeSubscriptionUseCase *usecase.DowngradeSubscriptionUseCase
+	cancelSubscriptionUseCase    *usecase.CancelSubscriptionUseCase
+	grantSubscriptionUseCase     *usecase.GrantComplimentarySubscriptionUseCase
+}
+
+func NewSubscriptionHandler(
+	getSubscriptionUseCase *usecase.GetSubscriptionUseCase,
+	upgradeSubscriptionUseCase *usecase.UpgradeSubscriptionUseCase,
+	downgradeSubscriptionUseCase *usecase.DowngradeSubscriptionUseCase,
+	cancelSubscriptionUseCase *usecase.CancelSubscriptionUseCase,
+	grantSubscriptionUseCase *usecase.GrantComplimentarySubscriptionUseCase,
+) *SubscriptionHandler {
+	return &SubscriptionHandler{
+		getSubscriptionUseCase:       getSubscriptionUseCase,
+		upgradeSubscriptionUseCase:   upgradeSubscriptionUseCase,
+		downgradeSubscriptionUseCase: downgradeSubscriptionUseCase,
+		cancelSubscriptionUseCase:    cancelSubscriptionUseCase,
+		grantSubscriptionUseCase:     grantSubscriptionUseCase,
+	}
+}
+
+type UpgradeSubscriptionRequest struct {
+	Plan  string `json:"plan"`
+	Trial bool   `json:"trial"`
+}
+
+type DowngradeSubscriptionRequest struct {
+	Plan string `json:"plan"`
+}
+
+type SubscriptionResponse struct {
+	UserID      string     `json:"userId"`
+	Plan        string     `json:"plan"`
+	IsPremium   bool       `json:"isPremium"`
+	IsTrial     bool       `json:"isTrial"`
+	IsCancelled bool       `json:"isCancelled"`
+	StartedAt   *time.Time `json:"startedAt,omitempty"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+}
+
+func NewSubscriptionResponse(output *usecase.SubscriptionOutput) SubscriptionResponse {
+	return SubscriptionResponse{
+		UserID:      output.UserID,
+		Plan:        output.Plan,
+		IsPremium:   output.IsPremium,
+		IsTrial:     output.IsTrial,
+		IsCancelled: output.IsCancelled,
+		StartedAt:   output.StartedAt,
+		ExpiresAt:   output.ExpiresAt,
+	}
+}
+
+func (h *SubscriptionHandler) GetSubscription(w http.ResponseWriter, r *http.Request) {
+	output, err := h.getSubscriptionUseCase.Execute(usecase.GetSubscriptionInput{
+		Actor:  AuthenticatedPrincipal(r.Context()),
+		UserID: chi.URLParam(r, "userID"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, NewSubscriptionResponse(output))
+}
+
+func (h *SubscriptionHandler) UpgradeSubscription(w http.ResponseWriter, r *http.Request) {
+	var req UpgradeSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.upgradeSubscriptionUseCase.Execute(usecase.UpgradeSubscriptionInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		RequestID: requestID(r),
+		UserID:    chi.URLParam(r, "userID"),
+		Plan:      req.Plan,
+		Trial:     req.Trial,
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, NewSubscriptionResponse(output))
+}
+
+func (h *SubscriptionHandler) DowngradeSubscription(w http.ResponseWriter, r *http.Request) {
+	var req DowngradeSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.downgradeSubscriptionUseCase.Execute(usecase.DowngradeSubscriptionInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		RequestID: requestID(r),
+		UserID:    chi.URLParam(r, "userID"),
+		Plan:      req.Plan,
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, NewSubscriptionResponse(output))
+}
+
+func (h *SubscriptionHandler) CancelSubscription(w http.ResponseWriter, r *http.Request) {
+	output, err := h.cancelSubscriptionUseCase.Execute(usecase.CancelSubscriptionInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		RequestID: requestID(r),
+		UserID:    chi.URLParam(r, "userID"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, NewSubscriptionResponse(output))
+}
+
+func (h *SubscriptionHandler) GrantComplimentarySubscription(w http.ResponseWriter, r *http.Request) {
+	output, err := h.grantSubscriptionUseCase.Execute(usecase.GrantComplimentarySubscriptionInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		RequestID: requestID(r),
+		UserID:    chi.URLParam(r, "userID"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, NewSubscriptionResponse(output))
+}
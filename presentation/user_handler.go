@@ -1,20 +1,20 @@
 package presentation
 
 import (
-	"ddd-bottomup/domain"
 	"ddd-bottomup/usecase"
 	"encoding/json"
-	"errors"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
 )
 
 type UserHandler struct {
-	createUserUseCase *usecase.CreateUserUseCase
-	getUserUseCase    *usecase.GetUserUseCase
-	updateUserUseCase *usecase.UpdateUserUseCase
-	deleteUserUseCase *usecase.DeleteUserUseCase
+	createUserUseCase  *usecase.CreateUserUseCase
+	getUserUseCase     *usecase.GetUserUseCase
+	updateUserUseCase  *usecase.UpdateUserUseCase
+	deleteUserUseCase  *usecase.DeleteUserUseCase
+	restoreUserUseCase *usecase.RestoreUserUseCase
+	verifyEmailUseCase *usecase.VerifyEmailUseCase
 }
 
 func NewUserHandler(
@@ -22,12 +22,16 @@ func NewUserHandler(
 	getUserUseCase *usecase.GetUserUseCase,
 	updateUserUseCase *usecase.UpdateUserUseCase,
 	deleteUserUseCase *usecase.DeleteUserUseCase,
+	restoreUserUseCase *usecase.RestoreUserUseCase,
+	verifyEmailUseCase *usecase.VerifyEmailUseCase,
 ) *UserHandler {
 	return &UserHandler{
-		createUserUseCase: createUserUseCase,
-		getUserUseCase:    getUserUseCase,
-		updateUserUseCase: updateUserUseCase,
-		deleteUserUseCase: deleteUserUseCase,
+		createUserUseCase:  createUserUseCase,
+		getUserUseCase:     getUserUseCase,
+		updateUserUseCase:  updateUserUseCase,
+		deleteUserUseCase:  deleteUserUseCase,
+		restoreUserUseCase: restoreUserUseCase,
+		verifyEmailUseCase: verifyEmailUseCase,
 	}
 }
 
@@ -35,6 +39,7 @@ type CreateUserRequest struct {
 	FirstName string `json:"firstName"`
 	LastName  string `json:"lastName"`
 	Email     string `json:"email"`
+	Password  string `json:"password"`
 }
 
 type CreateUserResponse struct {
@@ -42,10 +47,13 @@ type CreateUserResponse struct {
 }
 
 type GetUserResponse struct {
-	UserID    string `json:"userId"`
-	FirstName string `json:"firstName"`
-	LastName  string `json:"lastName"`
-	Email     string `json:"email"`
+	UserID        string `json:"userId"`
+	FirstName     string `json:"firstName"`
+	LastName      string `json:"lastName"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"emailVerified"`
+	PendingEmail  string `json:"pendingEmail,omitempty"`
+	IsPremium     bool   `json:"isPremium"`
 }
 
 type UpdateUserRequest struct {
@@ -55,32 +63,51 @@ type UpdateUserRequest struct {
 }
 
 type UpdateUserResponse struct {
-	UserID    string `json:"userId"`
-	FirstName string `json:"firstName"`
-	LastName  string `json:"lastName"`
-	Email     string `json:"email"`
+	UserID        string `json:"userId"`
+	FirstName     string `json:"firstName"`
+	LastName      string `json:"lastName"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"emailVerified"`
+	PendingEmail  string `json:"pendingEmail,omitempty"`
+}
+
+type RestoreUserResponse struct {
+	UserID              string   `json:"userId"`
+	RestoredCircleIDs   []string `json:"restoredCircleIds"`
+	ReattachedCircleIDs []string `json:"reattachedCircleIds"`
+	FullCircleIDs       []string `json:"fullCircleIds"` // 定員に達していて戻せなかったサークル
 }
 
-type ErrorResponse struct {
-	Error string `json:"error"`
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+type VerifyEmailResponse struct {
+	UserID        string `json:"userId"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"emailVerified"`
+	PendingEmail  string `json:"pendingEmail,omitempty"`
 }
 
 func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 	var req CreateUserRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.writeError(w, "Invalid request body", http.StatusBadRequest)
+		writeError(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
 
 	input := usecase.CreateUserInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		RequestID: requestID(r),
 		FirstName: req.FirstName,
 		LastName:  req.LastName,
 		Email:     req.Email,
+		Password:  req.Password,
 	}
 
 	output, err := h.createUserUseCase.Execute(input)
 	if err != nil {
-		h.handleError(w, err)
+		handleError(w, err)
 		return
 	}
 
@@ -95,20 +122,24 @@ func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 func (h *UserHandler) GetUser(w http.ResponseWriter, r *http.Request) {
 	userID := chi.URLParam(r, "userID")
 	input := usecase.GetUserInput{
+		Actor:  AuthenticatedPrincipal(r.Context()),
 		UserID: userID,
 	}
 
 	output, err := h.getUserUseCase.Execute(input)
 	if err != nil {
-		h.handleError(w, err)
+		handleError(w, err)
 		return
 	}
 
 	response := GetUserResponse{
-		UserID:    output.UserID,
-		FirstName: output.FirstName,
-		LastName:  output.LastName,
-		Email:     output.Email,
+		UserID:        output.UserID,
+		FirstName:     output.FirstName,
+		LastName:      output.LastName,
+		Email:         output.Email,
+		EmailVerified: output.EmailVerified,
+		PendingEmail:  output.PendingEmail,
+		IsPremium:     output.IsPremium,
 	}
 
 	w.WriteHeader(http.StatusOK)
@@ -119,11 +150,13 @@ func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 	userID := chi.URLParam(r, "userID")
 	var req UpdateUserRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.writeError(w, "Invalid request body", http.StatusBadRequest)
+		writeError(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
 
 	input := usecase.UpdateUserInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		RequestID: requestID(r),
 		UserID:    userID,
 		FirstName: req.FirstName,
 		LastName:  req.LastName,
@@ -132,15 +165,17 @@ func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 
 	output, err := h.updateUserUseCase.Execute(input)
 	if err != nil {
-		h.handleError(w, err)
+		handleError(w, err)
 		return
 	}
 
 	response := UpdateUserResponse{
-		UserID:    output.UserID,
-		FirstName: output.FirstName,
-		LastName:  output.LastName,
-		Email:     output.Email,
+		UserID:        output.UserID,
+		FirstName:     output.FirstName,
+		LastName:      output.LastName,
+		Email:         output.Email,
+		EmailVerified: output.EmailVerified,
+		PendingEmail:  output.PendingEmail,
 	}
 
 	w.WriteHeader(http.StatusOK)
@@ -150,30 +185,66 @@ func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 func (h *UserHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
 	userID := chi.URLParam(r, "userID")
 	input := usecase.DeleteUserInput{
-		UserID: userID,
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		RequestID: requestID(r),
+		UserID:    userID,
 	}
 
 	err := h.deleteUserUseCase.Execute(input)
 	if err != nil {
-		h.handleError(w, err)
+		handleError(w, err)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
-func (h *UserHandler) handleError(w http.ResponseWriter, err error) {
-	var domainErr domain.DomainError
-	if errors.As(err, &domainErr) {
-		// ドメインエラーの場合、適切なHTTPステータスを使用
-		h.writeError(w, err.Error(), domainErr.HTTPStatus())
-	} else {
-		// その他のエラーは内部サーバーエラー
-		h.writeError(w, "Internal server error", http.StatusInternalServerError)
+func (h *UserHandler) RestoreUser(w http.ResponseWriter, r *http.Request) {
+	output, err := h.restoreUserUseCase.Execute(usecase.RestoreUserInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		RequestID: requestID(r),
+		UserID:    chi.URLParam(r, "userID"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
 	}
+
+	writeJSON(w, http.StatusOK, RestoreUserResponse{
+		UserID:              output.UserID,
+		RestoredCircleIDs:   output.RestoredCircleIDs,
+		ReattachedCircleIDs: output.ReattachedCircleIDs,
+		FullCircleIDs:       output.FullCircleIDs,
+	})
 }
 
-func (h *UserHandler) writeError(w http.ResponseWriter, message string, status int) {
-	w.WriteHeader(status)
-	json.NewEncoder(w).Encode(ErrorResponse{Error: message})
+func (h *UserHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	userID := chi.URLParam(r, "userID")
+	var req VerifyEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	input := usecase.VerifyEmailInput{
+		RequestID: requestID(r),
+		UserID:    userID,
+		Token:     req.Token,
+	}
+
+	output, err := h.verifyEmailUseCase.Execute(input)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	response := VerifyEmailResponse{
+		UserID:        output.UserID,
+		Email:         output.Email,
+		EmailVerified: output.EmailVerified,
+		PendingEmail:  output.PendingEmail,
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
 }
@@ -0,0 +1,153 @@
+package presentation
+
+import (
+	"ddd-bottomup/usecase"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type WebhookHandler struct {
+	registerCircleWebhookUseCase *usecase.RegisterCircleWebhookUseCase
+	listCircleWebhooksUseCase    *usecase.ListCircleWebhooksUseCase
+	listWebhookDeliveriesUseCase *usecase.ListWebhookDeliveriesUseCase
+}
+
+func NewWebhookHandler(
+	registerCircleWebhookUseCase *usecase.RegisterCircleWebhookUseCase,
+	listCircleWebhooksUseCase *usecase.ListCircleWebhooksUseCase,
+	listWebhookDeliveriesUseCase *usecase.ListWebhookDeliveriesUseCase,
+) *WebhookHandler {
+	return &WebhookHandler{
+		registerCircleWebhookUseCase: registerCircleWebhookUseCase,
+		listCircleWebhooksUseCase:    listCircleWebhooksUseCase,
+		listWebhookDeliveriesUseCase: listWebhookDeliveriesUseCase,
+	}
+}
+
+type RegisterWebhookRequest struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+}
+
+type WebhookResponse struct {
+	WebhookID string    `json:"webhookId"`
+	CircleID  string    `json:"circleId"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Secret    string    `json:"secret,omitempty"` // 登録時のみ
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func NewWebhookResponse(output *usecase.WebhookOutput) WebhookResponse {
+	return WebhookResponse{
+		WebhookID: output.WebhookID,
+		CircleID:  output.CircleID,
+		URL:       output.URL,
+		Events:    output.Events,
+		CreatedAt: output.CreatedAt,
+	}
+}
+
+type ListWebhooksResponse struct {
+	CircleID string            `json:"circleId"`
+	Webhooks []WebhookResponse `json:"webhooks"`
+}
+
+type WebhookDeliveryResponse struct {
+	DeliveryID     string          `json:"deliveryId"`
+	Event          string          `json:"event"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	ResponseStatus int             `json:"responseStatus,omitempty"`
+	LastError      string          `json:"lastError,omitempty"`
+	NextAttemptAt  *time.Time      `json:"nextAttemptAt,omitempty"`
+	CreatedAt      time.Time       `json:"createdAt"`
+	CompletedAt    *time.Time      `json:"completedAt,omitempty"`
+}
+
+type ListWebhookDeliveriesResponse struct {
+	WebhookID  string                    `json:"webhookId"`
+	Deliveries []WebhookDeliveryResponse `json:"deliveries"`
+}
+
+func (h *WebhookHandler) RegisterWebhook(w http.ResponseWriter, r *http.Request) {
+	var req RegisterWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.registerCircleWebhookUseCase.Execute(usecase.RegisterCircleWebhookInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		RequestID: requestID(r),
+		CircleID:  chi.URLParam(r, "circleID"),
+		URL:       req.URL,
+		Events:    req.Events,
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	response := NewWebhookResponse(output.WebhookOutput)
+	response.Secret = output.Secret
+	writeJSON(w, http.StatusCreated, response)
+}
+
+func (h *WebhookHandler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	output, err := h.listCircleWebhooksUseCase.Execute(usecase.ListCircleWebhooksInput{
+		Actor:    AuthenticatedPrincipal(r.Context()),
+		CircleID: chi.URLParam(r, "circleID"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	webhooks := make([]WebhookResponse, 0, len(output.Webhooks))
+	for _, webhook := range output.Webhooks {
+		webhooks = append(webhooks, NewWebhookResponse(webhook))
+	}
+
+	writeJSON(w, http.StatusOK, ListWebhooksResponse{
+		CircleID: output.CircleID,
+		Webhooks: webhooks,
+	})
+}
+
+func (h *WebhookHandler) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	output, err := h.listWebhookDeliveriesUseCase.Execute(usecase.ListWebhookDeliveriesInput{
+		Actor:     AuthenticatedPrincipal(r.Context()),
+		CircleID:  chi.URLParam(r, "circleID"),
+		WebhookID: chi.URLParam(r, "hookID"),
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	deliveries := make([]WebhookDeliveryResponse, 0, len(output.Deliveries))
+	for _, delivery := range output.Deliveries {
+		deliveries = append(deliveries, WebhookDeliveryResponse{
+			DeliveryID:     delivery.DeliveryID,
+			Event:          delivery.EventName,
+			Payload:        delivery.Payload,
+			Status:         delivery.Status,
+			Attempts:       delivery.Attempts,
+			ResponseStatus: delivery.ResponseStatus,
+			LastError:      delivery.LastError,
+			NextAttemptAt:  delivery.NextAttemptAt,
+			CreatedAt:      delivery.CreatedAt,
+			CompletedAt:    delivery.CompletedAt,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, ListWebhookDeliveriesResponse{
+		WebhookID:  output.WebhookID,
+		Deliveries: deliveries,
+	})
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+// authorizeUser は対象のユーザーに対する action を actor に認可し、対象の UserID を返す
+// ID の形式や対象が存在するかは、許可されると分かってから調べる
+func authorizeUser(actor *domain.Principal, action domain.Action, userID string) (*domain.UserID, error) {
+	id, parseErr := domain.ReconstructUserID(userID)
+	if err := domain.Authorize(actor, action, domain.Resource{UserID: id}); err != nil {
+		return nil, err
+	}
+	if parseErr != nil {
+		return nil, parseErr
+	}
+	return id, nil
+}
+
+// authorizeCircle はサークルに対する action を actor に認可する
+func authorizeCircle(actor *domain.Principal, action domain.Action, circle *domain.Circle) error {
+	return domain.Authorize(actor, action, domain.Resource{Circle: circle})
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"errors"
+	"testing"
+)
+
+// userActor は userID の利用者を主体として返す（空の場合は匿名として nil を返す）
+func userActor(t *testing.T, userID string) *domain.Principal {
+	t.Helper()
+
+	if userID == "" {
+		return nil
+	}
+	return domain.NewUserPrincipal(mustUserID(t, userID), false)
+}
+
+// adminActor は管理者を主体として返す（認可を検証しないテストで使う）
+func adminActor() *domain.Principal {
+	return domain.NewUserPrincipal(domain.NewUserID(), true)
+}
+
+// servicePrincipal は permissions を持つ API キーのサービスを返す
+func servicePrincipal(t *testing.T, permissions ...domain.Permission) *domain.Principal {
+	t.Helper()
+
+	key, _, err := domain.NewAPIKey("batch", permissions, "test", testLoginNow, nil)
+	if err != nil {
+		t.Fatalf("Failed to create API key: %v", err)
+	}
+	return domain.NewServicePrincipal(key)
+}
+
+func assertAuthorizationError(t *testing.T, err, want error) {
+	t.Helper()
+
+	switch want.(type) {
+	case nil:
+		if err != nil {
+			t.Errorf("Expected no error, but got: %v", err)
+		}
+	case domain.UnauthenticatedError:
+		var e domain.UnauthenticatedError
+		if !errors.As(err, &e) {
+			t.Errorf("Expected UnauthenticatedError, but got %v", err)
+		}
+	case domain.ForbiddenError:
+		var e domain.ForbiddenError
+		if !errors.As(err, &e) {
+			t.Errorf("Expected ForbiddenError, but got %v", err)
+		}
+	}
+}
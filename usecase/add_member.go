@@ -3,26 +3,43 @@ package usecase
 import (
 	"ddd-bottomup/domain"
 	"errors"
-	"fmt"
+	"time"
 )
 
 type AddMemberInput struct {
-	CircleID string
-	UserID   string
+	Actor     *domain.Principal // 参加する本人かオーナー、または circles:write が必要
+	RequestID string            // 監査ログに記録するリクエストID
+	CircleID  string
+	UserID    string
 }
 
 type AddMemberUseCase struct {
-	circleRepository domain.CircleRepository
-	userRepository   domain.UserRepository
+	circleRepository     domain.CircleRepository
+	userRepository       domain.UserRepository
+	ledgerRepository     domain.LedgerRepository
+	circleMemberService  *domain.CircleMemberService
+	requireVerifiedEmail bool // true の場合、メールアドレスを確認していないユーザーは参加できない
+	clock                domain.Clock
+	auditLog             *AuditLog
 }
 
 func NewAddMemberUseCase(
 	circleRepository domain.CircleRepository,
 	userRepository domain.UserRepository,
+	ledgerRepository domain.LedgerRepository,
+	circleMemberService *domain.CircleMemberService,
+	requireVerifiedEmail bool,
+	clock domain.Clock,
+	auditLog *AuditLog,
 ) *AddMemberUseCase {
 	return &AddMemberUseCase{
-		circleRepository: circleRepository,
-		userRepository:   userRepository,
+		circleRepository:     circleRepository,
+		userRepository:       userRepository,
+		ledgerRepository:     ledgerRepository,
+		circleMemberService:  circleMemberService,
+		requireVerifiedEmail: requireVerifiedEmail,
+		clock:                clock,
+		auditLog:             auditLog,
 	}
 }
 
@@ -45,7 +62,12 @@ func (uc *AddMemberUseCase) Execute(input AddMemberInput) error {
 		return err
 	}
 	if circle == nil {
-		return errors.New("circle not found")
+		return domain.CircleNotFoundError{ID: input.CircleID}
+	}
+
+	// 他のユーザーを追加できるのはオーナーのみ（本人は自分で参加できる）
+	if err := domain.Authorize(input.Actor, domain.ActionJoinCircle, domain.Resource{Circle: circle, UserID: userID}); err != nil {
+		return err
 	}
 
 	// ユーザーの存在確認
@@ -54,7 +76,7 @@ func (uc *AddMemberUseCase) Execute(input AddMemberInput) error {
 		return err
 	}
 	if user == nil {
-		return errors.New("user not found")
+		return domain.UserNotFoundError{ID: input.UserID}
 	}
 
 	// 基本的なバリデーション
@@ -64,39 +86,85 @@ func (uc *AddMemberUseCase) Execute(input AddMemberInput) error {
 	if circle.IsMember(userID) {
 		return nil // 既にメンバーの場合はエラーではない
 	}
+	if uc.requireVerifiedEmail && !user.IsEmailVerified() {
+		return domain.EmailNotVerifiedError{UserID: input.UserID}
+	}
 
-	// オーナーを取得
-	owner, err := uc.userRepository.FindByID(circle.OwnerID())
+	// サークルに適用される定員ポリシーから定員を決定
+	now := uc.clock.Now()
+	capacity, err := circleCapacity(uc.userRepository, uc.circleMemberService, circle, now)
 	if err != nil {
 		return err
 	}
+
+	// メンバーを追加（定員超過は集約が拒否する）
+	before := domain.CircleAuditSnapshot(circle)
+	if err := circle.AddMember(userID, capacity, now); err != nil {
+		return err
+	}
+
+	// 会費は保存の前に請求する（保存に失敗しても再実行で二重に請求しない）
+	if err := uc.chargeMembershipDues(circle, userID); err != nil {
+		return err
+	}
+
+	// 保存
+	if err := uc.circleRepository.Save(circle); err != nil {
+		return err
+	}
+	changes := domain.DiffAuditSnapshots(before, domain.CircleAuditSnapshot(circle))
+	return uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditCircleMemberAdded, domain.CircleAuditTarget(circle.ID()), changes)
+}
+
+// chargeMembershipDues は会費のあるサークルの会費をサークルとメンバーごとに1度だけ請求する
+func (uc *AddMemberUseCase) chargeMembershipDues(circle *domain.Circle, userID *domain.UserID) error {
+	if !circle.HasMembershipDues() {
+		return nil
+	}
+	ledger, err := uc.ledgerRepository.FindByUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	var duplicate domain.DuplicateLedgerEntryError
+	entry, err := ledger.ChargeOnce(
+		circle.MembershipDues(),
+		"Membership dues: "+circle.Name().Value(),
+		"circle_dues:"+circle.ID().Value()+":"+userID.Value(),
+		uc.clock.Now(),
+	)
+	if errors.As(err, &duplicate) {
+		return nil // 前回の実行で請求済み
+	}
+	if err != nil {
+		return err
+	}
+	if err := uc.ledgerRepository.Append(entry); err != nil && !errors.As(err, &duplicate) {
+		return err
+	}
+	return nil
+}
+
+// circleCapacity はオーナーと現在のメンバーの now 時点の契約からサークルの定員を決定する
+func circleCapacity(userRepository domain.UserRepository, circleMemberService *domain.CircleMemberService, circle *domain.Circle, now time.Time) (*domain.CircleCapacity, error) {
+	owner, err := userRepository.FindByID(circle.OwnerID())
+	if err != nil {
+		return nil, err
+	}
 	if owner == nil {
-		return errors.New("owner not found")
+		return nil, errors.New("owner not found")
 	}
 
-	// メンバーリストを構築
 	var members []*domain.User
 	for _, memberID := range circle.GetMemberIDs() {
-		member, err := uc.userRepository.FindByID(memberID)
+		member, err := userRepository.FindByID(memberID)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if member != nil {
 			members = append(members, member)
 		}
 	}
 
-	// プレミアム制限をチェック
-	circleMembers := domain.NewCircleMembers(owner, members)
-	memberService := domain.NewCircleMemberService()
-	if !memberService.CanAddMember(circleMembers) {
-		maxLimit := memberService.GetMaxLimit(circleMembers)
-		return fmt.Errorf("circle is full: maximum %d participants (including owner) allowed", maxLimit)
-	}
-
-	// メンバーを追加
-	circle.AddMember(userID)
-
-	// 保存
-	return uc.circleRepository.Save(circle)
+	return circleMemberService.GetCapacity(circle, domain.NewCircleMembers(owner, members, now)), nil
 }
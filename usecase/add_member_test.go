@@ -0,0 +1,256 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// テスト用ヘルパー：オーナーとmemberCount人のメンバー（うちpremiumCount人がプレミアム）を持つサークルを作成する
+func setupCircleWithMembers(t *testing.T, userRepo domain.UserRepository, circleRepo domain.CircleRepository, memberCount, premiumCount int) *domain.Circle {
+	t.Helper()
+
+	ownerName, _ := domain.NewFullName("オーナー", "田中")
+	ownerEmail, _ := domain.NewEmail("owner@example.com")
+	owner := domain.NewUser(ownerName, ownerEmail, time.Now())
+	if err := userRepo.Save(owner); err != nil {
+		t.Fatalf("Failed to save owner: %v", err)
+	}
+
+	var memberIDs []*domain.UserID
+	for i := 0; i < memberCount; i++ {
+		name, _ := domain.NewFullName(fmt.Sprintf("member%d", i), "test")
+		email, _ := domain.NewEmail(fmt.Sprintf("member%d@example.com", i))
+		member := domain.ReconstructUser(domain.NewUserID(), name, email, i < premiumCount)
+		if err := userRepo.Save(member); err != nil {
+			t.Fatalf("Failed to save member: %v", err)
+		}
+		memberIDs = append(memberIDs, member.ID())
+	}
+
+	circleName, _ := domain.NewCircleName("テストサークル")
+	circle := domain.ReconstructCircle(domain.NewCircleID(), circleName, owner.ID(), memberIDs, nil, time.Now())
+	if err := circleRepo.Save(circle); err != nil {
+		t.Fatalf("Failed to save circle: %v", err)
+	}
+	return circle
+}
+
+func saveNewUser(t *testing.T, userRepo domain.UserRepository, firstName string) *domain.User {
+	t.Helper()
+
+	name, _ := domain.NewFullName(firstName, "新規")
+	email, _ := domain.NewEmail(firstName + "@example.com")
+	user := domain.NewUser(name, email, time.Now())
+	if err := userRepo.Save(user); err != nil {
+		t.Fatalf("Failed to save user: %v", err)
+	}
+	return user
+}
+
+func TestAddMemberUseCase_Execute_Success(t *testing.T) {
+	// Arrange
+	userRepo := infrastructure.NewMemoryUserRepository()
+	circleRepo := infrastructure.NewMemoryCircleRepository()
+	circle := setupCircleWithMembers(t, userRepo, circleRepo, 3, 0)
+	newUser := saveNewUser(t, userRepo, "newcomer")
+	useCase := NewAddMemberUseCase(circleRepo, userRepo, infrastructure.NewMemoryLedgerRepository(), domain.NewCircleMemberService(nil), false, domain.SystemClock{}, newTestAuditLog())
+
+	// Act
+	err := useCase.Execute(AddMemberInput{
+		Actor:    adminActor(),
+		CircleID: circle.ID().Value(),
+		UserID:   newUser.ID().Value(),
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	saved, _ := circleRepo.FindByID(circle.ID())
+	if !saved.IsMember(newUser.ID()) {
+		t.Error("Expected user to be a member")
+	}
+}
+
+func TestAddMemberUseCase_Execute_PremiumBoundary(t *testing.T) {
+	tests := []struct {
+		name         string
+		premiumCount int
+		expectFull   bool
+	}{
+		// オーナー1名 + メンバー29名 = 30名
+		{"プレミアム9人では満員エラー", 9, true},
+		{"プレミアム10人では追加可能", 10, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			userRepo := infrastructure.NewMemoryUserRepository()
+			circleRepo := infrastructure.NewMemoryCircleRepository()
+			circle := setupCircleWithMembers(t, userRepo, circleRepo, 29, tt.premiumCount)
+			newUser := saveNewUser(t, userRepo, "newcomer")
+			useCase := NewAddMemberUseCase(circleRepo, userRepo, infrastructure.NewMemoryLedgerRepository(), domain.NewCircleMemberService(nil), false, domain.SystemClock{}, newTestAuditLog())
+
+			// Act
+			err := useCase.Execute(AddMemberInput{
+				Actor:    adminActor(),
+				CircleID: circle.ID().Value(),
+				UserID:   newUser.ID().Value(),
+			})
+
+			// Assert
+			if tt.expectFull {
+				if fullErr, ok := err.(domain.CircleFullError); !ok {
+					t.Errorf("Expected CircleFullError, but got %T: %v", err, err)
+				} else if fullErr.MaxParticipants != domain.BasicMemberLimit {
+					t.Errorf("Expected max participants %d, but got %d", domain.BasicMemberLimit, fullErr.MaxParticipants)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Expected no error, but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestAddMemberUseCase_Execute_RequireVerifiedEmail(t *testing.T) {
+	tests := []struct {
+		name        string
+		verified    bool
+		expectError bool
+	}{
+		{"未確認のユーザーは参加できない", false, true},
+		{"確認済みのユーザーは参加できる", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			userRepo := infrastructure.NewMemoryUserRepository()
+			circleRepo := infrastructure.NewMemoryCircleRepository()
+			circle := setupCircleWithMembers(t, userRepo, circleRepo, 0, 0)
+			newUser := saveNewUser(t, userRepo, "newcomer")
+			if tt.verified {
+				newUser = saveVerifiedUser(t, userRepo, "verified")
+			}
+			useCase := NewAddMemberUseCase(circleRepo, userRepo, infrastructure.NewMemoryLedgerRepository(), domain.NewCircleMemberService(nil), true, domain.SystemClock{}, newTestAuditLog())
+
+			// Act
+			err := useCase.Execute(AddMemberInput{
+				Actor:    adminActor(),
+				CircleID: circle.ID().Value(),
+				UserID:   newUser.ID().Value(),
+			})
+
+			// Assert
+			var notVerified domain.EmailNotVerifiedError
+			if tt.expectError != errors.As(err, &notVerified) {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			saved, _ := circleRepo.FindByID(circle.ID())
+			if saved.IsMember(newUser.ID()) == tt.expectError {
+				t.Errorf("Expected membership %v", !tt.expectError)
+			}
+		})
+	}
+}
+
+// flakyCircleRepository は最初の failures 回の保存に失敗する
+// DB と同様に、保存していない変更は取得し直すと残らない
+type flakyCircleRepository struct {
+	domain.CircleRepository
+	failures int
+}
+
+func (r *flakyCircleRepository) FindByID(id *domain.CircleID) (*domain.Circle, error) {
+	circle, err := r.CircleRepository.FindByID(id)
+	if err != nil || circle == nil {
+		return circle, err
+	}
+	memberIDs := append([]*domain.UserID(nil), circle.GetMemberIDs()...)
+	return domain.ReconstructCircle(circle.ID(), circle.Name(), circle.OwnerID(), memberIDs, circle.MembershipDues(), circle.CreatedAt()), nil
+}
+
+func (r *flakyCircleRepository) Save(circle *domain.Circle) error {
+	if r.failures > 0 {
+		r.failures--
+		return errors.New("connection lost")
+	}
+	return r.CircleRepository.Save(circle)
+}
+
+// flakyLedgerRepository は最初の failures 回の追記に失敗する
+type flakyLedgerRepository struct {
+	domain.LedgerRepository
+	failures int
+}
+
+func (r *flakyLedgerRepository) Append(entry *domain.LedgerEntry) error {
+	if r.failures > 0 {
+		r.failures--
+		return errors.New("connection lost")
+	}
+	return r.LedgerRepository.Append(entry)
+}
+
+func TestAddMemberUseCase_Execute_RetryAfterFailure_ChargesDuesOnce(t *testing.T) {
+	tests := []struct {
+		name           string
+		circleFailures int
+		ledgerFailures int
+	}{
+		{"サークルの保存に失敗", 1, 0},
+		{"会費の請求に失敗", 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			userRepo := infrastructure.NewMemoryUserRepository()
+			circleRepo := &flakyCircleRepository{CircleRepository: infrastructure.NewMemoryCircleRepository()}
+			ledgerRepo := &flakyLedgerRepository{LedgerRepository: infrastructure.NewMemoryLedgerRepository()}
+			circle := setupCircleWithMembers(t, userRepo, circleRepo, 0, 0)
+			dues, _ := domain.NewMoney(3000, "JPY")
+			if err := circle.ChangeMembershipDues(dues); err != nil {
+				t.Fatalf("Failed to set dues: %v", err)
+			}
+			if err := circleRepo.Save(circle); err != nil {
+				t.Fatalf("Failed to save circle: %v", err)
+			}
+			newUser := saveNewUser(t, userRepo, "newcomer")
+			useCase := NewAddMemberUseCase(circleRepo, userRepo, ledgerRepo, domain.NewCircleMemberService(nil), false, domain.SystemClock{}, newTestAuditLog())
+			input := AddMemberInput{Actor: adminActor(), CircleID: circle.ID().Value(), UserID: newUser.ID().Value()}
+			circleRepo.failures = tt.circleFailures
+			ledgerRepo.failures = tt.ledgerFailures
+
+			// Act
+			firstErr := useCase.Execute(input)
+			retryErr := useCase.Execute(input)
+
+			// Assert
+			if firstErr == nil {
+				t.Fatal("Expected the first attempt to fail")
+			}
+			if retryErr != nil {
+				t.Fatalf("Expected the retry to succeed, but got: %v", retryErr)
+			}
+			saved, _ := circleRepo.FindByID(circle.ID())
+			if !saved.IsMember(newUser.ID()) {
+				t.Error("Expected the retry to add the member")
+			}
+			ledger, _ := ledgerRepo.FindByUserID(newUser.ID())
+			if len(ledger.Entries()) != 1 {
+				t.Fatalf("Expected dues to be charged once, but got %d entries", len(ledger.Entries()))
+			}
+			if balance, _ := ledger.Balance(); !balance.Equals(dues) {
+				t.Errorf("Expected balance %s, but got %s", dues, balance)
+			}
+		})
+	}
+}
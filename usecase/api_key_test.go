@@ -0,0 +1,222 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"errors"
+	"testing"
+	"time"
+)
+
+type apiKeyTestFixture struct {
+	repo         domain.APIKeyRepository
+	clock        *domain.FixedClock
+	create       *CreateAPIKeyUseCase
+	revoke       *RevokeAPIKeyUseCase
+	list         *ListAPIKeysUseCase
+	authenticate *AuthenticateAPIKeyUseCase
+	admin        *domain.Principal
+}
+
+func newAPIKeyTestFixture() *apiKeyTestFixture {
+	f := &apiKeyTestFixture{
+		repo:  infrastructure.NewMemoryAPIKeyRepository(),
+		clock: domain.NewFixedClock(testLoginNow),
+		admin: domain.NewUserPrincipal(domain.NewUserID(), true),
+	}
+	f.create = NewCreateAPIKeyUseCase(f.repo, f.clock, newTestAuditLog())
+	f.revoke = NewRevokeAPIKeyUseCase(f.repo, f.clock, newTestAuditLog())
+	f.list = NewListAPIKeysUseCase(f.repo)
+	f.authenticate = NewAuthenticateAPIKeyUseCase(f.repo, f.clock)
+	return f
+}
+
+func (f *apiKeyTestFixture) mint(t *testing.T, permissions ...string) *CreateAPIKeyOutput {
+	t.Helper()
+
+	output, err := f.create.Execute(CreateAPIKeyInput{Actor: f.admin, Name: "batch", Permissions: permissions})
+	if err != nil {
+		t.Fatalf("Failed to mint API key: %v", err)
+	}
+	return output
+}
+
+func TestAuthenticateAPIKeyUseCase_Execute_ReturnsServicePrincipal(t *testing.T) {
+	// Arrange
+	f := newAPIKeyTestFixture()
+	minted := f.mint(t, "users:read", "circles:write")
+	f.clock.Advance(time.Hour)
+
+	// Act
+	output, err := f.authenticate.Execute(AuthenticateAPIKeyInput{Key: minted.Key})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	principal := output.Principal
+	if !principal.IsService() || principal.APIKeyID() != minted.ID || principal.UserID() != nil {
+		t.Errorf("Expected a service principal for key %s, but got %+v", minted.ID, principal)
+	}
+	if !principal.HasPermission(domain.PermissionCirclesWrite) || principal.HasPermission(domain.PermissionUsersWrite) {
+		t.Errorf("Expected the key's permissions, but got %v", minted.Permissions)
+	}
+	saved, _ := f.repo.FindByID(minted.ID)
+	if saved.LastUsedAt() == nil || !saved.LastUsedAt().Equal(f.clock.Now()) {
+		t.Errorf("Expected last used at %v, but got %v", f.clock.Now(), saved.LastUsedAt())
+	}
+}
+
+// revokingAPIKeyRepository は認証中のキーを読み込んだ直後に、別のリクエストがそのキーを失効させた状態を作る
+type revokingAPIKeyRepository struct {
+	domain.APIKeyRepository
+	revoke func()
+}
+
+func (r *revokingAPIKeyRepository) FindByPrefix(prefix string) (*domain.APIKey, error) {
+	key, err := r.APIKeyRepository.FindByPrefix(prefix)
+	r.revoke()
+	return key, err
+}
+
+func TestAuthenticateAPIKeyUseCase_Execute_RevokedDuringRequest_StaysRevoked(t *testing.T) {
+	// Arrange
+	f := newAPIKeyTestFixture()
+	minted := f.mint(t, string(domain.PermissionCirclesRead))
+	repo := &revokingAPIKeyRepository{APIKeyRepository: f.repo, revoke: func() {
+		if _, err := f.revoke.Execute(RevokeAPIKeyInput{Actor: f.admin, APIKeyID: minted.ID}); err != nil {
+			t.Fatalf("Failed to revoke API key: %v", err)
+		}
+	}}
+
+	// Act
+	_, err := NewAuthenticateAPIKeyUseCase(repo, f.clock).Execute(AuthenticateAPIKeyInput{Key: minted.Key})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected the request in flight to succeed, but got: %v", err)
+	}
+	saved, _ := f.repo.FindByID(minted.ID)
+	if !saved.IsRevoked() {
+		t.Error("Expected the key to stay revoked after recording its use")
+	}
+	if saved.LastUsedAt() == nil || !saved.LastUsedAt().Equal(f.clock.Now()) {
+		t.Errorf("Expected last used at %v, but got %v", f.clock.Now(), saved.LastUsedAt())
+	}
+	var unauthenticated domain.UnauthenticatedError
+	if _, err := f.authenticate.Execute(AuthenticateAPIKeyInput{Key: minted.Key}); !errors.As(err, &unauthenticated) {
+		t.Errorf("Expected UnauthenticatedError for the next request, but got %v", err)
+	}
+}
+
+func TestAuthenticateAPIKeyUseCase_Execute_RejectsInvalidKeys(t *testing.T) {
+	tests := []struct {
+		name string
+		key  func(f *apiKeyTestFixture, minted *CreateAPIKeyOutput) string
+	}{
+		{
+			name: "存在しない prefix",
+			key:  func(*apiKeyTestFixture, *CreateAPIKeyOutput) string { return "ak_000000000000_secret" },
+		},
+		{
+			name: "secret が異なる",
+			key: func(_ *apiKeyTestFixture, minted *CreateAPIKeyOutput) string {
+				return "ak_" + minted.Prefix + "_wrong-secret"
+			},
+		},
+		{
+			name: "失効したキー",
+			key: func(f *apiKeyTestFixture, minted *CreateAPIKeyOutput) string {
+				if _, err := f.revoke.Execute(RevokeAPIKeyInput{Actor: f.admin, APIKeyID: minted.ID}); err != nil {
+					t.Fatalf("Failed to revoke API key: %v", err)
+				}
+				return minted.Key
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			f := newAPIKeyTestFixture()
+			minted := f.mint(t, "users:read")
+			key := tt.key(f, minted)
+
+			// Act
+			_, err := f.authenticate.Execute(AuthenticateAPIKeyInput{Key: key})
+
+			// Assert
+			var e domain.UnauthenticatedError
+			if !errors.As(err, &e) {
+				t.Errorf("Expected UnauthenticatedError, but got %v", err)
+			}
+		})
+	}
+}
+
+func TestCreateAPIKeyUseCase_Execute_Authorization(t *testing.T) {
+	manager := servicePrincipal(t, domain.PermissionAPIKeysManage, domain.PermissionUsersRead)
+
+	tests := []struct {
+		name        string
+		actor       *domain.Principal
+		permissions []string
+		wantErr     error
+	}{
+		{"管理者", domain.NewUserPrincipal(domain.NewUserID(), true), []string{"users:write"}, nil},
+		{"管理権限を持つサービスが自分の権限を付与", manager, []string{"users:read"}, nil},
+		{"管理権限を持つサービスが持たない権限を付与", manager, []string{"users:write"}, domain.ForbiddenError{}},
+		{"管理者でない利用者", domain.NewUserPrincipal(domain.NewUserID(), false), []string{"users:read"}, domain.ForbiddenError{}},
+		{"匿名", nil, []string{"users:read"}, domain.UnauthenticatedError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			f := newAPIKeyTestFixture()
+
+			// Act
+			_, err := f.create.Execute(CreateAPIKeyInput{Actor: tt.actor, Name: "batch", Permissions: tt.permissions})
+
+			// Assert
+			assertAuthorizationError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestListAPIKeysUseCase_Execute_ShowsRevokedKeysWithoutSecrets(t *testing.T) {
+	// Arrange
+	f := newAPIKeyTestFixture()
+	first := f.mint(t, "users:read")
+	f.clock.Advance(time.Minute)
+	f.mint(t, "circles:read")
+	f.revoke.Execute(RevokeAPIKeyInput{Actor: f.admin, APIKeyID: first.ID})
+
+	// Act
+	keys, err := f.list.Execute(ListAPIKeysInput{Actor: f.admin})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("Expected 2 keys, but got %d", len(keys))
+	}
+	if keys[0].ID != first.ID || keys[0].RevokedAt == nil {
+		t.Errorf("Expected the first key to be listed as revoked, but got %+v", keys[0])
+	}
+}
+
+func TestRevokeAPIKeyUseCase_Execute_UnknownKey(t *testing.T) {
+	// Arrange
+	f := newAPIKeyTestFixture()
+
+	// Act
+	_, err := f.revoke.Execute(RevokeAPIKeyInput{Actor: f.admin, APIKeyID: "missing"})
+
+	// Assert
+	var e domain.APIKeyNotFoundError
+	if !errors.As(err, &e) {
+		t.Errorf("Expected APIKeyNotFoundError, but got %v", err)
+	}
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+// AuditLog は状態を変更したユースケースの操作を監査ログに追記する
+type AuditLog struct {
+	auditRepository domain.AuditRepository
+	clock           domain.Clock
+}
+
+func NewAuditLog(auditRepository domain.AuditRepository, clock domain.Clock) *AuditLog {
+	return &AuditLog{
+		auditRepository: auditRepository,
+		clock:           clock,
+	}
+}
+
+// Record は actor による操作を記録する
+// 変更を保存した後に呼ぶため、失敗した場合は変更が記録されずに残る
+func (l *AuditLog) Record(actor *domain.Principal, requestID string, action domain.AuditAction, target domain.AuditTarget, changes []domain.AuditChange) error {
+	entry, err := domain.NewAuditEntry(actor, action, target, changes, requestID, l.clock.Now())
+	if err != nil {
+		return err
+	}
+	return l.auditRepository.Append(entry)
+}
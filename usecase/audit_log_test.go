@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newTestAuditLog は記録内容を検証しないテスト用の監査ログを返す
+func newTestAuditLog() *AuditLog {
+	return NewAuditLog(infrastructure.NewMemoryAuditRepository(), domain.SystemClock{})
+}
+
+var testAuditNow = time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+func findAuditEntries(t *testing.T, auditRepo domain.AuditRepository) []*domain.AuditEntry {
+	t.Helper()
+
+	entries, err := auditRepo.Find(domain.AuditFilter{Limit: MaxAuditEntryLimit})
+	if err != nil {
+		t.Fatalf("Failed to find audit entries: %v", err)
+	}
+	return entries
+}
+
+func TestAddMemberUseCase_Execute_RecordsAuditEntry(t *testing.T) {
+	// Arrange
+	userRepo := infrastructure.NewMemoryUserRepository()
+	circleRepo := infrastructure.NewMemoryCircleRepository()
+	auditRepo := infrastructure.NewMemoryAuditRepository()
+	circle := setupCircleWithMembers(t, userRepo, circleRepo, 1, 0)
+	existingMember := circle.GetMemberIDs()[0].Value()
+	newUser := saveNewUser(t, userRepo, "newcomer")
+	useCase := NewAddMemberUseCase(circleRepo, userRepo, infrastructure.NewMemoryLedgerRepository(), domain.NewCircleMemberService(nil), false,
+		domain.NewFixedClock(testAuditNow), NewAuditLog(auditRepo, domain.NewFixedClock(testAuditNow)))
+
+	// Act
+	err := useCase.Execute(AddMemberInput{
+		Actor:     userActor(t, circle.OwnerID().Value()),
+		RequestID: "req-123",
+		CircleID:  circle.ID().Value(),
+		UserID:    newUser.ID().Value(),
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	entries := findAuditEntries(t, auditRepo)
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 audit entry, but got %d", len(entries))
+	}
+	entry := entries[0]
+	if entry.ActorType() != domain.AuditActorUser || entry.ActorID() != circle.OwnerID().Value() {
+		t.Errorf("Expected owner as actor, but got %s %s", entry.ActorType(), entry.ActorID())
+	}
+	if entry.Action() != domain.AuditCircleMemberAdded {
+		t.Errorf("Expected action %s, but got %s", domain.AuditCircleMemberAdded, entry.Action())
+	}
+	if entry.Target() != domain.CircleAuditTarget(circle.ID()) {
+		t.Errorf("Expected circle target, but got %+v", entry.Target())
+	}
+	if entry.RequestID() != "req-123" || !entry.OccurredAt().Equal(testAuditNow) {
+		t.Errorf("Expected request req-123 at %v, but got %s at %v", testAuditNow, entry.RequestID(), entry.OccurredAt())
+	}
+	memberIDs := []string{existingMember, newUser.ID().Value()}
+	if memberIDs[0] > memberIDs[1] {
+		memberIDs[0], memberIDs[1] = memberIDs[1], memberIDs[0]
+	}
+	want := []domain.AuditChange{{Field: "memberIds", Before: existingMember, After: memberIDs[0] + "," + memberIDs[1]}}
+	if !reflect.DeepEqual(entry.Changes(), want) {
+		t.Errorf("Expected changes %+v, but got %+v", want, entry.Changes())
+	}
+}
+
+func TestUpdateUserUseCase_Execute_RecordsChangedFieldsOnly(t *testing.T) {
+	// Arrange
+	userRepo := infrastructure.NewMemoryUserRepository()
+	auditRepo := infrastructure.NewMemoryAuditRepository()
+	user := saveNewUser(t, userRepo, "before")
+	useCase := NewUpdateUserUseCase(userRepo, domain.NewUserExistenceService(userRepo), domain.SystemClock{}, NewAuditLog(auditRepo, domain.NewFixedClock(testAuditNow)))
+	firstName, lastName := "after", "新規"
+
+	// Act
+	_, err := useCase.Execute(UpdateUserInput{
+		Actor:     userActor(t, user.ID().Value()),
+		UserID:    user.ID().Value(),
+		FirstName: &firstName,
+		LastName:  &lastName,
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	entries := findAuditEntries(t, auditRepo)
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 audit entry, but got %d", len(entries))
+	}
+	want := []domain.AuditChange{{Field: "firstName", Before: "before", After: "after"}}
+	if !reflect.DeepEqual(entries[0].Changes(), want) {
+		t.Errorf("Expected changes %+v, but got %+v", want, entries[0].Changes())
+	}
+}
+
+func TestCancelShipmentUseCase_Execute_RecordsServiceActor(t *testing.T) {
+	// Arrange
+	f := newAuthorizationTestFixture(t)
+	auditRepo := infrastructure.NewMemoryAuditRepository()
+	service := servicePrincipal(t, domain.PermissionShipmentsWrite)
+	useCase := NewCancelShipmentUseCase(f.shipmentRepo, f.clock, NewAuditLog(auditRepo, f.clock))
+
+	// Act
+	_, err := useCase.Execute(CancelShipmentInput{Actor: service, ShipmentID: f.shipmentID})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	entries := findAuditEntries(t, auditRepo)
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 audit entry, but got %d", len(entries))
+	}
+	if entries[0].ActorType() != domain.AuditActorService || entries[0].ActorID() != service.APIKeyID() {
+		t.Errorf("Expected API key %s as actor, but got %s %s", service.APIKeyID(), entries[0].ActorType(), entries[0].ActorID())
+	}
+	if entries[0].Target() != (domain.AuditTarget{Type: domain.AuditTargetShipment, ID: f.shipmentID}) {
+		t.Errorf("Expected shipment target, but got %+v", entries[0].Target())
+	}
+}
+
+func TestAuditLog_Record_SkipsFailedOperations(t *testing.T) {
+	tests := []struct {
+		name    string
+		execute func(f *authorizationTestFixture, auditLog *AuditLog) error
+	}{
+		{"認可されない操作", func(f *authorizationTestFixture, auditLog *AuditLog) error {
+			return NewAddMemberUseCase(f.circleRepo, f.userRepo, f.ledgerRepo, domain.NewCircleMemberService(nil), false, f.clock, auditLog).
+				Execute(AddMemberInput{Actor: userActor(t, f.member), CircleID: f.circleID, UserID: f.stranger})
+		}},
+		{"集約が拒否した操作", func(f *authorizationTestFixture, auditLog *AuditLog) error {
+			_, err := NewUpdateShipmentStatusUseCase(f.shipmentRepo, f.clock, auditLog).
+				Execute(UpdateShipmentStatusInput{Actor: adminActor(), ShipmentID: f.shipmentID, Status: "delivered"})
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			f := newAuthorizationTestFixture(t)
+			auditRepo := infrastructure.NewMemoryAuditRepository()
+
+			// Act
+			err := tt.execute(f, NewAuditLog(auditRepo, f.clock))
+
+			// Assert
+			if err == nil {
+				t.Fatal("Expected error, but got nil")
+			}
+			if entries := findAuditEntries(t, auditRepo); len(entries) != 0 {
+				t.Errorf("Expected no audit entries, but got %d", len(entries))
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"strings"
+	"time"
+)
+
+type AuthenticateInput struct {
+	Token string
+}
+
+type AuthenticateOutput struct {
+	UserID    string
+	Principal *domain.Principal
+	ExpiresAt time.Time
+}
+
+// AuthenticateUseCase はセッションのトークンまたはアクセストークン（JWT）から利用者を特定する
+// 期限切れのセッションは削除し、削除されたユーザーのセッションは無効とする
+// アクセストークンは署名と期限だけを確かめ、リポジトリを引かない
+// 設定で管理者に指定されたユーザーは管理者の主体になる
+type AuthenticateUseCase struct {
+	sessionRepository domain.SessionRepository
+	accessTokenCodec  domain.AccessTokenCodec
+	userRepository    domain.UserRepository
+	administrators    *domain.Administrators
+	clock             domain.Clock
+}
+
+func NewAuthenticateUseCase(
+	sessionRepository domain.SessionRepository,
+	accessTokenCodec domain.AccessTokenCodec,
+	userRepository domain.UserRepository,
+	administrators *domain.Administrators,
+	clock domain.Clock,
+) *AuthenticateUseCase {
+	return &AuthenticateUseCase{
+		sessionRepository: sessionRepository,
+		accessTokenCodec:  accessTokenCodec,
+		userRepository:    userRepository,
+		administrators:    administrators,
+		clock:             clock,
+	}
+}
+
+func (uc *AuthenticateUseCase) Execute(input AuthenticateInput) (*AuthenticateOutput, error) {
+	if input.Token == "" {
+		return nil, domain.UnauthenticatedError{Reason: "missing token"}
+	}
+	// セッションのトークンは "." を含まないため、JWT の形式かどうかで見分けられる
+	if strings.Count(input.Token, ".") == 2 {
+		return uc.authenticateAccessToken(input.Token)
+	}
+	return uc.authenticateSession(input.Token)
+}
+
+func (uc *AuthenticateUseCase) authenticateAccessToken(value string) (*AuthenticateOutput, error) {
+	token, err := uc.accessTokenCodec.Decode(value)
+	if err != nil {
+		return nil, err
+	}
+	if token.IsExpiredAt(uc.clock.Now()) {
+		return nil, domain.UnauthenticatedError{Reason: "access token expired"}
+	}
+
+	return uc.output(token.UserID(), token.ExpiresAt()), nil
+}
+
+func (uc *AuthenticateUseCase) authenticateSession(token string) (*AuthenticateOutput, error) {
+	session, err := uc.sessionRepository.FindByTokenHash(domain.HashSessionToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, domain.UnauthenticatedError{Reason: "unknown session"}
+	}
+	if session.IsExpiredAt(uc.clock.Now()) {
+		if err := uc.sessionRepository.Delete(session.ID()); err != nil {
+			return nil, err
+		}
+		return nil, domain.UnauthenticatedError{Reason: "session expired"}
+	}
+
+	user, err := uc.userRepository.FindByID(session.UserID())
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domain.UnauthenticatedError{Reason: "unknown session"}
+	}
+
+	return uc.output(user.ID(), session.ExpiresAt()), nil
+}
+
+func (uc *AuthenticateUseCase) output(userID *domain.UserID, expiresAt time.Time) *AuthenticateOutput {
+	return &AuthenticateOutput{
+		UserID:    userID.Value(),
+		Principal: domain.NewUserPrincipal(userID, uc.administrators.Contains(userID)),
+		ExpiresAt: expiresAt,
+	}
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type AuthenticateAPIKeyInput struct {
+	Key string
+}
+
+type AuthenticateAPIKeyOutput struct {
+	Principal *domain.Principal
+}
+
+// AuthenticateAPIKeyUseCase は API キーからサービスを特定する
+// prefix でキーを引き、secret のハッシュを照合する。最終利用日時も記録する
+type AuthenticateAPIKeyUseCase struct {
+	apiKeyRepository domain.APIKeyRepository
+	clock            domain.Clock
+}
+
+func NewAuthenticateAPIKeyUseCase(apiKeyRepository domain.APIKeyRepository, clock domain.Clock) *AuthenticateAPIKeyUseCase {
+	return &AuthenticateAPIKeyUseCase{
+		apiKeyRepository: apiKeyRepository,
+		clock:            clock,
+	}
+}
+
+func (uc *AuthenticateAPIKeyUseCase) Execute(input AuthenticateAPIKeyInput) (*AuthenticateAPIKeyOutput, error) {
+	prefix, secret, err := domain.ParseAPIKey(input.Key)
+	if err != nil {
+		return nil, err
+	}
+	key, err := uc.apiKeyRepository.FindByPrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, domain.UnauthenticatedError{Reason: "invalid API key"}
+	}
+
+	now := uc.clock.Now()
+	if err := key.Authenticate(secret, now); err != nil {
+		return nil, err
+	}
+	// 最終利用日時だけを書き込む（この間に失効したキーを Save で元に戻さない）
+	if key.RecordUse(now) {
+		if err := uc.apiKeyRepository.RecordUse(key.ID(), now); err != nil {
+			return nil, err
+		}
+	}
+
+	return &AuthenticateAPIKeyOutput{Principal: domain.NewServicePrincipal(key)}, nil
+}
@@ -0,0 +1,424 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"errors"
+	"testing"
+	"time"
+)
+
+// authorizationRole はユースケースを呼び出す主体の種類
+type authorizationRole string
+
+const (
+	roleAnonymous        authorizationRole = "匿名"
+	roleOwner            authorizationRole = "本人・オーナー"
+	roleMember           authorizationRole = "メンバー"
+	roleStranger         authorizationRole = "他のユーザー"
+	roleAdministrator    authorizationRole = "管理者"
+	rolePermittedService authorizationRole = "権限を持つサービス"
+	roleForbiddenService authorizationRole = "権限のないサービス"
+)
+
+const authorizationTestZone = "domestic"
+
+var authorizationRoles = []authorizationRole{
+	roleAnonymous, roleOwner, roleMember, roleStranger, roleAdministrator, rolePermittedService, roleForbiddenService,
+}
+
+// authorizationTestFixture はオーナーとメンバー1人のサークル、サークル外のユーザー、オーナー宛ての発送を用意する
+type authorizationTestFixture struct {
+	owner, member, stranger string
+	circleID                string
+	shipmentID              string
+	userRepo                domain.UserRepository
+	circleRepo              domain.CircleRepository
+	eventRepo               domain.CircleEventRepository
+	expenseRepo             domain.CircleExpenseRepository
+	webhookRepo             domain.WebhookRepository
+	shipmentRepo            domain.ShipmentRepository
+	ledgerRepo              domain.LedgerRepository
+	apiKeyRepo              domain.APIKeyRepository
+	clock                   *domain.FixedClock
+}
+
+func newAuthorizationTestFixture(t *testing.T) *authorizationTestFixture {
+	t.Helper()
+
+	f := &authorizationTestFixture{
+		userRepo:     infrastructure.NewMemoryUserRepository(),
+		circleRepo:   infrastructure.NewMemoryCircleRepository(),
+		eventRepo:    infrastructure.NewMemoryCircleEventRepository(),
+		expenseRepo:  infrastructure.NewMemoryCircleExpenseRepository(),
+		webhookRepo:  infrastructure.NewMemoryWebhookRepository(),
+		shipmentRepo: infrastructure.NewMemoryShipmentRepository(),
+		ledgerRepo:   infrastructure.NewMemoryLedgerRepository(),
+		apiKeyRepo:   infrastructure.NewMemoryAPIKeyRepository(),
+		clock:        domain.NewFixedClock(time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)),
+	}
+	circle := setupCircleWithMembers(t, f.userRepo, f.circleRepo, 1, 0)
+	f.owner = circle.OwnerID().Value()
+	f.member = circle.GetMemberIDs()[0].Value()
+	f.stranger = saveNewUser(t, f.userRepo, "stranger").ID().Value()
+	f.circleID = circle.ID().Value()
+
+	shipment, err := f.createShipment().Execute(CreateShipmentInput{
+		Actor:           adminActor(),
+		RecipientID:     f.owner,
+		DestinationZone: authorizationTestZone,
+		Baggage:         []BaggageInput{{Description: "タオル", WeightGrams: 200, LengthCm: 20, WidthCm: 20, HeightCm: 5}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create shipment: %v", err)
+	}
+	f.shipmentID = shipment.ShipmentID
+	return f
+}
+
+func (f *authorizationTestFixture) createShipment() *CreateShipmentUseCase {
+	return NewCreateShipmentUseCase(f.shipmentRepo, f.userRepo, domain.NewShippingFeeCalculator(domain.DefaultShippingRateTable()), f.clock, newTestAuditLog())
+}
+
+func (f *authorizationTestFixture) actor(t *testing.T, role authorizationRole, permission domain.Permission) *domain.Principal {
+	t.Helper()
+
+	switch role {
+	case roleOwner:
+		return userActor(t, f.owner)
+	case roleMember:
+		return userActor(t, f.member)
+	case roleStranger:
+		return userActor(t, f.stranger)
+	case roleAdministrator:
+		return adminActor()
+	case rolePermittedService:
+		if permission == "" {
+			return servicePrincipal(t, domain.AllPermissions()...)
+		}
+		return servicePrincipal(t, permission)
+	case roleForbiddenService:
+		// 必要な権限以外をすべて持つサービス
+		var others []domain.Permission
+		for _, granted := range domain.AllPermissions() {
+			if granted != permission {
+				others = append(others, granted)
+			}
+		}
+		return servicePrincipal(t, others...)
+	}
+	return nil
+}
+
+func TestUseCases_Authorization(t *testing.T) {
+	var (
+		everyone   = []authorizationRole{roleAnonymous, roleOwner, roleMember, roleStranger, roleAdministrator, rolePermittedService}
+		self       = []authorizationRole{roleOwner, roleAdministrator, rolePermittedService}
+		privileged = []authorizationRole{roleAdministrator, rolePermittedService}
+		members    = []authorizationRole{roleOwner, roleMember, roleAdministrator, rolePermittedService}
+	)
+
+	tests := []struct {
+		name       string
+		permission domain.Permission   // 権限を持つサービスに与える権限
+		allowed    []authorizationRole // 許可される主体（それ以外は匿名なら 401、それ以外は 403）
+		execute    func(f *authorizationTestFixture, actor *domain.Principal) error
+	}{
+		{"ユーザー登録", domain.PermissionUsersWrite, everyone, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewCreateUserUseCase(f.userRepo, domain.NewUserExistenceService(f.userRepo), infrastructure.NewMemoryCredentialRepository(), newTestPasswordHasher(), f.clock, newTestAuditLog()).
+				Execute(CreateUserInput{Actor: actor, FirstName: "花子", LastName: "登録", Email: "hanako@example.com"})
+			return err
+		}},
+		{"ユーザー取得", domain.PermissionUsersRead, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewGetUserUseCase(f.userRepo, domain.SystemClock{}).Execute(GetUserInput{Actor: actor, UserID: f.owner})
+			return err
+		}},
+		{"ユーザー更新", domain.PermissionUsersWrite, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			email := "renamed@example.com"
+			_, err := NewUpdateUserUseCase(f.userRepo, domain.NewUserExistenceService(f.userRepo), domain.SystemClock{}, newTestAuditLog()).
+				Execute(UpdateUserInput{Actor: actor, UserID: f.owner, Email: &email})
+			return err
+		}},
+		{"ユーザー削除", domain.PermissionUsersWrite, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			return NewDeleteUserUseCase(f.userRepo, f.circleRepo, infrastructure.NewMemorySessionRepository(), infrastructure.NewMemoryRefreshTokenRepository(), f.clock, newTestAuditLog()).
+				Execute(DeleteUserInput{Actor: actor, UserID: f.owner})
+		}},
+		{"ユーザー復元", domain.PermissionUsersWrite, privileged, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			if err := NewDeleteUserUseCase(f.userRepo, f.circleRepo, infrastructure.NewMemorySessionRepository(), infrastructure.NewMemoryRefreshTokenRepository(), f.clock, newTestAuditLog()).
+				Execute(DeleteUserInput{Actor: adminActor(), UserID: f.owner}); err != nil {
+				t.Fatalf("Failed to delete user: %v", err)
+			}
+			_, err := NewRestoreUserUseCase(f.userRepo, f.circleRepo, domain.NewUserExistenceService(f.userRepo), domain.NewCircleMemberService(nil), domain.DefaultDeletionPolicy(), f.clock, newTestAuditLog()).
+				Execute(RestoreUserInput{Actor: actor, UserID: f.owner})
+			return err
+		}},
+		{"サブスクリプション取得", domain.PermissionUsersRead, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewGetSubscriptionUseCase(f.userRepo, domain.SystemClock{}).Execute(GetSubscriptionInput{Actor: actor, UserID: f.owner})
+			return err
+		}},
+		{"アップグレード", domain.PermissionUsersWrite, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewUpgradeSubscriptionUseCase(f.userRepo, f.ledgerRepo, domain.DefaultPlanPriceList(), f.clock, newTestAuditLog()).
+				Execute(UpgradeSubscriptionInput{Actor: actor, UserID: f.owner, Plan: "premium_monthly"})
+			return err
+		}},
+		{"ダウングレード", domain.PermissionUsersWrite, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewDowngradeSubscriptionUseCase(f.userRepo, f.clock, newTestAuditLog()).Execute(DowngradeSubscriptionInput{Actor: actor, UserID: f.owner, Plan: "free"})
+			return err
+		}},
+		{"解約", domain.PermissionUsersWrite, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewCancelSubscriptionUseCase(f.userRepo, f.clock, newTestAuditLog()).Execute(CancelSubscriptionInput{Actor: actor, UserID: f.owner})
+			return err
+		}},
+		{"無償のプレミアム付与", domain.PermissionBillingWrite, privileged, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewGrantComplimentarySubscriptionUseCase(f.userRepo, f.clock, newTestAuditLog()).Execute(GrantComplimentarySubscriptionInput{Actor: actor, UserID: f.owner})
+			return err
+		}},
+		{"台帳取得", domain.PermissionUsersRead, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewGetLedgerUseCase(f.userRepo, f.ledgerRepo, nil).Execute(GetLedgerInput{Actor: actor, UserID: f.owner})
+			return err
+		}},
+		{"入金の記録", domain.PermissionBillingWrite, privileged, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewRecordPaymentUseCase(f.userRepo, f.ledgerRepo, f.clock, newTestAuditLog()).
+				Execute(RecordPaymentInput{Actor: actor, UserID: f.owner, Amount: 500, Currency: "JPY"})
+			return err
+		}},
+		{"返金の記録", domain.PermissionBillingWrite, privileged, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewRecordRefundUseCase(f.userRepo, f.ledgerRepo, f.clock, newTestAuditLog()).
+				Execute(RecordRefundInput{Actor: actor, UserID: f.owner, Amount: 500, Currency: "JPY"})
+			return err
+		}},
+		{"決済の返金", domain.PermissionBillingWrite, privileged, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			gateway := infrastructure.NewFakePaymentGateway("test-secret", f.clock)
+			_, err := NewRefundPaymentUseCase(f.userRepo, f.ledgerRepo, gateway, f.clock, newTestAuditLog()).
+				Execute(RefundPaymentInput{Actor: actor, UserID: f.owner, ChargeID: "ch_unknown", Amount: 500, Currency: "JPY", IdempotencyKey: "refund-1"})
+			return err
+		}},
+		{"残高の支払い", domain.PermissionUsersWrite, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			gateway := infrastructure.NewFakePaymentGateway("test-secret", f.clock)
+			_, err := NewPayBalanceUseCase(f.userRepo, f.ledgerRepo, gateway, f.clock, newTestAuditLog()).
+				Execute(PayBalanceInput{Actor: actor, UserID: f.owner, IdempotencyKey: "pay-1"})
+			return err
+		}},
+		{"通知設定の取得", domain.PermissionUsersRead, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewGetNotificationSettingsUseCase(f.userRepo, infrastructure.NewMemoryNotificationPreferencesRepository(), domain.LocaleJapanese, f.clock).
+				Execute(GetNotificationSettingsInput{Actor: actor, UserID: f.owner})
+			return err
+		}},
+		{"通知設定の更新", domain.PermissionUsersWrite, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			locale := "en"
+			_, err := NewUpdateNotificationSettingsUseCase(f.userRepo, infrastructure.NewMemoryNotificationPreferencesRepository(), domain.LocaleJapanese, f.clock, newTestAuditLog()).
+				Execute(UpdateNotificationSettingsInput{Actor: actor, UserID: f.owner, Locale: &locale})
+			return err
+		}},
+		{"ユーザーのカレンダー", domain.PermissionUsersRead, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewExportUserCalendarUseCase(f.userRepo, f.circleRepo, f.eventRepo, infrastructure.NewMemoryCalendarFeedTokenRepository(), f.clock).
+				Execute(ExportUserCalendarInput{Actor: actor, UserID: f.owner})
+			return err
+		}},
+		{"カレンダーのフィードトークンの発行", domain.PermissionUsersWrite, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewIssueCalendarFeedTokenUseCase(f.userRepo, infrastructure.NewMemoryCalendarFeedTokenRepository(), f.clock, newTestAuditLog()).
+				Execute(IssueCalendarFeedTokenInput{Actor: actor, UserID: f.owner})
+			return err
+		}},
+		{"発送一覧", domain.PermissionShipmentsRead, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewListUserShipmentsUseCase(f.shipmentRepo, f.userRepo).Execute(ListUserShipmentsInput{Actor: actor, UserID: f.owner})
+			return err
+		}},
+		{"発送取得", domain.PermissionShipmentsRead, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewGetShipmentUseCase(f.shipmentRepo).Execute(GetShipmentInput{Actor: actor, ShipmentID: f.shipmentID})
+			return err
+		}},
+		{"送料の見積もり", domain.PermissionShipmentsRead, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewQuoteShippingFeeUseCase(f.userRepo, domain.NewShippingFeeCalculator(domain.DefaultShippingRateTable()), domain.SystemClock{}).
+				Execute(QuoteShippingFeeInput{
+					Actor: actor, RecipientID: f.owner, DestinationZone: authorizationTestZone,
+					Baggage: []BaggageInput{{Description: "タオル", WeightGrams: 200, LengthCm: 20, WidthCm: 20, HeightCm: 5}},
+				})
+			return err
+		}},
+		{"発送の作成", domain.PermissionShipmentsWrite, privileged, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := f.createShipment().Execute(CreateShipmentInput{
+				Actor: actor, RecipientID: f.owner, DestinationZone: authorizationTestZone,
+				Baggage: []BaggageInput{{Description: "タオル", WeightGrams: 200, LengthCm: 20, WidthCm: 20, HeightCm: 5}},
+			})
+			return err
+		}},
+		{"発送状況の更新", domain.PermissionShipmentsWrite, privileged, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewUpdateShipmentStatusUseCase(f.shipmentRepo, f.clock, newTestAuditLog()).
+				Execute(UpdateShipmentStatusInput{Actor: actor, ShipmentID: f.shipmentID, Status: "shipped", TrackingNumber: "TRK-1"})
+			return err
+		}},
+		{"発送の取り消し", domain.PermissionShipmentsWrite, privileged, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewCancelShipmentUseCase(f.shipmentRepo, f.clock, newTestAuditLog()).Execute(CancelShipmentInput{Actor: actor, ShipmentID: f.shipmentID})
+			return err
+		}},
+		{"サークル作成", domain.PermissionCirclesWrite, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewCreateCircleUseCase(f.circleRepo, f.userRepo, domain.NewCircleExistenceService(f.circleRepo), newTestAuditLog()).
+				Execute(CreateCircleInput{Actor: actor, CircleName: "新しいサークル", OwnerID: f.owner})
+			return err
+		}},
+		{"サークル取得", domain.PermissionCirclesRead, everyone, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewGetCircleUseCase(f.circleRepo, f.userRepo, domain.NewCircleMemberService(nil), domain.SystemClock{}).Execute(GetCircleInput{Actor: actor, CircleID: f.circleID})
+			return err
+		}},
+		{"おすすめサークル", domain.PermissionCirclesRead, everyone, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewGetRecommendedCirclesUseCase(f.circleRepo).Execute(GetRecommendedCirclesInput{Actor: actor})
+			return err
+		}},
+		{"メンバーの追加", domain.PermissionCirclesWrite, []authorizationRole{roleOwner, roleStranger, roleAdministrator, rolePermittedService}, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			return NewAddMemberUseCase(f.circleRepo, f.userRepo, f.ledgerRepo, domain.NewCircleMemberService(nil), false, f.clock, newTestAuditLog()).
+				Execute(AddMemberInput{Actor: actor, CircleID: f.circleID, UserID: f.stranger})
+		}},
+		{"メンバーの脱退・除名", domain.PermissionCirclesWrite, members, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			return NewRemoveMemberUseCase(f.circleRepo, f.clock, newTestAuditLog()).Execute(RemoveMemberInput{Actor: actor, CircleID: f.circleID, UserID: f.member})
+		}},
+		{"会費の変更", domain.PermissionCirclesWrite, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			return NewChangeCircleDuesUseCase(f.circleRepo, newTestAuditLog()).Execute(ChangeCircleDuesInput{Actor: actor, CircleID: f.circleID, Amount: 1000, Currency: "JPY"})
+		}},
+		{"サークル削除", domain.PermissionCirclesWrite, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			return NewDeleteCircleUseCase(f.circleRepo, f.clock, newTestAuditLog()).Execute(DeleteCircleInput{Actor: actor, CircleID: f.circleID})
+		}},
+		{"サークル復元", domain.PermissionCirclesWrite, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			if err := NewDeleteCircleUseCase(f.circleRepo, f.clock, newTestAuditLog()).Execute(DeleteCircleInput{Actor: adminActor(), CircleID: f.circleID}); err != nil {
+				t.Fatalf("Failed to delete circle: %v", err)
+			}
+			return NewRestoreCircleUseCase(f.circleRepo, f.userRepo, domain.DefaultDeletionPolicy(), f.clock, newTestAuditLog()).
+				Execute(RestoreCircleInput{Actor: actor, CircleID: f.circleID})
+		}},
+		{"イベント作成", domain.PermissionCirclesWrite, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			startsAt := f.clock.Now().Add(24 * time.Hour)
+			_, err := NewCreateCircleEventUseCase(f.circleRepo, f.eventRepo, f.clock, newTestAuditLog()).Execute(CreateCircleEventInput{
+				Actor: actor, CircleID: f.circleID, Title: "練習会", StartsAt: startsAt, EndsAt: startsAt.Add(2 * time.Hour),
+			})
+			return err
+		}},
+		{"イベント更新", domain.PermissionCirclesWrite, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			startsAt := f.clock.Now().Add(24 * time.Hour)
+			_, err := NewUpdateCircleEventUseCase(f.circleRepo, f.eventRepo, f.clock, newTestAuditLog()).Execute(UpdateCircleEventInput{
+				Actor: actor, CircleID: f.circleID, EventID: domain.NewCircleEventID().Value(), Title: "練習会", StartsAt: startsAt, EndsAt: startsAt.Add(2 * time.Hour),
+			})
+			return err
+		}},
+		{"イベント取り消し", domain.PermissionCirclesWrite, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewCancelCircleEventUseCase(f.circleRepo, f.eventRepo, f.clock, newTestAuditLog()).
+				Execute(CancelCircleEventInput{Actor: actor, CircleID: f.circleID, EventID: domain.NewCircleEventID().Value()})
+			return err
+		}},
+		{"イベント取得", domain.PermissionCirclesRead, everyone, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewGetCircleEventUseCase(f.circleRepo, f.eventRepo).
+				Execute(GetCircleEventInput{Actor: actor, CircleID: f.circleID, EventID: domain.NewCircleEventID().Value()})
+			return err
+		}},
+		{"イベント一覧", domain.PermissionCirclesRead, everyone, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewListCircleEventsUseCase(f.circleRepo, f.eventRepo, f.clock).Execute(ListCircleEventsInput{Actor: actor, CircleID: f.circleID})
+			return err
+		}},
+		{"サークルのカレンダー", domain.PermissionCirclesRead, everyone, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewExportCircleCalendarUseCase(f.circleRepo, f.eventRepo, f.clock).Execute(ExportCircleCalendarInput{Actor: actor, CircleID: f.circleID})
+			return err
+		}},
+		{"出欠の回答", domain.PermissionCirclesWrite, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewRespondCircleEventRSVPUseCase(f.circleRepo, f.eventRepo, f.clock, newTestAuditLog()).Execute(RespondCircleEventRSVPInput{
+				Actor: actor, CircleID: f.circleID, EventID: domain.NewCircleEventID().Value(), UserID: f.owner, Status: "going",
+			})
+			return err
+		}},
+		{"立て替えの記録", domain.PermissionCirclesWrite, []authorizationRole{roleMember, roleAdministrator, rolePermittedService}, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			// メンバーが立て替えた分（オーナーでも他の人の分は記録できない）
+			_, err := NewRecordCircleExpenseUseCase(f.circleRepo, f.expenseRepo, f.clock, newTestAuditLog()).Execute(RecordCircleExpenseInput{
+				Actor: actor, CircleID: f.circleID, PayerID: f.member, Amount: 1000, Currency: "JPY", Description: "会場費", SplitMethod: "equal",
+				Participants: []ExpenseParticipantInput{{UserID: f.owner}, {UserID: f.member}},
+			})
+			return err
+		}},
+		{"立て替え一覧", domain.PermissionCirclesRead, members, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewListCircleExpensesUseCase(f.circleRepo, f.expenseRepo).Execute(ListCircleExpensesInput{Actor: actor, CircleID: f.circleID})
+			return err
+		}},
+		{"精算", domain.PermissionCirclesRead, members, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewGetCircleSettlementUseCase(f.circleRepo, f.expenseRepo, domain.NewSettlementService()).
+				Execute(GetCircleSettlementInput{Actor: actor, CircleID: f.circleID})
+			return err
+		}},
+		{"Webhook 登録", domain.PermissionCirclesWrite, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewRegisterCircleWebhookUseCase(f.circleRepo, f.webhookRepo, f.clock, newTestAuditLog()).Execute(RegisterCircleWebhookInput{
+				Actor: actor, CircleID: f.circleID, URL: "https://example.com/hooks", Events: []string{"circle.member_joined"},
+			})
+			return err
+		}},
+		{"Webhook 一覧", domain.PermissionCirclesRead, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewListCircleWebhooksUseCase(f.circleRepo, f.webhookRepo).Execute(ListCircleWebhooksInput{Actor: actor, CircleID: f.circleID})
+			return err
+		}},
+		{"Webhook 配信履歴", domain.PermissionCirclesRead, self, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewListWebhookDeliveriesUseCase(f.circleRepo, f.webhookRepo, infrastructure.NewMemoryWebhookDeliveryRepository()).
+				Execute(ListWebhookDeliveriesInput{Actor: actor, CircleID: f.circleID, WebhookID: domain.NewWebhookID().Value()})
+			return err
+		}},
+		{"API キー発行", domain.PermissionAPIKeysManage, privileged, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewCreateAPIKeyUseCase(f.apiKeyRepo, f.clock, newTestAuditLog()).
+				Execute(CreateAPIKeyInput{Actor: actor, Name: "batch", Permissions: []string{string(domain.PermissionAPIKeysManage)}})
+			return err
+		}},
+		{"API キー一覧", domain.PermissionAPIKeysManage, privileged, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewListAPIKeysUseCase(f.apiKeyRepo).Execute(ListAPIKeysInput{Actor: actor})
+			return err
+		}},
+		{"API キー失効", domain.PermissionAPIKeysManage, privileged, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewRevokeAPIKeyUseCase(f.apiKeyRepo, f.clock, newTestAuditLog()).Execute(RevokeAPIKeyInput{Actor: actor, APIKeyID: "unknown"})
+			return err
+		}},
+		{"監査ログの検索", domain.PermissionAuditRead, privileged, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := NewListAuditEntriesUseCase(infrastructure.NewMemoryAuditRepository()).Execute(ListAuditEntriesInput{Actor: actor})
+			return err
+		}},
+		{"外部のアカウントの紐付け", "", []authorizationRole{roleAnonymous, roleOwner, roleMember, roleStranger, roleAdministrator}, func(f *authorizationTestFixture, actor *domain.Principal) error {
+			_, err := newOIDCTestFixture(t).start.Execute(StartOIDCLoginInput{Actor: actor})
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		for _, role := range authorizationRoles {
+			t.Run(tt.name+"/"+string(role), func(t *testing.T) {
+				// Arrange
+				f := newAuthorizationTestFixture(t)
+				actor := f.actor(t, role, tt.permission)
+
+				// Act
+				err := tt.execute(f, actor)
+
+				// Assert
+				// 許可された場合は、対象が存在しないなどの業務上のエラーは問わない
+				want := expectedAuthorizationError(role, tt.allowed)
+				if want == nil {
+					if isAuthorizationError(err) {
+						t.Errorf("Expected to be authorized, but got: %v", err)
+					}
+					return
+				}
+				assertAuthorizationError(t, err, want)
+			})
+		}
+	}
+}
+
+// expectedAuthorizationError は許可されない主体に期待するエラーを返す
+func expectedAuthorizationError(role authorizationRole, allowed []authorizationRole) error {
+	for _, permitted := range allowed {
+		if role == permitted {
+			return nil
+		}
+	}
+	if role == roleAnonymous {
+		return domain.UnauthenticatedError{}
+	}
+	return domain.ForbiddenError{}
+}
+
+// isAuthorizationError は認証・認可で拒否されたエラーかを返す
+func isAuthorizationError(err error) bool {
+	var unauthenticated domain.UnauthenticatedError
+	var forbidden domain.ForbiddenError
+	return errors.As(err, &unauthenticated) || errors.As(err, &forbidden)
+}
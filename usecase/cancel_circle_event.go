@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type CancelCircleEventInput struct {
+	Actor     *domain.Principal // オーナーか circles:write が必要
+	RequestID string            // 監査ログに記録するリクエストID
+	CircleID  string
+	EventID   string
+}
+
+type CancelCircleEventUseCase struct {
+	circleRepository domain.CircleRepository
+	eventRepository  domain.CircleEventRepository
+	clock            domain.Clock
+	auditLog         *AuditLog
+}
+
+func NewCancelCircleEventUseCase(
+	circleRepository domain.CircleRepository,
+	eventRepository domain.CircleEventRepository,
+	clock domain.Clock,
+	auditLog *AuditLog,
+) *CancelCircleEventUseCase {
+	return &CancelCircleEventUseCase{
+		circleRepository: circleRepository,
+		eventRepository:  eventRepository,
+		clock:            clock,
+		auditLog:         auditLog,
+	}
+}
+
+func (uc *CancelCircleEventUseCase) Execute(input CancelCircleEventInput) (*CircleEventOutput, error) {
+	circle, err := findCircle(uc.circleRepository, input.CircleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeCircle(input.Actor, domain.ActionManageCircle, circle); err != nil {
+		return nil, err
+	}
+	event, err := findCircleEvent(uc.eventRepository, circle, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := event.Cancel(uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.eventRepository.Save(event); err != nil {
+		return nil, err
+	}
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditCircleEventCancelled, domain.AuditTarget{Type: domain.AuditTargetCircleEvent, ID: event.ID().Value()}, nil); err != nil {
+		return nil, err
+	}
+
+	return NewCircleEventOutput(event), nil
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type CancelShipmentInput struct {
+	Actor      *domain.Principal // shipments:write が必要
+	RequestID  string            // 監査ログに記録するリクエストID
+	ShipmentID string
+}
+
+type CancelShipmentUseCase struct {
+	shipmentRepository domain.ShipmentRepository
+	clock              domain.Clock
+	auditLog           *AuditLog
+}
+
+func NewCancelShipmentUseCase(shipmentRepository domain.ShipmentRepository, clock domain.Clock, auditLog *AuditLog) *CancelShipmentUseCase {
+	return &CancelShipmentUseCase{
+		shipmentRepository: shipmentRepository,
+		clock:              clock,
+		auditLog:           auditLog,
+	}
+}
+
+func (uc *CancelShipmentUseCase) Execute(input CancelShipmentInput) (*ShipmentOutput, error) {
+	if err := domain.Authorize(input.Actor, domain.ActionManageShipment, domain.Resource{}); err != nil {
+		return nil, err
+	}
+
+	shipment, err := findShipment(uc.shipmentRepository, input.ShipmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := shipment.Cancel(uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.shipmentRepository.Save(shipment); err != nil {
+		return nil, err
+	}
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditShipmentCancelled, domain.AuditTarget{Type: domain.AuditTargetShipment, ID: shipment.ID().Value()}, nil); err != nil {
+		return nil, err
+	}
+
+	return NewShipmentOutput(shipment), nil
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type CancelSubscriptionInput struct {
+	Actor     *domain.Principal // 本人か users:write が必要
+	RequestID string            // 監査ログに記録するリクエストID
+	UserID    string
+}
+
+type CancelSubscriptionUseCase struct {
+	userRepository domain.UserRepository
+	clock          domain.Clock
+	auditLog       *AuditLog
+}
+
+func NewCancelSubscriptionUseCase(userRepository domain.UserRepository, clock domain.Clock, auditLog *AuditLog) *CancelSubscriptionUseCase {
+	return &CancelSubscriptionUseCase{
+		userRepository: userRepository,
+		clock:          clock,
+		auditLog:       auditLog,
+	}
+}
+
+func (uc *CancelSubscriptionUseCase) Execute(input CancelSubscriptionInput) (*SubscriptionOutput, error) {
+	if _, err := authorizeUser(input.Actor, domain.ActionUpdateUser, input.UserID); err != nil {
+		return nil, err
+	}
+
+	user, err := findUser(uc.userRepository, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := domain.UserAuditSnapshot(user)
+	if err := user.CancelSubscription(uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.userRepository.Save(user); err != nil {
+		return nil, err
+	}
+
+	changes := domain.DiffAuditSnapshots(before, domain.UserAuditSnapshot(user))
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditSubscriptionCancelled, domain.UserAuditTarget(user.ID()), changes); err != nil {
+		return nil, err
+	}
+
+	return NewSubscriptionOutput(user, uc.clock.Now()), nil
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type ChangeCircleDuesInput struct {
+	Actor     *domain.Principal // オーナーか circles:write が必要
+	RequestID string            // 監査ログに記録するリクエストID
+	CircleID  string
+	Amount    int64
+	Currency  string // 空文字の場合は会費なしに変更
+}
+
+type ChangeCircleDuesUseCase struct {
+	circleRepository domain.CircleRepository
+	auditLog         *AuditLog
+}
+
+func NewChangeCircleDuesUseCase(circleRepository domain.CircleRepository, auditLog *AuditLog) *ChangeCircleDuesUseCase {
+	return &ChangeCircleDuesUseCase{
+		circleRepository: circleRepository,
+		auditLog:         auditLog,
+	}
+}
+
+func (uc *ChangeCircleDuesUseCase) Execute(input ChangeCircleDuesInput) error {
+	circleID, err := domain.ReconstructCircleID(input.CircleID)
+	if err != nil {
+		return err
+	}
+
+	var dues *domain.Money
+	if input.Currency != "" {
+		dues, err = domain.NewMoney(input.Amount, input.Currency)
+		if err != nil {
+			return err
+		}
+	}
+
+	circle, err := uc.circleRepository.FindByID(circleID)
+	if err != nil {
+		return err
+	}
+	if circle == nil {
+		return domain.CircleNotFoundError{ID: input.CircleID}
+	}
+	if err := authorizeCircle(input.Actor, domain.ActionManageCircle, circle); err != nil {
+		return err
+	}
+
+	before := domain.CircleAuditSnapshot(circle)
+	if err := circle.ChangeMembershipDues(dues); err != nil {
+		return err
+	}
+
+	if err := uc.circleRepository.Save(circle); err != nil {
+		return err
+	}
+
+	changes := domain.DiffAuditSnapshots(before, domain.CircleAuditSnapshot(circle))
+	return uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditCircleDuesChanged, domain.CircleAuditTarget(circle.ID()), changes)
+}
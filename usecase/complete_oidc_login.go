@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+type CompleteOIDCLoginInput struct {
+	State string
+	Code  string
+	Error string // プロバイダーが返した error パラメーター
+}
+
+type CompleteOIDCLoginOutput struct {
+	Token       string
+	UserID      string
+	ExpiresAt   time.Time
+	UserCreated bool // 初回のログインでユーザーを作成した場合
+}
+
+// CompleteOIDCLoginUseCase はコールバックの認可コードを ID トークンと交換し、セッションを発行する
+// 外部のアカウントは次の順でユーザーに結び付ける
+//   - ログイン中に始めた場合は、そのユーザーに紐付ける
+//   - 紐付け済みであれば、そのユーザーとしてログインする
+//   - 同じメールアドレスのユーザーがいれば、双方で確認済みの場合に限り紐付ける（乗っ取りを防ぐ）
+//   - いなければ CreateUserUseCase でユーザーを作成して紐付ける
+type CompleteOIDCLoginUseCase struct {
+	loginRequestRepository     domain.OIDCLoginRequestRepository
+	externalIdentityRepository domain.ExternalIdentityRepository
+	userRepository             domain.UserRepository
+	sessionRepository          domain.SessionRepository
+	provider                   domain.OIDCProvider
+	createUserUseCase          *CreateUserUseCase
+	sessionTTL                 time.Duration
+	clock                      domain.Clock
+}
+
+func NewCompleteOIDCLoginUseCase(
+	loginRequestRepository domain.OIDCLoginRequestRepository,
+	externalIdentityRepository domain.ExternalIdentityRepository,
+	userRepository domain.UserRepository,
+	sessionRepository domain.SessionRepository,
+	provider domain.OIDCProvider,
+	createUserUseCase *CreateUserUseCase,
+	sessionTTL time.Duration,
+	clock domain.Clock,
+) *CompleteOIDCLoginUseCase {
+	return &CompleteOIDCLoginUseCase{
+		loginRequestRepository:     loginRequestRepository,
+		externalIdentityRepository: externalIdentityRepository,
+		userRepository:             userRepository,
+		sessionRepository:          sessionRepository,
+		provider:                   provider,
+		createUserUseCase:          createUserUseCase,
+		sessionTTL:                 sessionTTL,
+		clock:                      clock,
+	}
+}
+
+func (uc *CompleteOIDCLoginUseCase) Execute(input CompleteOIDCLoginInput) (*CompleteOIDCLoginOutput, error) {
+	request, err := uc.loginRequestRepository.FindByState(input.State)
+	if err != nil {
+		return nil, err
+	}
+	if request == nil {
+		return nil, domain.InvalidOIDCLoginError{Reason: "unknown state"}
+	}
+	// 成否にかかわらず一度しか使わせない
+	if err := uc.loginRequestRepository.Delete(request.State()); err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+	if request.IsExpiredAt(now) {
+		return nil, domain.InvalidOIDCLoginError{Reason: "login request has expired"}
+	}
+	if input.Error != "" {
+		return nil, domain.InvalidOIDCLoginError{Reason: "provider returned " + input.Error}
+	}
+	claims, err := uc.provider.ExchangeCode(input.Code, request.CodeVerifier())
+	if err != nil {
+		return nil, err
+	}
+	if err := claims.Validate(request, now); err != nil {
+		return nil, err
+	}
+
+	userID, created, err := uc.resolveUser(request, claims, now)
+	if err != nil {
+		return nil, err
+	}
+
+	session, token, err := domain.NewSession(userID, now, uc.sessionTTL)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.sessionRepository.Save(session); err != nil {
+		return nil, err
+	}
+
+	return &CompleteOIDCLoginOutput{
+		Token:       token,
+		UserID:      userID.Value(),
+		ExpiresAt:   session.ExpiresAt(),
+		UserCreated: created,
+	}, nil
+}
+
+// resolveUser は外部のアカウントに対応するユーザーを求め、必要に応じて紐付ける
+func (uc *CompleteOIDCLoginUseCase) resolveUser(request *domain.OIDCLoginRequest, claims *domain.IDTokenClaims, now time.Time) (*domain.UserID, bool, error) {
+	linkedUser, err := uc.findLinkedUser(claims)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if request.LinkUserID() != nil {
+		if linkedUser != nil && !linkedUser.ID().Equals(request.LinkUserID()) {
+			return nil, false, domain.ExternalIdentityConflictError{Reason: "already linked to another user"}
+		}
+		user, err := findUser(uc.userRepository, request.LinkUserID().Value())
+		if err != nil {
+			return nil, false, err
+		}
+		return user.ID(), false, uc.link(claims, user.ID(), now)
+	}
+
+	if linkedUser != nil {
+		return linkedUser.ID(), false, nil
+	}
+
+	if email, err := domain.NewEmail(claims.Email); err == nil {
+		existing, err := uc.userRepository.FindByEmail(email)
+		if err != nil {
+			return nil, false, err
+		}
+		if existing != nil {
+			if !claims.EmailVerified || !existing.IsEmailVerified() {
+				return nil, false, domain.ExternalIdentityConflictError{
+					Reason: "a user with this email already exists; log in and link the account instead",
+				}
+			}
+			return existing.ID(), false, uc.link(claims, existing.ID(), now)
+		}
+	}
+
+	output, err := uc.createUserUseCase.Execute(CreateUserInput{
+		FirstName: claims.GivenName,
+		LastName:  claims.FamilyName,
+		Email:     claims.Email,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	userID, err := domain.ReconstructUserID(output.UserID)
+	if err != nil {
+		return nil, false, err
+	}
+	return userID, true, uc.link(claims, userID, now)
+}
+
+// findLinkedUser は紐付け先のユーザーを返す。紐付け先が削除されていれば紐付けがないものとして扱う
+func (uc *CompleteOIDCLoginUseCase) findLinkedUser(claims *domain.IDTokenClaims) (*domain.User, error) {
+	identity, err := uc.externalIdentityRepository.FindBySubject(claims.Issuer, claims.Subject)
+	if err != nil || identity == nil {
+		return nil, err
+	}
+	return uc.userRepository.FindByID(identity.UserID())
+}
+
+func (uc *CompleteOIDCLoginUseCase) link(claims *domain.IDTokenClaims, userID *domain.UserID, now time.Time) error {
+	identity, err := domain.NewExternalIdentity(claims.Issuer, claims.Subject, userID, now)
+	if err != nil {
+		return err
+	}
+	return uc.externalIdentityRepository.Save(identity)
+}
@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+type CreateAPIKeyInput struct {
+	Actor       *domain.Principal // api_keys:manage が必要
+	RequestID   string            // 監査ログに記録するリクエストID
+	Name        string
+	Permissions []string
+	ExpiresAt   *time.Time // 省略した場合は期限なし
+}
+
+type APIKeyOutput struct {
+	ID          string
+	Name        string
+	Prefix      string
+	Permissions []string
+	CreatedBy   string
+	CreatedAt   time.Time
+	ExpiresAt   *time.Time
+	LastUsedAt  *time.Time
+	RevokedAt   *time.Time
+}
+
+func NewAPIKeyOutput(key *domain.APIKey) *APIKeyOutput {
+	permissions := make([]string, len(key.Permissions()))
+	for i, permission := range key.Permissions() {
+		permissions[i] = string(permission)
+	}
+	return &APIKeyOutput{
+		ID:          key.ID(),
+		Name:        key.Name(),
+		Prefix:      key.Prefix(),
+		Permissions: permissions,
+		CreatedBy:   key.CreatedBy(),
+		CreatedAt:   key.CreatedAt(),
+		ExpiresAt:   key.ExpiresAt(),
+		LastUsedAt:  key.LastUsedAt(),
+		RevokedAt:   key.RevokedAt(),
+	}
+}
+
+type CreateAPIKeyOutput struct {
+	APIKeyOutput
+	Key string // 発行時にのみ返す
+}
+
+// CreateAPIKeyUseCase は API キーを発行する
+// 自分の持たない権限は付与できない（管理者はすべての権限を持つ）
+type CreateAPIKeyUseCase struct {
+	apiKeyRepository domain.APIKeyRepository
+	clock            domain.Clock
+	auditLog         *AuditLog
+}
+
+func NewCreateAPIKeyUseCase(apiKeyRepository domain.APIKeyRepository, clock domain.Clock, auditLog *AuditLog) *CreateAPIKeyUseCase {
+	return &CreateAPIKeyUseCase{
+		apiKeyRepository: apiKeyRepository,
+		clock:            clock,
+		auditLog:         auditLog,
+	}
+}
+
+func (uc *CreateAPIKeyUseCase) Execute(input CreateAPIKeyInput) (*CreateAPIKeyOutput, error) {
+	if err := domain.Authorize(input.Actor, domain.ActionManageAPIKeys, domain.Resource{}); err != nil {
+		return nil, err
+	}
+
+	permissions, err := domain.ParsePermissions(input.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	for _, permission := range permissions {
+		if !input.Actor.HasPermission(permission) {
+			return nil, domain.ForbiddenError{Reason: "cannot grant permission " + string(permission)}
+		}
+	}
+
+	key, value, err := domain.NewAPIKey(input.Name, permissions, principalID(input.Actor), uc.clock.Now(), input.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.apiKeyRepository.Save(key); err != nil {
+		return nil, err
+	}
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditAPIKeyCreated, domain.AuditTarget{Type: domain.AuditTargetAPIKey, ID: key.ID()}, nil); err != nil {
+		return nil, err
+	}
+
+	return &CreateAPIKeyOutput{APIKeyOutput: *NewAPIKeyOutput(key), Key: value}, nil
+}
+
+// principalID は記録に使う主体の ID（利用者の UserID または API キーの ID）を返す
+func principalID(actor *domain.Principal) string {
+	if actor.IsService() {
+		return actor.APIKeyID()
+	}
+	return actor.UserID().Value()
+}
@@ -2,10 +2,11 @@ package usecase
 
 import (
 	"ddd-bottomup/domain"
-	"errors"
 )
 
 type CreateCircleInput struct {
+	Actor      *domain.Principal // オーナー本人か circles:write が必要
+	RequestID  string            // 監査ログに記録するリクエストID
 	CircleName string
 	OwnerID    string
 }
@@ -18,17 +19,20 @@ type CreateCircleUseCase struct {
 	circleRepository       domain.CircleRepository
 	userRepository         domain.UserRepository
 	circleExistenceService *domain.CircleExistenceService
+	auditLog               *AuditLog
 }
 
 func NewCreateCircleUseCase(
 	circleRepository domain.CircleRepository,
 	userRepository domain.UserRepository,
 	circleExistenceService *domain.CircleExistenceService,
+	auditLog *AuditLog,
 ) *CreateCircleUseCase {
 	return &CreateCircleUseCase{
 		circleRepository:       circleRepository,
 		userRepository:         userRepository,
 		circleExistenceService: circleExistenceService,
+		auditLog:               auditLog,
 	}
 }
 
@@ -39,8 +43,8 @@ func (uc *CreateCircleUseCase) Execute(input CreateCircleInput) (*CreateCircleOu
 		return nil, err
 	}
 
-	// オーナーIDからUserIDを再構成
-	ownerID, err := domain.ReconstructUserID(input.OwnerID)
+	// オーナーになれるのは自分自身のみ
+	ownerID, err := authorizeUser(input.Actor, domain.ActionCreateCircle, input.OwnerID)
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +55,7 @@ func (uc *CreateCircleUseCase) Execute(input CreateCircleInput) (*CreateCircleOu
 		return nil, err
 	}
 	if owner == nil {
-		return nil, errors.New("owner user not found")
+		return nil, domain.UserNotFoundError{ID: input.OwnerID}
 	}
 
 	// 同名のサークルが存在しないかチェック
@@ -60,7 +64,7 @@ func (uc *CreateCircleUseCase) Execute(input CreateCircleInput) (*CreateCircleOu
 		return nil, err
 	}
 	if existingCircle != nil {
-		return nil, errors.New("circle name already exists")
+		return nil, domain.CircleAlreadyExistsError{Name: circleName.Value()}
 	}
 
 	// サークル作成
@@ -72,6 +76,11 @@ func (uc *CreateCircleUseCase) Execute(input CreateCircleInput) (*CreateCircleOu
 		return nil, err
 	}
 
+	changes := domain.DiffAuditSnapshots(nil, domain.CircleAuditSnapshot(circle))
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditCircleCreated, domain.CircleAuditTarget(circle.ID()), changes); err != nil {
+		return nil, err
+	}
+
 	return &CreateCircleOutput{
 		CircleID: circle.ID().Value(),
 	}, nil
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+type CreateCircleEventInput struct {
+	Actor     *domain.Principal // オーナーか circles:write が必要
+	RequestID string            // 監査ログに記録するリクエストID
+	CircleID  string
+	Title     string
+	StartsAt  time.Time
+	EndsAt    time.Time
+	Location  string
+	Capacity  int // 0は定員なし
+}
+
+type RSVPOutput struct {
+	UserID      string
+	Status      string
+	RespondedAt time.Time
+}
+
+type CircleEventOutput struct {
+	EventID     string
+	CircleID    string
+	Title       string
+	StartsAt    time.Time
+	EndsAt      time.Time
+	Location    string
+	Capacity    int
+	GoingCount  int
+	RSVPs       []*RSVPOutput
+	Cancelled   bool
+	CreatedAt   time.Time
+	CancelledAt *time.Time
+}
+
+func NewCircleEventOutput(event *domain.CircleEvent) *CircleEventOutput {
+	rsvps := make([]*RSVPOutput, 0, len(event.RSVPs()))
+	for _, rsvp := range event.RSVPs() {
+		rsvps = append(rsvps, &RSVPOutput{
+			UserID:      rsvp.UserID().Value(),
+			Status:      rsvp.Status().String(),
+			RespondedAt: rsvp.RespondedAt(),
+		})
+	}
+	return &CircleEventOutput{
+		EventID:     event.ID().Value(),
+		CircleID:    event.CircleID().Value(),
+		Title:       event.Title(),
+		StartsAt:    event.Schedule().StartsAt(),
+		EndsAt:      event.Schedule().EndsAt(),
+		Location:    event.Location(),
+		Capacity:    event.Capacity(),
+		GoingCount:  event.GoingCount(),
+		RSVPs:       rsvps,
+		Cancelled:   event.IsCancelled(),
+		CreatedAt:   event.CreatedAt(),
+		CancelledAt: optionalTime(event.CancelledAt()),
+	}
+}
+
+type CreateCircleEventUseCase struct {
+	circleRepository domain.CircleRepository
+	eventRepository  domain.CircleEventRepository
+	clock            domain.Clock
+	auditLog         *AuditLog
+}
+
+func NewCreateCircleEventUseCase(
+	circleRepository domain.CircleRepository,
+	eventRepository domain.CircleEventRepository,
+	clock domain.Clock,
+	auditLog *AuditLog,
+) *CreateCircleEventUseCase {
+	return &CreateCircleEventUseCase{
+		circleRepository: circleRepository,
+		eventRepository:  eventRepository,
+		clock:            clock,
+		auditLog:         auditLog,
+	}
+}
+
+func (uc *CreateCircleEventUseCase) Execute(input CreateCircleEventInput) (*CircleEventOutput, error) {
+	schedule, err := domain.NewEventSchedule(input.StartsAt, input.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+
+	circle, err := findCircle(uc.circleRepository, input.CircleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeCircle(input.Actor, domain.ActionManageCircle, circle); err != nil {
+		return nil, err
+	}
+
+	event, err := domain.NewCircleEvent(circle, input.Title, schedule, input.Location, input.Capacity, uc.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.eventRepository.Save(event); err != nil {
+		return nil, err
+	}
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditCircleEventCreated, domain.AuditTarget{Type: domain.AuditTargetCircleEvent, ID: event.ID().Value()}, nil); err != nil {
+		return nil, err
+	}
+
+	return NewCircleEventOutput(event), nil
+}
@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type circleEventTestFixture struct {
+	circle  *domain.Circle
+	clock   *domain.FixedClock
+	create  *CreateCircleEventUseCase
+	list    *ListCircleEventsUseCase
+	update  *UpdateCircleEventUseCase
+	cancel  *CancelCircleEventUseCase
+	respond *RespondCircleEventRSVPUseCase
+}
+
+func setupCircleEventTest(t *testing.T, memberCount int) *circleEventTestFixture {
+	t.Helper()
+
+	userRepo := infrastructure.NewMemoryUserRepository()
+	circleRepo := infrastructure.NewMemoryCircleRepository()
+	eventRepo := infrastructure.NewMemoryCircleEventRepository()
+	circle := setupCircleWithMembers(t, userRepo, circleRepo, memberCount, 0)
+	clock := domain.NewFixedClock(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+
+	return &circleEventTestFixture{
+		circle:  circle,
+		clock:   clock,
+		create:  NewCreateCircleEventUseCase(circleRepo, eventRepo, clock, newTestAuditLog()),
+		list:    NewListCircleEventsUseCase(circleRepo, eventRepo, clock),
+		update:  NewUpdateCircleEventUseCase(circleRepo, eventRepo, clock, newTestAuditLog()),
+		cancel:  NewCancelCircleEventUseCase(circleRepo, eventRepo, clock, newTestAuditLog()),
+		respond: NewRespondCircleEventRSVPUseCase(circleRepo, eventRepo, clock, newTestAuditLog()),
+	}
+}
+
+// createEvent は現在時刻から offset 後に開始する2時間のイベントを作成する
+func (f *circleEventTestFixture) createEvent(t *testing.T, title string, offset time.Duration, capacity int) *CircleEventOutput {
+	t.Helper()
+
+	startsAt := f.clock.Now().Add(offset)
+	output, err := f.create.Execute(CreateCircleEventInput{
+		Actor:    adminActor(),
+		CircleID: f.circle.ID().Value(),
+		Title:    title,
+		StartsAt: startsAt,
+		EndsAt:   startsAt.Add(2 * time.Hour),
+		Location: "市民体育館",
+		Capacity: capacity,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create event: %v", err)
+	}
+	return output
+}
+
+func TestCreateCircleEventUseCase_Execute_Success(t *testing.T) {
+	// Arrange
+	f := setupCircleEventTest(t, 1)
+
+	// Act
+	output := f.createEvent(t, "土曜の練習会", 24*time.Hour, 10)
+
+	// Assert
+	if output.CircleID != f.circle.ID().Value() {
+		t.Errorf("Expected circle %s, but got %s", f.circle.ID().Value(), output.CircleID)
+	}
+	if output.Capacity != 10 || output.GoingCount != 0 || output.Cancelled {
+		t.Errorf("Unexpected event: %+v", output)
+	}
+}
+
+func TestListCircleEventsUseCase_Execute_Filters(t *testing.T) {
+	// Arrange
+	f := setupCircleEventTest(t, 1)
+	f.createEvent(t, "第1回", 1*time.Hour, 0)
+	f.createEvent(t, "第2回", 24*time.Hour, 0)
+	f.createEvent(t, "第3回", 48*time.Hour, 0)
+	f.clock.Advance(30 * time.Hour) // 第1回・第2回が終了
+
+	tests := []struct {
+		name     string
+		filter   string
+		expected []string
+	}{
+		{"今後のイベント", "upcoming", []string{"第3回"}},
+		{"過去のイベントは新しい順", "past", []string{"第2回", "第1回"}},
+		{"指定なしはすべて", "", []string{"第1回", "第2回", "第3回"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			output, err := f.list.Execute(ListCircleEventsInput{Actor: adminActor(), CircleID: f.circle.ID().Value(), Filter: tt.filter})
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if len(output.Events) != len(tt.expected) {
+				t.Fatalf("Expected %d events, but got %d", len(tt.expected), len(output.Events))
+			}
+			for i, title := range tt.expected {
+				if output.Events[i].Title != title {
+					t.Errorf("Expected event %d to be %s, but got %s", i, title, output.Events[i].Title)
+				}
+			}
+		})
+	}
+}
+
+func TestRespondCircleEventRSVPUseCase_Execute_Errors(t *testing.T) {
+	f := setupCircleEventTest(t, 2)
+	full := f.createEvent(t, "少人数の会", 24*time.Hour, 1)
+	if _, err := f.respond.Execute(RespondCircleEventRSVPInput{
+		Actor:    adminActor(),
+		CircleID: f.circle.ID().Value(), EventID: full.EventID, UserID: f.circle.OwnerID().Value(), Status: "going",
+	}); err != nil {
+		t.Fatalf("Failed to RSVP: %v", err)
+	}
+	cancelled := f.createEvent(t, "中止の会", 24*time.Hour, 0)
+	if _, err := f.cancel.Execute(CancelCircleEventInput{Actor: adminActor(), CircleID: f.circle.ID().Value(), EventID: cancelled.EventID}); err != nil {
+		t.Fatalf("Failed to cancel: %v", err)
+	}
+	member := f.circle.GetMemberIDs()[0].Value()
+
+	tests := []struct {
+		name     string
+		input    RespondCircleEventRSVPInput
+		expected int
+	}{
+		{"定員に達している", RespondCircleEventRSVPInput{Actor: adminActor(), EventID: full.EventID, UserID: member, Status: "going"}, http.StatusConflict},
+		{"取り消し済み", RespondCircleEventRSVPInput{Actor: adminActor(), EventID: cancelled.EventID, UserID: member, Status: "going"}, http.StatusConflict},
+		{"サークル外のユーザー", RespondCircleEventRSVPInput{Actor: adminActor(), EventID: full.EventID, UserID: domain.NewUserID().Value(), Status: "maybe"}, http.StatusBadRequest},
+		{"不明な回答", RespondCircleEventRSVPInput{Actor: adminActor(), EventID: full.EventID, UserID: member, Status: "perhaps"}, http.StatusBadRequest},
+		{"存在しないイベント", RespondCircleEventRSVPInput{Actor: adminActor(), EventID: domain.NewCircleEventID().Value(), UserID: member, Status: "going"}, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.input.CircleID = f.circle.ID().Value()
+
+			// Act
+			_, err := f.respond.Execute(tt.input)
+
+			// Assert
+			var httpErr interface{ HTTPStatus() int }
+			if !errors.As(err, &httpErr) {
+				t.Fatalf("Expected domain error, but got %v", err)
+			}
+			if httpErr.HTTPStatus() != tt.expected {
+				t.Errorf("Expected status %d, but got %d (%v)", tt.expected, httpErr.HTTPStatus(), err)
+			}
+		})
+	}
+}
+
+func TestUpdateCircleEventUseCase_Execute_Success(t *testing.T) {
+	// Arrange
+	f := setupCircleEventTest(t, 1)
+	created := f.createEvent(t, "土曜の練習会", 24*time.Hour, 0)
+	startsAt := created.StartsAt.Add(24 * time.Hour)
+
+	// Act
+	output, err := f.update.Execute(UpdateCircleEventInput{
+		Actor:    adminActor(),
+		CircleID: f.circle.ID().Value(),
+		EventID:  created.EventID,
+		Title:    "日曜の練習会",
+		StartsAt: startsAt,
+		EndsAt:   startsAt.Add(3 * time.Hour),
+		Location: "第二体育館",
+		Capacity: 5,
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if output.Title != "日曜の練習会" || !output.StartsAt.Equal(startsAt) || output.Capacity != 5 {
+		t.Errorf("Unexpected event: %+v", output)
+	}
+}
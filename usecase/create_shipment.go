@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+type BaggageInput struct {
+	Description string
+	WeightGrams int64
+	LengthCm    int64
+	WidthCm     int64
+	HeightCm    int64
+}
+
+type CreateShipmentInput struct {
+	Actor           *domain.Principal // shipments:write が必要
+	RequestID       string            // 監査ログに記録するリクエストID
+	RecipientID     string
+	DestinationZone string
+	Baggage         []BaggageInput
+}
+
+type BaggageOutput struct {
+	Description string
+	WeightGrams int64
+	LengthCm    int64
+	WidthCm     int64
+	HeightCm    int64
+}
+
+type ShipmentOutput struct {
+	ShipmentID       string
+	RecipientID      string
+	DestinationZone  string
+	Baggage          []*BaggageOutput
+	TotalWeightGrams int64
+	Fee              *ShippingFeeOutput
+	Status           string
+	TrackingNumber   string
+	CreatedAt        time.Time
+	ShippedAt        *time.Time
+	CompletedAt      *time.Time
+}
+
+func NewShipmentOutput(shipment *domain.Shipment) *ShipmentOutput {
+	baggage := make([]*BaggageOutput, 0, len(shipment.Baggage()))
+	for _, item := range shipment.Baggage() {
+		dimensions := item.Dimensions()
+		baggage = append(baggage, &BaggageOutput{
+			Description: item.Description(),
+			WeightGrams: item.WeightGrams(),
+			LengthCm:    dimensions.LengthCm(),
+			WidthCm:     dimensions.WidthCm(),
+			HeightCm:    dimensions.HeightCm(),
+		})
+	}
+	// 受取人を物理削除した発送は RecipientID を空にする
+	var recipientID string
+	if shipment.RecipientID() != nil {
+		recipientID = shipment.RecipientID().Value()
+	}
+	return &ShipmentOutput{
+		ShipmentID:       shipment.ID().Value(),
+		RecipientID:      recipientID,
+		DestinationZone:  shipment.DestinationZone(),
+		Baggage:          baggage,
+		TotalWeightGrams: shipment.TotalWeightGrams(),
+		Fee:              NewShippingFeeOutput(shipment.FeeBreakdown()),
+		Status:           shipment.Status().String(),
+		TrackingNumber:   shipment.TrackingNumber(),
+		CreatedAt:        shipment.CreatedAt(),
+		ShippedAt:        optionalTime(shipment.ShippedAt()),
+		CompletedAt:      optionalTime(shipment.CompletedAt()),
+	}
+}
+
+type CreateShipmentUseCase struct {
+	shipmentRepository domain.ShipmentRepository
+	userRepository     domain.UserRepository
+	feeCalculator      *domain.ShippingFeeCalculator
+	clock              domain.Clock
+	auditLog           *AuditLog
+}
+
+func NewCreateShipmentUseCase(
+	shipmentRepository domain.ShipmentRepository,
+	userRepository domain.UserRepository,
+	feeCalculator *domain.ShippingFeeCalculator,
+	clock domain.Clock,
+	auditLog *AuditLog,
+) *CreateShipmentUseCase {
+	return &CreateShipmentUseCase{
+		shipmentRepository: shipmentRepository,
+		userRepository:     userRepository,
+		feeCalculator:      feeCalculator,
+		clock:              clock,
+		auditLog:           auditLog,
+	}
+}
+
+func (uc *CreateShipmentUseCase) Execute(input CreateShipmentInput) (*ShipmentOutput, error) {
+	if _, err := authorizeUser(input.Actor, domain.ActionManageShipment, input.RecipientID); err != nil {
+		return nil, err
+	}
+
+	recipient, err := findUser(uc.userRepository, input.RecipientID)
+	if err != nil {
+		return nil, err
+	}
+
+	baggage, err := buildBaggage(input.Baggage)
+	if err != nil {
+		return nil, err
+	}
+	// 送料は料金表から計算する（プレミアム会員は割引）
+	fee, err := uc.feeCalculator.Calculate(baggage, input.DestinationZone, recipient.IsPremiumAt(uc.clock.Now()))
+	if err != nil {
+		return nil, err
+	}
+
+	shipment, err := domain.NewShipment(recipient.ID(), baggage, fee, uc.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.shipmentRepository.Save(shipment); err != nil {
+		return nil, err
+	}
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditShipmentCreated, domain.AuditTarget{Type: domain.AuditTargetShipment, ID: shipment.ID().Value()}, nil); err != nil {
+		return nil, err
+	}
+
+	return NewShipmentOutput(shipment), nil
+}
+
+func buildBaggage(inputs []BaggageInput) ([]*domain.Baggage, error) {
+	baggage := make([]*domain.Baggage, 0, len(inputs))
+	for _, input := range inputs {
+		dimensions, err := domain.NewDimensions(input.LengthCm, input.WidthCm, input.HeightCm)
+		if err != nil {
+			return nil, err
+		}
+		item, err := domain.NewBaggage(input.Description, input.WeightGrams, dimensions)
+		if err != nil {
+			return nil, err
+		}
+		baggage = append(baggage, item)
+	}
+	return baggage, nil
+}
@@ -0,0 +1,245 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"errors"
+	"testing"
+	"time"
+)
+
+type shipmentTestFixture struct {
+	recipient *domain.User
+	clock     *domain.FixedClock
+	create    *CreateShipmentUseCase
+	get       *GetShipmentUseCase
+	list      *ListUserShipmentsUseCase
+	update    *UpdateShipmentStatusUseCase
+	cancel    *CancelShipmentUseCase
+}
+
+func setupShipmentTest(t *testing.T) *shipmentTestFixture {
+	t.Helper()
+
+	userRepo := infrastructure.NewMemoryUserRepository()
+	shipmentRepo := infrastructure.NewMemoryShipmentRepository()
+	clock := domain.NewFixedClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+
+	return &shipmentTestFixture{
+		recipient: saveNewUser(t, userRepo, "recipient"),
+		clock:     clock,
+		create:    NewCreateShipmentUseCase(shipmentRepo, userRepo, domain.NewShippingFeeCalculator(domain.DefaultShippingRateTable()), clock, newTestAuditLog()),
+		get:       NewGetShipmentUseCase(shipmentRepo),
+		list:      NewListUserShipmentsUseCase(shipmentRepo, userRepo),
+		update:    NewUpdateShipmentStatusUseCase(shipmentRepo, clock, newTestAuditLog()),
+		cancel:    NewCancelShipmentUseCase(shipmentRepo, clock, newTestAuditLog()),
+	}
+}
+
+func (f *shipmentTestFixture) createShipment(t *testing.T) *ShipmentOutput {
+	t.Helper()
+
+	output, err := f.create.Execute(CreateShipmentInput{
+		Actor:           adminActor(),
+		RecipientID:     f.recipient.ID().Value(),
+		DestinationZone: "domestic",
+		Baggage: []BaggageInput{
+			{Description: "サークルTシャツ", WeightGrams: 300, LengthCm: 30, WidthCm: 20, HeightCm: 5},
+			{Description: "タオル", WeightGrams: 200, LengthCm: 20, WidthCm: 20, HeightCm: 5},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create shipment: %v", err)
+	}
+	return output
+}
+
+func TestCreateShipmentUseCase_Execute_Success(t *testing.T) {
+	// Arrange
+	f := setupShipmentTest(t)
+
+	// Act
+	output := f.createShipment(t)
+
+	// Assert
+	if output.Status != domain.ShipmentStatusPending.String() {
+		t.Errorf("Expected status pending, but got %s", output.Status)
+	}
+	if output.TotalWeightGrams != 500 {
+		t.Errorf("Expected total weight 500g, but got %d", output.TotalWeightGrams)
+	}
+	// 容積重量 30×20×5/5000 + 20×20×5/5000 = 1kg のため1kgまでの料金
+	if output.Fee.Total.Amount != 700 {
+		t.Errorf("Expected fee 700, but got %d", output.Fee.Total.Amount)
+	}
+	listed, err := f.list.Execute(ListUserShipmentsInput{Actor: adminActor(), UserID: f.recipient.ID().Value()})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if len(listed.Shipments) != 1 || listed.Shipments[0].ShipmentID != output.ShipmentID {
+		t.Errorf("Expected the created shipment to be listed, but got %d shipments", len(listed.Shipments))
+	}
+}
+
+func TestCreateShipmentUseCase_Execute_UnknownRecipient_ReturnsError(t *testing.T) {
+	// Arrange
+	f := setupShipmentTest(t)
+
+	// Act
+	output, err := f.create.Execute(CreateShipmentInput{
+		Actor:           adminActor(),
+		RecipientID:     domain.NewUserID().Value(),
+		DestinationZone: "domestic",
+		Baggage:         []BaggageInput{{Description: "タオル", WeightGrams: 200, LengthCm: 20, WidthCm: 20, HeightCm: 5}},
+	})
+
+	// Assert
+	var notFound domain.UserNotFoundError
+	if !errors.As(err, &notFound) {
+		t.Errorf("Expected UserNotFoundError, but got %v", err)
+	}
+	if output != nil {
+		t.Error("Expected nil output")
+	}
+}
+
+func TestUpdateShipmentStatusUseCase_Execute_TracksDelivery(t *testing.T) {
+	// Arrange
+	f := setupShipmentTest(t)
+	created := f.createShipment(t)
+
+	// Act
+	f.clock.Advance(24 * time.Hour)
+	if _, err := f.update.Execute(UpdateShipmentStatusInput{
+		Actor:          adminActor(),
+		ShipmentID:     created.ShipmentID,
+		Status:         "shipped",
+		TrackingNumber: "TRK-0001",
+	}); err != nil {
+		t.Fatalf("Failed to ship: %v", err)
+	}
+	f.clock.Advance(48 * time.Hour)
+	if _, err := f.update.Execute(UpdateShipmentStatusInput{
+		Actor:      adminActor(),
+		ShipmentID: created.ShipmentID,
+		Status:     "delivered",
+	}); err != nil {
+		t.Fatalf("Failed to deliver: %v", err)
+	}
+
+	// Assert
+	tracked, err := f.get.Execute(GetShipmentInput{Actor: adminActor(), ShipmentID: created.ShipmentID})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if tracked.Status != "delivered" || tracked.TrackingNumber != "TRK-0001" {
+		t.Errorf("Expected delivered with TRK-0001, but got %s / %s", tracked.Status, tracked.TrackingNumber)
+	}
+	if tracked.ShippedAt == nil || tracked.CompletedAt == nil || !tracked.CompletedAt.After(*tracked.ShippedAt) {
+		t.Error("Expected shipped and completed times to be recorded in order")
+	}
+}
+
+func TestCancelShipmentUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name        string
+		shipFirst   bool
+		expectError bool
+	}{
+		{"発送前は取り消せる", false, false},
+		{"発送後は取り消せない", true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			f := setupShipmentTest(t)
+			created := f.createShipment(t)
+			if tt.shipFirst {
+				if _, err := f.update.Execute(UpdateShipmentStatusInput{
+					Actor:      adminActor(),
+					ShipmentID: created.ShipmentID, Status: "shipped", TrackingNumber: "TRK-0001",
+				}); err != nil {
+					t.Fatalf("Failed to ship: %v", err)
+				}
+			}
+
+			// Act
+			output, err := f.cancel.Execute(CancelShipmentInput{Actor: adminActor(), ShipmentID: created.ShipmentID})
+
+			// Assert
+			if tt.expectError {
+				var transitionErr domain.InvalidShipmentTransitionError
+				if !errors.As(err, &transitionErr) {
+					t.Errorf("Expected InvalidShipmentTransitionError, but got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if output.Status != "cancelled" {
+				t.Errorf("Expected status cancelled, but got %s", output.Status)
+			}
+		})
+	}
+}
+
+func TestGetShipmentUseCase_Execute_NotFound_ReturnsError(t *testing.T) {
+	// Arrange
+	f := setupShipmentTest(t)
+
+	// Act
+	_, err := f.get.Execute(GetShipmentInput{Actor: adminActor(), ShipmentID: domain.NewShipmentID().Value()})
+
+	// Assert
+	var notFound domain.ShipmentNotFoundError
+	if !errors.As(err, &notFound) {
+		t.Errorf("Expected ShipmentNotFoundError, but got %v", err)
+	}
+}
+
+func TestQuoteShippingFeeUseCase_Execute_PremiumDiscount(t *testing.T) {
+	tests := []struct {
+		name          string
+		isPremium     bool
+		expectedItems int
+		expectedTotal int64
+	}{
+		{"一般会員は割引なし", false, 1, 700},
+		{"プレミアム会員は10%引き", true, 2, 630},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			userRepo := infrastructure.NewMemoryUserRepository()
+			name, _ := domain.NewFullName("受取", "テスト")
+			email, _ := domain.NewEmail("quote@example.com")
+			recipient := domain.ReconstructUser(domain.NewUserID(), name, email, tt.isPremium)
+			if err := userRepo.Save(recipient); err != nil {
+				t.Fatalf("Failed to save user: %v", err)
+			}
+			useCase := NewQuoteShippingFeeUseCase(userRepo, domain.NewShippingFeeCalculator(domain.DefaultShippingRateTable()), domain.SystemClock{})
+
+			// Act
+			output, err := useCase.Execute(QuoteShippingFeeInput{
+				Actor:           adminActor(),
+				RecipientID:     recipient.ID().Value(),
+				DestinationZone: "domestic",
+				Baggage:         []BaggageInput{{Description: "タオル", WeightGrams: 200, LengthCm: 20, WidthCm: 20, HeightCm: 5}},
+			})
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if len(output.Items) != tt.expectedItems {
+				t.Errorf("Expected %d items, but got %d", tt.expectedItems, len(output.Items))
+			}
+			if output.Total.Amount != tt.expectedTotal {
+				t.Errorf("Expected total %d, but got %d", tt.expectedTotal, output.Total.Amount)
+			}
+		})
+	}
+}
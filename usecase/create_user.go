@@ -5,10 +5,12 @@ import (
 )
 
 type CreateUserInput struct {
+	Actor     *domain.Principal // 匿名でも登録できる（API キーの場合は users:write が必要）
+	RequestID string            // 監査ログに記録するリクエストID
 	FirstName string
 	LastName  string
 	Email     string
-	IsPremium bool
+	Password  string // 空の場合はパスワードでログインできない（外部の認証のみ）
 }
 
 type CreateUserOutput struct {
@@ -18,19 +20,35 @@ type CreateUserOutput struct {
 type CreateUserUseCase struct {
 	userRepository       domain.UserRepository
 	userExistenceService *domain.UserExistenceService
+	credentialRepository domain.CredentialRepository
+	passwordHasher       domain.PasswordHasher
+	clock                domain.Clock
+	auditLog             *AuditLog
 }
 
 func NewCreateUserUseCase(
 	userRepository domain.UserRepository,
 	userExistenceService *domain.UserExistenceService,
+	credentialRepository domain.CredentialRepository,
+	passwordHasher domain.PasswordHasher,
+	clock domain.Clock,
+	auditLog *AuditLog,
 ) *CreateUserUseCase {
 	return &CreateUserUseCase{
 		userRepository:       userRepository,
 		userExistenceService: userExistenceService,
+		credentialRepository: credentialRepository,
+		passwordHasher:       passwordHasher,
+		clock:                clock,
+		auditLog:             auditLog,
 	}
 }
 
 func (uc *CreateUserUseCase) Execute(input CreateUserInput) (*CreateUserOutput, error) {
+	if err := domain.Authorize(input.Actor, domain.ActionCreateUser, domain.Resource{}); err != nil {
+		return nil, err
+	}
+
 	fullName, err := domain.NewFullName(input.FirstName, input.LastName)
 	if err != nil {
 		return nil, err
@@ -41,7 +59,15 @@ func (uc *CreateUserUseCase) Execute(input CreateUserInput) (*CreateUserOutput,
 		return nil, err
 	}
 
-	user := domain.NewUser(fullName, email, input.IsPremium)
+	var password *domain.Password
+	if input.Password != "" {
+		password, err = domain.NewPassword(input.Password)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	user := domain.NewUser(fullName, email, uc.clock.Now())
 
 	exists, err := uc.userExistenceService.Exists(user)
 	if err != nil {
@@ -51,9 +77,40 @@ func (uc *CreateUserUseCase) Execute(input CreateUserInput) (*CreateUserOutput,
 		return nil, domain.DuplicateUserNameError{Name: user.Name().String()}
 	}
 
+	existing, err := uc.userRepository.FindByEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, domain.UserAlreadyExistsError{Email: email.Value()}
+	}
+
+	// ハッシュ化に時間がかかるため、ユーザーの保存より前に済ませる
+	var credential *domain.Credential
+	if password != nil {
+		hash, err := uc.passwordHasher.Hash(password)
+		if err != nil {
+			return nil, err
+		}
+		credential, err = domain.NewCredential(user.ID(), hash, uc.clock.Now())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if err := uc.userRepository.Save(user); err != nil {
 		return nil, err
 	}
+	if credential != nil {
+		if err := uc.credentialRepository.Save(credential); err != nil {
+			return nil, err
+		}
+	}
+
+	changes := domain.DiffAuditSnapshots(nil, domain.UserAuditSnapshot(user))
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditUserCreated, domain.UserAuditTarget(user.ID()), changes); err != nil {
+		return nil, err
+	}
 
 	return &CreateUserOutput{
 		UserID: user.ID().String(),
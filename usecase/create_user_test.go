@@ -11,13 +11,12 @@ func TestCreateUserUseCase_Execute_Success(t *testing.T) {
 	// Arrange
 	repo := infrastructure.NewMemoryUserRepository()
 	userExistenceService := domain.NewUserExistenceService(repo)
-	useCase := NewCreateUserUseCase(repo, userExistenceService)
+	useCase := NewCreateUserUseCase(repo, userExistenceService, infrastructure.NewMemoryCredentialRepository(), newTestPasswordHasher(), domain.SystemClock{}, newTestAuditLog())
 
 	input := CreateUserInput{
 		FirstName: "太郎",
 		LastName:  "田中",
 		Email:     "taro@example.com",
-		IsPremium: false,
 	}
 
 	// Act
@@ -41,13 +40,12 @@ func TestCreateUserUseCase_Execute_DuplicateName_ReturnsError(t *testing.T) {
 	// Arrange
 	repo := infrastructure.NewMemoryUserRepository()
 	userExistenceService := domain.NewUserExistenceService(repo)
-	useCase := NewCreateUserUseCase(repo, userExistenceService)
+	useCase := NewCreateUserUseCase(repo, userExistenceService, infrastructure.NewMemoryCredentialRepository(), newTestPasswordHasher(), domain.SystemClock{}, newTestAuditLog())
 
 	input := CreateUserInput{
 		FirstName: "太郎",
 		LastName:  "田中",
 		Email:     "taro@example.com",
-		IsPremium: false,
 	}
 
 	// 最初のユーザーを作成
@@ -61,7 +59,6 @@ func TestCreateUserUseCase_Execute_DuplicateName_ReturnsError(t *testing.T) {
 		FirstName: "太郎",
 		LastName:  "田中",
 		Email:     "taro2@example.com",
-		IsPremium: false,
 	}
 
 	// Act
@@ -87,7 +84,7 @@ func TestCreateUserUseCase_Execute_InvalidEmail_ReturnsError(t *testing.T) {
 	// Arrange
 	repo := infrastructure.NewMemoryUserRepository()
 	userExistenceService := domain.NewUserExistenceService(repo)
-	useCase := NewCreateUserUseCase(repo, userExistenceService)
+	useCase := NewCreateUserUseCase(repo, userExistenceService, infrastructure.NewMemoryCredentialRepository(), newTestPasswordHasher(), domain.SystemClock{}, newTestAuditLog())
 
 	tests := []struct {
 		name  string
@@ -105,7 +102,6 @@ func TestCreateUserUseCase_Execute_InvalidEmail_ReturnsError(t *testing.T) {
 				FirstName: "太郎",
 				LastName:  "田中",
 				Email:     tt.email,
-				IsPremium: false,
 			}
 
 			// Act
@@ -127,7 +123,7 @@ func TestCreateUserUseCase_Execute_InvalidNames_ReturnsError(t *testing.T) {
 	// Arrange
 	repo := infrastructure.NewMemoryUserRepository()
 	userExistenceService := domain.NewUserExistenceService(repo)
-	useCase := NewCreateUserUseCase(repo, userExistenceService)
+	useCase := NewCreateUserUseCase(repo, userExistenceService, infrastructure.NewMemoryCredentialRepository(), newTestPasswordHasher(), domain.SystemClock{}, newTestAuditLog())
 
 	tests := []struct {
 		name      string
@@ -147,7 +143,6 @@ func TestCreateUserUseCase_Execute_InvalidNames_ReturnsError(t *testing.T) {
 				FirstName: tt.firstName,
 				LastName:  tt.lastName,
 				Email:     "test@example.com",
-				IsPremium: false,
 			}
 
 			// Act
@@ -171,17 +166,16 @@ func TestCreateUserUseCase_Execute_InvalidNames_ReturnsError(t *testing.T) {
 	}
 }
 
-func TestCreateUserUseCase_Execute_PremiumUser_Success(t *testing.T) {
+func TestCreateUserUseCase_Execute_StartsOnFreePlan(t *testing.T) {
 	// Arrange
 	repo := infrastructure.NewMemoryUserRepository()
 	userExistenceService := domain.NewUserExistenceService(repo)
-	useCase := NewCreateUserUseCase(repo, userExistenceService)
+	useCase := NewCreateUserUseCase(repo, userExistenceService, infrastructure.NewMemoryCredentialRepository(), newTestPasswordHasher(), domain.SystemClock{}, newTestAuditLog())
 
 	input := CreateUserInput{
 		FirstName: "花子",
 		LastName:  "佐藤",
 		Email:     "hanako@example.com",
-		IsPremium: true,
 	}
 
 	// Act
@@ -200,7 +194,7 @@ func TestCreateUserUseCase_Execute_PremiumUser_Success(t *testing.T) {
 		t.Error("Expected UserID to be set, but got empty string")
 	}
 
-	// プレミアムユーザーとして保存されているか確認（リポジトリから取得して確認）
+	// 無料プランで保存されているか確認（リポジトリから取得して確認）
 	userID, _ := domain.ReconstructUserID(output.UserID)
 	savedUser, err := repo.FindByID(userID)
 	if err != nil {
@@ -209,7 +203,7 @@ func TestCreateUserUseCase_Execute_PremiumUser_Success(t *testing.T) {
 
 	if savedUser == nil {
 		t.Error("Expected saved user, but got nil")
-	} else if !savedUser.IsPremium() {
-		t.Error("Expected user to be premium, but was not")
+	} else if savedUser.Subscription().Plan() != domain.SubscriptionPlanFree {
+		t.Errorf("Expected user to start on the free plan, but got %s", savedUser.Subscription().Plan())
 	}
 }
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type DeleteCircleInput struct {
+	Actor     *domain.Principal // オーナーか circles:write が必要
+	RequestID string            // 監査ログに記録するリクエストID
+	CircleID  string
+}
+
+// DeleteCircleUseCase はサークルを論理削除する（メンバーはそのまま残し、復元すると元に戻る）
+type DeleteCircleUseCase struct {
+	circleRepository domain.CircleRepository
+	clock            domain.Clock
+	auditLog         *AuditLog
+}
+
+func NewDeleteCircleUseCase(circleRepository domain.CircleRepository, clock domain.Clock, auditLog *AuditLog) *DeleteCircleUseCase {
+	return &DeleteCircleUseCase{
+		circleRepository: circleRepository,
+		clock:            clock,
+		auditLog:         auditLog,
+	}
+}
+
+func (uc *DeleteCircleUseCase) Execute(input DeleteCircleInput) error {
+	circleID, err := domain.ReconstructCircleID(input.CircleID)
+	if err != nil {
+		return err
+	}
+
+	circle, err := uc.circleRepository.FindByID(circleID)
+	if err != nil {
+		return err
+	}
+	if circle == nil {
+		return domain.CircleNotFoundError{ID: input.CircleID}
+	}
+	if err := authorizeCircle(input.Actor, domain.ActionManageCircle, circle); err != nil {
+		return err
+	}
+
+	before := domain.CircleAuditSnapshot(circle)
+	if err := circle.MarkDeleted(uc.clock.Now()); err != nil {
+		return err
+	}
+	if err := uc.circleRepository.Save(circle); err != nil {
+		return err
+	}
+
+	changes := domain.DiffAuditSnapshots(before, domain.CircleAuditSnapshot(circle))
+	return uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditCircleDeleted, domain.CircleAuditTarget(circle.ID()), changes)
+}
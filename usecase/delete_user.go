@@ -2,24 +2,46 @@ package usecase
 
 import (
 	"ddd-bottomup/domain"
+	"time"
 )
 
 type DeleteUserInput struct {
-	UserID string
+	Actor     *domain.Principal // 本人か users:write が必要
+	RequestID string            // 監査ログに記録するリクエストID
+	UserID    string
 }
 
+// DeleteUserUseCase はユーザーを論理削除する
+// 復元できる期間が過ぎるまで認証情報やサークルとの関係は残し、物理削除は PurgeDeletedRecordsUseCase が行う
 type DeleteUserUseCase struct {
-	userRepository domain.UserRepository
+	userRepository         domain.UserRepository
+	circleRepository       domain.CircleRepository
+	sessionRepository      domain.SessionRepository
+	refreshTokenRepository domain.RefreshTokenRepository
+	clock                  domain.Clock
+	auditLog               *AuditLog
 }
 
-func NewDeleteUserUseCase(userRepository domain.UserRepository) *DeleteUserUseCase {
+func NewDeleteUserUseCase(
+	userRepository domain.UserRepository,
+	circleRepository domain.CircleRepository,
+	sessionRepository domain.SessionRepository,
+	refreshTokenRepository domain.RefreshTokenRepository,
+	clock domain.Clock,
+	auditLog *AuditLog,
+) *DeleteUserUseCase {
 	return &DeleteUserUseCase{
-		userRepository: userRepository,
+		userRepository:         userRepository,
+		circleRepository:       circleRepository,
+		sessionRepository:      sessionRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		clock:                  clock,
+		auditLog:               auditLog,
 	}
 }
 
 func (uc *DeleteUserUseCase) Execute(input DeleteUserInput) error {
-	userID, err := domain.ReconstructUserID(input.UserID)
+	userID, err := authorizeUser(input.Actor, domain.ActionDeleteUser, input.UserID)
 	if err != nil {
 		return err
 	}
@@ -32,5 +54,57 @@ func (uc *DeleteUserUseCase) Execute(input DeleteUserInput) error {
 		return domain.UserNotFoundError{ID: input.UserID}
 	}
 
-	return uc.userRepository.Delete(userID)
+	now := uc.clock.Now()
+	before := domain.UserAuditSnapshot(user)
+	if err := user.MarkDeleted(now); err != nil {
+		return err
+	}
+	if err := uc.userRepository.Save(user); err != nil {
+		return err
+	}
+
+	// オーナーのサークルは一緒に論理削除し、参加中のサークルからは外す（復元すると元に戻る）
+	circles, err := uc.circleRepository.FindAll()
+	if err != nil {
+		return err
+	}
+	for _, circle := range circles {
+		if err := uc.leaveCircle(input, circle, userID, now); err != nil {
+			return err
+		}
+	}
+
+	// ログイン中のセッション・リフレッシュトークンは削除する
+	if err := uc.sessionRepository.DeleteByUserID(userID); err != nil {
+		return err
+	}
+	if err := uc.refreshTokenRepository.DeleteByUserID(userID); err != nil {
+		return err
+	}
+
+	changes := domain.DiffAuditSnapshots(before, domain.UserAuditSnapshot(user))
+	return uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditUserDeleted, domain.UserAuditTarget(userID), changes)
+}
+
+func (uc *DeleteUserUseCase) leaveCircle(input DeleteUserInput, circle *domain.Circle, userID *domain.UserID, now time.Time) error {
+	before := domain.CircleAuditSnapshot(circle)
+
+	var action domain.AuditAction
+	switch {
+	case circle.IsOwner(userID):
+		if err := circle.MarkDeleted(now); err != nil {
+			return err
+		}
+		action = domain.AuditCircleDeleted
+	case circle.DetachMember(userID, now):
+		action = domain.AuditCircleMemberDetached
+	default:
+		return nil
+	}
+
+	if err := uc.circleRepository.Save(circle); err != nil {
+		return err
+	}
+	changes := domain.DiffAuditSnapshots(before, domain.CircleAuditSnapshot(circle))
+	return uc.auditLog.Record(input.Actor, input.RequestID, action, domain.CircleAuditTarget(circle.ID()), changes)
 }
@@ -3,7 +3,9 @@ package usecase
 import (
 	"ddd-bottomup/domain"
 	"ddd-bottomup/infrastructure"
+	"errors"
 	"testing"
+	"time"
 )
 
 func TestDeleteUserUseCase_Execute_Success(t *testing.T) {
@@ -13,14 +15,14 @@ func TestDeleteUserUseCase_Execute_Success(t *testing.T) {
 	// テスト用のユーザーを作成・保存
 	fullName, _ := domain.NewFullName("太郎", "田中")
 	email, _ := domain.NewEmail("taro@example.com")
-	user := domain.NewUser(fullName, email, false)
+	user := domain.NewUser(fullName, email, time.Now())
 	err := repo.Save(user)
 	if err != nil {
 		t.Fatalf("Failed to save test user: %v", err)
 	}
 
-	useCase := NewDeleteUserUseCase(repo)
-	input := DeleteUserInput{UserID: user.ID().Value()}
+	useCase := NewDeleteUserUseCase(repo, infrastructure.NewMemoryCircleRepository(), infrastructure.NewMemorySessionRepository(), infrastructure.NewMemoryRefreshTokenRepository(), domain.SystemClock{}, newTestAuditLog())
+	input := DeleteUserInput{Actor: domain.NewUserPrincipal(user.ID(), false), UserID: user.ID().Value()}
 
 	// Act
 	err = useCase.Execute(input)
@@ -44,16 +46,62 @@ func TestDeleteUserUseCase_Execute_Success(t *testing.T) {
 	if memoryRepo.Count() != 0 {
 		t.Errorf("Expected 0 users in repository, but got %d", memoryRepo.Count())
 	}
+
+	// 復元できるように論理削除で残っていることを確認
+	softDeleted, err := repo.FindDeletedByID(user.ID())
+	if err != nil {
+		t.Fatalf("Error finding soft-deleted user: %v", err)
+	}
+	if softDeleted == nil || !softDeleted.IsDeleted() {
+		t.Error("Expected user to be soft-deleted")
+	}
+}
+
+func TestDeleteUserUseCase_Execute_LeavesCircles(t *testing.T) {
+	// Arrange
+	userRepo := infrastructure.NewMemoryUserRepository()
+	circleRepo := infrastructure.NewMemoryCircleRepository()
+	circle := setupCircleWithMembers(t, userRepo, circleRepo, 2, 0)
+	member := circle.GetMemberIDs()[0]
+	otherName, _ := domain.NewCircleName("別のサークル")
+	ownedCircle := domain.NewCircle(otherName, member)
+	if err := circleRepo.Save(ownedCircle); err != nil {
+		t.Fatalf("Failed to save circle: %v", err)
+	}
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	useCase := NewDeleteUserUseCase(userRepo, circleRepo, infrastructure.NewMemorySessionRepository(), infrastructure.NewMemoryRefreshTokenRepository(), domain.NewFixedClock(now), newTestAuditLog())
+
+	// Act
+	err := useCase.Execute(DeleteUserInput{Actor: userActor(t, member.Value()), UserID: member.Value()})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	joined, _ := circleRepo.FindByID(circle.ID())
+	if joined.IsMember(member) || !joined.IsDetachedMember(member) {
+		t.Error("Expected the user to be detached from the circle they joined")
+	}
+	if joined.GetMemberCount() != 1 {
+		t.Errorf("Expected 1 member to remain, but got %d", joined.GetMemberCount())
+	}
+	if found, _ := circleRepo.FindByID(ownedCircle.ID()); found != nil {
+		t.Error("Expected the owned circle to be hidden")
+	}
+	deleted, _ := circleRepo.FindDeletedByID(ownedCircle.ID())
+	if deleted == nil || !deleted.DeletedAt().Equal(now) {
+		t.Error("Expected the owned circle to be soft-deleted with the user")
+	}
 }
 
 func TestDeleteUserUseCase_Execute_UserNotFound(t *testing.T) {
 	// Arrange
 	repo := infrastructure.NewMemoryUserRepository()
-	useCase := NewDeleteUserUseCase(repo)
+	useCase := NewDeleteUserUseCase(repo, infrastructure.NewMemoryCircleRepository(), infrastructure.NewMemorySessionRepository(), infrastructure.NewMemoryRefreshTokenRepository(), domain.SystemClock{}, newTestAuditLog())
 
 	// 存在しないUserIDを使用
 	nonExistentID := domain.NewUserID()
-	input := DeleteUserInput{UserID: nonExistentID.Value()}
+	input := DeleteUserInput{Actor: domain.NewUserPrincipal(nonExistentID, false), UserID: nonExistentID.Value()}
 
 	// Act
 	err := useCase.Execute(input)
@@ -72,7 +120,7 @@ func TestDeleteUserUseCase_Execute_UserNotFound(t *testing.T) {
 func TestDeleteUserUseCase_Execute_InvalidUserID(t *testing.T) {
 	// Arrange
 	repo := infrastructure.NewMemoryUserRepository()
-	useCase := NewDeleteUserUseCase(repo)
+	useCase := NewDeleteUserUseCase(repo, infrastructure.NewMemoryCircleRepository(), infrastructure.NewMemorySessionRepository(), infrastructure.NewMemoryRefreshTokenRepository(), domain.SystemClock{}, newTestAuditLog())
 
 	testCases := []struct {
 		name   string
@@ -85,7 +133,8 @@ func TestDeleteUserUseCase_Execute_InvalidUserID(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			input := DeleteUserInput{UserID: tc.userID}
+			// 管理者であれば本人かどうかに関わらず ID の検証まで進む
+			input := DeleteUserInput{Actor: domain.NewUserPrincipal(domain.NewUserID(), true), UserID: tc.userID}
 
 			// Act
 			err := useCase.Execute(input)
@@ -102,8 +151,8 @@ func TestDeleteUserUseCase_Execute_MultipleUsersOneDeleted(t *testing.T) {
 	// Arrange
 	repo := infrastructure.NewMemoryUserRepository()
 	userExistenceService := domain.NewUserExistenceService(repo)
-	createUseCase := NewCreateUserUseCase(repo, userExistenceService)
-	deleteUseCase := NewDeleteUserUseCase(repo)
+	createUseCase := NewCreateUserUseCase(repo, userExistenceService, infrastructure.NewMemoryCredentialRepository(), newTestPasswordHasher(), domain.SystemClock{}, newTestAuditLog())
+	deleteUseCase := NewDeleteUserUseCase(repo, infrastructure.NewMemoryCircleRepository(), infrastructure.NewMemorySessionRepository(), infrastructure.NewMemoryRefreshTokenRepository(), domain.SystemClock{}, newTestAuditLog())
 
 	// 複数ユーザーを作成
 	users := []CreateUserInput{
@@ -122,7 +171,7 @@ func TestDeleteUserUseCase_Execute_MultipleUsersOneDeleted(t *testing.T) {
 	}
 
 	// 最初のユーザーを削除
-	deleteInput := DeleteUserInput{UserID: createdUserIDs[0]}
+	deleteInput := DeleteUserInput{Actor: userActor(t, createdUserIDs[0]), UserID: createdUserIDs[0]}
 	err := deleteUseCase.Execute(deleteInput)
 	if err != nil {
 		t.Fatalf("Failed to delete user: %v", err)
@@ -159,11 +208,11 @@ func TestDeleteUserUseCase_Execute_DeleteSameUserTwice(t *testing.T) {
 
 	fullName, _ := domain.NewFullName("太郎", "田中")
 	email, _ := domain.NewEmail("taro@example.com")
-	user := domain.NewUser(fullName, email, false)
+	user := domain.NewUser(fullName, email, time.Now())
 	repo.Save(user)
 
-	useCase := NewDeleteUserUseCase(repo)
-	input := DeleteUserInput{UserID: user.ID().Value()}
+	useCase := NewDeleteUserUseCase(repo, infrastructure.NewMemoryCircleRepository(), infrastructure.NewMemorySessionRepository(), infrastructure.NewMemoryRefreshTokenRepository(), domain.SystemClock{}, newTestAuditLog())
+	input := DeleteUserInput{Actor: domain.NewUserPrincipal(user.ID(), false), UserID: user.ID().Value()}
 
 	// Act - 最初の削除
 	err := useCase.Execute(input)
@@ -184,3 +233,46 @@ func TestDeleteUserUseCase_Execute_DeleteSameUserTwice(t *testing.T) {
 		t.Errorf("Expected UserNotFoundError, but got %T", err)
 	}
 }
+
+func TestDeleteUserUseCase_Execute_OtherUser_Forbidden(t *testing.T) {
+	// Arrange
+	repo := infrastructure.NewMemoryUserRepository()
+	user := saveNewUser(t, repo, "taro")
+	other := saveNewUser(t, repo, "hanako")
+	useCase := NewDeleteUserUseCase(repo, infrastructure.NewMemoryCircleRepository(), infrastructure.NewMemorySessionRepository(), infrastructure.NewMemoryRefreshTokenRepository(), domain.SystemClock{}, newTestAuditLog())
+
+	// Act
+	err := useCase.Execute(DeleteUserInput{Actor: domain.NewUserPrincipal(other.ID(), false), UserID: user.ID().Value()})
+
+	// Assert
+	var forbidden domain.ForbiddenError
+	if !errors.As(err, &forbidden) {
+		t.Fatalf("Expected ForbiddenError, but got %v", err)
+	}
+	if found, _ := repo.FindByID(user.ID()); found == nil {
+		t.Error("Expected the user to remain")
+	}
+}
+
+func TestDeleteUserUseCase_Execute_RevokesSessions(t *testing.T) {
+	// Arrange
+	f := newAuthTestFixture()
+	userID := f.createUser(t, "taro", "correct horse battery")
+	login, _ := f.login.Execute(LoginInput{Email: "taro@example.com", Password: "correct horse battery"})
+	tokens, _ := f.tokenLogin.Execute(TokenLoginInput{Email: "taro@example.com", Password: "correct horse battery"})
+	useCase := NewDeleteUserUseCase(f.userRepo, infrastructure.NewMemoryCircleRepository(), f.sessionRepo, f.refreshTokenRepo, domain.SystemClock{}, newTestAuditLog())
+
+	// Act
+	err := useCase.Execute(DeleteUserInput{Actor: userActor(t, userID), UserID: userID})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if _, err := f.authenticate.Execute(AuthenticateInput{Token: login.Token}); err == nil {
+		t.Error("Expected the session to be revoked")
+	}
+	if _, err := f.refresh.Execute(RefreshAccessTokenInput{RefreshToken: tokens.RefreshToken}); err == nil {
+		t.Error("Expected the refresh token to be revoked")
+	}
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+// 1回の実行で送信する最大件数
+const webhookDeliveryBatchSize = 50
+
+type DeliverWebhooksOutput struct {
+	Succeeded int
+	Retrying  int // 失敗して再試行を待つ件数
+	Failed    int // 上限回数まで失敗した件数
+}
+
+// DeliverWebhooksUseCase は配信時刻を迎えた配信を送信し、結果を配信ログに記録する
+type DeliverWebhooksUseCase struct {
+	webhookRepository  domain.WebhookRepository
+	deliveryRepository domain.WebhookDeliveryRepository
+	sender             domain.WebhookSender
+	retryPolicy        *domain.WebhookRetryPolicy
+	clock              domain.Clock
+}
+
+func NewDeliverWebhooksUseCase(
+	webhookRepository domain.WebhookRepository,
+	deliveryRepository domain.WebhookDeliveryRepository,
+	sender domain.WebhookSender,
+	retryPolicy *domain.WebhookRetryPolicy,
+	clock domain.Clock,
+) *DeliverWebhooksUseCase {
+	return &DeliverWebhooksUseCase{
+		webhookRepository:  webhookRepository,
+		deliveryRepository: deliveryRepository,
+		sender:             sender,
+		retryPolicy:        retryPolicy,
+		clock:              clock,
+	}
+}
+
+func (uc *DeliverWebhooksUseCase) Execute() (*DeliverWebhooksOutput, error) {
+	deliveries, err := uc.deliveryRepository.FindDue(uc.clock.Now(), webhookDeliveryBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &DeliverWebhooksOutput{}
+	for _, delivery := range deliveries {
+		webhook, err := uc.webhookRepository.FindByID(delivery.WebhookID())
+		if err != nil {
+			return nil, err
+		}
+
+		if webhook == nil {
+			err = delivery.RecordFailure(0, domain.WebhookNotFoundError{ID: delivery.WebhookID().Value()}.Error(), uc.retryPolicy, uc.clock.Now())
+		} else if status, sendErr := uc.sender.Send(webhook, delivery); sendErr != nil {
+			err = delivery.RecordFailure(status, sendErr.Error(), uc.retryPolicy, uc.clock.Now())
+		} else {
+			err = delivery.RecordSuccess(status, uc.clock.Now())
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err := uc.deliveryRepository.Save(delivery); err != nil {
+			return nil, err
+		}
+
+		switch delivery.Status() {
+		case domain.WebhookDeliverySucceeded:
+			output.Succeeded++
+		case domain.WebhookDeliveryFailed:
+			output.Failed++
+		default:
+			output.Retrying++
+		}
+	}
+
+	return output, nil
+}
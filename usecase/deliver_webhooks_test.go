@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+)
+
+// webhookReceiver は受信したリクエストを記録し、指定した回数だけ500を返す
+type webhookReceiver struct {
+	mu       sync.Mutex
+	failures int
+	bodies   [][]byte
+	headers  []http.Header
+}
+
+func (rcv *webhookReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	rcv.mu.Lock()
+	defer rcv.mu.Unlock()
+	rcv.bodies = append(rcv.bodies, body)
+	rcv.headers = append(rcv.headers, r.Header.Clone())
+	if rcv.failures > 0 {
+		rcv.failures--
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type webhookFixture struct {
+	circle       *domain.Circle
+	webhook      *RegisterCircleWebhookOutput
+	clock        *domain.FixedClock
+	enqueue      *EnqueueWebhookDeliveriesUseCase
+	deliver      *DeliverWebhooksUseCase
+	listDelivery *ListWebhookDeliveriesUseCase
+}
+
+// setupWebhookFixture は公開アドレスで登録した Webhook の送信先を url に置き換える
+// テストの受信サーバーはループバックのため、登録時の検査を通さずに保存し、送信側でループバックを許可する
+func setupWebhookFixture(t *testing.T, url string) *webhookFixture {
+	t.Helper()
+
+	userRepo := infrastructure.NewMemoryUserRepository()
+	circleRepo := infrastructure.NewMemoryCircleRepository()
+	webhookRepo := infrastructure.NewMemoryWebhookRepository()
+	deliveryRepo := infrastructure.NewMemoryWebhookDeliveryRepository()
+	clock := domain.NewFixedClock(time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC))
+	circle := setupCircleWithMembers(t, userRepo, circleRepo, 1, 0)
+
+	webhook, err := NewRegisterCircleWebhookUseCase(circleRepo, webhookRepo, clock, newTestAuditLog()).Execute(RegisterCircleWebhookInput{
+		Actor:    domain.NewUserPrincipal(circle.OwnerID(), false),
+		CircleID: circle.ID().Value(),
+		URL:      "https://example.com/hooks",
+		Events:   []string{"circle.member_joined"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to register webhook: %v", err)
+	}
+	webhookID, _ := domain.ReconstructWebhookID(webhook.WebhookID)
+	registered, _ := webhookRepo.FindByID(webhookID)
+	moved := domain.ReconstructWebhookSubscription(registered.ID(), registered.CircleID(), url, registered.Events(), registered.Secret(), registered.CreatedAt())
+	if err := webhookRepo.Save(moved); err != nil {
+		t.Fatalf("Failed to save webhook: %v", err)
+	}
+
+	return &webhookFixture{
+		circle:       circle,
+		webhook:      webhook,
+		clock:        clock,
+		enqueue:      NewEnqueueWebhookDeliveriesUseCase(webhookRepo, deliveryRepo, clock),
+		deliver:      NewDeliverWebhooksUseCase(webhookRepo, deliveryRepo, infrastructure.NewHTTPWebhookSender(time.Second, netip.MustParsePrefix("127.0.0.0/8")), domain.DefaultWebhookRetryPolicy(), clock),
+		listDelivery: NewListWebhookDeliveriesUseCase(circleRepo, webhookRepo, deliveryRepo),
+	}
+}
+
+func (f *webhookFixture) memberEvent(eventName string) EnqueueWebhookDeliveriesInput {
+	return EnqueueWebhookDeliveriesInput{
+		EventName:  eventName,
+		CircleID:   f.circle.ID().Value(),
+		UserID:     f.circle.GetMemberIDs()[0].Value(),
+		OccurredAt: f.clock.Now(),
+	}
+}
+
+func (f *webhookFixture) deliveries(t *testing.T) []*WebhookDeliveryOutput {
+	t.Helper()
+
+	output, err := f.listDelivery.Execute(ListWebhookDeliveriesInput{
+		Actor:     adminActor(),
+		CircleID:  f.circle.ID().Value(),
+		WebhookID: f.webhook.WebhookID,
+	})
+	if err != nil {
+		t.Fatalf("Failed to list deliveries: %v", err)
+	}
+	return output.Deliveries
+}
+
+func TestEnqueueWebhookDeliveriesUseCase_Execute_SubscribedEventsOnlyOnce(t *testing.T) {
+	// Arrange
+	fixture := setupWebhookFixture(t, "https://example.com/hooks")
+
+	tests := []struct {
+		name     string
+		input    EnqueueWebhookDeliveriesInput
+		expected int
+	}{
+		{"購読しているイベントは配信待ちにする", fixture.memberEvent("circle.member_joined"), 1},
+		{"同じイベントの再配信は重複させない", fixture.memberEvent("circle.member_joined"), 0},
+		{"購読していないイベントは無視する", fixture.memberEvent("circle.member_left"), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			output, err := fixture.enqueue.Execute(tt.input)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if output.Enqueued != tt.expected {
+				t.Errorf("Expected %d enqueued, but got %d", tt.expected, output.Enqueued)
+			}
+		})
+	}
+	if deliveries := fixture.deliveries(t); len(deliveries) != 1 {
+		t.Errorf("Expected 1 delivery in the log, but got %d", len(deliveries))
+	}
+}
+
+func TestDeliverWebhooksUseCase_Execute_SignsAndRetriesUntilSuccess(t *testing.T) {
+	// Arrange
+	receiver := &webhookReceiver{failures: 1}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+	fixture := setupWebhookFixture(t, server.URL)
+	if _, err := fixture.enqueue.Execute(fixture.memberEvent("circle.member_joined")); err != nil {
+		t.Fatalf("Failed to enqueue delivery: %v", err)
+	}
+
+	steps := []struct {
+		name             string
+		advance          time.Duration
+		expectedRequests int
+		expectedStatus   string
+		expectedAttempts int
+	}{
+		{"1回目は500で再試行待ちになる", 0, 1, "pending", 1},
+		{"再試行時刻前は送信しない", 5 * time.Second, 1, "pending", 1},
+		{"10秒後の再試行で成功する", 5 * time.Second, 2, "succeeded", 2},
+		{"成功後は送信しない", time.Hour, 2, "succeeded", 2},
+	}
+
+	for _, step := range steps {
+		// Act
+		fixture.clock.Advance(step.advance)
+		if _, err := fixture.deliver.Execute(); err != nil {
+			t.Fatalf("%s: expected no error, but got: %v", step.name, err)
+		}
+
+		// Assert
+		delivery := fixture.deliveries(t)[0]
+		if len(receiver.bodies) != step.expectedRequests {
+			t.Errorf("%s: expected %d requests, but got %d", step.name, step.expectedRequests, len(receiver.bodies))
+		}
+		if delivery.Status != step.expectedStatus || delivery.Attempts != step.expectedAttempts {
+			t.Errorf("%s: expected %s after %d attempts, but got %s after %d",
+				step.name, step.expectedStatus, step.expectedAttempts, delivery.Status, delivery.Attempts)
+		}
+	}
+
+	// 受信側と同じ方法で署名を検証する
+	mac := hmac.New(sha256.New, []byte(fixture.webhook.Secret))
+	mac.Write(receiver.bodies[1])
+	if receiver.headers[1].Get("X-Webhook-Signature") != hex.EncodeToString(mac.Sum(nil)) {
+		t.Error("Expected the signature to match the HMAC-SHA256 of the body")
+	}
+	if receiver.headers[0].Get("X-Webhook-Delivery") != receiver.headers[1].Get("X-Webhook-Delivery") ||
+		string(receiver.bodies[0]) != string(receiver.bodies[1]) {
+		t.Error("Expected retries to resend the same delivery")
+	}
+	var payload struct {
+		Event string `json:"event"`
+		Data  struct {
+			CircleID string `json:"circleId"`
+			UserID   string `json:"userId"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(receiver.bodies[1], &payload); err != nil {
+		t.Fatalf("Expected a JSON payload, but got: %s", receiver.bodies[1])
+	}
+	if payload.Event != "circle.member_joined" || payload.Data.CircleID != fixture.circle.ID().Value() {
+		t.Errorf("Unexpected payload: %s", receiver.bodies[1])
+	}
+	if delivery := fixture.deliveries(t)[0]; delivery.ResponseStatus != http.StatusNoContent || delivery.LastError != "" {
+		t.Errorf("Expected the log to record 204 without error, but got %d %q", delivery.ResponseStatus, delivery.LastError)
+	}
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type DowngradeSubscriptionInput struct {
+	Actor     *domain.Principal // 本人か users:write が必要
+	RequestID string            // 監査ログに記録するリクエストID
+	UserID    string
+	Plan      string
+}
+
+type DowngradeSubscriptionUseCase struct {
+	userRepository domain.UserRepository
+	clock          domain.Clock
+	auditLog       *AuditLog
+}
+
+func NewDowngradeSubscriptionUseCase(userRepository domain.UserRepository, clock domain.Clock, auditLog *AuditLog) *DowngradeSubscriptionUseCase {
+	return &DowngradeSubscriptionUseCase{
+		userRepository: userRepository,
+		clock:          clock,
+		auditLog:       auditLog,
+	}
+}
+
+func (uc *DowngradeSubscriptionUseCase) Execute(input DowngradeSubscriptionInput) (*SubscriptionOutput, error) {
+	if _, err := authorizeUser(input.Actor, domain.ActionUpdateUser, input.UserID); err != nil {
+		return nil, err
+	}
+
+	plan, err := domain.ParseSubscriptionPlan(input.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := findUser(uc.userRepository, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := domain.UserAuditSnapshot(user)
+	if err := user.DowngradeSubscription(plan, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.userRepository.Save(user); err != nil {
+		return nil, err
+	}
+
+	changes := domain.DiffAuditSnapshots(before, domain.UserAuditSnapshot(user))
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditSubscriptionDowngraded, domain.UserAuditTarget(user.ID()), changes); err != nil {
+		return nil, err
+	}
+
+	return NewSubscriptionOutput(user, uc.clock.Now()), nil
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EnqueueWebhookDeliveriesInput はサークルのメンバー変更イベント
+type EnqueueWebhookDeliveriesInput struct {
+	EventName  string
+	CircleID   string
+	UserID     string
+	OccurredAt time.Time
+}
+
+type EnqueueWebhookDeliveriesOutput struct {
+	Enqueued int // 新たに配信待ちにした件数（重複したイベントは数えない）
+}
+
+// webhookPayload は受信側に送るJSON
+type webhookPayload struct {
+	ID         string             `json:"id"`
+	Event      string             `json:"event"`
+	OccurredAt time.Time          `json:"occurredAt"`
+	Data       webhookPayloadData `json:"data"`
+}
+
+type webhookPayloadData struct {
+	CircleID string `json:"circleId"`
+	UserID   string `json:"userId"`
+}
+
+// EnqueueWebhookDeliveriesUseCase はイベントを購読しているWebhookごとに配信を作成する
+// イベントは少なくとも1回届くため、同じイベントからは同じキーを作り重複を防ぐ
+type EnqueueWebhookDeliveriesUseCase struct {
+	webhookRepository  domain.WebhookRepository
+	deliveryRepository domain.WebhookDeliveryRepository
+	clock              domain.Clock
+}
+
+func NewEnqueueWebhookDeliveriesUseCase(
+	webhookRepository domain.WebhookRepository,
+	deliveryRepository domain.WebhookDeliveryRepository,
+	clock domain.Clock,
+) *EnqueueWebhookDeliveriesUseCase {
+	return &EnqueueWebhookDeliveriesUseCase{
+		webhookRepository:  webhookRepository,
+		deliveryRepository: deliveryRepository,
+		clock:              clock,
+	}
+}
+
+func (uc *EnqueueWebhookDeliveriesUseCase) Execute(input EnqueueWebhookDeliveriesInput) (*EnqueueWebhookDeliveriesOutput, error) {
+	circleID, err := domain.ReconstructCircleID(input.CircleID)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks, err := uc.webhookRepository.FindByCircleID(circleID)
+	if err != nil {
+		return nil, err
+	}
+
+	eventKey := webhookEventKey(input)
+	payload, err := json.Marshal(webhookPayload{
+		ID:         eventKey,
+		Event:      input.EventName,
+		OccurredAt: input.OccurredAt.UTC(),
+		Data:       webhookPayloadData{CircleID: input.CircleID, UserID: input.UserID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := &EnqueueWebhookDeliveriesOutput{}
+	for _, webhook := range webhooks {
+		if !webhook.Subscribes(input.EventName) {
+			continue
+		}
+
+		existing, err := uc.deliveryRepository.FindByEventKey(webhook.ID(), eventKey)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			continue
+		}
+
+		delivery, err := domain.NewWebhookDelivery(webhook, input.EventName, eventKey, payload, uc.clock.Now())
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.deliveryRepository.Save(delivery); err != nil {
+			return nil, err
+		}
+		output.Enqueued++
+	}
+
+	return output, nil
+}
+
+// webhookEventKey はイベントの内容から決まるキーを返す
+func webhookEventKey(input EnqueueWebhookDeliveriesInput) string {
+	name := input.EventName + "|" + input.CircleID + "|" + input.UserID + "|" +
+		strconv.FormatInt(input.OccurredAt.UnixNano(), 10)
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(name)).String()
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+// CalendarEntryOutput はカレンダーに載せるイベント1件（所属サークル名付き）
+type CalendarEntryOutput struct {
+	CircleName string
+	Event      *CircleEventOutput
+}
+
+// CalendarOutput はカレンダー配信の内容（取り消し済みのイベントも含む）
+type CalendarOutput struct {
+	Name        string
+	GeneratedAt time.Time
+	Entries     []*CalendarEntryOutput
+}
+
+type ExportCircleCalendarInput struct {
+	Actor    *domain.Principal // 匿名でも取得できる（API キーの場合は circles:read が必要）
+	CircleID string
+}
+
+type ExportCircleCalendarUseCase struct {
+	circleRepository domain.CircleRepository
+	eventRepository  domain.CircleEventRepository
+	clock            domain.Clock
+}
+
+func NewExportCircleCalendarUseCase(
+	circleRepository domain.CircleRepository,
+	eventRepository domain.CircleEventRepository,
+	clock domain.Clock,
+) *ExportCircleCalendarUseCase {
+	return &ExportCircleCalendarUseCase{
+		circleRepository: circleRepository,
+		eventRepository:  eventRepository,
+		clock:            clock,
+	}
+}
+
+func (uc *ExportCircleCalendarUseCase) Execute(input ExportCircleCalendarInput) (*CalendarOutput, error) {
+	circle, err := findCircle(uc.circleRepository, input.CircleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeCircle(input.Actor, domain.ActionViewCircle, circle); err != nil {
+		return nil, err
+	}
+
+	events, err := uc.eventRepository.FindByCircleID(circle.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*CalendarEntryOutput, 0, len(events))
+	for _, event := range events {
+		entries = append(entries, &CalendarEntryOutput{
+			CircleName: circle.Name().Value(),
+			Event:      NewCircleEventOutput(event),
+		})
+	}
+
+	return &CalendarOutput{
+		Name:        circle.Name().Value(),
+		GeneratedAt: uc.clock.Now(),
+		Entries:     entries,
+	}, nil
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"sort"
+)
+
+type ExportUserCalendarInput struct {
+	Actor     *domain.Principal // 本人または users:read が必要（FeedToken を指定した場合は不要）
+	UserID    string
+	FeedToken string // カレンダーアプリが購読 URL で送るフィードトークン
+}
+
+// ExportUserCalendarUseCase はユーザーがオーナーまたはメンバーのサークルのイベントをまとめて配信する
+type ExportUserCalendarUseCase struct {
+	userRepository   domain.UserRepository
+	circleRepository domain.CircleRepository
+	eventRepository  domain.CircleEventRepository
+	feedRepository   domain.CalendarFeedTokenRepository
+	clock            domain.Clock
+}
+
+func NewExportUserCalendarUseCase(
+	userRepository domain.UserRepository,
+	circleRepository domain.CircleRepository,
+	eventRepository domain.CircleEventRepository,
+	feedRepository domain.CalendarFeedTokenRepository,
+	clock domain.Clock,
+) *ExportUserCalendarUseCase {
+	return &ExportUserCalendarUseCase{
+		userRepository:   userRepository,
+		circleRepository: circleRepository,
+		eventRepository:  eventRepository,
+		feedRepository:   feedRepository,
+		clock:            clock,
+	}
+}
+
+func (uc *ExportUserCalendarUseCase) Execute(input ExportUserCalendarInput) (*CalendarOutput, error) {
+	if err := uc.authorize(input); err != nil {
+		return nil, err
+	}
+
+	user, err := findUser(uc.userRepository, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	circles, err := uc.circleRepository.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*CalendarEntryOutput
+	for _, circle := range circles {
+		if !circle.IsParticipant(user.ID()) {
+			continue
+		}
+
+		events, err := uc.eventRepository.FindByCircleID(circle.ID())
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range events {
+			entries = append(entries, &CalendarEntryOutput{
+				CircleName: circle.Name().Value(),
+				Event:      NewCircleEventOutput(event),
+			})
+		}
+	}
+
+	// サークルをまたいで開始時刻順に並べる
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Event.StartsAt.Before(entries[j].Event.StartsAt)
+	})
+
+	return &CalendarOutput{
+		Name:        user.Name().String(),
+		GeneratedAt: uc.clock.Now(),
+		Entries:     entries,
+	}, nil
+}
+
+// authorize はフィードトークンを指定した場合はトークンで、それ以外は actor で認可する
+// カレンダーアプリは認証情報を送れないため、購読 URL にはトークンを含める
+func (uc *ExportUserCalendarUseCase) authorize(input ExportUserCalendarInput) error {
+	if input.FeedToken == "" {
+		_, err := authorizeUser(input.Actor, domain.ActionExportUserCalendar, input.UserID)
+		return err
+	}
+
+	// ユーザーが存在するかどうかを明かさないよう、どの失敗も同じエラーにする
+	invalid := domain.UnauthenticatedError{Reason: "invalid calendar feed token"}
+	userID, err := domain.ReconstructUserID(input.UserID)
+	if err != nil {
+		return invalid
+	}
+	token, err := uc.feedRepository.FindByUserID(userID)
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		return invalid
+	}
+	return token.Authenticate(input.FeedToken)
+}
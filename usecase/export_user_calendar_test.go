@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestExportUserCalendarUseCase_Execute_AggregatesParticipatingCircles(t *testing.T) {
+	// Arrange
+	userRepo := infrastructure.NewMemoryUserRepository()
+	circleRepo := infrastructure.NewMemoryCircleRepository()
+	eventRepo := infrastructure.NewMemoryCircleEventRepository()
+	clock := domain.NewFixedClock(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+	user := saveNewUser(t, userRepo, "calendar")
+
+	saveCircle := func(name string, ownerID *domain.UserID, memberIDs []*domain.UserID) *domain.Circle {
+		circleName, _ := domain.NewCircleName(name)
+		circle := domain.ReconstructCircle(domain.NewCircleID(), circleName, ownerID, memberIDs, nil, time.Now())
+		if err := circleRepo.Save(circle); err != nil {
+			t.Fatalf("Failed to save circle: %v", err)
+		}
+		return circle
+	}
+	owned := saveCircle("主宰サークル", user.ID(), nil)
+	joined := saveCircle("参加サークル", domain.NewUserID(), []*domain.UserID{user.ID()})
+	other := saveCircle("無関係のサークル", domain.NewUserID(), nil)
+
+	create := NewCreateCircleEventUseCase(circleRepo, eventRepo, clock, newTestAuditLog())
+	schedule := []struct {
+		circle *domain.Circle
+		title  string
+		offset time.Duration
+	}{
+		{joined, "参加サークルの会", 48 * time.Hour},
+		{owned, "主宰サークルの会", 24 * time.Hour},
+		{other, "無関係の会", 36 * time.Hour},
+	}
+	for _, s := range schedule {
+		startsAt := clock.Now().Add(s.offset)
+		if _, err := create.Execute(CreateCircleEventInput{
+			Actor:    adminActor(),
+			CircleID: s.circle.ID().Value(),
+			Title:    s.title,
+			StartsAt: startsAt,
+			EndsAt:   startsAt.Add(time.Hour),
+		}); err != nil {
+			t.Fatalf("Failed to create event: %v", err)
+		}
+	}
+	useCase := NewExportUserCalendarUseCase(userRepo, circleRepo, eventRepo, infrastructure.NewMemoryCalendarFeedTokenRepository(), clock)
+
+	// Act
+	output, err := useCase.Execute(ExportUserCalendarInput{Actor: adminActor(), UserID: user.ID().Value()})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	expected := []struct{ circle, title string }{
+		{"主宰サークル", "主宰サークルの会"},
+		{"参加サークル", "参加サークルの会"},
+	}
+	if len(output.Entries) != len(expected) {
+		t.Fatalf("Expected %d entries, but got %d", len(expected), len(output.Entries))
+	}
+	for i, e := range expected {
+		entry := output.Entries[i]
+		if entry.CircleName != e.circle || entry.Event.Title != e.title {
+			t.Errorf("Expected entry %d to be %s/%s, but got %s/%s", i, e.circle, e.title, entry.CircleName, entry.Event.Title)
+		}
+	}
+	if !output.GeneratedAt.Equal(clock.Now()) {
+		t.Errorf("Expected generated time %v, but got %v", clock.Now(), output.GeneratedAt)
+	}
+}
+
+func TestExportUserCalendarUseCase_Execute_FeedToken(t *testing.T) {
+	tests := []struct {
+		name      string
+		token     func(issued, reissued string) string
+		userID    func(user *domain.User) string
+		expectErr bool
+	}{
+		{"最新のトークンで取得できる", func(issued, reissued string) string { return reissued }, func(user *domain.User) string { return user.ID().Value() }, false},
+		{"発行し直す前のトークンは使えない", func(issued, reissued string) string { return issued }, func(user *domain.User) string { return user.ID().Value() }, true},
+		{"誤ったトークンは使えない", func(issued, reissued string) string { return "wrong" }, func(user *domain.User) string { return user.ID().Value() }, true},
+		{"他のユーザーのフィードには使えない", func(issued, reissued string) string { return reissued }, func(user *domain.User) string { return domain.NewUserID().Value() }, true},
+		{"トークンも認証情報もなければ取得できない", func(issued, reissued string) string { return "" }, func(user *domain.User) string { return user.ID().Value() }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			userRepo := infrastructure.NewMemoryUserRepository()
+			feedRepo := infrastructure.NewMemoryCalendarFeedTokenRepository()
+			clock := domain.NewFixedClock(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+			user := saveNewUser(t, userRepo, "feed")
+			issue := NewIssueCalendarFeedTokenUseCase(userRepo, feedRepo, clock, newTestAuditLog())
+			first, err := issue.Execute(IssueCalendarFeedTokenInput{Actor: userActor(t, user.ID().Value()), UserID: user.ID().Value()})
+			if err != nil {
+				t.Fatalf("Failed to issue token: %v", err)
+			}
+			second, err := issue.Execute(IssueCalendarFeedTokenInput{Actor: userActor(t, user.ID().Value()), UserID: user.ID().Value()})
+			if err != nil {
+				t.Fatalf("Failed to reissue token: %v", err)
+			}
+			useCase := NewExportUserCalendarUseCase(userRepo, infrastructure.NewMemoryCircleRepository(), infrastructure.NewMemoryCircleEventRepository(), feedRepo, clock)
+
+			// Act
+			output, err := useCase.Execute(ExportUserCalendarInput{UserID: tt.userID(user), FeedToken: tt.token(first.Token, second.Token)})
+
+			// Assert
+			if tt.expectErr {
+				var unauthenticated domain.UnauthenticatedError
+				if !errors.As(err, &unauthenticated) {
+					t.Fatalf("Expected UnauthenticatedError, but got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if output.Name != user.Name().String() {
+				t.Errorf("Expected calendar name %s, but got %s", user.Name().String(), output.Name)
+			}
+		})
+	}
+}
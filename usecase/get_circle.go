@@ -6,6 +6,7 @@ import (
 )
 
 type GetCircleInput struct {
+	Actor    *domain.Principal // 匿名でも取得できる（API キーの場合は circles:read が必要）
 	CircleID string
 }
 
@@ -19,31 +20,34 @@ type GetCircleOutput struct {
 }
 
 type GetCircleUseCase struct {
-	circleRepository domain.CircleRepository
-	userRepository   domain.UserRepository
+	circleRepository    domain.CircleRepository
+	userRepository      domain.UserRepository
+	circleMemberService *domain.CircleMemberService
+	clock               domain.Clock
 }
 
-func NewGetCircleUseCase(circleRepository domain.CircleRepository, userRepository domain.UserRepository) *GetCircleUseCase {
+func NewGetCircleUseCase(
+	circleRepository domain.CircleRepository,
+	userRepository domain.UserRepository,
+	circleMemberService *domain.CircleMemberService,
+	clock domain.Clock,
+) *GetCircleUseCase {
 	return &GetCircleUseCase{
-		circleRepository: circleRepository,
-		userRepository:   userRepository,
+		circleRepository:    circleRepository,
+		userRepository:      userRepository,
+		circleMemberService: circleMemberService,
+		clock:               clock,
 	}
 }
 
 func (uc *GetCircleUseCase) Execute(input GetCircleInput) (*GetCircleOutput, error) {
-	// CircleIDを再構成
-	circleID, err := domain.ReconstructCircleID(input.CircleID)
-	if err != nil {
-		return nil, err
-	}
-
 	// リポジトリからエンティティを取得
-	circle, err := uc.circleRepository.FindByID(circleID)
+	circle, err := findCircle(uc.circleRepository, input.CircleID)
 	if err != nil {
 		return nil, err
 	}
-	if circle == nil {
-		return nil, errors.New("circle not found")
+	if err := authorizeCircle(input.Actor, domain.ActionViewCircle, circle); err != nil {
+		return nil, err
 	}
 
 	// オーナーを取得
@@ -67,9 +71,9 @@ func (uc *GetCircleUseCase) Execute(input GetCircleInput) (*GetCircleOutput, err
 		}
 	}
 
-	// プレミアム制限を考慮した利用可能枠を計算
-	circleMembers := domain.NewCircleMembers(owner, members)
-	memberService := domain.NewCircleMemberService()
+	// サークルに適用される定員ポリシーから利用可能枠を計算
+	circleMembers := domain.NewCircleMembers(owner, members, uc.clock.Now())
+	capacity := uc.circleMemberService.GetCapacity(circle, circleMembers)
 
 	// アウトプットに変換
 	return &GetCircleOutput{
@@ -78,10 +82,27 @@ func (uc *GetCircleUseCase) Execute(input GetCircleInput) (*GetCircleOutput, err
 		OwnerID:        circle.OwnerID().Value(),
 		MemberIDs:      convertUserIDsToStrings(circle.GetMemberIDs()),
 		TotalMembers:   circle.GetTotalParticipants(),
-		AvailableSlots: memberService.GetAvailableSlots(circleMembers),
+		AvailableSlots: circle.GetAvailableSlots(capacity),
 	}, nil
 }
 
+// findCircle はIDからサークルを取得し、存在しない場合は CircleNotFoundError を返す
+func findCircle(circleRepository domain.CircleRepository, id string) (*domain.Circle, error) {
+	circleID, err := domain.ReconstructCircleID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	circle, err := circleRepository.FindByID(circleID)
+	if err != nil {
+		return nil, err
+	}
+	if circle == nil {
+		return nil, domain.CircleNotFoundError{ID: id}
+	}
+	return circle, nil
+}
+
 func convertUserIDsToStrings(userIDs []*domain.UserID) []string {
 	result := make([]string, len(userIDs))
 	for i, userID := range userIDs {
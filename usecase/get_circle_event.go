@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type GetCircleEventInput struct {
+	Actor    *domain.Principal // 匿名でも取得できる（API キーの場合は circles:read が必要）
+	CircleID string
+	EventID  string
+}
+
+type GetCircleEventUseCase struct {
+	circleRepository domain.CircleRepository
+	eventRepository  domain.CircleEventRepository
+}
+
+func NewGetCircleEventUseCase(
+	circleRepository domain.CircleRepository,
+	eventRepository domain.CircleEventRepository,
+) *GetCircleEventUseCase {
+	return &GetCircleEventUseCase{
+		circleRepository: circleRepository,
+		eventRepository:  eventRepository,
+	}
+}
+
+func (uc *GetCircleEventUseCase) Execute(input GetCircleEventInput) (*CircleEventOutput, error) {
+	circle, err := findCircle(uc.circleRepository, input.CircleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeCircle(input.Actor, domain.ActionViewCircle, circle); err != nil {
+		return nil, err
+	}
+
+	event, err := findCircleEvent(uc.eventRepository, circle, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCircleEventOutput(event), nil
+}
+
+// findCircleEvent はサークルのイベントを取得し、存在しない・別サークルの場合は CircleEventNotFoundError を返す
+func findCircleEvent(eventRepository domain.CircleEventRepository, circle *domain.Circle, id string) (*domain.CircleEvent, error) {
+	eventID, err := domain.ReconstructCircleEventID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := eventRepository.FindByID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil || !event.CircleID().Equals(circle.ID()) {
+		return nil, domain.CircleEventNotFoundError{ID: id}
+	}
+	return event, nil
+}
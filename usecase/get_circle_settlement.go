@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type GetCircleSettlementInput struct {
+	Actor    *domain.Principal // オーナー・メンバーか circles:read が必要
+	CircleID string
+}
+
+type MemberBalanceOutput struct {
+	UserID string
+	Net    *MoneyOutput // 正: 受け取る側、負: 支払う側
+}
+
+type SettlementTransferOutput struct {
+	FromUserID string
+	ToUserID   string
+	Amount     *MoneyOutput
+}
+
+type GetCircleSettlementOutput struct {
+	CircleID  string
+	Balances  []*MemberBalanceOutput
+	Transfers []*SettlementTransferOutput
+	IsSettled bool
+}
+
+type GetCircleSettlementUseCase struct {
+	circleRepository  domain.CircleRepository
+	expenseRepository domain.CircleExpenseRepository
+	settlementService *domain.SettlementService
+}
+
+func NewGetCircleSettlementUseCase(
+	circleRepository domain.CircleRepository,
+	expenseRepository domain.CircleExpenseRepository,
+	settlementService *domain.SettlementService,
+) *GetCircleSettlementUseCase {
+	return &GetCircleSettlementUseCase{
+		circleRepository:  circleRepository,
+		expenseRepository: expenseRepository,
+		settlementService: settlementService,
+	}
+}
+
+func (uc *GetCircleSettlementUseCase) Execute(input GetCircleSettlementInput) (*GetCircleSettlementOutput, error) {
+	circle, err := findCircle(uc.circleRepository, input.CircleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeCircle(input.Actor, domain.ActionViewCircleExpenses, circle); err != nil {
+		return nil, err
+	}
+
+	expenses, err := uc.expenseRepository.FindByCircleID(circle.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	settlement, err := uc.settlementService.Settle(circle.ID(), expenses)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]*MemberBalanceOutput, 0, len(settlement.Balances()))
+	for _, balance := range settlement.Balances() {
+		balances = append(balances, &MemberBalanceOutput{
+			UserID: balance.UserID().Value(),
+			Net:    NewMoneyOutput(balance.Net()),
+		})
+	}
+
+	transfers := make([]*SettlementTransferOutput, 0, len(settlement.Transfers()))
+	for _, transfer := range settlement.Transfers() {
+		transfers = append(transfers, &SettlementTransferOutput{
+			FromUserID: transfer.From().Value(),
+			ToUserID:   transfer.To().Value(),
+			Amount:     NewMoneyOutput(transfer.Amount()),
+		})
+	}
+
+	return &GetCircleSettlementOutput{
+		CircleID:  circle.ID().Value(),
+		Balances:  balances,
+		Transfers: transfers,
+		IsSettled: settlement.IsSettled(),
+	}, nil
+}
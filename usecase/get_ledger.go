@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+type GetLedgerInput struct {
+	Actor          *domain.Principal // 本人か users:read が必要
+	UserID         string
+	ReportCurrency string // 指定した場合は各記録をその時点のレートで換算して報告する
+}
+
+type MoneyOutput struct {
+	Amount    int64
+	Currency  string
+	Formatted string
+}
+
+func NewMoneyOutput(money *domain.Money) *MoneyOutput {
+	if money == nil {
+		return nil
+	}
+	return &MoneyOutput{
+		Amount:    money.Amount(),
+		Currency:  money.Currency(),
+		Formatted: money.String(),
+	}
+}
+
+// ConvertedMoneyOutput は換算結果とその根拠（レート・取得元・レートの日付）
+type ConvertedMoneyOutput struct {
+	Amount     *MoneyOutput
+	Rate       string
+	RateSource string
+	RateAsOf   time.Time
+}
+
+func NewConvertedMoneyOutput(converted *domain.ConvertedMoney) *ConvertedMoneyOutput {
+	return &ConvertedMoneyOutput{
+		Amount:     NewMoneyOutput(converted.Money()),
+		Rate:       converted.Rate().Rate(),
+		RateSource: converted.Rate().Source(),
+		RateAsOf:   converted.Rate().AsOf(),
+	}
+}
+
+type LedgerEntryOutput struct {
+	EntryID     string
+	Type        string
+	Amount      *MoneyOutput
+	Converted   *ConvertedMoneyOutput // 報告通貨を指定した場合のみ
+	Description string
+	Reference   string
+	OccurredAt  time.Time
+}
+
+func NewLedgerEntryOutput(entry *domain.LedgerEntry) *LedgerEntryOutput {
+	return &LedgerEntryOutput{
+		EntryID:     entry.ID().Value(),
+		Type:        entry.Type().String(),
+		Amount:      NewMoneyOutput(entry.Amount()),
+		Description: entry.Description(),
+		Reference:   entry.Reference(),
+		OccurredAt:  entry.OccurredAt(),
+	}
+}
+
+type GetLedgerOutput struct {
+	UserID        string
+	Entries       []*LedgerEntryOutput
+	Balance       *MoneyOutput // 記録がない場合、または報告通貨を指定した場合はnil
+	ReportBalance *MoneyOutput // 報告通貨に換算した残高（指定した場合のみ）
+}
+
+type GetLedgerUseCase struct {
+	userRepository   domain.UserRepository
+	ledgerRepository domain.LedgerRepository
+	converter        *domain.CurrencyConverter
+}
+
+func NewGetLedgerUseCase(
+	userRepository domain.UserRepository,
+	ledgerRepository domain.LedgerRepository,
+	converter *domain.CurrencyConverter,
+) *GetLedgerUseCase {
+	return &GetLedgerUseCase{
+		userRepository:   userRepository,
+		ledgerRepository: ledgerRepository,
+		converter:        converter,
+	}
+}
+
+func (uc *GetLedgerUseCase) Execute(input GetLedgerInput) (*GetLedgerOutput, error) {
+	if _, err := authorizeUser(input.Actor, domain.ActionViewUser, input.UserID); err != nil {
+		return nil, err
+	}
+
+	user, err := findUser(uc.userRepository, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	ledger, err := uc.ledgerRepository.FindByUserID(user.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*LedgerEntryOutput, 0, len(ledger.Entries()))
+	for _, entry := range ledger.Entries() {
+		entries = append(entries, NewLedgerEntryOutput(entry))
+	}
+
+	output := &GetLedgerOutput{
+		UserID:  user.ID().Value(),
+		Entries: entries,
+	}
+
+	if input.ReportCurrency != "" {
+		if uc.converter == nil {
+			return nil, domain.InvalidExchangeRateError{Reason: "currency conversion is not configured"}
+		}
+		reportBalance, conversions, err := ledger.BalanceIn(input.ReportCurrency, uc.converter)
+		if err != nil {
+			return nil, err
+		}
+		for i, converted := range conversions {
+			entries[i].Converted = NewConvertedMoneyOutput(converted)
+		}
+		output.ReportBalance = NewMoneyOutput(reportBalance)
+		return output, nil
+	}
+
+	// 通貨が混在する場合は CurrencyMismatchError
+	balance, err := ledger.Balance()
+	if err != nil {
+		return nil, err
+	}
+	output.Balance = NewMoneyOutput(balance)
+	return output, nil
+}
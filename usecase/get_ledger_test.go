@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"testing"
+	"time"
+)
+
+func TestGetLedgerUseCase_Execute_SubscriptionChargeAndPayment(t *testing.T) {
+	// Arrange
+	userRepo := infrastructure.NewMemoryUserRepository()
+	ledgerRepo := infrastructure.NewMemoryLedgerRepository()
+	clock := domain.NewFixedClock(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC))
+	user := setupSubscriptionUser(t, userRepo)
+
+	upgrade := NewUpgradeSubscriptionUseCase(userRepo, ledgerRepo, domain.DefaultPlanPriceList(), clock, newTestAuditLog())
+	if _, err := upgrade.Execute(UpgradeSubscriptionInput{Actor: adminActor(), UserID: user.ID().Value(), Plan: "premium_monthly"}); err != nil {
+		t.Fatalf("Failed to upgrade: %v", err)
+	}
+	payment := NewRecordPaymentUseCase(userRepo, ledgerRepo, clock, newTestAuditLog())
+	if _, err := payment.Execute(RecordPaymentInput{Actor: adminActor(), UserID: user.ID().Value(), Amount: 300, Currency: "JPY"}); err != nil {
+		t.Fatalf("Failed to record payment: %v", err)
+	}
+
+	// Act
+	output, err := NewGetLedgerUseCase(userRepo, ledgerRepo, nil).Execute(GetLedgerInput{Actor: adminActor(), UserID: user.ID().Value()})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if len(output.Entries) != 2 {
+		t.Fatalf("Expected 2 entries, but got %d", len(output.Entries))
+	}
+	if output.Entries[0].Type != "charge" || output.Entries[0].Reference != "subscription:premium_monthly" {
+		t.Errorf("Expected subscription charge, but got %s (%s)", output.Entries[0].Type, output.Entries[0].Reference)
+	}
+	if output.Balance == nil || output.Balance.Amount != 200 || output.Balance.Currency != "JPY" {
+		t.Errorf("Expected balance ¥200, but got %+v", output.Balance)
+	}
+}
+
+func TestGetLedgerUseCase_Execute_TrialIsNotCharged(t *testing.T) {
+	userRepo := infrastructure.NewMemoryUserRepository()
+	ledgerRepo := infrastructure.NewMemoryLedgerRepository()
+	clock := domain.NewFixedClock(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC))
+	user := setupSubscriptionUser(t, userRepo)
+
+	upgrade := NewUpgradeSubscriptionUseCase(userRepo, ledgerRepo, domain.DefaultPlanPriceList(), clock, newTestAuditLog())
+	if _, err := upgrade.Execute(UpgradeSubscriptionInput{Actor: adminActor(), UserID: user.ID().Value(), Plan: "premium_monthly", Trial: true}); err != nil {
+		t.Fatalf("Failed to start trial: %v", err)
+	}
+
+	output, err := NewGetLedgerUseCase(userRepo, ledgerRepo, nil).Execute(GetLedgerInput{Actor: adminActor(), UserID: user.ID().Value()})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if len(output.Entries) != 0 || output.Balance != nil {
+		t.Errorf("Expected empty ledger, but got %d entries", len(output.Entries))
+	}
+}
+
+func TestGetLedgerUseCase_Execute_MixedCurrencies_ReturnsError(t *testing.T) {
+	userRepo := infrastructure.NewMemoryUserRepository()
+	ledgerRepo := infrastructure.NewMemoryLedgerRepository()
+	clock := domain.NewFixedClock(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC))
+	user := setupSubscriptionUser(t, userRepo)
+	payment := NewRecordPaymentUseCase(userRepo, ledgerRepo, clock, newTestAuditLog())
+	payment.Execute(RecordPaymentInput{Actor: adminActor(), UserID: user.ID().Value(), Amount: 300, Currency: "JPY"})
+	payment.Execute(RecordPaymentInput{Actor: adminActor(), UserID: user.ID().Value(), Amount: 300, Currency: "USD"})
+
+	output, err := NewGetLedgerUseCase(userRepo, ledgerRepo, nil).Execute(GetLedgerInput{Actor: adminActor(), UserID: user.ID().Value()})
+
+	if _, ok := err.(domain.CurrencyMismatchError); !ok {
+		t.Errorf("Expected CurrencyMismatchError, but got %T", err)
+	}
+	if output != nil {
+		t.Error("Expected no output")
+	}
+}
+
+func TestGetLedgerUseCase_Execute_ReportCurrency_UsesRateAtEntryDate(t *testing.T) {
+	// Arrange
+	userRepo := infrastructure.NewMemoryUserRepository()
+	ledgerRepo := infrastructure.NewMemoryLedgerRepository()
+	clock := domain.NewFixedClock(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC))
+	user := setupSubscriptionUser(t, userRepo)
+
+	rates := infrastructure.NewStaticExchangeRateProvider()
+	april, _ := domain.NewExchangeRate("USD", "JPY", "150", time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), "ecb")
+	may, _ := domain.NewExchangeRate("USD", "JPY", "140", time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC), "ecb")
+	rates.Add(april)
+	rates.Add(may)
+
+	payment := NewRecordPaymentUseCase(userRepo, ledgerRepo, clock, newTestAuditLog())
+	payment.Execute(RecordPaymentInput{Actor: adminActor(), UserID: user.ID().Value(), Amount: 300, Currency: "JPY"})
+	clock.Set(time.Date(2025, 5, 10, 0, 0, 0, 0, time.UTC))
+	payment.Execute(RecordPaymentInput{Actor: adminActor(), UserID: user.ID().Value(), Amount: 100, Currency: "USD"})
+
+	useCase := NewGetLedgerUseCase(userRepo, ledgerRepo, domain.NewCurrencyConverter(rates, domain.RoundHalfEven))
+
+	// Act
+	output, err := useCase.Execute(GetLedgerInput{Actor: adminActor(), UserID: user.ID().Value(), ReportCurrency: "JPY"})
+
+	// Assert: -300 - $1.00 × 140
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if output.ReportBalance == nil || output.ReportBalance.Amount != -440 || output.ReportBalance.Currency != "JPY" {
+		t.Errorf("Expected report balance ¥-440, but got %+v", output.ReportBalance)
+	}
+	converted := output.Entries[1].Converted
+	if converted == nil || converted.Rate != "140" || converted.RateSource != "ecb" {
+		t.Errorf("Expected conversion at May rate, but got %+v", converted)
+	}
+	if output.Balance != nil {
+		t.Error("Expected no single-currency balance when reporting")
+	}
+}
+
+func TestAddMemberUseCase_Execute_ChargesMembershipDues(t *testing.T) {
+	// Arrange
+	userRepo := infrastructure.NewMemoryUserRepository()
+	circleRepo := infrastructure.NewMemoryCircleRepository()
+	ledgerRepo := infrastructure.NewMemoryLedgerRepository()
+	circle := setupCircleWithMembers(t, userRepo, circleRepo, 1, 0)
+	newUser := saveNewUser(t, userRepo, "newcomer")
+
+	if err := NewChangeCircleDuesUseCase(circleRepo, newTestAuditLog()).Execute(ChangeCircleDuesInput{
+		Actor:    adminActor(),
+		CircleID: circle.ID().Value(),
+		Amount:   1500,
+		Currency: "JPY",
+	}); err != nil {
+		t.Fatalf("Failed to set dues: %v", err)
+	}
+	useCase := NewAddMemberUseCase(circleRepo, userRepo, ledgerRepo, domain.NewCircleMemberService(nil), false, domain.SystemClock{}, newTestAuditLog())
+
+	// Act
+	err := useCase.Execute(AddMemberInput{Actor: adminActor(), CircleID: circle.ID().Value(), UserID: newUser.ID().Value()})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	ledger, _ := ledgerRepo.FindByUserID(newUser.ID())
+	balance, _ := ledger.Balance()
+	if balance == nil || balance.Amount() != 1500 {
+		t.Errorf("Expected dues ¥1500 to be charged, but got %v", balance)
+	}
+}
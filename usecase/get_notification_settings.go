@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+type GetNotificationSettingsInput struct {
+	Actor  *domain.Principal // 本人か users:read が必要
+	UserID string
+}
+
+type QuietHoursOutput struct {
+	Start string // HH:MM
+	End   string
+}
+
+type NotificationSettingsOutput struct {
+	UserID       string
+	Locale       string
+	TimeZone     string
+	Channels     map[string]string // 通知の種類 → immediate / digest / off
+	QuietHours   *QuietHoursOutput // nil はおやすみ時間なし
+	DigestTime   string            // HH:MM（ユーザーのタイムゾーン）
+	NextDigestAt time.Time
+	UpdatedAt    *time.Time // 未設定の場合 nil
+}
+
+func NewNotificationSettingsOutput(preferences *domain.NotificationPreferences, now time.Time) *NotificationSettingsOutput {
+	channels := make(map[string]string)
+	for kind, channel := range preferences.Channels() {
+		channels[kind.String()] = channel.String()
+	}
+	output := &NotificationSettingsOutput{
+		UserID:       preferences.UserID().Value(),
+		Locale:       preferences.Locale().String(),
+		TimeZone:     preferences.TimeZone().String(),
+		Channels:     channels,
+		DigestTime:   preferences.DigestAt().String(),
+		NextDigestAt: preferences.NextDigestAt(now),
+		UpdatedAt:    optionalTime(preferences.UpdatedAt()),
+	}
+	if quietHours := preferences.QuietHours(); quietHours != nil {
+		output.QuietHours = &QuietHoursOutput{
+			Start: quietHours.Start().String(),
+			End:   quietHours.End().String(),
+		}
+	}
+	return output
+}
+
+type GetNotificationSettingsUseCase struct {
+	userRepository        domain.UserRepository
+	preferencesRepository domain.NotificationPreferencesRepository
+	defaultLocale         domain.Locale
+	clock                 domain.Clock
+}
+
+func NewGetNotificationSettingsUseCase(
+	userRepository domain.UserRepository,
+	preferencesRepository domain.NotificationPreferencesRepository,
+	defaultLocale domain.Locale,
+	clock domain.Clock,
+) *GetNotificationSettingsUseCase {
+	return &GetNotificationSettingsUseCase{
+		userRepository:        userRepository,
+		preferencesRepository: preferencesRepository,
+		defaultLocale:         defaultLocale,
+		clock:                 clock,
+	}
+}
+
+func (uc *GetNotificationSettingsUseCase) Execute(input GetNotificationSettingsInput) (*NotificationSettingsOutput, error) {
+	if _, err := authorizeUser(input.Actor, domain.ActionViewUser, input.UserID); err != nil {
+		return nil, err
+	}
+
+	user, err := findUser(uc.userRepository, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := findNotificationPreferences(uc.preferencesRepository, user.ID(), uc.defaultLocale)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewNotificationSettingsOutput(preferences, uc.clock.Now()), nil
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type PublicSigningKeyOutput struct {
+	KeyID     string
+	Algorithm string
+	PublicKey []byte
+}
+
+type GetPublicSigningKeysOutput struct {
+	Keys []PublicSigningKeyOutput
+}
+
+// GetPublicSigningKeysUseCase はアクセストークンを検証するための公開鍵を返す
+type GetPublicSigningKeysUseCase struct {
+	accessTokenCodec domain.AccessTokenCodec
+}
+
+func NewGetPublicSigningKeysUseCase(accessTokenCodec domain.AccessTokenCodec) *GetPublicSigningKeysUseCase {
+	return &GetPublicSigningKeysUseCase{
+		accessTokenCodec: accessTokenCodec,
+	}
+}
+
+func (uc *GetPublicSigningKeysUseCase) Execute() (*GetPublicSigningKeysOutput, error) {
+	keys := make([]PublicSigningKeyOutput, 0)
+	for _, key := range uc.accessTokenCodec.PublicKeys() {
+		keys = append(keys, PublicSigningKeyOutput{
+			KeyID:     key.KeyID,
+			Algorithm: key.Algorithm,
+			PublicKey: key.PublicKey,
+		})
+	}
+	return &GetPublicSigningKeysOutput{Keys: keys}, nil
+}
@@ -5,6 +5,10 @@ import (
 	"time"
 )
 
+type GetRecommendedCirclesInput struct {
+	Actor *domain.Principal // 匿名でも取得できる（API キーの場合は circles:read が必要）
+}
+
 type GetRecommendedCirclesOutput struct {
 	Circles []RecommendedCircleInfo
 }
@@ -30,7 +34,11 @@ func NewGetRecommendedCirclesUseCase(
 	}
 }
 
-func (uc *GetRecommendedCirclesUseCase) Execute() (*GetRecommendedCirclesOutput, error) {
+func (uc *GetRecommendedCirclesUseCase) Execute(input GetRecommendedCirclesInput) (*GetRecommendedCirclesOutput, error) {
+	if err := domain.Authorize(input.Actor, domain.ActionRecommendCircles, domain.Resource{}); err != nil {
+		return nil, err
+	}
+
 	// おすすめサークルサービスを作成
 	recommendationService := domain.NewCircleRecommendationService(time.Now())
 
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type GetShipmentInput struct {
+	Actor      *domain.Principal // 受取人本人か shipments:read が必要
+	ShipmentID string
+}
+
+type GetShipmentUseCase struct {
+	shipmentRepository domain.ShipmentRepository
+}
+
+func NewGetShipmentUseCase(shipmentRepository domain.ShipmentRepository) *GetShipmentUseCase {
+	return &GetShipmentUseCase{
+		shipmentRepository: shipmentRepository,
+	}
+}
+
+func (uc *GetShipmentUseCase) Execute(input GetShipmentInput) (*ShipmentOutput, error) {
+	shipment, err := findShipment(uc.shipmentRepository, input.ShipmentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := domain.Authorize(input.Actor, domain.ActionViewShipment, domain.Resource{UserID: shipment.RecipientID()}); err != nil {
+		return nil, err
+	}
+
+	return NewShipmentOutput(shipment), nil
+}
+
+// findShipment はIDから発送を取得し、存在しない場合は ShipmentNotFoundError を返す
+func findShipment(shipmentRepository domain.ShipmentRepository, id string) (*domain.Shipment, error) {
+	shipmentID, err := domain.ReconstructShipmentID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	shipment, err := shipmentRepository.FindByID(shipmentID)
+	if err != nil {
+		return nil, err
+	}
+	if shipment == nil {
+		return nil, domain.ShipmentNotFoundError{ID: id}
+	}
+	return shipment, nil
+}
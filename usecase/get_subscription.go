@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+type GetSubscriptionInput struct {
+	Actor  *domain.Principal // 本人か users:read が必要
+	UserID string
+}
+
+type SubscriptionOutput struct {
+	UserID      string
+	Plan        string
+	IsPremium   bool
+	IsTrial     bool
+	IsCancelled bool
+	StartedAt   *time.Time
+	ExpiresAt   *time.Time
+}
+
+// NewSubscriptionOutput は now 時点の契約状態を返す
+func NewSubscriptionOutput(user *domain.User, now time.Time) *SubscriptionOutput {
+	subscription := user.Subscription()
+	return &SubscriptionOutput{
+		UserID:      user.ID().Value(),
+		Plan:        subscription.Plan().String(),
+		IsPremium:   user.IsPremiumAt(now),
+		IsTrial:     subscription.IsTrial(),
+		IsCancelled: subscription.IsCancelled(),
+		StartedAt:   optionalTime(subscription.StartedAt()),
+		ExpiresAt:   optionalTime(subscription.ExpiresAt()),
+	}
+}
+
+type GetSubscriptionUseCase struct {
+	userRepository domain.UserRepository
+	clock          domain.Clock
+}
+
+func NewGetSubscriptionUseCase(userRepository domain.UserRepository, clock domain.Clock) *GetSubscriptionUseCase {
+	return &GetSubscriptionUseCase{
+		userRepository: userRepository,
+		clock:          clock,
+	}
+}
+
+func (uc *GetSubscriptionUseCase) Execute(input GetSubscriptionInput) (*SubscriptionOutput, error) {
+	if _, err := authorizeUser(input.Actor, domain.ActionViewUser, input.UserID); err != nil {
+		return nil, err
+	}
+
+	user, err := findUser(uc.userRepository, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSubscriptionOutput(user, uc.clock.Now()), nil
+}
+
+// findUser はIDからユーザーを取得し、存在しない場合は UserNotFoundError を返す
+func findUser(userRepository domain.UserRepository, id string) (*domain.User, error) {
+	userID, err := domain.ReconstructUserID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := userRepository.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domain.UserNotFoundError{ID: id}
+	}
+	return user, nil
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
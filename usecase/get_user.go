@@ -2,40 +2,50 @@ package usecase
 
 import (
 	"ddd-bottomup/domain"
+	"time"
 )
 
 type GetUserInput struct {
+	Actor  *domain.Principal // 本人か users:read が必要
 	UserID string
 }
 
 type GetUserOutput struct {
-	UserID    string
-	FirstName string
-	LastName  string
-	Email     string
+	UserID        string
+	FirstName     string
+	LastName      string
+	Email         string
+	EmailVerified bool
+	PendingEmail  string // 確認待ちの新しいメールアドレス（なければ空）
+	IsPremium     bool
 }
 
-func NewGetUserOutput(user *domain.User) *GetUserOutput {
+func NewGetUserOutput(user *domain.User, now time.Time) *GetUserOutput {
 	return &GetUserOutput{
-		UserID:    user.ID().Value(),
-		FirstName: user.Name().FirstName(),
-		LastName:  user.Name().LastName(),
-		Email:     user.Email().Value(),
+		UserID:        user.ID().Value(),
+		FirstName:     user.Name().FirstName(),
+		LastName:      user.Name().LastName(),
+		Email:         user.Email().Value(),
+		EmailVerified: user.IsEmailVerified(),
+		PendingEmail:  pendingEmailValue(user),
+		IsPremium:     user.IsPremiumAt(now),
 	}
 }
 
 type GetUserUseCase struct {
 	userRepository domain.UserRepository
+	clock          domain.Clock
 }
 
-func NewGetUserUseCase(userRepository domain.UserRepository) *GetUserUseCase {
+func NewGetUserUseCase(userRepository domain.UserRepository, clock domain.Clock) *GetUserUseCase {
 	return &GetUserUseCase{
 		userRepository: userRepository,
+		clock:          clock,
 	}
 }
 
 func (uc *GetUserUseCase) Execute(input GetUserInput) (*GetUserOutput, error) {
-	userID, err := domain.ReconstructUserID(input.UserID)
+	userID, err := authorizeUser(input.Actor, domain.ActionViewUser, input.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -49,5 +59,5 @@ func (uc *GetUserUseCase) Execute(input GetUserInput) (*GetUserOutput, error) {
 		return nil, domain.UserNotFoundError{ID: input.UserID}
 	}
 
-	return NewGetUserOutput(user), nil
+	return NewGetUserOutput(user, uc.clock.Now()), nil
 }
@@ -4,6 +4,7 @@ import (
 	"ddd-bottomup/domain"
 	"ddd-bottomup/infrastructure"
 	"testing"
+	"time"
 )
 
 func TestGetUserUseCase_Execute_Success(t *testing.T) {
@@ -13,14 +14,14 @@ func TestGetUserUseCase_Execute_Success(t *testing.T) {
 	// テスト用のユーザーを作成・保存
 	fullName, _ := domain.NewFullName("太郎", "田中")
 	email, _ := domain.NewEmail("taro@example.com")
-	user := domain.NewUser(fullName, email, false)
+	user := domain.NewUser(fullName, email, time.Now())
 	err := repo.Save(user)
 	if err != nil {
 		t.Fatalf("Failed to save test user: %v", err)
 	}
 
-	useCase := NewGetUserUseCase(repo)
-	input := GetUserInput{UserID: user.ID().Value()}
+	useCase := NewGetUserUseCase(repo, domain.SystemClock{})
+	input := GetUserInput{Actor: adminActor(), UserID: user.ID().Value()}
 
 	// Act
 	output, err := useCase.Execute(input)
@@ -50,11 +51,11 @@ func TestGetUserUseCase_Execute_Success(t *testing.T) {
 func TestGetUserUseCase_Execute_UserNotFound(t *testing.T) {
 	// Arrange
 	repo := infrastructure.NewMemoryUserRepository()
-	useCase := NewGetUserUseCase(repo)
+	useCase := NewGetUserUseCase(repo, domain.SystemClock{})
 
 	// 存在しないUserIDを使用
 	nonExistentID := domain.NewUserID()
-	input := GetUserInput{UserID: nonExistentID.Value()}
+	input := GetUserInput{Actor: adminActor(), UserID: nonExistentID.Value()}
 
 	// Act
 	output, err := useCase.Execute(input)
@@ -77,7 +78,7 @@ func TestGetUserUseCase_Execute_UserNotFound(t *testing.T) {
 func TestGetUserUseCase_Execute_InvalidUserID(t *testing.T) {
 	// Arrange
 	repo := infrastructure.NewMemoryUserRepository()
-	useCase := NewGetUserUseCase(repo)
+	useCase := NewGetUserUseCase(repo, domain.SystemClock{})
 
 	testCases := []struct {
 		name   string
@@ -90,7 +91,7 @@ func TestGetUserUseCase_Execute_InvalidUserID(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			input := GetUserInput{UserID: tc.userID}
+			input := GetUserInput{Actor: adminActor(), UserID: tc.userID}
 
 			// Act
 			output, err := useCase.Execute(input)
@@ -111,8 +112,8 @@ func TestGetUserUseCase_Execute_MultipleUsers(t *testing.T) {
 	// Arrange
 	repo := infrastructure.NewMemoryUserRepository()
 	userExistenceService := domain.NewUserExistenceService(repo)
-	createUseCase := NewCreateUserUseCase(repo, userExistenceService)
-	getUserUseCase := NewGetUserUseCase(repo)
+	createUseCase := NewCreateUserUseCase(repo, userExistenceService, infrastructure.NewMemoryCredentialRepository(), newTestPasswordHasher(), domain.SystemClock{}, newTestAuditLog())
+	getUserUseCase := NewGetUserUseCase(repo, domain.SystemClock{})
 
 	// 複数ユーザーを作成
 	users := []CreateUserInput{
@@ -132,7 +133,7 @@ func TestGetUserUseCase_Execute_MultipleUsers(t *testing.T) {
 
 	// Act & Assert - 各ユーザーを取得
 	for i, userID := range createdUserIDs {
-		input := GetUserInput{UserID: userID}
+		input := GetUserInput{Actor: adminActor(), UserID: userID}
 		output, err := getUserUseCase.Execute(input)
 
 		if err != nil {
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type GrantComplimentarySubscriptionInput struct {
+	Actor     *domain.Principal // billing:write が必要（本人は付与できない）
+	RequestID string            // 監査ログに記録するリクエストID
+	UserID    string
+}
+
+// GrantComplimentarySubscriptionUseCase は管理者が期限なしのプレミアム契約を付与する
+// 新規登録したユーザーは無料プランで始まるため、招待や補償などの無償提供はこのユースケースで行う
+type GrantComplimentarySubscriptionUseCase struct {
+	userRepository domain.UserRepository
+	clock          domain.Clock
+	auditLog       *AuditLog
+}
+
+func NewGrantComplimentarySubscriptionUseCase(userRepository domain.UserRepository, clock domain.Clock, auditLog *AuditLog) *GrantComplimentarySubscriptionUseCase {
+	return &GrantComplimentarySubscriptionUseCase{
+		userRepository: userRepository,
+		clock:          clock,
+		auditLog:       auditLog,
+	}
+}
+
+func (uc *GrantComplimentarySubscriptionUseCase) Execute(input GrantComplimentarySubscriptionInput) (*SubscriptionOutput, error) {
+	if _, err := authorizeUser(input.Actor, domain.ActionGrantSubscription, input.UserID); err != nil {
+		return nil, err
+	}
+
+	user, err := findUser(uc.userRepository, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := domain.UserAuditSnapshot(user)
+	if err := user.GrantComplimentarySubscription(uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.userRepository.Save(user); err != nil {
+		return nil, err
+	}
+
+	changes := domain.DiffAuditSnapshots(before, domain.UserAuditSnapshot(user))
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditSubscriptionGranted, domain.UserAuditTarget(user.ID()), changes); err != nil {
+		return nil, err
+	}
+
+	return NewSubscriptionOutput(user, uc.clock.Now()), nil
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"errors"
+	"time"
+)
+
+type HandlePaymentWebhookInput struct {
+	Payload   []byte
+	Signature string
+}
+
+type HandlePaymentWebhookOutput struct {
+	EventID string
+	Type    string
+	Applied bool // 台帳に反映した場合true（重複・対象外のイベントはfalse）
+}
+
+type HandlePaymentWebhookUseCase struct {
+	paymentGateway   domain.PaymentGateway
+	ledgerRepository domain.LedgerRepository
+}
+
+func NewHandlePaymentWebhookUseCase(paymentGateway domain.PaymentGateway, ledgerRepository domain.LedgerRepository) *HandlePaymentWebhookUseCase {
+	return &HandlePaymentWebhookUseCase{
+		paymentGateway:   paymentGateway,
+		ledgerRepository: ledgerRepository,
+	}
+}
+
+func (uc *HandlePaymentWebhookUseCase) Execute(input HandlePaymentWebhookInput) (*HandlePaymentWebhookOutput, error) {
+	event, err := uc.paymentGateway.VerifyWebhook(input.Payload, input.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &HandlePaymentWebhookOutput{
+		EventID: event.EventID,
+		Type:    string(event.Type),
+	}
+
+	var entry *domain.LedgerEntry
+	switch event.Type {
+	case domain.WebhookChargeSucceeded:
+		entry, err = recordGatewayEntry(
+			uc.ledgerRepository, event.UserID, domain.LedgerEntryPayment, event.Amount,
+			"Payment", domain.PaymentReference(event.ChargeID), event.OccurredAt,
+		)
+	case domain.WebhookRefundSucceeded:
+		entry, err = recordGatewayEntry(
+			uc.ledgerRepository, event.UserID, domain.LedgerEntryRefund, event.Amount,
+			"Refund", domain.RefundReference(event.RefundID), event.OccurredAt,
+		)
+	default:
+		// 決済失敗などは台帳に影響しない
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	output.Applied = entry != nil
+	return output, nil
+}
+
+// recordGatewayEntry は決済事業者の結果を台帳に記録する
+// 同じ参照キーが既に記録済みの場合は何もせず nil を返す（Webhookの再送・重複対策）
+func recordGatewayEntry(
+	ledgerRepository domain.LedgerRepository,
+	userID *domain.UserID,
+	entryType domain.LedgerEntryType,
+	amount *domain.Money,
+	description string,
+	reference string,
+	now time.Time,
+) (*domain.LedgerEntry, error) {
+	ledger, err := ledgerRepository.FindByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var entry *domain.LedgerEntry
+	switch entryType {
+	case domain.LedgerEntryPayment:
+		entry, err = ledger.RecordPayment(amount, description, reference, now)
+	case domain.LedgerEntryRefund:
+		entry, err = ledger.RecordRefund(amount, description, reference, now)
+	default:
+		return nil, domain.InvalidLedgerEntryError{Reason: "unsupported gateway entry type: " + entryType.String()}
+	}
+	if err == nil {
+		// 同時に届いた重複はリポジトリの一意制約で弾かれる
+		err = ledgerRepository.Append(entry)
+	}
+
+	var duplicate domain.DuplicateLedgerEntryError
+	if errors.As(err, &duplicate) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"testing"
+)
+
+func TestHandlePaymentWebhookUseCase_Execute_DuplicateWebhooks_CreditOnce(t *testing.T) {
+	// Arrange
+	f := setupPaymentTest(t)
+	f.gateway.SetDuplicateWebhooks(true)
+	f.gateway.DelayNext()
+	if _, err := f.payBalance.Execute(PayBalanceInput{Actor: adminActor(), UserID: f.user.ID().Value()}); err != nil {
+		t.Fatalf("Failed to pay balance: %v", err)
+	}
+	f.gateway.SettlePending()
+	webhooks := f.gateway.DrainWebhooks()
+	if len(webhooks) != 2 {
+		t.Fatalf("Expected duplicated webhook, but got %d", len(webhooks))
+	}
+
+	// Act: 重複送信に加えて、同じWebhookを再送する
+	applied := 0
+	for _, webhook := range append(webhooks, webhooks[0]) {
+		output, err := f.webhook.Execute(HandlePaymentWebhookInput{Payload: webhook.Payload, Signature: webhook.Signature})
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		if output.Applied {
+			applied++
+		}
+	}
+
+	// Assert
+	if applied != 1 {
+		t.Errorf("Expected webhook to be applied once, but got %d", applied)
+	}
+	if balance := f.balance(t); balance != 0 {
+		t.Errorf("Expected balance 0, but got %d", balance)
+	}
+}
+
+func TestHandlePaymentWebhookUseCase_Execute_InvalidSignature_ReturnsError(t *testing.T) {
+	// Arrange
+	f := setupPaymentTest(t)
+	f.gateway.DelayNext()
+	if _, err := f.payBalance.Execute(PayBalanceInput{Actor: adminActor(), UserID: f.user.ID().Value()}); err != nil {
+		t.Fatalf("Failed to pay balance: %v", err)
+	}
+	f.gateway.SettlePending()
+	webhook := f.gateway.DrainWebhooks()[0]
+
+	tests := []struct {
+		name      string
+		payload   []byte
+		signature string
+	}{
+		{"署名なし", webhook.Payload, ""},
+		{"不正な署名", webhook.Payload, "deadbeef"},
+		{"改ざんされたペイロード", append([]byte(" "), webhook.Payload...), webhook.Signature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			output, err := f.webhook.Execute(HandlePaymentWebhookInput{Payload: tt.payload, Signature: tt.signature})
+
+			// Assert
+			if _, ok := err.(domain.InvalidWebhookSignatureError); !ok {
+				t.Errorf("Expected InvalidWebhookSignatureError, but got %T", err)
+			}
+			if output != nil {
+				t.Error("Expected no output")
+			}
+		})
+	}
+
+	if balance := f.balance(t); balance != 500 {
+		t.Errorf("Expected balance 500, but got %d", balance)
+	}
+}
+
+func TestRefundPaymentUseCase_Execute_RefundWebhookReplayed_CreditsOnce(t *testing.T) {
+	// Arrange
+	f := setupPaymentTest(t)
+	paid, err := f.payBalance.Execute(PayBalanceInput{Actor: adminActor(), UserID: f.user.ID().Value()})
+	if err != nil {
+		t.Fatalf("Failed to pay balance: %v", err)
+	}
+	f.gateway.DrainWebhooks()
+	refund := NewRefundPaymentUseCase(f.userRepo, f.ledgerRepo, f.gateway, f.clock, newTestAuditLog())
+
+	// Act
+	output, err := refund.Execute(RefundPaymentInput{
+		Actor:    adminActor(),
+		UserID:   f.user.ID().Value(),
+		ChargeID: paid.ChargeID,
+		Amount:   200,
+		Currency: "JPY",
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if output.Status != "succeeded" {
+		t.Errorf("Expected succeeded refund, but got %s", output.Status)
+	}
+	if applied := f.deliverWebhooks(t); applied != 0 {
+		t.Errorf("Expected refund webhook not to be applied again, but %d applied", applied)
+	}
+	if balance := f.balance(t); balance != 200 {
+		t.Errorf("Expected balance 200 after refund, but got %d", balance)
+	}
+
+	// 請求額を超える返金は拒否される
+	_, err = refund.Execute(RefundPaymentInput{Actor: adminActor(), UserID: f.user.ID().Value(), ChargeID: paid.ChargeID, Amount: 400, Currency: "JPY"})
+	if _, ok := err.(domain.PaymentDeclinedError); !ok {
+		t.Errorf("Expected PaymentDeclinedError, but got %T", err)
+	}
+}
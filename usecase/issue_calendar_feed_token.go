@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+type IssueCalendarFeedTokenInput struct {
+	Actor     *domain.Principal // 本人または users:write が必要
+	RequestID string            // 監査ログに記録するリクエストID
+	UserID    string
+}
+
+type IssueCalendarFeedTokenOutput struct {
+	UserID   string
+	Token    string // 発行時にのみ返す
+	IssuedAt time.Time
+}
+
+// IssueCalendarFeedTokenUseCase はユーザーのカレンダーフィードを購読するためのトークンを発行する
+// 発行し直すと以前のトークンは使えなくなる（購読 URL が漏れた場合の無効化にも使う）
+type IssueCalendarFeedTokenUseCase struct {
+	userRepository domain.UserRepository
+	feedRepository domain.CalendarFeedTokenRepository
+	clock          domain.Clock
+	auditLog       *AuditLog
+}
+
+func NewIssueCalendarFeedTokenUseCase(
+	userRepository domain.UserRepository,
+	feedRepository domain.CalendarFeedTokenRepository,
+	clock domain.Clock,
+	auditLog *AuditLog,
+) *IssueCalendarFeedTokenUseCase {
+	return &IssueCalendarFeedTokenUseCase{
+		userRepository: userRepository,
+		feedRepository: feedRepository,
+		clock:          clock,
+		auditLog:       auditLog,
+	}
+}
+
+func (uc *IssueCalendarFeedTokenUseCase) Execute(input IssueCalendarFeedTokenInput) (*IssueCalendarFeedTokenOutput, error) {
+	if _, err := authorizeUser(input.Actor, domain.ActionIssueCalendarToken, input.UserID); err != nil {
+		return nil, err
+	}
+
+	user, err := findUser(uc.userRepository, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	token, value, err := domain.NewCalendarFeedToken(user.ID(), uc.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.feedRepository.Save(token); err != nil {
+		return nil, err
+	}
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditCalendarFeedTokenIssued, domain.UserAuditTarget(user.ID()), nil); err != nil {
+		return nil, err
+	}
+
+	return &IssueCalendarFeedTokenOutput{
+		UserID:   user.ID().Value(),
+		Token:    value,
+		IssuedAt: token.IssuedAt(),
+	}, nil
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type ListAPIKeysInput struct {
+	Actor *domain.Principal // api_keys:manage が必要
+}
+
+// ListAPIKeysUseCase は発行済みの API キーを失効したものも含めて返す（キーそのものは返さない）
+type ListAPIKeysUseCase struct {
+	apiKeyRepository domain.APIKeyRepository
+}
+
+func NewListAPIKeysUseCase(apiKeyRepository domain.APIKeyRepository) *ListAPIKeysUseCase {
+	return &ListAPIKeysUseCase{apiKeyRepository: apiKeyRepository}
+}
+
+func (uc *ListAPIKeysUseCase) Execute(input ListAPIKeysInput) ([]*APIKeyOutput, error) {
+	if err := domain.Authorize(input.Actor, domain.ActionManageAPIKeys, domain.Resource{}); err != nil {
+		return nil, err
+	}
+
+	keys, err := uc.apiKeyRepository.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]*APIKeyOutput, len(keys))
+	for i, key := range keys {
+		outputs[i] = NewAPIKeyOutput(key)
+	}
+	return outputs, nil
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+const (
+	DefaultAuditEntryLimit = 100
+	MaxAuditEntryLimit     = 1000
+)
+
+type ListAuditEntriesInput struct {
+	Actor      *domain.Principal // audit:read が必要
+	ActorID    string            // 操作した利用者の UserID または API キーの ID
+	Action     string
+	TargetType string
+	TargetID   string
+	RequestID  string
+	Since      *time.Time
+	Until      *time.Time
+	Limit      int // 0 の場合は DefaultAuditEntryLimit
+}
+
+type AuditChangeOutput struct {
+	Field  string
+	Before string
+	After  string
+}
+
+type AuditEntryOutput struct {
+	ID         string
+	ActorType  string
+	ActorID    string
+	Action     string
+	TargetType string
+	TargetID   string
+	Changes    []AuditChangeOutput
+	RequestID  string
+	OccurredAt time.Time
+}
+
+func NewAuditEntryOutput(entry *domain.AuditEntry) *AuditEntryOutput {
+	changes := make([]AuditChangeOutput, len(entry.Changes()))
+	for i, change := range entry.Changes() {
+		changes[i] = AuditChangeOutput(change)
+	}
+	return &AuditEntryOutput{
+		ID:         entry.ID(),
+		ActorType:  string(entry.ActorType()),
+		ActorID:    entry.ActorID(),
+		Action:     string(entry.Action()),
+		TargetType: string(entry.Target().Type),
+		TargetID:   entry.Target().ID,
+		Changes:    changes,
+		RequestID:  entry.RequestID(),
+		OccurredAt: entry.OccurredAt(),
+	}
+}
+
+// ListAuditEntriesUseCase は監査ログを新しい順に返す
+type ListAuditEntriesUseCase struct {
+	auditRepository domain.AuditRepository
+}
+
+func NewListAuditEntriesUseCase(auditRepository domain.AuditRepository) *ListAuditEntriesUseCase {
+	return &ListAuditEntriesUseCase{auditRepository: auditRepository}
+}
+
+func (uc *ListAuditEntriesUseCase) Execute(input ListAuditEntriesInput) ([]*AuditEntryOutput, error) {
+	if err := domain.Authorize(input.Actor, domain.ActionViewAuditLog, domain.Resource{}); err != nil {
+		return nil, err
+	}
+
+	limit := input.Limit
+	if limit == 0 {
+		limit = DefaultAuditEntryLimit
+	}
+	if limit < 0 || limit > MaxAuditEntryLimit {
+		return nil, domain.InvalidAuditFilterError{Reason: "limit must be between 1 and 1000"}
+	}
+	if input.Since != nil && input.Until != nil && !input.Since.Before(*input.Until) {
+		return nil, domain.InvalidAuditFilterError{Reason: "since must be before until"}
+	}
+
+	entries, err := uc.auditRepository.Find(domain.AuditFilter{
+		ActorID:    input.ActorID,
+		Action:     domain.AuditAction(input.Action),
+		TargetType: domain.AuditTargetType(input.TargetType),
+		TargetID:   input.TargetID,
+		RequestID:  input.RequestID,
+		Since:      input.Since,
+		Until:      input.Until,
+		Limit:      limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]*AuditEntryOutput, len(entries))
+	for i, entry := range entries {
+		outputs[i] = NewAuditEntryOutput(entry)
+	}
+	return outputs, nil
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestListAuditEntriesUseCase_Execute_Filters(t *testing.T) {
+	// Arrange
+	auditRepo := infrastructure.NewMemoryAuditRepository()
+	clock := domain.NewFixedClock(testAuditNow)
+	auditLog := NewAuditLog(auditRepo, clock)
+	alice, bob := domain.NewUserID(), domain.NewUserID()
+	record := func(actor *domain.UserID, action domain.AuditAction, target *domain.UserID, requestID string) {
+		t.Helper()
+		if err := auditLog.Record(domain.NewUserPrincipal(actor, false), requestID, action, domain.UserAuditTarget(target), nil); err != nil {
+			t.Fatalf("Failed to record audit entry: %v", err)
+		}
+		clock.Advance(time.Hour)
+	}
+	record(alice, domain.AuditUserCreated, alice, "req-1")
+	record(alice, domain.AuditUserUpdated, alice, "req-2")
+	record(bob, domain.AuditUserDeleted, alice, "req-3")
+	record(bob, domain.AuditUserCreated, bob, "req-4")
+	since, until := testAuditNow.Add(time.Hour), testAuditNow.Add(3*time.Hour)
+
+	tests := []struct {
+		name          string
+		input         ListAuditEntriesInput
+		wantRequestID []string
+	}{
+		{"条件なしは新しい順", ListAuditEntriesInput{}, []string{"req-4", "req-3", "req-2", "req-1"}},
+		{"操作した主体", ListAuditEntriesInput{ActorID: bob.Value()}, []string{"req-4", "req-3"}},
+		{"操作の種類", ListAuditEntriesInput{Action: string(domain.AuditUserCreated)}, []string{"req-4", "req-1"}},
+		{"対象の集約", ListAuditEntriesInput{TargetType: "user", TargetID: alice.Value()}, []string{"req-3", "req-2", "req-1"}},
+		{"リクエストID", ListAuditEntriesInput{RequestID: "req-2"}, []string{"req-2"}},
+		{"期間は開始を含み終了を含まない", ListAuditEntriesInput{Since: &since, Until: &until}, []string{"req-3", "req-2"}},
+		{"件数の上限", ListAuditEntriesInput{Limit: 1}, []string{"req-4"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.input.Actor = adminActor()
+
+			// Act
+			outputs, err := NewListAuditEntriesUseCase(auditRepo).Execute(tt.input)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if len(outputs) != len(tt.wantRequestID) {
+				t.Fatalf("Expected %d entries, but got %d", len(tt.wantRequestID), len(outputs))
+			}
+			for i, output := range outputs {
+				if output.RequestID != tt.wantRequestID[i] {
+					t.Errorf("Expected entry %d to be %s, but got %s", i, tt.wantRequestID[i], output.RequestID)
+				}
+			}
+		})
+	}
+}
+
+func TestListAuditEntriesUseCase_Execute_InvalidFilter(t *testing.T) {
+	since := testAuditNow
+	tests := []struct {
+		name  string
+		input ListAuditEntriesInput
+	}{
+		{"件数が負", ListAuditEntriesInput{Limit: -1}},
+		{"件数が上限を超える", ListAuditEntriesInput{Limit: MaxAuditEntryLimit + 1}},
+		{"開始と終了が同じ", ListAuditEntriesInput{Since: &since, Until: &since}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.input.Actor = adminActor()
+			useCase := NewListAuditEntriesUseCase(infrastructure.NewMemoryAuditRepository())
+
+			// Act
+			_, err := useCase.Execute(tt.input)
+
+			// Assert
+			var filterErr domain.InvalidAuditFilterError
+			if !errors.As(err, &filterErr) {
+				t.Errorf("Expected InvalidAuditFilterError, but got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"sort"
+)
+
+// 一覧の絞り込み（空の場合はすべて）
+const (
+	CircleEventFilterUpcoming = "upcoming"
+	CircleEventFilterPast     = "past"
+)
+
+type ListCircleEventsInput struct {
+	Actor    *domain.Principal // 匿名でも取得できる（API キーの場合は circles:read が必要）
+	CircleID string
+	Filter   string // upcoming / past / 空
+}
+
+type ListCircleEventsOutput struct {
+	CircleID string
+	Events   []*CircleEventOutput
+}
+
+type ListCircleEventsUseCase struct {
+	circleRepository domain.CircleRepository
+	eventRepository  domain.CircleEventRepository
+	clock            domain.Clock
+}
+
+func NewListCircleEventsUseCase(
+	circleRepository domain.CircleRepository,
+	eventRepository domain.CircleEventRepository,
+	clock domain.Clock,
+) *ListCircleEventsUseCase {
+	return &ListCircleEventsUseCase{
+		circleRepository: circleRepository,
+		eventRepository:  eventRepository,
+		clock:            clock,
+	}
+}
+
+func (uc *ListCircleEventsUseCase) Execute(input ListCircleEventsInput) (*ListCircleEventsOutput, error) {
+	switch input.Filter {
+	case "", CircleEventFilterUpcoming, CircleEventFilterPast:
+	default:
+		return nil, domain.InvalidCircleEventError{Reason: "unknown event filter: " + input.Filter}
+	}
+
+	circle, err := findCircle(uc.circleRepository, input.CircleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeCircle(input.Actor, domain.ActionViewCircle, circle); err != nil {
+		return nil, err
+	}
+
+	events, err := uc.eventRepository.FindByCircleID(circle.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	// 終了していないイベントを「今後」、終了したイベントを「過去」とする
+	now := uc.clock.Now()
+	var filtered []*domain.CircleEvent
+	for _, event := range events {
+		upcoming := event.IsUpcomingAt(now)
+		if (input.Filter == CircleEventFilterUpcoming && !upcoming) ||
+			(input.Filter == CircleEventFilterPast && upcoming) {
+			continue
+		}
+		filtered = append(filtered, event)
+	}
+
+	// 過去のイベントは新しい順、それ以外は開始時刻順
+	sort.SliceStable(filtered, func(i, j int) bool {
+		a, b := filtered[i].Schedule().StartsAt(), filtered[j].Schedule().StartsAt()
+		if input.Filter == CircleEventFilterPast {
+			return a.After(b)
+		}
+		return a.Before(b)
+	})
+
+	outputs := make([]*CircleEventOutput, 0, len(filtered))
+	for _, event := range filtered {
+		outputs = append(outputs, NewCircleEventOutput(event))
+	}
+
+	return &ListCircleEventsOutput{
+		CircleID: circle.ID().Value(),
+		Events:   outputs,
+	}, nil
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type ListCircleExpensesInput struct {
+	Actor    *domain.Principal // オーナー・メンバーか circles:read が必要
+	CircleID string
+}
+
+type ListCircleExpensesOutput struct {
+	CircleID string
+	Expenses []*CircleExpenseOutput
+}
+
+type ListCircleExpensesUseCase struct {
+	circleRepository  domain.CircleRepository
+	expenseRepository domain.CircleExpenseRepository
+}
+
+func NewListCircleExpensesUseCase(
+	circleRepository domain.CircleRepository,
+	expenseRepository domain.CircleExpenseRepository,
+) *ListCircleExpensesUseCase {
+	return &ListCircleExpensesUseCase{
+		circleRepository:  circleRepository,
+		expenseRepository: expenseRepository,
+	}
+}
+
+func (uc *ListCircleExpensesUseCase) Execute(input ListCircleExpensesInput) (*ListCircleExpensesOutput, error) {
+	circle, err := findCircle(uc.circleRepository, input.CircleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeCircle(input.Actor, domain.ActionViewCircleExpenses, circle); err != nil {
+		return nil, err
+	}
+
+	expenses, err := uc.expenseRepository.FindByCircleID(circle.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]*CircleExpenseOutput, 0, len(expenses))
+	for _, expense := range expenses {
+		outputs = append(outputs, NewCircleExpenseOutput(expense))
+	}
+
+	return &ListCircleExpensesOutput{
+		CircleID: circle.ID().Value(),
+		Expenses: outputs,
+	}, nil
+}
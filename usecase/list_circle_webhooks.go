@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type ListCircleWebhooksInput struct {
+	Actor    *domain.Principal // オーナーか circles:read が必要
+	CircleID string
+}
+
+type ListCircleWebhooksOutput struct {
+	CircleID string
+	Webhooks []*WebhookOutput
+}
+
+type ListCircleWebhooksUseCase struct {
+	circleRepository  domain.CircleRepository
+	webhookRepository domain.WebhookRepository
+}
+
+func NewListCircleWebhooksUseCase(
+	circleRepository domain.CircleRepository,
+	webhookRepository domain.WebhookRepository,
+) *ListCircleWebhooksUseCase {
+	return &ListCircleWebhooksUseCase{
+		circleRepository:  circleRepository,
+		webhookRepository: webhookRepository,
+	}
+}
+
+func (uc *ListCircleWebhooksUseCase) Execute(input ListCircleWebhooksInput) (*ListCircleWebhooksOutput, error) {
+	circle, err := findCircle(uc.circleRepository, input.CircleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeCircle(input.Actor, domain.ActionViewCircleWebhooks, circle); err != nil {
+		return nil, err
+	}
+
+	webhooks, err := uc.webhookRepository.FindByCircleID(circle.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]*WebhookOutput, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		outputs = append(outputs, NewWebhookOutput(webhook))
+	}
+
+	return &ListCircleWebhooksOutput{
+		CircleID: circle.ID().Value(),
+		Webhooks: outputs,
+	}, nil
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type ListUserShipmentsInput struct {
+	Actor  *domain.Principal // 本人か shipments:read が必要
+	UserID string
+}
+
+type ListUserShipmentsOutput struct {
+	UserID    string
+	Shipments []*ShipmentOutput
+}
+
+type ListUserShipmentsUseCase struct {
+	shipmentRepository domain.ShipmentRepository
+	userRepository     domain.UserRepository
+}
+
+func NewListUserShipmentsUseCase(
+	shipmentRepository domain.ShipmentRepository,
+	userRepository domain.UserRepository,
+) *ListUserShipmentsUseCase {
+	return &ListUserShipmentsUseCase{
+		shipmentRepository: shipmentRepository,
+		userRepository:     userRepository,
+	}
+}
+
+func (uc *ListUserShipmentsUseCase) Execute(input ListUserShipmentsInput) (*ListUserShipmentsOutput, error) {
+	if _, err := authorizeUser(input.Actor, domain.ActionViewShipment, input.UserID); err != nil {
+		return nil, err
+	}
+
+	user, err := findUser(uc.userRepository, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	shipments, err := uc.shipmentRepository.FindByRecipientID(user.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]*ShipmentOutput, 0, len(shipments))
+	for _, shipment := range shipments {
+		outputs = append(outputs, NewShipmentOutput(shipment))
+	}
+
+	return &ListUserShipmentsOutput{
+		UserID:    user.ID().Value(),
+		Shipments: outputs,
+	}, nil
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+type ListWebhookDeliveriesInput struct {
+	Actor     *domain.Principal // オーナーか circles:read が必要
+	CircleID  string
+	WebhookID string
+}
+
+type WebhookDeliveryOutput struct {
+	DeliveryID     string
+	EventName      string
+	EventKey       string
+	Payload        []byte
+	Status         string
+	Attempts       int
+	ResponseStatus int
+	LastError      string
+	NextAttemptAt  *time.Time // 配信待ちの場合のみ
+	CreatedAt      time.Time
+	CompletedAt    *time.Time
+}
+
+func NewWebhookDeliveryOutput(delivery *domain.WebhookDelivery) *WebhookDeliveryOutput {
+	output := &WebhookDeliveryOutput{
+		DeliveryID:     delivery.ID(),
+		EventName:      delivery.EventName(),
+		EventKey:       delivery.EventKey(),
+		Payload:        delivery.Payload(),
+		Status:         delivery.Status().String(),
+		Attempts:       delivery.Attempts(),
+		ResponseStatus: delivery.ResponseStatus(),
+		LastError:      delivery.LastError(),
+		CreatedAt:      delivery.CreatedAt(),
+		CompletedAt:    optionalTime(delivery.CompletedAt()),
+	}
+	if delivery.Status() == domain.WebhookDeliveryPending {
+		output.NextAttemptAt = optionalTime(delivery.NextAttemptAt())
+	}
+	return output
+}
+
+type ListWebhookDeliveriesOutput struct {
+	WebhookID  string
+	Deliveries []*WebhookDeliveryOutput // 新しい順
+}
+
+type ListWebhookDeliveriesUseCase struct {
+	circleRepository   domain.CircleRepository
+	webhookRepository  domain.WebhookRepository
+	deliveryRepository domain.WebhookDeliveryRepository
+}
+
+func NewListWebhookDeliveriesUseCase(
+	circleRepository domain.CircleRepository,
+	webhookRepository domain.WebhookRepository,
+	deliveryRepository domain.WebhookDeliveryRepository,
+) *ListWebhookDeliveriesUseCase {
+	return &ListWebhookDeliveriesUseCase{
+		circleRepository:   circleRepository,
+		webhookRepository:  webhookRepository,
+		deliveryRepository: deliveryRepository,
+	}
+}
+
+func (uc *ListWebhookDeliveriesUseCase) Execute(input ListWebhookDeliveriesInput) (*ListWebhookDeliveriesOutput, error) {
+	circle, err := findCircle(uc.circleRepository, input.CircleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeCircle(input.Actor, domain.ActionViewCircleWebhooks, circle); err != nil {
+		return nil, err
+	}
+	webhook, err := findWebhook(uc.webhookRepository, circle, input.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := uc.deliveryRepository.FindByWebhookID(webhook.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]*WebhookDeliveryOutput, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		outputs = append(outputs, NewWebhookDeliveryOutput(delivery))
+	}
+
+	return &ListWebhookDeliveriesOutput{
+		WebhookID:  webhook.ID().Value(),
+		Deliveries: outputs,
+	}, nil
+}
+
+// findWebhook はサークルのWebhookを取得し、存在しない・別サークルの場合は WebhookNotFoundError を返す
+func findWebhook(webhookRepository domain.WebhookRepository, circle *domain.Circle, id string) (*domain.WebhookSubscription, error) {
+	webhookID, err := domain.ReconstructWebhookID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := webhookRepository.FindByID(webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if webhook == nil || !webhook.CircleID().Equals(circle.ID()) {
+		return nil, domain.WebhookNotFoundError{ID: id}
+	}
+	return webhook, nil
+}
@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"sync"
+	"time"
+)
+
+type LoginInput struct {
+	Email    string
+	Password string
+}
+
+type LoginOutput struct {
+	Token     string
+	UserID    string
+	ExpiresAt time.Time
+}
+
+// LoginUseCase はメールアドレスとパスワードを照合してセッションを発行する
+type LoginUseCase struct {
+	passwordVerifier  *passwordVerifier
+	sessionRepository domain.SessionRepository
+	sessionTTL        time.Duration
+	clock             domain.Clock
+}
+
+func NewLoginUseCase(
+	userRepository domain.UserRepository,
+	credentialRepository domain.CredentialRepository,
+	sessionRepository domain.SessionRepository,
+	passwordHasher domain.PasswordHasher,
+	sessionTTL time.Duration,
+	clock domain.Clock,
+) *LoginUseCase {
+	return &LoginUseCase{
+		passwordVerifier:  newPasswordVerifier(userRepository, credentialRepository, passwordHasher),
+		sessionRepository: sessionRepository,
+		sessionTTL:        sessionTTL,
+		clock:             clock,
+	}
+}
+
+func (uc *LoginUseCase) Execute(input LoginInput) (*LoginOutput, error) {
+	userID, err := uc.passwordVerifier.verify(input.Email, input.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	session, token, err := domain.NewSession(userID, uc.clock.Now(), uc.sessionTTL)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.sessionRepository.Save(session); err != nil {
+		return nil, err
+	}
+
+	return &LoginOutput{
+		Token:     token,
+		UserID:    session.UserID().Value(),
+		ExpiresAt: session.ExpiresAt(),
+	}, nil
+}
+
+// passwordVerifier はメールアドレスとパスワードを照合する（セッションとトークンのログインで共有）
+type passwordVerifier struct {
+	userRepository       domain.UserRepository
+	credentialRepository domain.CredentialRepository
+	passwordHasher       domain.PasswordHasher
+
+	// 存在しないユーザーでも照合と同じ時間をかけ、登録の有無を応答時間から推測されないようにする
+	dummyHashOnce sync.Once
+	dummyHash     string
+}
+
+func newPasswordVerifier(
+	userRepository domain.UserRepository,
+	credentialRepository domain.CredentialRepository,
+	passwordHasher domain.PasswordHasher,
+) *passwordVerifier {
+	return &passwordVerifier{
+		userRepository:       userRepository,
+		credentialRepository: credentialRepository,
+		passwordHasher:       passwordHasher,
+	}
+}
+
+// verify は照合できたユーザーの ID を返し、できなければ InvalidCredentialsError を返す
+func (v *passwordVerifier) verify(email, password string) (*domain.UserID, error) {
+	credential, err := v.findCredential(email)
+	if err != nil {
+		return nil, err
+	}
+	if credential == nil {
+		v.verifyDummy(password)
+		return nil, domain.InvalidCredentialsError{}
+	}
+
+	ok, err := v.passwordHasher.Verify(credential.PasswordHash(), password)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, domain.InvalidCredentialsError{}
+	}
+	return credential.UserID(), nil
+}
+
+// findCredential はメールアドレスのユーザーがパスワードを設定していなければ nil を返す
+func (v *passwordVerifier) findCredential(emailValue string) (*domain.Credential, error) {
+	email, err := domain.NewEmail(emailValue)
+	if err != nil {
+		return nil, nil
+	}
+	user, err := v.userRepository.FindByEmail(email)
+	if err != nil || user == nil {
+		return nil, err
+	}
+	return v.credentialRepository.FindByUserID(user.ID())
+}
+
+func (v *passwordVerifier) verifyDummy(password string) {
+	v.dummyHashOnce.Do(func() {
+		dummy, _ := domain.NewPassword("dummy-password-for-timing")
+		v.dummyHash, _ = v.passwordHasher.Hash(dummy)
+	})
+	if v.dummyHash != "" {
+		v.passwordHasher.Verify(v.dummyHash, password)
+	}
+}
@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"errors"
+	"testing"
+	"time"
+)
+
+var testLoginNow = time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)
+
+// newTestPasswordHasher はテストが遅くならないよう最小のコストでハッシュ化する
+func newTestPasswordHasher() domain.PasswordHasher {
+	return infrastructure.NewArgon2idPasswordHasher(infrastructure.Argon2idParams{
+		Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32,
+	})
+}
+
+type authTestFixture struct {
+	userRepo         domain.UserRepository
+	credentialRepo   domain.CredentialRepository
+	sessionRepo      domain.SessionRepository
+	refreshTokenRepo domain.RefreshTokenRepository
+	clock            *domain.FixedClock
+	login            *LoginUseCase
+	logout           *LogoutUseCase
+	tokenLogin       *TokenLoginUseCase
+	refresh          *RefreshAccessTokenUseCase
+	revoke           *RevokeRefreshTokenUseCase
+	authenticate     *AuthenticateUseCase
+	create           *CreateUserUseCase
+}
+
+// アクセストークンは 15 分、セッションは 1 時間、リフレッシュトークンは 1 日で切れる
+func newAuthTestFixture() *authTestFixture {
+	f := &authTestFixture{
+		userRepo:         infrastructure.NewMemoryUserRepository(),
+		credentialRepo:   infrastructure.NewMemoryCredentialRepository(),
+		sessionRepo:      infrastructure.NewMemorySessionRepository(),
+		refreshTokenRepo: infrastructure.NewMemoryRefreshTokenRepository(),
+		clock:            domain.NewFixedClock(testLoginNow),
+	}
+	hasher := newTestPasswordHasher()
+	codec, _ := infrastructure.NewJWTAccessTokenCodec("test-issuer",
+		infrastructure.NewHMACJWTKey("test-key", []byte("0123456789abcdef0123456789abcdef")))
+	f.login = NewLoginUseCase(f.userRepo, f.credentialRepo, f.sessionRepo, hasher, time.Hour, f.clock)
+	f.logout = NewLogoutUseCase(f.sessionRepo)
+	f.tokenLogin = NewTokenLoginUseCase(f.userRepo, f.credentialRepo, f.refreshTokenRepo, hasher, codec, 15*time.Minute, 24*time.Hour, f.clock)
+	f.refresh = NewRefreshAccessTokenUseCase(f.refreshTokenRepo, f.userRepo, codec, 15*time.Minute, 24*time.Hour, f.clock)
+	f.revoke = NewRevokeRefreshTokenUseCase(f.refreshTokenRepo, f.clock)
+	f.authenticate = NewAuthenticateUseCase(f.sessionRepo, codec, f.userRepo, nil, f.clock)
+	f.create = NewCreateUserUseCase(f.userRepo, domain.NewUserExistenceService(f.userRepo), f.credentialRepo, hasher, f.clock, newTestAuditLog())
+	return f
+}
+
+func (f *authTestFixture) createUser(t *testing.T, firstName, password string) string {
+	t.Helper()
+
+	output, err := f.create.Execute(CreateUserInput{
+		FirstName: firstName,
+		LastName:  "認証",
+		Email:     firstName + "@example.com",
+		Password:  password,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	return output.UserID
+}
+
+func TestLoginUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name        string
+		email       string
+		password    string
+		expectLogin bool
+	}{
+		{"正しいパスワード", "taro@example.com", "correct horse battery", true},
+		{"誤ったパスワード", "taro@example.com", "wrong password", false},
+		{"登録されていないメールアドレス", "nobody@example.com", "correct horse battery", false},
+		{"パスワードを設定していないユーザー", "hanako@example.com", "", false},
+		{"メールアドレスの形式が不正", "not-an-email", "correct horse battery", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			f := newAuthTestFixture()
+			userID := f.createUser(t, "taro", "correct horse battery")
+			f.createUser(t, "hanako", "")
+
+			// Act
+			output, err := f.login.Execute(LoginInput{Email: tt.email, Password: tt.password})
+
+			// Assert
+			if !tt.expectLogin {
+				var invalid domain.InvalidCredentialsError
+				if !errors.As(err, &invalid) {
+					t.Fatalf("Expected InvalidCredentialsError, but got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if output.UserID != userID || output.Token == "" || !output.ExpiresAt.Equal(testLoginNow.Add(time.Hour)) {
+				t.Errorf("Unexpected login output: %+v", output)
+			}
+		})
+	}
+}
+
+func TestAuthenticateUseCase_Execute_SessionLifecycle(t *testing.T) {
+	// Arrange
+	f := newAuthTestFixture()
+	userID := f.createUser(t, "taro", "correct horse battery")
+	login, err := f.login.Execute(LoginInput{Email: "taro@example.com", Password: "correct horse battery"})
+	if err != nil {
+		t.Fatalf("Failed to log in: %v", err)
+	}
+
+	steps := []struct {
+		name          string
+		act           func()
+		expectedError bool
+	}{
+		{"ログイン直後は認証できる", func() {}, false},
+		{"期限の直前まで認証できる", func() { f.clock.Advance(time.Hour - time.Second) }, false},
+		{"ログアウト後は認証できない", func() {
+			if err := f.logout.Execute(LogoutInput{Token: login.Token}); err != nil {
+				t.Fatalf("Failed to log out: %v", err)
+			}
+		}, true},
+	}
+
+	for _, step := range steps {
+		// Act
+		step.act()
+		output, err := f.authenticate.Execute(AuthenticateInput{Token: login.Token})
+
+		// Assert
+		if step.expectedError {
+			var unauthenticated domain.UnauthenticatedError
+			if !errors.As(err, &unauthenticated) {
+				t.Errorf("%s: expected UnauthenticatedError, but got %v", step.name, err)
+			}
+			continue
+		}
+		if err != nil || output.UserID != userID {
+			t.Errorf("%s: expected user %s, but got %+v (%v)", step.name, userID, output, err)
+		}
+	}
+}
+
+func TestAuthenticateUseCase_Execute_ExpiredSession(t *testing.T) {
+	// Arrange
+	f := newAuthTestFixture()
+	f.createUser(t, "taro", "correct horse battery")
+	login, _ := f.login.Execute(LoginInput{Email: "taro@example.com", Password: "correct horse battery"})
+	f.clock.Advance(time.Hour)
+
+	// Act
+	_, err := f.authenticate.Execute(AuthenticateInput{Token: login.Token})
+
+	// Assert
+	var unauthenticated domain.UnauthenticatedError
+	if !errors.As(err, &unauthenticated) {
+		t.Fatalf("Expected UnauthenticatedError, but got %v", err)
+	}
+	if session, _ := f.sessionRepo.FindByTokenHash(domain.HashSessionToken(login.Token)); session != nil {
+		t.Error("Expected the expired session to be removed")
+	}
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type LogoutInput struct {
+	Token string
+}
+
+// LogoutUseCase はセッションを削除する
+// 既に切れた・存在しないトークンでもエラーにしない
+type LogoutUseCase struct {
+	sessionRepository domain.SessionRepository
+}
+
+func NewLogoutUseCase(sessionRepository domain.SessionRepository) *LogoutUseCase {
+	return &LogoutUseCase{
+		sessionRepository: sessionRepository,
+	}
+}
+
+func (uc *LogoutUseCase) Execute(input LogoutInput) error {
+	session, err := uc.sessionRepository.FindByTokenHash(domain.HashSessionToken(input.Token))
+	if err != nil {
+		return err
+	}
+	if session == nil {
+		return nil
+	}
+	return uc.sessionRepository.Delete(session.ID())
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+// NotificationDispatcher はユーザーの通知設定に従って通知を送る
+// すぐに送れない通知（まとめて送る・おやすみ時間中）は保存し、SendNotificationDigestsUseCase が送る
+type NotificationDispatcher struct {
+	preferencesRepository domain.NotificationPreferencesRepository
+	pendingRepository     domain.PendingNotificationRepository
+	mailer                domain.Mailer
+	defaultLocale         domain.Locale
+	clock                 domain.Clock
+}
+
+func NewNotificationDispatcher(
+	preferencesRepository domain.NotificationPreferencesRepository,
+	pendingRepository domain.PendingNotificationRepository,
+	mailer domain.Mailer,
+	defaultLocale domain.Locale,
+	clock domain.Clock,
+) *NotificationDispatcher {
+	return &NotificationDispatcher{
+		preferencesRepository: preferencesRepository,
+		pendingRepository:     pendingRepository,
+		mailer:                mailer,
+		defaultLocale:         defaultLocale,
+		clock:                 clock,
+	}
+}
+
+func (d *NotificationDispatcher) preferencesFor(userID *domain.UserID) (*domain.NotificationPreferences, error) {
+	return findNotificationPreferences(d.preferencesRepository, userID, d.defaultLocale)
+}
+
+// findNotificationPreferences は保存されていなければ既定の設定を返す
+func findNotificationPreferences(
+	preferencesRepository domain.NotificationPreferencesRepository,
+	userID *domain.UserID,
+	defaultLocale domain.Locale,
+) (*domain.NotificationPreferences, error) {
+	preferences, err := preferencesRepository.FindByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if preferences == nil {
+		return domain.DefaultNotificationPreferences(userID, defaultLocale), nil
+	}
+	return preferences, nil
+}
+
+// dispatch は設定の言語で描画したメールを送る、または送る時刻まで保存する
+func (d *NotificationDispatcher) dispatch(preferences *domain.NotificationPreferences, kind domain.NotificationKind, message *domain.MailMessage) error {
+	now := d.clock.Now()
+	deliverAt, ok := preferences.ScheduleFor(kind, now)
+	if !ok {
+		return nil
+	}
+	if !deliverAt.After(now) {
+		return d.mailer.Send(message)
+	}
+
+	digest := preferences.ChannelFor(kind) == domain.NotificationDigest
+	pending, err := domain.NewPendingNotification(preferences.UserID(), kind, message, digest, deliverAt, now)
+	if err != nil {
+		return err
+	}
+	return d.pendingRepository.Save(pending)
+}
+
+// sendImmediately は通知設定によらずすぐに送る（メールアドレスの確認など）
+func (d *NotificationDispatcher) sendImmediately(message *domain.MailMessage) error {
+	return d.mailer.Send(message)
+}
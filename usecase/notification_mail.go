@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"bytes"
+	"ddd-bottomup/domain"
+	"errors"
+	"fmt"
+	"text/template"
+)
+
+// mailTemplate は件名と本文のテンプレート
+type mailTemplate struct {
+	subject *template.Template
+	body    *template.Template
+}
+
+func newMailTemplate(subject, body string) mailTemplate {
+	return mailTemplate{
+		subject: template.Must(template.New("subject").Parse(subject)),
+		body:    template.Must(template.New("body").Parse(body)),
+	}
+}
+
+// テンプレートに渡すデータ
+type addedToCircleMail struct {
+	UserName   string
+	CircleName string
+}
+
+type circleFilledMail struct {
+	OwnerName       string
+	CircleName      string
+	MaxParticipants int
+}
+
+type emailChangedMail struct {
+	UserName string
+	OldEmail string
+	NewEmail string
+}
+
+// mailTemplates は通知の種類・言語ごとのテンプレート
+var mailTemplates = map[domain.NotificationKind]map[domain.Locale]mailTemplate{
+	domain.NotificationAddedToCircle: {
+		domain.LocaleJapanese: newMailTemplate(
+			"「{{.CircleName}}」に参加しました",
+			"{{.UserName}} さん\n\nサークル「{{.CircleName}}」のメンバーに追加されました。\n",
+		),
+		domain.LocaleEnglish: newMailTemplate(
+			"You joined {{.CircleName}}",
+			"Hi {{.UserName}},\n\nYou have been added as a member of {{.CircleName}}.\n",
+		),
+	},
+	domain.NotificationCircleFilled: {
+		domain.LocaleJapanese: newMailTemplate(
+			"「{{.CircleName}}」が定員に達しました",
+			"{{.OwnerName}} さん\n\nサークル「{{.CircleName}}」の参加者が定員（{{.MaxParticipants}}名）に達しました。\n新しいメンバーは追加できません。\n",
+		),
+		domain.LocaleEnglish: newMailTemplate(
+			"{{.CircleName}} is full",
+			"Hi {{.OwnerName}},\n\n{{.CircleName}} has reached its capacity of {{.MaxParticipants}} participants.\nNo more members can be added.\n",
+		),
+	},
+	domain.NotificationEmailChanged: {
+		domain.LocaleJapanese: newMailTemplate(
+			"メールアドレスが変更されました",
+			"{{.UserName}} さん\n\nメールアドレスが {{.OldEmail}} から {{.NewEmail}} に変更されました。\n心当たりがない場合はお問い合わせください。\n",
+		),
+		domain.LocaleEnglish: newMailTemplate(
+			"Your email address was changed",
+			"Hi {{.UserName}},\n\nYour email address was changed from {{.OldEmail}} to {{.NewEmail}}.\nIf you did not make this change, please contact us.\n",
+		),
+	},
+}
+
+type digestMail struct {
+	Count int
+	Items []digestMailItem
+}
+
+type digestMailItem struct {
+	Subject string
+	Body    string
+}
+
+// digestTemplates はまとめて送る通知のテンプレート
+var digestTemplates = map[domain.Locale]mailTemplate{
+	domain.LocaleJapanese: newMailTemplate(
+		"お知らせのまとめ（{{.Count}}件）",
+		"{{range .Items}}■ {{.Subject}}\n\n{{.Body}}\n{{end}}",
+	),
+	domain.LocaleEnglish: newMailTemplate(
+		"Your notification digest ({{.Count}})",
+		"{{range .Items}}* {{.Subject}}\n\n{{.Body}}\n{{end}}",
+	),
+}
+
+type emailVerificationMail struct {
+	UserName  string
+	UserID    string
+	Email     string
+	Token     string
+	ExpiresAt string
+}
+
+// verificationTemplates はメールアドレス確認のテンプレート
+// 通知設定の対象ではなく、常にすぐ送る
+var verificationTemplates = map[domain.Locale]mailTemplate{
+	domain.LocaleJapanese: newMailTemplate(
+		"メールアドレスの確認",
+		"{{.UserName}} さん\n\n{{.Email}} があなたのメールアドレスであることを確認するため、次の確認コードを送信してください。\n\nPOST /users/{{.UserID}}/email/verify\n{{.Token}}\n\n確認コードの有効期限は {{.ExpiresAt}} です。\n心当たりがない場合はこのメールを破棄してください。\n",
+	),
+	domain.LocaleEnglish: newMailTemplate(
+		"Verify your email address",
+		"Hi {{.UserName}},\n\nTo confirm that {{.Email}} is your email address, submit this verification code:\n\nPOST /users/{{.UserID}}/email/verify\n{{.Token}}\n\nThe code expires at {{.ExpiresAt}}.\nIf you did not request this, you can ignore this email.\n",
+	),
+}
+
+// renderMail はテンプレートからメールを作成する
+func renderMail(kind domain.NotificationKind, locale domain.Locale, to *domain.Email, data interface{}) (*domain.MailMessage, error) {
+	tmpl, ok := mailTemplates[kind][locale]
+	if !ok {
+		return nil, fmt.Errorf("no mail template for %s (%s)", kind, locale)
+	}
+	return executeMailTemplate(tmpl, to, data)
+}
+
+// renderDigestMail は複数の通知を1通にまとめる
+func renderDigestMail(locale domain.Locale, to *domain.Email, messages []*domain.MailMessage) (*domain.MailMessage, error) {
+	tmpl, ok := digestTemplates[locale]
+	if !ok {
+		return nil, fmt.Errorf("no digest template for %s", locale)
+	}
+
+	items := make([]digestMailItem, 0, len(messages))
+	for _, message := range messages {
+		items = append(items, digestMailItem{Subject: message.Subject(), Body: message.Body()})
+	}
+	return executeMailTemplate(tmpl, to, digestMail{Count: len(items), Items: items})
+}
+
+func renderVerificationMail(locale domain.Locale, to *domain.Email, data emailVerificationMail) (*domain.MailMessage, error) {
+	tmpl, ok := verificationTemplates[locale]
+	if !ok {
+		return nil, fmt.Errorf("no verification template for %s", locale)
+	}
+	return executeMailTemplate(tmpl, to, data)
+}
+
+func executeMailTemplate(tmpl mailTemplate, to *domain.Email, data interface{}) (*domain.MailMessage, error) {
+	var subject, body bytes.Buffer
+	if err := tmpl.subject.Execute(&subject, data); err != nil {
+		return nil, err
+	}
+	if err := tmpl.body.Execute(&body, data); err != nil {
+		return nil, err
+	}
+	return domain.NewMailMessage(to, subject.String(), body.String())
+}
+
+// displayName は言語に合わせた氏名の表記を返す（日本語は姓・名の順）
+func displayName(name *domain.FullName, locale domain.Locale) string {
+	if locale == domain.LocaleJapanese {
+		return name.LastName() + " " + name.FirstName()
+	}
+	return name.String()
+}
+
+// ignoreMissingRecipient は通知の前に削除されたユーザー・サークルを無視する
+// イベントは後から配信されるため、その間に削除されていても再試行しても意味がない
+func ignoreMissingRecipient(err error) error {
+	var userNotFound domain.UserNotFoundError
+	var circleNotFound domain.CircleNotFoundError
+	if errors.As(err, &userNotFound) || errors.As(err, &circleNotFound) {
+		return nil
+	}
+	return err
+}
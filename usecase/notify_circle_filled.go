@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type NotifyCircleFilledInput struct {
+	CircleID        string
+	MaxParticipants int
+}
+
+// NotifyCircleFilledUseCase はサークルが定員に達したことをオーナーへメールで知らせる
+type NotifyCircleFilledUseCase struct {
+	userRepository   domain.UserRepository
+	circleRepository domain.CircleRepository
+	dispatcher       *NotificationDispatcher
+}
+
+func NewNotifyCircleFilledUseCase(
+	userRepository domain.UserRepository,
+	circleRepository domain.CircleRepository,
+	dispatcher *NotificationDispatcher,
+) *NotifyCircleFilledUseCase {
+	return &NotifyCircleFilledUseCase{
+		userRepository:   userRepository,
+		circleRepository: circleRepository,
+		dispatcher:       dispatcher,
+	}
+}
+
+func (uc *NotifyCircleFilledUseCase) Execute(input NotifyCircleFilledInput) error {
+	circle, err := findCircle(uc.circleRepository, input.CircleID)
+	if err != nil {
+		return ignoreMissingRecipient(err)
+	}
+	owner, err := findUser(uc.userRepository, circle.OwnerID().Value())
+	if err != nil {
+		return ignoreMissingRecipient(err)
+	}
+
+	preferences, err := uc.dispatcher.preferencesFor(owner.ID())
+	if err != nil {
+		return err
+	}
+	message, err := renderMail(domain.NotificationCircleFilled, preferences.Locale(), owner.Email(), circleFilledMail{
+		OwnerName:       displayName(owner.Name(), preferences.Locale()),
+		CircleName:      circle.Name().Value(),
+		MaxParticipants: input.MaxParticipants,
+	})
+	if err != nil {
+		return err
+	}
+	return uc.dispatcher.dispatch(preferences, domain.NotificationCircleFilled, message)
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type NotifyEmailChangedInput struct {
+	UserID   string
+	OldEmail string
+	NewEmail string
+}
+
+// NotifyEmailChangedUseCase はメールアドレスの変更を新旧両方のアドレスへ知らせる
+// 旧アドレスへの通知により、本人以外による変更に気付けるようにする
+type NotifyEmailChangedUseCase struct {
+	userRepository domain.UserRepository
+	dispatcher     *NotificationDispatcher
+}
+
+func NewNotifyEmailChangedUseCase(
+	userRepository domain.UserRepository,
+	dispatcher *NotificationDispatcher,
+) *NotifyEmailChangedUseCase {
+	return &NotifyEmailChangedUseCase{
+		userRepository: userRepository,
+		dispatcher:     dispatcher,
+	}
+}
+
+func (uc *NotifyEmailChangedUseCase) Execute(input NotifyEmailChangedInput) error {
+	user, err := findUser(uc.userRepository, input.UserID)
+	if err != nil {
+		return ignoreMissingRecipient(err)
+	}
+	oldEmail, err := domain.NewEmail(input.OldEmail)
+	if err != nil {
+		return err
+	}
+	newEmail, err := domain.NewEmail(input.NewEmail)
+	if err != nil {
+		return err
+	}
+
+	preferences, err := uc.dispatcher.preferencesFor(user.ID())
+	if err != nil {
+		return err
+	}
+	data := emailChangedMail{
+		UserName: displayName(user.Name(), preferences.Locale()),
+		OldEmail: oldEmail.Value(),
+		NewEmail: newEmail.Value(),
+	}
+	for _, to := range []*domain.Email{oldEmail, newEmail} {
+		message, err := renderMail(domain.NotificationEmailChanged, preferences.Locale(), to, data)
+		if err != nil {
+			return err
+		}
+		if err := uc.dispatcher.dispatch(preferences, domain.NotificationEmailChanged, message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
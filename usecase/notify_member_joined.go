@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type NotifyMemberJoinedInput struct {
+	CircleID string
+	UserID   string
+}
+
+// NotifyMemberJoinedUseCase はサークルに追加されたメンバーへメールで知らせる
+type NotifyMemberJoinedUseCase struct {
+	userRepository   domain.UserRepository
+	circleRepository domain.CircleRepository
+	dispatcher       *NotificationDispatcher
+}
+
+func NewNotifyMemberJoinedUseCase(
+	userRepository domain.UserRepository,
+	circleRepository domain.CircleRepository,
+	dispatcher *NotificationDispatcher,
+) *NotifyMemberJoinedUseCase {
+	return &NotifyMemberJoinedUseCase{
+		userRepository:   userRepository,
+		circleRepository: circleRepository,
+		dispatcher:       dispatcher,
+	}
+}
+
+func (uc *NotifyMemberJoinedUseCase) Execute(input NotifyMemberJoinedInput) error {
+	circle, err := findCircle(uc.circleRepository, input.CircleID)
+	if err != nil {
+		return ignoreMissingRecipient(err)
+	}
+	user, err := findUser(uc.userRepository, input.UserID)
+	if err != nil {
+		return ignoreMissingRecipient(err)
+	}
+
+	preferences, err := uc.dispatcher.preferencesFor(user.ID())
+	if err != nil {
+		return err
+	}
+	message, err := renderMail(domain.NotificationAddedToCircle, preferences.Locale(), user.Email(), addedToCircleMail{
+		UserName:   displayName(user.Name(), preferences.Locale()),
+		CircleName: circle.Name().Value(),
+	})
+	if err != nil {
+		return err
+	}
+	return uc.dispatcher.dispatch(preferences, domain.NotificationAddedToCircle, message)
+}
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"strings"
+	"testing"
+)
+
+// recordingMailer は送信したメールを記録する
+type recordingMailer struct {
+	sent []*domain.MailMessage
+}
+
+func (m *recordingMailer) Send(message *domain.MailMessage) error {
+	m.sent = append(m.sent, message)
+	return nil
+}
+
+// newTestDispatcher は通知設定のないユーザーにすぐ送るディスパッチャーを作成する
+func newTestDispatcher(mailer domain.Mailer, locale domain.Locale) *NotificationDispatcher {
+	return NewNotificationDispatcher(
+		infrastructure.NewMemoryNotificationPreferencesRepository(),
+		infrastructure.NewMemoryPendingNotificationRepository(),
+		mailer, locale, domain.SystemClock{},
+	)
+}
+
+func TestNotifyMemberJoinedUseCase_Execute_RendersLocale(t *testing.T) {
+	tests := []struct {
+		name            string
+		locale          domain.Locale
+		expectedSubject string
+		expectedGreet   string
+	}{
+		{"日本語", domain.LocaleJapanese, "「テストサークル」に参加しました", "新規 newcomer さん"},
+		{"英語", domain.LocaleEnglish, "You joined テストサークル", "Hi newcomer 新規,"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			userRepo := infrastructure.NewMemoryUserRepository()
+			circleRepo := infrastructure.NewMemoryCircleRepository()
+			circle := setupCircleWithMembers(t, userRepo, circleRepo, 0, 0)
+			user := saveNewUser(t, userRepo, "newcomer")
+			mailer := &recordingMailer{}
+			useCase := NewNotifyMemberJoinedUseCase(userRepo, circleRepo, newTestDispatcher(mailer, tt.locale))
+
+			// Act
+			err := useCase.Execute(NotifyMemberJoinedInput{CircleID: circle.ID().Value(), UserID: user.ID().Value()})
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if len(mailer.sent) != 1 {
+				t.Fatalf("Expected 1 mail, but got %d", len(mailer.sent))
+			}
+			sent := mailer.sent[0]
+			if !sent.To().Equals(user.Email()) || sent.Subject() != tt.expectedSubject {
+				t.Errorf("Unexpected mail to %s: %q", sent.To(), sent.Subject())
+			}
+			if !strings.HasPrefix(sent.Body(), tt.expectedGreet) {
+				t.Errorf("Expected body to start with %q, but got %q", tt.expectedGreet, sent.Body())
+			}
+		})
+	}
+}
+
+func TestNotifyCircleFilledUseCase_Execute_MailsOwner(t *testing.T) {
+	// Arrange
+	userRepo := infrastructure.NewMemoryUserRepository()
+	circleRepo := infrastructure.NewMemoryCircleRepository()
+	circle := setupCircleWithMembers(t, userRepo, circleRepo, 2, 0)
+	owner, _ := userRepo.FindByID(circle.OwnerID())
+	mailer := &recordingMailer{}
+	useCase := NewNotifyCircleFilledUseCase(userRepo, circleRepo, newTestDispatcher(mailer, domain.LocaleJapanese))
+
+	// Act
+	err := useCase.Execute(NotifyCircleFilledInput{CircleID: circle.ID().Value(), MaxParticipants: 3})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if len(mailer.sent) != 1 || !mailer.sent[0].To().Equals(owner.Email()) {
+		t.Fatalf("Expected 1 mail to the owner, but got %d", len(mailer.sent))
+	}
+	if !strings.Contains(mailer.sent[0].Body(), "定員（3名）") {
+		t.Errorf("Expected the capacity in the body, but got %q", mailer.sent[0].Body())
+	}
+}
+
+func TestNotifyEmailChangedUseCase_Execute_MailsOldAndNewAddress(t *testing.T) {
+	// Arrange
+	userRepo := infrastructure.NewMemoryUserRepository()
+	user := saveNewUser(t, userRepo, "taro")
+	mailer := &recordingMailer{}
+	useCase := NewNotifyEmailChangedUseCase(userRepo, newTestDispatcher(mailer, domain.LocaleEnglish))
+
+	// Act
+	err := useCase.Execute(NotifyEmailChangedInput{
+		UserID:   user.ID().Value(),
+		OldEmail: "old@example.com",
+		NewEmail: "taro@example.com",
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if len(mailer.sent) != 2 {
+		t.Fatalf("Expected 2 mails, but got %d", len(mailer.sent))
+	}
+	if mailer.sent[0].To().Value() != "old@example.com" || mailer.sent[1].To().Value() != "taro@example.com" {
+		t.Errorf("Expected mails to the old and new address, but got %s and %s", mailer.sent[0].To(), mailer.sent[1].To())
+	}
+}
+
+func TestNotifyMemberJoinedUseCase_Execute_DeletedUser_SendsNothing(t *testing.T) {
+	// Arrange
+	userRepo := infrastructure.NewMemoryUserRepository()
+	circleRepo := infrastructure.NewMemoryCircleRepository()
+	circle := setupCircleWithMembers(t, userRepo, circleRepo, 0, 0)
+	mailer := &recordingMailer{}
+	useCase := NewNotifyMemberJoinedUseCase(userRepo, circleRepo, newTestDispatcher(mailer, domain.LocaleJapanese))
+
+	// Act
+	err := useCase.Execute(NotifyMemberJoinedInput{CircleID: circle.ID().Value(), UserID: domain.NewUserID().Value()})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected a deleted user to be skipped, but got: %v", err)
+	}
+	if len(mailer.sent) != 0 {
+		t.Errorf("Expected no mail, but got %d", len(mailer.sent))
+	}
+}
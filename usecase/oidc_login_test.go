@@ -0,0 +1,255 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+const testOIDCIssuer = "https://idp.example.test"
+
+// oidcTestFixture は同じプロセス内の偽の OpenID プロバイダーを相手にログインする
+type oidcTestFixture struct {
+	*authTestFixture
+	provider     *infrastructure.FakeOIDCProvider
+	identityRepo domain.ExternalIdentityRepository
+	start        *StartOIDCLoginUseCase
+	complete     *CompleteOIDCLoginUseCase
+}
+
+func newOIDCTestFixture(t *testing.T) *oidcTestFixture {
+	t.Helper()
+
+	f := &oidcTestFixture{
+		authTestFixture: newAuthTestFixture(),
+		identityRepo:    infrastructure.NewMemoryExternalIdentityRepository(),
+	}
+	provider, err := infrastructure.NewFakeOIDCProvider(testOIDCIssuer, "ddd-bottomup", "client-secret", f.clock)
+	if err != nil {
+		t.Fatalf("Failed to create provider: %v", err)
+	}
+	provider.AddUser(infrastructure.FakeOIDCUser{
+		Subject: "sso-taro", Email: "taro@example.com", EmailVerified: true, GivenName: "太郎", FamilyName: "山田",
+	})
+	provider.AddUser(infrastructure.FakeOIDCUser{
+		Subject: "sso-jiro", Email: "jiro@example.com", EmailVerified: false, GivenName: "次郎", FamilyName: "山田",
+	})
+	client := infrastructure.NewOIDCClient(infrastructure.OIDCClientConfig{
+		Issuer:       testOIDCIssuer,
+		ClientID:     "ddd-bottomup",
+		ClientSecret: "client-secret",
+		RedirectURL:  "https://app.example.test/auth/oidc/callback",
+	}, provider.HTTPClient())
+
+	loginRequestRepo := infrastructure.NewMemoryOIDCLoginRequestRepository()
+	f.provider = provider
+	f.start = NewStartOIDCLoginUseCase(loginRequestRepo, client, domain.DefaultOIDCLoginTTL, f.clock)
+	f.complete = NewCompleteOIDCLoginUseCase(loginRequestRepo, f.identityRepo, f.userRepo, f.sessionRepo,
+		client, f.create, time.Hour, f.clock)
+	return f
+}
+
+// authorize はプロバイダーでログインし、コールバックに渡されるパラメーターを返す
+func (f *oidcTestFixture) authorize(t *testing.T, actorID, loginHint string) CompleteOIDCLoginInput {
+	t.Helper()
+
+	started, err := f.start.Execute(StartOIDCLoginInput{Actor: userActor(t, actorID), LoginHint: loginHint})
+	if err != nil {
+		t.Fatalf("Failed to start login: %v", err)
+	}
+	response, err := f.provider.HTTPClient().Get(started.AuthorizationURL)
+	if err != nil {
+		t.Fatalf("Failed to authorize: %v", err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusFound {
+		t.Fatalf("Expected a redirect, but got status %d", response.StatusCode)
+	}
+	location, _ := url.Parse(response.Header.Get("Location"))
+	query := location.Query()
+	return CompleteOIDCLoginInput{State: query.Get("state"), Code: query.Get("code"), Error: query.Get("error")}
+}
+
+func (f *oidcTestFixture) login(t *testing.T, actorID, loginHint string) (*CompleteOIDCLoginOutput, error) {
+	t.Helper()
+
+	return f.complete.Execute(f.authorize(t, actorID, loginHint))
+}
+
+func TestCompleteOIDCLoginUseCase_Execute_CreatesUserJustInTime(t *testing.T) {
+	// Arrange
+	f := newOIDCTestFixture(t)
+
+	// Act
+	first, err := f.login(t, "", "taro@example.com")
+	if err != nil {
+		t.Fatalf("Failed to log in: %v", err)
+	}
+	second, err := f.login(t, "", "taro@example.com")
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if !first.UserCreated || second.UserCreated {
+		t.Errorf("Expected only the first login to create a user, but got %v and %v", first.UserCreated, second.UserCreated)
+	}
+	if first.UserID != second.UserID {
+		t.Errorf("Expected both logins to resolve to %s, but got %s", first.UserID, second.UserID)
+	}
+	user, _ := f.userRepo.FindByID(mustUserID(t, first.UserID))
+	if user.Name().String() != "太郎 山田" || user.Email().Value() != "taro@example.com" {
+		t.Errorf("Unexpected user: %s <%s>", user.Name(), user.Email())
+	}
+	if authenticated, err := f.authenticate.Execute(AuthenticateInput{Token: second.Token}); err != nil || authenticated.UserID != first.UserID {
+		t.Errorf("Expected the session to authenticate %s, but got %+v (%v)", first.UserID, authenticated, err)
+	}
+}
+
+func TestCompleteOIDCLoginUseCase_Execute_ExistingEmail(t *testing.T) {
+	tests := []struct {
+		name           string
+		loginHint      string
+		existingUser   func(t *testing.T, repo domain.UserRepository) *domain.User
+		expectedLinked bool
+	}{
+		{"双方で確認済みのメールアドレスは紐付ける", "taro@example.com", func(t *testing.T, repo domain.UserRepository) *domain.User {
+			return saveVerifiedUser(t, repo, "taro")
+		}, true},
+		{"こちらで未確認のメールアドレスは紐付けない", "taro@example.com", func(t *testing.T, repo domain.UserRepository) *domain.User {
+			return saveNewUser(t, repo, "taro")
+		}, false},
+		{"プロバイダーで未確認のメールアドレスは紐付けない", "jiro@example.com", func(t *testing.T, repo domain.UserRepository) *domain.User {
+			return saveVerifiedUser(t, repo, "jiro")
+		}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			f := newOIDCTestFixture(t)
+			existing := tt.existingUser(t, f.userRepo)
+
+			// Act
+			output, err := f.login(t, "", tt.loginHint)
+
+			// Assert
+			if !tt.expectedLinked {
+				var conflict domain.ExternalIdentityConflictError
+				if !errors.As(err, &conflict) {
+					t.Errorf("Expected ExternalIdentityConflictError, but got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if output.UserID != existing.ID().Value() || output.UserCreated {
+				t.Errorf("Expected to log in as %s, but got %+v", existing.ID(), output)
+			}
+		})
+	}
+}
+
+func TestCompleteOIDCLoginUseCase_Execute_LinksToLoggedInUser(t *testing.T) {
+	// Arrange
+	f := newOIDCTestFixture(t)
+	userID := f.createUser(t, "hanako", "correct horse battery")
+
+	// Act
+	linked, err := f.login(t, userID, "jiro@example.com")
+	if err != nil {
+		t.Fatalf("Failed to link: %v", err)
+	}
+	loggedIn, err := f.login(t, "", "jiro@example.com")
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if linked.UserID != userID || loggedIn.UserID != userID || linked.UserCreated || loggedIn.UserCreated {
+		t.Errorf("Expected both logins to resolve to %s, but got %+v and %+v", userID, linked, loggedIn)
+	}
+}
+
+func TestCompleteOIDCLoginUseCase_Execute_RejectsLinkToAnotherUser(t *testing.T) {
+	// Arrange
+	f := newOIDCTestFixture(t)
+	if _, err := f.login(t, "", "taro@example.com"); err != nil {
+		t.Fatalf("Failed to log in: %v", err)
+	}
+	otherUserID := f.createUser(t, "hanako", "correct horse battery")
+
+	// Act
+	_, err := f.login(t, otherUserID, "taro@example.com")
+
+	// Assert
+	var conflict domain.ExternalIdentityConflictError
+	if !errors.As(err, &conflict) {
+		t.Errorf("Expected ExternalIdentityConflictError, but got %v", err)
+	}
+}
+
+func TestCompleteOIDCLoginUseCase_Execute_RejectsInvalidCallbacks(t *testing.T) {
+	tests := []struct {
+		name     string
+		callback func(t *testing.T, f *oidcTestFixture) CompleteOIDCLoginInput
+	}{
+		{"存在しない state", func(t *testing.T, f *oidcTestFixture) CompleteOIDCLoginInput {
+			input := f.authorize(t, "", "taro@example.com")
+			input.State = "unknown"
+			return input
+		}},
+		{"使用済みの state", func(t *testing.T, f *oidcTestFixture) CompleteOIDCLoginInput {
+			input := f.authorize(t, "", "taro@example.com")
+			if _, err := f.complete.Execute(input); err != nil {
+				t.Fatalf("Failed to log in: %v", err)
+			}
+			return input
+		}},
+		{"期限切れのログイン", func(t *testing.T, f *oidcTestFixture) CompleteOIDCLoginInput {
+			input := f.authorize(t, "", "taro@example.com")
+			f.clock.Advance(domain.DefaultOIDCLoginTTL)
+			return input
+		}},
+		{"プロバイダーのエラー", func(t *testing.T, f *oidcTestFixture) CompleteOIDCLoginInput {
+			return f.authorize(t, "", "") // 利用者が2人いるため login_hint がなければログインできない
+		}},
+		{"認可コードの書き換え", func(t *testing.T, f *oidcTestFixture) CompleteOIDCLoginInput {
+			input := f.authorize(t, "", "taro@example.com")
+			input.Code = f.authorize(t, "", "jiro@example.com").Code
+			return input
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			f := newOIDCTestFixture(t)
+			input := tt.callback(t, f)
+
+			// Act
+			_, err := f.complete.Execute(input)
+
+			// Assert
+			var invalid domain.InvalidOIDCLoginError
+			if !errors.As(err, &invalid) {
+				t.Errorf("Expected InvalidOIDCLoginError, but got %v", err)
+			}
+		})
+	}
+}
+
+func mustUserID(t *testing.T, value string) *domain.UserID {
+	t.Helper()
+
+	userID, err := domain.ReconstructUserID(value)
+	if err != nil {
+		t.Fatalf("Invalid user ID %q: %v", value, err)
+	}
+	return userID
+}
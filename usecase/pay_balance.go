@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type PayBalanceInput struct {
+	Actor          *domain.Principal // 本人か users:write が必要
+	RequestID      string            // 監査ログに記録するリクエストID
+	UserID         string
+	IdempotencyKey string // クライアントの再送で二重に請求しないためのキー
+}
+
+type PayBalanceOutput struct {
+	ChargeID string
+	Status   string
+	Amount   *MoneyOutput
+	Entry    *LedgerEntryOutput // 即時に入金が確定した場合のみ（保留中・重複時はnil）
+}
+
+// PayBalanceUseCase は未払残高を決済事業者経由で支払う
+type PayBalanceUseCase struct {
+	userRepository   domain.UserRepository
+	ledgerRepository domain.LedgerRepository
+	paymentGateway   domain.PaymentGateway
+	clock            domain.Clock
+	auditLog         *AuditLog
+}
+
+func NewPayBalanceUseCase(
+	userRepository domain.UserRepository,
+	ledgerRepository domain.LedgerRepository,
+	paymentGateway domain.PaymentGateway,
+	clock domain.Clock,
+	auditLog *AuditLog,
+) *PayBalanceUseCase {
+	return &PayBalanceUseCase{
+		userRepository:   userRepository,
+		ledgerRepository: ledgerRepository,
+		paymentGateway:   paymentGateway,
+		clock:            clock,
+		auditLog:         auditLog,
+	}
+}
+
+func (uc *PayBalanceUseCase) Execute(input PayBalanceInput) (*PayBalanceOutput, error) {
+	if _, err := authorizeUser(input.Actor, domain.ActionUpdateUser, input.UserID); err != nil {
+		return nil, err
+	}
+
+	user, err := findUser(uc.userRepository, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	ledger, err := uc.ledgerRepository.FindByUserID(user.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := ledger.Balance()
+	if err != nil {
+		return nil, err
+	}
+	if balance == nil || !balance.IsPositive() {
+		return nil, domain.InvalidLedgerEntryError{Reason: "no outstanding balance"}
+	}
+
+	result, err := uc.paymentGateway.Charge(domain.ChargeRequest{
+		UserID:         user.ID(),
+		Amount:         balance,
+		Description:    "Outstanding balance",
+		IdempotencyKey: input.IdempotencyKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := &PayBalanceOutput{
+		ChargeID: result.ChargeID,
+		Status:   string(result.Status),
+		Amount:   NewMoneyOutput(balance),
+	}
+
+	switch result.Status {
+	case domain.PaymentDeclined:
+		return nil, domain.PaymentDeclinedError{Reason: result.DeclineReason}
+	case domain.PaymentSucceeded:
+		// Webhookより先に記録してもよい（参照キーが同じなので二重計上されない）
+		entry, err := recordGatewayEntry(
+			uc.ledgerRepository, user.ID(), domain.LedgerEntryPayment, balance,
+			"Payment", domain.PaymentReference(result.ChargeID), uc.clock.Now(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if entry != nil {
+			output.Entry = NewLedgerEntryOutput(entry)
+		}
+	case domain.PaymentPending:
+		// 入金は charge.succeeded のWebhookで記録する
+	}
+
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditBalancePaid, domain.AuditTarget{Type: domain.AuditTargetLedger, ID: user.ID().Value()}, nil); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
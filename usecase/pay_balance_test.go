@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"testing"
+	"time"
+)
+
+type paymentTestFixture struct {
+	user       *domain.User
+	userRepo   domain.UserRepository
+	ledgerRepo domain.LedgerRepository
+	gateway    *infrastructure.FakePaymentGateway
+	clock      *domain.FixedClock
+	payBalance *PayBalanceUseCase
+	webhook    *HandlePaymentWebhookUseCase
+}
+
+// setupPaymentTest は月額プランの請求（¥500）がある状態を用意する
+func setupPaymentTest(t *testing.T) *paymentTestFixture {
+	t.Helper()
+
+	userRepo := infrastructure.NewMemoryUserRepository()
+	ledgerRepo := infrastructure.NewMemoryLedgerRepository()
+	clock := domain.NewFixedClock(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC))
+	gateway := infrastructure.NewFakePaymentGateway("test-secret", clock)
+	user := setupSubscriptionUser(t, userRepo)
+
+	upgrade := NewUpgradeSubscriptionUseCase(userRepo, ledgerRepo, domain.DefaultPlanPriceList(), clock, newTestAuditLog())
+	if _, err := upgrade.Execute(UpgradeSubscriptionInput{Actor: adminActor(), UserID: user.ID().Value(), Plan: "premium_monthly"}); err != nil {
+		t.Fatalf("Failed to upgrade: %v", err)
+	}
+
+	return &paymentTestFixture{
+		user:       user,
+		userRepo:   userRepo,
+		ledgerRepo: ledgerRepo,
+		gateway:    gateway,
+		clock:      clock,
+		payBalance: NewPayBalanceUseCase(userRepo, ledgerRepo, gateway, clock, newTestAuditLog()),
+		webhook:    NewHandlePaymentWebhookUseCase(gateway, ledgerRepo),
+	}
+}
+
+func (f *paymentTestFixture) balance(t *testing.T) int64 {
+	t.Helper()
+
+	output, err := NewGetLedgerUseCase(f.userRepo, f.ledgerRepo, nil).Execute(GetLedgerInput{Actor: adminActor(), UserID: f.user.ID().Value()})
+	if err != nil {
+		t.Fatalf("Failed to get ledger: %v", err)
+	}
+	if output.Balance == nil {
+		return 0
+	}
+	return output.Balance.Amount
+}
+
+// deliverWebhooks は送信待ちのWebhookを全て処理し、台帳に反映された件数を返す
+func (f *paymentTestFixture) deliverWebhooks(t *testing.T) int {
+	t.Helper()
+
+	applied := 0
+	for _, webhook := range f.gateway.DrainWebhooks() {
+		output, err := f.webhook.Execute(HandlePaymentWebhookInput{Payload: webhook.Payload, Signature: webhook.Signature})
+		if err != nil {
+			t.Fatalf("Failed to handle webhook: %v", err)
+		}
+		if output.Applied {
+			applied++
+		}
+	}
+	return applied
+}
+
+func TestPayBalanceUseCase_Execute_Success(t *testing.T) {
+	// Arrange
+	f := setupPaymentTest(t)
+
+	// Act
+	output, err := f.payBalance.Execute(PayBalanceInput{Actor: adminActor(), UserID: f.user.ID().Value(), IdempotencyKey: "checkout-1"})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if output.Status != "succeeded" || output.Entry == nil {
+		t.Errorf("Expected recorded payment, but got status %s", output.Status)
+	}
+	if output.Amount.Amount != 500 {
+		t.Errorf("Expected charge of ¥500, but got %d", output.Amount.Amount)
+	}
+	if balance := f.balance(t); balance != 0 {
+		t.Errorf("Expected balance 0, but got %d", balance)
+	}
+
+	// 成功通知のWebhookは記録済みのため反映されない
+	if applied := f.deliverWebhooks(t); applied != 0 {
+		t.Errorf("Expected webhook not to be applied again, but %d applied", applied)
+	}
+	if balance := f.balance(t); balance != 0 {
+		t.Errorf("Expected balance 0 after webhook, but got %d", balance)
+	}
+}
+
+func TestPayBalanceUseCase_Execute_Declined_ReturnsError(t *testing.T) {
+	// Arrange
+	f := setupPaymentTest(t)
+	f.gateway.DeclineNext("insufficient_funds")
+
+	// Act
+	output, err := f.payBalance.Execute(PayBalanceInput{Actor: adminActor(), UserID: f.user.ID().Value()})
+
+	// Assert
+	if _, ok := err.(domain.PaymentDeclinedError); !ok {
+		t.Errorf("Expected PaymentDeclinedError, but got %T", err)
+	}
+	if output != nil {
+		t.Error("Expected no output")
+	}
+	if applied := f.deliverWebhooks(t); applied != 0 {
+		t.Errorf("Expected charge.failed not to touch the ledger, but %d applied", applied)
+	}
+	if balance := f.balance(t); balance != 500 {
+		t.Errorf("Expected balance 500, but got %d", balance)
+	}
+}
+
+func TestPayBalanceUseCase_Execute_Pending_SettledByWebhook(t *testing.T) {
+	// Arrange
+	f := setupPaymentTest(t)
+	f.gateway.DelayNext()
+
+	// Act
+	output, err := f.payBalance.Execute(PayBalanceInput{Actor: adminActor(), UserID: f.user.ID().Value()})
+
+	// Assert: 保留中は入金を記録しない
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if output.Status != "pending" || output.Entry != nil {
+		t.Errorf("Expected pending charge without entry, but got %s", output.Status)
+	}
+	if balance := f.balance(t); balance != 500 {
+		t.Errorf("Expected balance 500 while pending, but got %d", balance)
+	}
+
+	// 決済確定のWebhookで入金を記録する
+	f.gateway.SettlePending()
+	if applied := f.deliverWebhooks(t); applied != 1 {
+		t.Errorf("Expected 1 webhook applied, but got %d", applied)
+	}
+	if balance := f.balance(t); balance != 0 {
+		t.Errorf("Expected balance 0 after settlement, but got %d", balance)
+	}
+}
+
+func TestPayBalanceUseCase_Execute_SameIdempotencyKey_ChargesOnce(t *testing.T) {
+	// Arrange
+	f := setupPaymentTest(t)
+	f.gateway.DelayNext()
+	input := PayBalanceInput{Actor: adminActor(), UserID: f.user.ID().Value(), IdempotencyKey: "checkout-1"}
+	first, err := f.payBalance.Execute(input)
+	if err != nil {
+		t.Fatalf("Failed to pay balance: %v", err)
+	}
+
+	// Act: クライアントの再送
+	second, err := f.payBalance.Execute(input)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if first.ChargeID != second.ChargeID {
+		t.Errorf("Expected same charge, but got %s and %s", first.ChargeID, second.ChargeID)
+	}
+}
+
+func TestPayBalanceUseCase_Execute_NoBalance_ReturnsError(t *testing.T) {
+	// Arrange
+	userRepo := infrastructure.NewMemoryUserRepository()
+	ledgerRepo := infrastructure.NewMemoryLedgerRepository()
+	clock := domain.NewFixedClock(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC))
+	gateway := infrastructure.NewFakePaymentGateway("test-secret", clock)
+	user := setupSubscriptionUser(t, userRepo)
+
+	// Act
+	_, err := NewPayBalanceUseCase(userRepo, ledgerRepo, gateway, clock, newTestAuditLog()).Execute(PayBalanceInput{Actor: adminActor(), UserID: user.ID().Value()})
+
+	// Assert
+	if _, ok := err.(domain.InvalidLedgerEntryError); !ok {
+		t.Errorf("Expected InvalidLedgerEntryError, but got %T", err)
+	}
+}
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type PurgeDeletedRecordsOutput struct {
+	PurgedUsers   int
+	PurgedCircles int
+}
+
+// PurgeDeletedRecordsUseCase は保持期間を過ぎた論理削除済みのユーザー・サークルを物理削除する
+type PurgeDeletedRecordsUseCase struct {
+	userRepository       domain.UserRepository
+	circleRepository     domain.CircleRepository
+	credentialRepository domain.CredentialRepository
+	shipmentRepository   domain.ShipmentRepository
+	deletionPolicy       domain.DeletionPolicy
+	clock                domain.Clock
+	auditLog             *AuditLog
+}
+
+func NewPurgeDeletedRecordsUseCase(
+	userRepository domain.UserRepository,
+	circleRepository domain.CircleRepository,
+	credentialRepository domain.CredentialRepository,
+	shipmentRepository domain.ShipmentRepository,
+	deletionPolicy domain.DeletionPolicy,
+	clock domain.Clock,
+	auditLog *AuditLog,
+) *PurgeDeletedRecordsUseCase {
+	return &PurgeDeletedRecordsUseCase{
+		userRepository:       userRepository,
+		circleRepository:     circleRepository,
+		credentialRepository: credentialRepository,
+		shipmentRepository:   shipmentRepository,
+		deletionPolicy:       deletionPolicy,
+		clock:                clock,
+		auditLog:             auditLog,
+	}
+}
+
+func (uc *PurgeDeletedRecordsUseCase) Execute() (*PurgeDeletedRecordsOutput, error) {
+	cutoff := uc.deletionPolicy.PurgeCutoff(uc.clock.Now())
+	output := &PurgeDeletedRecordsOutput{}
+
+	// サークルを先に削除する（ユーザーと一緒に削除したサークルはオーナーより先に消す）
+	circles, err := uc.circleRepository.FindDeletedBefore(cutoff)
+	if err != nil {
+		return nil, err
+	}
+	for _, circle := range circles {
+		if err := uc.circleRepository.Delete(circle.ID()); err != nil {
+			return output, err
+		}
+		changes := domain.DiffAuditSnapshots(domain.CircleAuditSnapshot(circle), nil)
+		if err := uc.auditLog.Record(nil, "", domain.AuditCirclePurged, domain.CircleAuditTarget(circle.ID()), changes); err != nil {
+			return output, err
+		}
+		output.PurgedCircles++
+	}
+
+	users, err := uc.userRepository.FindDeletedBefore(cutoff)
+	if err != nil {
+		return output, err
+	}
+	if len(users) == 0 {
+		return output, nil
+	}
+	activeCircles, err := uc.circleRepository.FindAll()
+	if err != nil {
+		return output, err
+	}
+	for _, user := range users {
+		if err := uc.purgeUser(user, activeCircles); err != nil {
+			return output, err
+		}
+		output.PurgedUsers++
+	}
+	return output, nil
+}
+
+func (uc *PurgeDeletedRecordsUseCase) purgeUser(user *domain.User, activeCircles []*domain.Circle) error {
+	// 参加していたサークルに戻すための記録を消す
+	for _, circle := range activeCircles {
+		if !circle.ForgetDetachedMember(user.ID()) {
+			continue
+		}
+		if err := uc.circleRepository.Save(circle); err != nil {
+			return err
+		}
+	}
+
+	if err := uc.credentialRepository.Delete(user.ID()); err != nil {
+		return err
+	}
+	// 発送と送料は記録として残し、受取人だけを外す
+	if err := uc.shipmentRepository.DetachRecipient(user.ID()); err != nil {
+		return err
+	}
+	if err := uc.userRepository.Delete(user.ID()); err != nil {
+		return err
+	}
+
+	changes := domain.DiffAuditSnapshots(domain.UserAuditSnapshot(user), nil)
+	return uc.auditLog.Record(nil, "", domain.AuditUserPurged, domain.UserAuditTarget(user.ID()), changes)
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"testing"
+	"time"
+)
+
+func TestPurgeDeletedRecordsUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name        string
+		elapsed     time.Duration
+		wantUsers   int
+		wantCircles int
+	}{
+		{"保持期間内は削除しない", domain.DefaultDeletionRetention - time.Second, 0, 0},
+		{"保持期間を過ぎたら物理削除する", domain.DefaultDeletionRetention + time.Second, 1, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			f := newDeletionTestFixture(t, 2)
+			f.clock.Advance(tt.elapsed)
+			useCase := NewPurgeDeletedRecordsUseCase(f.userRepo, f.circleRepo, infrastructure.NewMemoryCredentialRepository(),
+				infrastructure.NewMemoryShipmentRepository(), domain.DefaultDeletionPolicy(), f.clock, NewAuditLog(f.auditRepo, f.clock))
+
+			// Act
+			output, err := useCase.Execute()
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if output.PurgedUsers != tt.wantUsers || output.PurgedCircles != tt.wantCircles {
+				t.Errorf("Expected %d users and %d circles, but got %d and %d", tt.wantUsers, tt.wantCircles, output.PurgedUsers, output.PurgedCircles)
+			}
+			deleted, _ := f.userRepo.FindDeletedByID(f.deleted)
+			joined, _ := f.circleRepo.FindByID(f.circle.ID())
+			purged := tt.wantUsers > 0
+			if (deleted == nil) != purged {
+				t.Errorf("Expected the user to be purged=%v", purged)
+			}
+			// 物理削除したユーザーはサークルに戻さない
+			if joined.IsDetachedMember(f.deleted) == purged {
+				t.Errorf("Expected the detached record to be forgotten=%v", purged)
+			}
+			if entries := findAuditEntries(t, f.auditRepo); len(entries) != tt.wantUsers+tt.wantCircles {
+				t.Errorf("Expected %d audit entries, but got %d", tt.wantUsers+tt.wantCircles, len(entries))
+			}
+		})
+	}
+}
+
+func TestPurgeDeletedRecordsUseCase_Execute_KeepsShipmentsOfPurgedRecipient(t *testing.T) {
+	// Arrange
+	f := newDeletionTestFixture(t, 2)
+	shipmentRepo := infrastructure.NewMemoryShipmentRepository()
+	dimensions, _ := domain.NewDimensions(30, 20, 5)
+	baggage, _ := domain.NewBaggage("サークルTシャツ", 300, dimensions)
+	fee, err := domain.NewShippingFeeCalculator(domain.DefaultShippingRateTable()).Calculate([]*domain.Baggage{baggage}, "domestic", false)
+	if err != nil {
+		t.Fatalf("Failed to calculate fee: %v", err)
+	}
+	shipment, _ := domain.NewShipment(f.deleted, []*domain.Baggage{baggage}, fee, f.clock.Now())
+	if err := shipmentRepo.Save(shipment); err != nil {
+		t.Fatalf("Failed to save shipment: %v", err)
+	}
+	f.clock.Advance(domain.DefaultDeletionRetention + time.Second)
+	useCase := NewPurgeDeletedRecordsUseCase(f.userRepo, f.circleRepo, infrastructure.NewMemoryCredentialRepository(),
+		shipmentRepo, domain.DefaultDeletionPolicy(), f.clock, NewAuditLog(f.auditRepo, f.clock))
+
+	// Act
+	output, err := useCase.Execute()
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if output.PurgedUsers != 1 {
+		t.Errorf("Expected 1 purged user, but got %d", output.PurgedUsers)
+	}
+	kept, _ := shipmentRepo.FindByID(shipment.ID())
+	if kept == nil {
+		t.Fatal("Expected the shipment to be kept")
+	}
+	if kept.RecipientID() != nil {
+		t.Errorf("Expected the recipient to be detached, but got %s", kept.RecipientID().Value())
+	}
+	if shipments, _ := shipmentRepo.FindByRecipientID(f.deleted); len(shipments) != 0 {
+		t.Errorf("Expected no shipments for the purged user, but got %d", len(shipments))
+	}
+	if output := NewShipmentOutput(kept); output.RecipientID != "" {
+		t.Errorf("Expected an empty recipient in the output, but got %s", output.RecipientID)
+	}
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type QuoteShippingFeeInput struct {
+	Actor           *domain.Principal // 受取人本人か shipments:read が必要
+	RecipientID     string
+	DestinationZone string
+	Baggage         []BaggageInput
+}
+
+type ShippingFeeItemOutput struct {
+	Code        string
+	Description string
+	Amount      *MoneyOutput
+}
+
+type ShippingFeeOutput struct {
+	DestinationZone       string
+	ActualWeightGrams     int64
+	VolumetricWeightGrams int64
+	ChargeableWeightGrams int64
+	Items                 []*ShippingFeeItemOutput
+	Total                 *MoneyOutput
+}
+
+func NewShippingFeeOutput(fee *domain.ShippingFee) *ShippingFeeOutput {
+	items := make([]*ShippingFeeItemOutput, 0, len(fee.Items()))
+	for _, item := range fee.Items() {
+		items = append(items, &ShippingFeeItemOutput{
+			Code:        item.Code().String(),
+			Description: item.Description(),
+			Amount:      NewMoneyOutput(item.Amount()),
+		})
+	}
+	return &ShippingFeeOutput{
+		DestinationZone:       fee.Zone(),
+		ActualWeightGrams:     fee.ActualWeightGrams(),
+		VolumetricWeightGrams: fee.VolumetricWeightGrams(),
+		ChargeableWeightGrams: fee.ChargeableWeightGrams(),
+		Items:                 items,
+		Total:                 NewMoneyOutput(fee.Total()),
+	}
+}
+
+// QuoteShippingFeeUseCase は発送を作成せずに送料の見積もりを返す
+type QuoteShippingFeeUseCase struct {
+	userRepository domain.UserRepository
+	feeCalculator  *domain.ShippingFeeCalculator
+	clock          domain.Clock
+}
+
+func NewQuoteShippingFeeUseCase(userRepository domain.UserRepository, feeCalculator *domain.ShippingFeeCalculator, clock domain.Clock) *QuoteShippingFeeUseCase {
+	return &QuoteShippingFeeUseCase{
+		userRepository: userRepository,
+		feeCalculator:  feeCalculator,
+		clock:          clock,
+	}
+}
+
+func (uc *QuoteShippingFeeUseCase) Execute(input QuoteShippingFeeInput) (*ShippingFeeOutput, error) {
+	if _, err := authorizeUser(input.Actor, domain.ActionQuoteShippingFee, input.RecipientID); err != nil {
+		return nil, err
+	}
+
+	recipient, err := findUser(uc.userRepository, input.RecipientID)
+	if err != nil {
+		return nil, err
+	}
+
+	baggage, err := buildBaggage(input.Baggage)
+	if err != nil {
+		return nil, err
+	}
+
+	fee, err := uc.feeCalculator.Calculate(baggage, input.DestinationZone, recipient.IsPremiumAt(uc.clock.Now()))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewShippingFeeOutput(fee), nil
+}
@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+// ExpenseParticipantInput は負担者1人分の指定（Shares は口数での按分、Amount は金額指定の場合のみ使う）
+type ExpenseParticipantInput struct {
+	UserID string
+	Shares int64
+	Amount int64
+}
+
+type RecordCircleExpenseInput struct {
+	Actor        *domain.Principal // オーナー・メンバー（本人が立て替えた分のみ）か circles:write が必要
+	RequestID    string            // 監査ログに記録するリクエストID
+	CircleID     string
+	PayerID      string
+	Amount       int64
+	Currency     string
+	Description  string
+	SplitMethod  string // equal / shares / exact
+	Participants []ExpenseParticipantInput
+}
+
+type ExpenseShareOutput struct {
+	UserID string
+	Amount *MoneyOutput
+}
+
+type CircleExpenseOutput struct {
+	ExpenseID   string
+	CircleID    string
+	PayerID     string
+	Amount      *MoneyOutput
+	Description string
+	SplitMethod string
+	Shares      []*ExpenseShareOutput
+	IncurredAt  time.Time
+}
+
+func NewCircleExpenseOutput(expense *domain.CircleExpense) *CircleExpenseOutput {
+	shares := make([]*ExpenseShareOutput, 0, len(expense.Shares()))
+	for _, share := range expense.Shares() {
+		shares = append(shares, &ExpenseShareOutput{
+			UserID: share.ParticipantID().Value(),
+			Amount: NewMoneyOutput(share.Amount()),
+		})
+	}
+	return &CircleExpenseOutput{
+		ExpenseID:   expense.ID().Value(),
+		CircleID:    expense.CircleID().Value(),
+		PayerID:     expense.PayerID().Value(),
+		Amount:      NewMoneyOutput(expense.Amount()),
+		Description: expense.Description(),
+		SplitMethod: expense.SplitMethod().String(),
+		Shares:      shares,
+		IncurredAt:  expense.IncurredAt(),
+	}
+}
+
+type RecordCircleExpenseUseCase struct {
+	circleRepository  domain.CircleRepository
+	expenseRepository domain.CircleExpenseRepository
+	clock             domain.Clock
+	auditLog          *AuditLog
+}
+
+func NewRecordCircleExpenseUseCase(
+	circleRepository domain.CircleRepository,
+	expenseRepository domain.CircleExpenseRepository,
+	clock domain.Clock,
+	auditLog *AuditLog,
+) *RecordCircleExpenseUseCase {
+	return &RecordCircleExpenseUseCase{
+		circleRepository:  circleRepository,
+		expenseRepository: expenseRepository,
+		clock:             clock,
+		auditLog:          auditLog,
+	}
+}
+
+func (uc *RecordCircleExpenseUseCase) Execute(input RecordCircleExpenseInput) (*CircleExpenseOutput, error) {
+	amount, err := domain.NewMoney(input.Amount, input.Currency)
+	if err != nil {
+		return nil, err
+	}
+	payerID, err := domain.ReconstructUserID(input.PayerID)
+	if err != nil {
+		return nil, err
+	}
+	split, err := buildExpenseSplit(input.SplitMethod, input.Participants, amount.Currency())
+	if err != nil {
+		return nil, err
+	}
+
+	circle, err := findCircle(uc.circleRepository, input.CircleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeCircle(input.Actor, domain.ActionRecordCircleExpense, circle); err != nil {
+		return nil, err
+	}
+	// 他の人が立て替えた分を記録できるのは circles:write を持つ場合のみ
+	if err := domain.Authorize(input.Actor, domain.ActionRecordExpensePayer, domain.Resource{UserID: payerID}); err != nil {
+		return nil, err
+	}
+
+	// 参加者の確認・負担額の計算は集約が行う
+	expense, err := domain.NewCircleExpense(circle, payerID, amount, input.Description, split, uc.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.expenseRepository.Save(expense); err != nil {
+		return nil, err
+	}
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditCircleExpenseRecorded, domain.AuditTarget{Type: domain.AuditTargetCircleExpense, ID: expense.ID().Value()}, nil); err != nil {
+		return nil, err
+	}
+
+	return NewCircleExpenseOutput(expense), nil
+}
+
+func buildExpenseSplit(method string, participants []ExpenseParticipantInput, currency string) (*domain.ExpenseSplit, error) {
+	splitMethod, err := domain.ParseSplitMethod(method)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]*domain.UserID, len(participants))
+	for i, participant := range participants {
+		userID, err := domain.ReconstructUserID(participant.UserID)
+		if err != nil {
+			return nil, err
+		}
+		userIDs[i] = userID
+	}
+
+	switch splitMethod {
+	case domain.SplitShares:
+		shares := make([]int64, len(participants))
+		for i, participant := range participants {
+			shares[i] = participant.Shares
+		}
+		return domain.NewSharesSplit(userIDs, shares)
+	case domain.SplitExact:
+		amounts := make([]*domain.Money, len(participants))
+		for i, participant := range participants {
+			amount, err := domain.NewMoney(participant.Amount, currency)
+			if err != nil {
+				return nil, err
+			}
+			amounts[i] = amount
+		}
+		return domain.NewExactSplit(userIDs, amounts)
+	default:
+		return domain.NewEqualSplit(userIDs)
+	}
+}
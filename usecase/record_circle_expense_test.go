@@ -0,0 +1,234 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type circleExpenseTestFixture struct {
+	circle       *domain.Circle
+	participants []string
+	record       *RecordCircleExpenseUseCase
+	list         *ListCircleExpensesUseCase
+	settlement   *GetCircleSettlementUseCase
+}
+
+func setupCircleExpenseTest(t *testing.T, memberCount int) *circleExpenseTestFixture {
+	t.Helper()
+
+	userRepo := infrastructure.NewMemoryUserRepository()
+	circleRepo := infrastructure.NewMemoryCircleRepository()
+	expenseRepo := infrastructure.NewMemoryCircleExpenseRepository()
+	circle := setupCircleWithMembers(t, userRepo, circleRepo, memberCount, 0)
+	clock := domain.NewFixedClock(time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC))
+
+	participants := []string{circle.OwnerID().Value()}
+	for _, memberID := range circle.GetMemberIDs() {
+		participants = append(participants, memberID.Value())
+	}
+
+	return &circleExpenseTestFixture{
+		circle:       circle,
+		participants: participants,
+		record:       NewRecordCircleExpenseUseCase(circleRepo, expenseRepo, clock, newTestAuditLog()),
+		list:         NewListCircleExpensesUseCase(circleRepo, expenseRepo),
+		settlement:   NewGetCircleSettlementUseCase(circleRepo, expenseRepo, domain.NewSettlementService()),
+	}
+}
+
+func (f *circleExpenseTestFixture) everyone() []ExpenseParticipantInput {
+	inputs := make([]ExpenseParticipantInput, len(f.participants))
+	for i, participant := range f.participants {
+		inputs[i] = ExpenseParticipantInput{UserID: participant}
+	}
+	return inputs
+}
+
+func TestRecordCircleExpenseUseCase_Execute_Success(t *testing.T) {
+	// Arrange
+	f := setupCircleExpenseTest(t, 2)
+
+	// Act
+	output, err := f.record.Execute(RecordCircleExpenseInput{
+		Actor:        adminActor(),
+		CircleID:     f.circle.ID().Value(),
+		PayerID:      f.participants[0],
+		Amount:       10000,
+		Currency:     "JPY",
+		Description:  "合宿の宿泊費",
+		SplitMethod:  "equal",
+		Participants: f.everyone(),
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	expected := []int64{3334, 3333, 3333}
+	for i, share := range output.Shares {
+		if share.Amount.Amount != expected[i] {
+			t.Errorf("Expected share %d to be %d, but got %d", i, expected[i], share.Amount.Amount)
+		}
+	}
+
+	listed, err := f.list.Execute(ListCircleExpensesInput{Actor: adminActor(), CircleID: f.circle.ID().Value()})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if len(listed.Expenses) != 1 {
+		t.Errorf("Expected 1 expense, but got %d", len(listed.Expenses))
+	}
+}
+
+func TestRecordCircleExpenseUseCase_Execute_InvalidInput_ReturnsError(t *testing.T) {
+	f := setupCircleExpenseTest(t, 1)
+	outsider := domain.NewUserID().Value()
+
+	tests := []struct {
+		name     string
+		circleID string
+		payerID  string
+		method   string
+		expected int
+	}{
+		{"存在しないサークル", domain.NewCircleID().Value(), f.participants[0], "equal", http.StatusNotFound},
+		{"参加者でない立て替え", f.circle.ID().Value(), outsider, "equal", http.StatusBadRequest},
+		{"未知の分け方", f.circle.ID().Value(), f.participants[0], "random", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			output, err := f.record.Execute(RecordCircleExpenseInput{
+				Actor:        adminActor(),
+				CircleID:     tt.circleID,
+				PayerID:      tt.payerID,
+				Amount:       1000,
+				Currency:     "JPY",
+				Description:  "会場費",
+				SplitMethod:  tt.method,
+				Participants: f.everyone(),
+			})
+
+			// Assert
+			if err == nil {
+				t.Fatal("Expected error, but got nil")
+			}
+			var domainErr domain.DomainError
+			if !errors.As(err, &domainErr) {
+				t.Fatalf("Expected domain error, but got %T: %v", err, err)
+			}
+			if domainErr.HTTPStatus() != tt.expected {
+				t.Errorf("Expected status %d, but got %d: %v", tt.expected, domainErr.HTTPStatus(), err)
+			}
+			if output != nil {
+				t.Error("Expected nil output")
+			}
+		})
+	}
+}
+
+func TestRecordCircleExpenseUseCase_Execute_PayerIsNotActor_ReturnsForbidden(t *testing.T) {
+	f := setupCircleExpenseTest(t, 1)
+	owner, member := f.participants[0], f.participants[1]
+
+	tests := []struct {
+		name      string
+		actor     *domain.Principal
+		payerID   string
+		forbidden bool
+	}{
+		{"メンバーが他の人の立て替えを記録する", userActor(t, member), owner, true},
+		{"メンバーが自分の立て替えを記録する", userActor(t, member), member, false},
+		{"circles:write を持つサービスは誰の立て替えも記録できる", servicePrincipal(t, domain.PermissionCirclesWrite), owner, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			output, err := f.record.Execute(RecordCircleExpenseInput{
+				Actor:        tt.actor,
+				CircleID:     f.circle.ID().Value(),
+				PayerID:      tt.payerID,
+				Amount:       1000,
+				Currency:     "JPY",
+				Description:  "会場費",
+				SplitMethod:  "equal",
+				Participants: f.everyone(),
+			})
+
+			// Assert
+			if !tt.forbidden {
+				if err != nil {
+					t.Fatalf("Expected no error, but got: %v", err)
+				}
+				if output.PayerID != tt.payerID {
+					t.Errorf("Expected payer %s, but got %s", tt.payerID, output.PayerID)
+				}
+				return
+			}
+			var forbidden domain.ForbiddenError
+			if !errors.As(err, &forbidden) {
+				t.Fatalf("Expected ForbiddenError, but got %T: %v", err, err)
+			}
+			if output != nil {
+				t.Error("Expected nil output")
+			}
+		})
+	}
+}
+
+func TestGetCircleSettlementUseCase_Execute_ReturnsTransfers(t *testing.T) {
+	// Arrange
+	f := setupCircleExpenseTest(t, 2)
+	owner, first, second := f.participants[0], f.participants[1], f.participants[2]
+
+	// オーナーが3人分の宿泊費を、1人目が2人分の食費を立て替える
+	expenses := []RecordCircleExpenseInput{
+		{
+			PayerID: owner, Amount: 9000, Description: "宿泊費", SplitMethod: "equal",
+			Participants: f.everyone(),
+		},
+		{
+			PayerID: first, Amount: 3000, Description: "食費", SplitMethod: "exact",
+			Participants: []ExpenseParticipantInput{
+				{UserID: first, Amount: 1000},
+				{UserID: second, Amount: 2000},
+			},
+		},
+	}
+	for _, input := range expenses {
+		input.Actor = adminActor()
+		input.CircleID = f.circle.ID().Value()
+		input.Currency = "JPY"
+		if _, err := f.record.Execute(input); err != nil {
+			t.Fatalf("Failed to record expense: %v", err)
+		}
+	}
+
+	// Act
+	output, err := f.settlement.Execute(GetCircleSettlementInput{Actor: adminActor(), CircleID: f.circle.ID().Value()})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	// オーナー: +6000、1人目: -1000、2人目: -5000
+	transfers := make(map[string]int64)
+	for _, transfer := range output.Transfers {
+		if transfer.ToUserID != owner {
+			t.Errorf("Expected all transfers to go to owner, but got %s", transfer.ToUserID)
+		}
+		transfers[transfer.FromUserID] = transfer.Amount.Amount
+	}
+	if len(output.Transfers) != 2 || transfers[first] != 1000 || transfers[second] != 5000 {
+		t.Errorf("Unexpected transfers: %v", transfers)
+	}
+	if output.IsSettled {
+		t.Error("Expected IsSettled to be false")
+	}
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type RecordPaymentInput struct {
+	Actor       *domain.Principal // billing:write が必要
+	RequestID   string            // 監査ログに記録するリクエストID
+	UserID      string
+	Amount      int64
+	Currency    string
+	Description string
+	Reference   string
+}
+
+type RecordPaymentUseCase struct {
+	userRepository   domain.UserRepository
+	ledgerRepository domain.LedgerRepository
+	clock            domain.Clock
+	auditLog         *AuditLog
+}
+
+func NewRecordPaymentUseCase(
+	userRepository domain.UserRepository,
+	ledgerRepository domain.LedgerRepository,
+	clock domain.Clock,
+	auditLog *AuditLog,
+) *RecordPaymentUseCase {
+	return &RecordPaymentUseCase{
+		userRepository:   userRepository,
+		ledgerRepository: ledgerRepository,
+		clock:            clock,
+		auditLog:         auditLog,
+	}
+}
+
+func (uc *RecordPaymentUseCase) Execute(input RecordPaymentInput) (*LedgerEntryOutput, error) {
+	if _, err := authorizeUser(input.Actor, domain.ActionRecordLedgerEntry, input.UserID); err != nil {
+		return nil, err
+	}
+
+	amount, err := domain.NewMoney(input.Amount, input.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := findUser(uc.userRepository, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	ledger, err := uc.ledgerRepository.FindByUserID(user.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := ledger.RecordPayment(amount, input.Description, input.Reference, uc.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.ledgerRepository.Append(entry); err != nil {
+		return nil, err
+	}
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditPaymentRecorded, domain.AuditTarget{Type: domain.AuditTargetLedger, ID: user.ID().Value()}, nil); err != nil {
+		return nil, err
+	}
+
+	return NewLedgerEntryOutput(entry), nil
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"errors"
+	"time"
+)
+
+type RefreshAccessTokenInput struct {
+	RefreshToken string
+}
+
+// RefreshAccessTokenUseCase はリフレッシュトークンを新しいトークンの組と交換する
+// 使ったリフレッシュトークンは使用済みになり、再び使われるとそのログインのトークンをすべて失効させる
+type RefreshAccessTokenUseCase struct {
+	refreshTokenRepository domain.RefreshTokenRepository
+	userRepository         domain.UserRepository
+	tokenIssuer            *tokenIssuer
+	clock                  domain.Clock
+}
+
+func NewRefreshAccessTokenUseCase(
+	refreshTokenRepository domain.RefreshTokenRepository,
+	userRepository domain.UserRepository,
+	accessTokenCodec domain.AccessTokenCodec,
+	accessTokenTTL time.Duration,
+	refreshTokenTTL time.Duration,
+	clock domain.Clock,
+) *RefreshAccessTokenUseCase {
+	return &RefreshAccessTokenUseCase{
+		refreshTokenRepository: refreshTokenRepository,
+		userRepository:         userRepository,
+		tokenIssuer:            newTokenIssuer(accessTokenCodec, accessTokenTTL, refreshTokenTTL),
+		clock:                  clock,
+	}
+}
+
+func (uc *RefreshAccessTokenUseCase) Execute(input RefreshAccessTokenInput) (*TokenPairOutput, error) {
+	current, err := uc.refreshTokenRepository.FindByTokenHash(domain.HashRefreshToken(input.RefreshToken))
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, domain.InvalidRefreshTokenError{Reason: "unknown token"}
+	}
+
+	now := uc.clock.Now()
+	next, nextValue, err := current.Rotate(now, uc.tokenIssuer.refreshTokenTTL)
+	if err != nil {
+		return nil, uc.revokeOnReuse(err, current, now)
+	}
+
+	user, err := uc.userRepository.FindByID(current.UserID())
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domain.InvalidRefreshTokenError{Reason: "unknown token"}
+	}
+
+	// 同じトークンで同時に更新された場合は、先に保存した方だけが成功し、残りは再利用として扱う
+	if err := uc.refreshTokenRepository.SaveRotation(current, next); err != nil {
+		return nil, uc.revokeOnReuse(err, current, now)
+	}
+	return uc.tokenIssuer.issue(next, nextValue, now)
+}
+
+// revokeOnReuse は再利用を検知した場合にファミリーを失効させ、err をそのまま返す
+func (uc *RefreshAccessTokenUseCase) revokeOnReuse(err error, current *domain.RefreshToken, now time.Time) error {
+	var reused domain.RefreshTokenReusedError
+	if !errors.As(err, &reused) {
+		return err
+	}
+	// 漏洩したトークンで正規の利用者より先に更新された可能性があるため、どちらの系列も使えなくする
+	if err := revokeRefreshTokenFamily(uc.refreshTokenRepository, current.FamilyID(), now); err != nil {
+		return err
+	}
+	return reused
+}
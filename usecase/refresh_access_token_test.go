@@ -0,0 +1,223 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"errors"
+	"testing"
+	"time"
+)
+
+func tokenLoginForTest(t *testing.T, f *authTestFixture) *TokenPairOutput {
+	t.Helper()
+
+	tokens, err := f.tokenLogin.Execute(TokenLoginInput{Email: "taro@example.com", Password: "correct horse battery"})
+	if err != nil {
+		t.Fatalf("Failed to log in: %v", err)
+	}
+	return tokens
+}
+
+func TestTokenLoginUseCase_Execute_IssuesTokenPair(t *testing.T) {
+	// Arrange
+	f := newAuthTestFixture()
+	userID := f.createUser(t, "taro", "correct horse battery")
+
+	// Act
+	tokens := tokenLoginForTest(t, f)
+	authenticated, err := f.authenticate.Execute(AuthenticateInput{Token: tokens.AccessToken})
+
+	// Assert
+	if err != nil || authenticated.UserID != userID {
+		t.Fatalf("Expected the access token to authenticate %s, but got %+v (%v)", userID, authenticated, err)
+	}
+	if !tokens.AccessTokenExpiresAt.Equal(testLoginNow.Add(15*time.Minute)) ||
+		!tokens.RefreshTokenExpiresAt.Equal(testLoginNow.Add(24*time.Hour)) {
+		t.Errorf("Unexpected expiry: access=%v refresh=%v", tokens.AccessTokenExpiresAt, tokens.RefreshTokenExpiresAt)
+	}
+}
+
+func TestAuthenticateUseCase_Execute_AccessToken(t *testing.T) {
+	tests := []struct {
+		name          string
+		advance       time.Duration
+		tamper        func(token string) string
+		expectedError bool
+	}{
+		{"有効なアクセストークン", 0, nil, false},
+		{"期限の直前", 15*time.Minute - time.Second, nil, false},
+		{"期限切れ", 15 * time.Minute, nil, true},
+		{"署名の改ざん", 0, tamperSignature, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			f := newAuthTestFixture()
+			f.createUser(t, "taro", "correct horse battery")
+			token := tokenLoginForTest(t, f).AccessToken
+			if tt.tamper != nil {
+				token = tt.tamper(token)
+			}
+			f.clock.Advance(tt.advance)
+
+			// Act
+			_, err := f.authenticate.Execute(AuthenticateInput{Token: token})
+
+			// Assert
+			if tt.expectedError && err == nil {
+				t.Error("Expected an error, but got none")
+			}
+			if !tt.expectedError && err != nil {
+				t.Errorf("Expected no error, but got: %v", err)
+			}
+		})
+	}
+}
+
+// tamperSignature は署名の途中の1文字を別の文字に置き換える
+func tamperSignature(token string) string {
+	i := len(token) - 10
+	replacement := "A"
+	if token[i] == 'A' {
+		replacement = "B"
+	}
+	return token[:i] + replacement + token[i+1:]
+}
+
+func TestRefreshAccessTokenUseCase_Execute_RotatesRefreshToken(t *testing.T) {
+	// Arrange
+	f := newAuthTestFixture()
+	f.createUser(t, "taro", "correct horse battery")
+	first := tokenLoginForTest(t, f)
+	f.clock.Advance(20 * time.Minute)
+
+	// Act
+	second, err := f.refresh.Execute(RefreshAccessTokenInput{RefreshToken: first.RefreshToken})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if second.RefreshToken == first.RefreshToken || second.AccessToken == first.AccessToken {
+		t.Error("Expected a new token pair")
+	}
+	if !second.RefreshTokenExpiresAt.Equal(f.clock.Now().Add(24 * time.Hour)) {
+		t.Errorf("Expected the refresh token to be extended, but got %v", second.RefreshTokenExpiresAt)
+	}
+	if _, err := f.authenticate.Execute(AuthenticateInput{Token: second.AccessToken}); err != nil {
+		t.Errorf("Expected the new access token to be valid, but got: %v", err)
+	}
+}
+
+func TestRefreshAccessTokenUseCase_Execute_ReuseRevokesFamily(t *testing.T) {
+	// Arrange
+	f := newAuthTestFixture()
+	f.createUser(t, "taro", "correct horse battery")
+	stolen := tokenLoginForTest(t, f)
+	otherDevice := tokenLoginForTest(t, f)
+	rotated, err := f.refresh.Execute(RefreshAccessTokenInput{RefreshToken: stolen.RefreshToken})
+	if err != nil {
+		t.Fatalf("Failed to refresh: %v", err)
+	}
+
+	// Act
+	_, err = f.refresh.Execute(RefreshAccessTokenInput{RefreshToken: stolen.RefreshToken})
+
+	// Assert
+	var reused domain.RefreshTokenReusedError
+	if !errors.As(err, &reused) {
+		t.Fatalf("Expected RefreshTokenReusedError, but got %v", err)
+	}
+	var invalid domain.InvalidRefreshTokenError
+	if _, err := f.refresh.Execute(RefreshAccessTokenInput{RefreshToken: rotated.RefreshToken}); !errors.As(err, &invalid) {
+		t.Errorf("Expected the rotated token to be revoked, but got %v", err)
+	}
+	if _, err := f.refresh.Execute(RefreshAccessTokenInput{RefreshToken: otherDevice.RefreshToken}); err != nil {
+		t.Errorf("Expected another login to stay valid, but got: %v", err)
+	}
+}
+
+// racingRefreshTokenRepository は最初の取得の直後に race を実行し、同じトークンでの同時の更新を再現する
+type racingRefreshTokenRepository struct {
+	domain.RefreshTokenRepository
+	race func()
+}
+
+func (r *racingRefreshTokenRepository) FindByTokenHash(tokenHash string) (*domain.RefreshToken, error) {
+	token, err := r.RefreshTokenRepository.FindByTokenHash(tokenHash)
+	if race := r.race; race != nil {
+		r.race = nil
+		race()
+	}
+	return token, err
+}
+
+func TestRefreshAccessTokenUseCase_Execute_ConcurrentRefreshCountsAsReuse(t *testing.T) {
+	// Arrange
+	f := newAuthTestFixture()
+	f.createUser(t, "taro", "correct horse battery")
+	first := tokenLoginForTest(t, f)
+	var winner *TokenPairOutput
+	var winnerErr error
+	racing := &racingRefreshTokenRepository{RefreshTokenRepository: f.refreshTokenRepo}
+	racing.race = func() {
+		// 読み込んだ後、保存する前に同じトークンで別のリクエストが更新を終える
+		winner, winnerErr = f.refresh.Execute(RefreshAccessTokenInput{RefreshToken: first.RefreshToken})
+	}
+	f.refresh.refreshTokenRepository = racing
+
+	// Act
+	_, err := f.refresh.Execute(RefreshAccessTokenInput{RefreshToken: first.RefreshToken})
+
+	// Assert
+	if winnerErr != nil {
+		t.Fatalf("Expected the first refresh to succeed, but got: %v", winnerErr)
+	}
+	var reused domain.RefreshTokenReusedError
+	if !errors.As(err, &reused) {
+		t.Fatalf("Expected the losing refresh to count as reuse, but got %v", err)
+	}
+	var invalid domain.InvalidRefreshTokenError
+	if _, err := f.refresh.Execute(RefreshAccessTokenInput{RefreshToken: winner.RefreshToken}); !errors.As(err, &invalid) {
+		t.Errorf("Expected the winning token to be revoked with its family, but got %v", err)
+	}
+}
+
+func TestRefreshAccessTokenUseCase_Execute_RejectsInvalidTokens(t *testing.T) {
+	tests := []struct {
+		name    string
+		prepare func(t *testing.T, f *authTestFixture, token string) string
+	}{
+		{"存在しないトークン", func(t *testing.T, f *authTestFixture, token string) string {
+			return "unknown-token"
+		}},
+		{"期限切れ", func(t *testing.T, f *authTestFixture, token string) string {
+			f.clock.Advance(24 * time.Hour)
+			return token
+		}},
+		{"失効したトークン", func(t *testing.T, f *authTestFixture, token string) string {
+			if err := f.revoke.Execute(RevokeRefreshTokenInput{RefreshToken: token}); err != nil {
+				t.Fatalf("Failed to revoke: %v", err)
+			}
+			return token
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			f := newAuthTestFixture()
+			f.createUser(t, "taro", "correct horse battery")
+			token := tt.prepare(t, f, tokenLoginForTest(t, f).RefreshToken)
+
+			// Act
+			_, err := f.refresh.Execute(RefreshAccessTokenInput{RefreshToken: token})
+
+			// Assert
+			var invalid domain.InvalidRefreshTokenError
+			if !errors.As(err, &invalid) {
+				t.Errorf("Expected InvalidRefreshTokenError, but got %v", err)
+			}
+		})
+	}
+}
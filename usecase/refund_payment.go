@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type RefundPaymentInput struct {
+	Actor          *domain.Principal // billing:write が必要
+	RequestID      string            // 監査ログに記録するリクエストID
+	UserID         string
+	ChargeID       string
+	Amount         int64
+	Currency       string
+	IdempotencyKey string
+}
+
+type RefundPaymentOutput struct {
+	RefundID string
+	Status   string
+	Amount   *MoneyOutput
+}
+
+// RefundPaymentUseCase は決済事業者経由で入金を返金する
+type RefundPaymentUseCase struct {
+	userRepository   domain.UserRepository
+	ledgerRepository domain.LedgerRepository
+	paymentGateway   domain.PaymentGateway
+	clock            domain.Clock
+	auditLog         *AuditLog
+}
+
+func NewRefundPaymentUseCase(
+	userRepository domain.UserRepository,
+	ledgerRepository domain.LedgerRepository,
+	paymentGateway domain.PaymentGateway,
+	clock domain.Clock,
+	auditLog *AuditLog,
+) *RefundPaymentUseCase {
+	return &RefundPaymentUseCase{
+		userRepository:   userRepository,
+		ledgerRepository: ledgerRepository,
+		paymentGateway:   paymentGateway,
+		clock:            clock,
+		auditLog:         auditLog,
+	}
+}
+
+func (uc *RefundPaymentUseCase) Execute(input RefundPaymentInput) (*RefundPaymentOutput, error) {
+	if _, err := authorizeUser(input.Actor, domain.ActionRecordLedgerEntry, input.UserID); err != nil {
+		return nil, err
+	}
+
+	amount, err := domain.NewMoney(input.Amount, input.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := findUser(uc.userRepository, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 台帳に入金として記録済みの決済のみ返金できる
+	ledger, err := uc.ledgerRepository.FindByUserID(user.ID())
+	if err != nil {
+		return nil, err
+	}
+	if !ledger.HasReference(domain.LedgerEntryPayment, domain.PaymentReference(input.ChargeID)) {
+		return nil, domain.InvalidLedgerEntryError{Reason: "payment not found: " + input.ChargeID}
+	}
+
+	result, err := uc.paymentGateway.Refund(domain.RefundRequest{
+		UserID:         user.ID(),
+		ChargeID:       input.ChargeID,
+		Amount:         amount,
+		IdempotencyKey: input.IdempotencyKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Status == domain.PaymentSucceeded {
+		if _, err := recordGatewayEntry(
+			uc.ledgerRepository, user.ID(), domain.LedgerEntryRefund, amount,
+			"Refund", domain.RefundReference(result.RefundID), uc.clock.Now(),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditPaymentRefunded, domain.AuditTarget{Type: domain.AuditTargetLedger, ID: user.ID().Value()}, nil); err != nil {
+		return nil, err
+	}
+
+	return &RefundPaymentOutput{
+		RefundID: result.RefundID,
+		Status:   string(result.Status),
+		Amount:   NewMoneyOutput(amount),
+	}, nil
+}
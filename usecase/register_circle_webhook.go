@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+type RegisterCircleWebhookInput struct {
+	Actor     *domain.Principal // オーナーか circles:write が必要
+	RequestID string            // 監査ログに記録するリクエストID
+	CircleID  string
+	URL       string
+	Events    []string
+}
+
+type WebhookOutput struct {
+	WebhookID string
+	CircleID  string
+	URL       string
+	Events    []string
+	CreatedAt time.Time
+}
+
+func NewWebhookOutput(webhook *domain.WebhookSubscription) *WebhookOutput {
+	return &WebhookOutput{
+		WebhookID: webhook.ID().Value(),
+		CircleID:  webhook.CircleID().Value(),
+		URL:       webhook.URL(),
+		Events:    webhook.Events(),
+		CreatedAt: webhook.CreatedAt(),
+	}
+}
+
+// RegisterCircleWebhookOutput のシークレットは登録時にだけ返す
+type RegisterCircleWebhookOutput struct {
+	*WebhookOutput
+	Secret string
+}
+
+type RegisterCircleWebhookUseCase struct {
+	circleRepository  domain.CircleRepository
+	webhookRepository domain.WebhookRepository
+	clock             domain.Clock
+	auditLog          *AuditLog
+}
+
+func NewRegisterCircleWebhookUseCase(
+	circleRepository domain.CircleRepository,
+	webhookRepository domain.WebhookRepository,
+	clock domain.Clock,
+	auditLog *AuditLog,
+) *RegisterCircleWebhookUseCase {
+	return &RegisterCircleWebhookUseCase{
+		circleRepository:  circleRepository,
+		webhookRepository: webhookRepository,
+		clock:             clock,
+		auditLog:          auditLog,
+	}
+}
+
+func (uc *RegisterCircleWebhookUseCase) Execute(input RegisterCircleWebhookInput) (*RegisterCircleWebhookOutput, error) {
+	circle, err := findCircle(uc.circleRepository, input.CircleID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeCircle(input.Actor, domain.ActionManageCircle, circle); err != nil {
+		return nil, err
+	}
+
+	// サービス・管理者による登録もオーナーの名義で行う
+	webhook, err := domain.NewWebhookSubscription(circle, circle.OwnerID(), input.URL, input.Events, uc.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.webhookRepository.Save(webhook); err != nil {
+		return nil, err
+	}
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditWebhookRegistered, domain.AuditTarget{Type: domain.AuditTargetWebhook, ID: webhook.ID().Value()}, nil); err != nil {
+		return nil, err
+	}
+
+	return &RegisterCircleWebhookOutput{
+		WebhookOutput: NewWebhookOutput(webhook),
+		Secret:        webhook.Secret(),
+	}, nil
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type RemoveMemberInput struct {
+	Actor     *domain.Principal // 脱退する本人かオーナー、または circles:write が必要
+	RequestID string            // 監査ログに記録するリクエストID
+	CircleID  string
+	UserID    string
+}
+
+// RemoveMemberUseCase はメンバーの脱退・オーナーによる除名を行う
+type RemoveMemberUseCase struct {
+	circleRepository domain.CircleRepository
+	clock            domain.Clock
+	auditLog         *AuditLog
+}
+
+func NewRemoveMemberUseCase(circleRepository domain.CircleRepository, clock domain.Clock, auditLog *AuditLog) *RemoveMemberUseCase {
+	return &RemoveMemberUseCase{
+		circleRepository: circleRepository,
+		clock:            clock,
+		auditLog:         auditLog,
+	}
+}
+
+func (uc *RemoveMemberUseCase) Execute(input RemoveMemberInput) error {
+	// CircleIDを再構成
+	circleID, err := domain.ReconstructCircleID(input.CircleID)
+	if err != nil {
+		return err
+	}
+
+	// UserIDを再構成
+	userID, err := domain.ReconstructUserID(input.UserID)
+	if err != nil {
+		return err
+	}
+
+	// サークルを取得
+	circle, err := uc.circleRepository.FindByID(circleID)
+	if err != nil {
+		return err
+	}
+	if circle == nil {
+		return domain.CircleNotFoundError{ID: input.CircleID}
+	}
+
+	// 他のメンバーを外せるのはオーナーのみ（本人は自分で脱退できる）
+	if err := domain.Authorize(input.Actor, domain.ActionLeaveCircle, domain.Resource{Circle: circle, UserID: userID}); err != nil {
+		return err
+	}
+	if !circle.IsMember(userID) {
+		return nil // メンバーでない場合はエラーではない
+	}
+
+	// メンバーを外す（MemberLeft は保存時にアウトボックスへ書かれる）
+	before := domain.CircleAuditSnapshot(circle)
+	circle.RemoveMember(userID, uc.clock.Now())
+	if err := uc.circleRepository.Save(circle); err != nil {
+		return err
+	}
+	changes := domain.DiffAuditSnapshots(before, domain.CircleAuditSnapshot(circle))
+	return uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditCircleMemberRemoved, domain.CircleAuditTarget(circle.ID()), changes)
+}
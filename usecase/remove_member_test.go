@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestRemoveMemberUseCase_Execute_QueuesMemberLeftWebhook(t *testing.T) {
+	// Arrange
+	outbox := infrastructure.NewMemoryOutbox()
+	userRepo := infrastructure.NewMemoryUserRepository()
+	circleRepo := infrastructure.NewOutboxCircleRepository(infrastructure.NewMemoryCircleRepository(), outbox)
+	webhookRepo := infrastructure.NewMemoryWebhookRepository()
+	deliveryRepo := infrastructure.NewMemoryWebhookDeliveryRepository()
+	clock := domain.NewFixedClock(time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC))
+	circle := setupCircleWithMembers(t, userRepo, circleRepo, 2, 0)
+	leaving := circle.GetMemberIDs()[0]
+
+	webhook, err := NewRegisterCircleWebhookUseCase(circleRepo, webhookRepo, clock, newTestAuditLog()).Execute(RegisterCircleWebhookInput{
+		Actor:    domain.NewUserPrincipal(circle.OwnerID(), false),
+		CircleID: circle.ID().Value(),
+		URL:      "https://example.com/hooks",
+		Events:   []string{"circle.member_left"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to register webhook: %v", err)
+	}
+
+	// アプリケーションと同じく MemberLeft を Webhook の配信待ちにする
+	enqueue := NewEnqueueWebhookDeliveriesUseCase(webhookRepo, deliveryRepo, clock)
+	bus := infrastructure.NewInProcessEventBus()
+	infrastructure.Subscribe(bus, func(event domain.MemberLeft) error {
+		_, err := enqueue.Execute(EnqueueWebhookDeliveriesInput{
+			EventName:  event.EventName(),
+			CircleID:   event.CircleID.Value(),
+			UserID:     event.UserID.Value(),
+			OccurredAt: event.OccurredAt(),
+		})
+		return err
+	})
+	relay := infrastructure.NewOutboxRelay(outbox, bus, domain.NewFixedClock(time.Now().Add(time.Minute)))
+
+	// Act
+	err = NewRemoveMemberUseCase(circleRepo, clock, newTestAuditLog()).Execute(RemoveMemberInput{
+		Actor:    userActor(t, leaving.Value()),
+		CircleID: circle.ID().Value(),
+		UserID:   leaving.Value(),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if _, err := relay.RunOnce(); err != nil {
+		t.Fatalf("Failed to relay outbox: %v", err)
+	}
+
+	// Assert
+	stored, _ := circleRepo.FindByID(circle.ID())
+	if stored.IsMember(leaving) || stored.GetMemberCount() != 1 {
+		t.Errorf("Expected the member to have left, but members are %v", stored.GetMemberIDs())
+	}
+
+	output, err := NewListWebhookDeliveriesUseCase(circleRepo, webhookRepo, deliveryRepo).Execute(ListWebhookDeliveriesInput{
+		Actor:     adminActor(),
+		CircleID:  circle.ID().Value(),
+		WebhookID: webhook.WebhookID,
+	})
+	if err != nil {
+		t.Fatalf("Failed to list deliveries: %v", err)
+	}
+	if len(output.Deliveries) != 1 || output.Deliveries[0].EventName != "circle.member_left" {
+		t.Fatalf("Expected 1 circle.member_left delivery, but got %+v", output.Deliveries)
+	}
+	var payload webhookPayload
+	if err := json.Unmarshal(output.Deliveries[0].Payload, &payload); err != nil {
+		t.Fatalf("Failed to decode payload: %v", err)
+	}
+	if payload.Data.UserID != leaving.Value() || !payload.OccurredAt.Equal(clock.Now()) {
+		t.Errorf("Expected payload for user %s at %v, but got %s at %v", leaving.Value(), clock.Now(), payload.Data.UserID, payload.OccurredAt)
+	}
+}
+
+func TestRemoveMemberUseCase_Execute_NotMember_DoesNothing(t *testing.T) {
+	// Arrange
+	userRepo := infrastructure.NewMemoryUserRepository()
+	circleRepo := infrastructure.NewMemoryCircleRepository()
+	circle := setupCircleWithMembers(t, userRepo, circleRepo, 1, 0)
+	stranger := saveNewUser(t, userRepo, "stranger")
+
+	// Act
+	err := NewRemoveMemberUseCase(circleRepo, domain.SystemClock{}, newTestAuditLog()).Execute(RemoveMemberInput{
+		Actor:    domain.NewUserPrincipal(circle.OwnerID(), false),
+		CircleID: circle.ID().Value(),
+		UserID:   stranger.ID().Value(),
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	stored, _ := circleRepo.FindByID(circle.ID())
+	if stored.GetMemberCount() != 1 || len(stored.PendingEvents()) != 0 {
+		t.Errorf("Expected the circle to be unchanged, but got %d members and %d events", stored.GetMemberCount(), len(stored.PendingEvents()))
+	}
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type RespondCircleEventRSVPInput struct {
+	Actor     *domain.Principal // 回答する本人か circles:write が必要
+	RequestID string            // 監査ログに記録するリクエストID
+	CircleID  string
+	EventID   string
+	UserID    string
+	Status    string // going / maybe / declined
+}
+
+type RespondCircleEventRSVPUseCase struct {
+	circleRepository domain.CircleRepository
+	eventRepository  domain.CircleEventRepository
+	clock            domain.Clock
+	auditLog         *AuditLog
+}
+
+func NewRespondCircleEventRSVPUseCase(
+	circleRepository domain.CircleRepository,
+	eventRepository domain.CircleEventRepository,
+	clock domain.Clock,
+	auditLog *AuditLog,
+) *RespondCircleEventRSVPUseCase {
+	return &RespondCircleEventRSVPUseCase{
+		circleRepository: circleRepository,
+		eventRepository:  eventRepository,
+		clock:            clock,
+		auditLog:         auditLog,
+	}
+}
+
+func (uc *RespondCircleEventRSVPUseCase) Execute(input RespondCircleEventRSVPInput) (*CircleEventOutput, error) {
+	if _, err := authorizeUser(input.Actor, domain.ActionRespondCircleEvent, input.UserID); err != nil {
+		return nil, err
+	}
+
+	userID, err := domain.ReconstructUserID(input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	status, err := domain.ParseRSVPStatus(input.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	circle, err := findCircle(uc.circleRepository, input.CircleID)
+	if err != nil {
+		return nil, err
+	}
+	event, err := findCircleEvent(uc.eventRepository, circle, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 参加者の確認・定員の確認は集約が行う
+	if err := event.RespondRSVP(circle, userID, status, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.eventRepository.Save(event); err != nil {
+		return nil, err
+	}
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditCircleEventRSVPRecorded, domain.AuditTarget{Type: domain.AuditTargetCircleEvent, ID: event.ID().Value()}, nil); err != nil {
+		return nil, err
+	}
+
+	return NewCircleEventOutput(event), nil
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+type RestoreCircleInput struct {
+	Actor     *domain.Principal // オーナーか circles:write が必要
+	RequestID string            // 監査ログに記録するリクエストID
+	CircleID  string
+}
+
+// RestoreCircleUseCase は論理削除したサークルを復元できる期間内に元に戻す
+type RestoreCircleUseCase struct {
+	circleRepository domain.CircleRepository
+	userRepository   domain.UserRepository
+	deletionPolicy   domain.DeletionPolicy
+	clock            domain.Clock
+	auditLog         *AuditLog
+}
+
+func NewRestoreCircleUseCase(
+	circleRepository domain.CircleRepository,
+	userRepository domain.UserRepository,
+	deletionPolicy domain.DeletionPolicy,
+	clock domain.Clock,
+	auditLog *AuditLog,
+) *RestoreCircleUseCase {
+	return &RestoreCircleUseCase{
+		circleRepository: circleRepository,
+		userRepository:   userRepository,
+		deletionPolicy:   deletionPolicy,
+		clock:            clock,
+		auditLog:         auditLog,
+	}
+}
+
+func (uc *RestoreCircleUseCase) Execute(input RestoreCircleInput) error {
+	circleID, err := domain.ReconstructCircleID(input.CircleID)
+	if err != nil {
+		return err
+	}
+
+	circle, err := uc.circleRepository.FindDeletedByID(circleID)
+	if err != nil {
+		return err
+	}
+	if circle == nil {
+		return domain.CircleNotFoundError{ID: input.CircleID}
+	}
+	if err := authorizeCircle(input.Actor, domain.ActionManageCircle, circle); err != nil {
+		return err
+	}
+	if !uc.deletionPolicy.CanRestore(circle.DeletedAt(), uc.clock.Now()) {
+		return domain.RestorePeriodExpiredError{ID: input.CircleID, Deadline: uc.deletionPolicy.RestoreDeadline(circle.DeletedAt())}
+	}
+
+	// オーナーが削除されている場合はオーナーの復元と一緒に戻す
+	owner, err := uc.userRepository.FindByID(circle.OwnerID())
+	if err != nil {
+		return err
+	}
+	if owner == nil {
+		return domain.UserNotFoundError{ID: circle.OwnerID().Value()}
+	}
+	if err := checkCircleRestorable(uc.circleRepository, circle); err != nil {
+		return err
+	}
+
+	return restoreCircle(uc.circleRepository, uc.userRepository, uc.auditLog, input.Actor, input.RequestID, circle, uc.clock.Now())
+}
+
+// checkCircleRestorable は削除している間に同じ名前のサークルが作られていないかを確認する
+func checkCircleRestorable(circleRepository domain.CircleRepository, circle *domain.Circle) error {
+	existing, err := circleRepository.FindByName(circle.Name())
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return domain.CircleAlreadyExistsError{Name: circle.Name().Value()}
+	}
+	return nil
+}
+
+// restoreCircle はサークルを復元して保存する
+// 削除している間にアカウントを削除したメンバーは外し、物理削除されたメンバーは除く
+func restoreCircle(
+	circleRepository domain.CircleRepository,
+	userRepository domain.UserRepository,
+	auditLog *AuditLog,
+	actor *domain.Principal,
+	requestID string,
+	circle *domain.Circle,
+	now time.Time,
+) error {
+	before := domain.CircleAuditSnapshot(circle)
+	for _, memberID := range circle.GetMemberIDs() {
+		member, err := userRepository.FindByID(memberID)
+		if err != nil {
+			return err
+		}
+		if member != nil {
+			continue
+		}
+		deleted, err := userRepository.FindDeletedByID(memberID)
+		if err != nil {
+			return err
+		}
+		if deleted != nil {
+			circle.DetachMember(memberID, now)
+		} else {
+			circle.RemoveMember(memberID, now)
+		}
+	}
+	circle.Restore()
+
+	if err := circleRepository.Save(circle); err != nil {
+		return err
+	}
+	changes := domain.DiffAuditSnapshots(before, domain.CircleAuditSnapshot(circle))
+	return auditLog.Record(actor, requestID, domain.AuditCircleRestored, domain.CircleAuditTarget(circle.ID()), changes)
+}
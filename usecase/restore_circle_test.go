@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRestoreCircleUseCase_Execute_DetachesMembersDeletedMeanwhile(t *testing.T) {
+	// Arrange
+	userRepo := infrastructure.NewMemoryUserRepository()
+	circleRepo := infrastructure.NewMemoryCircleRepository()
+	clock := domain.NewFixedClock(testAuditNow)
+	circle := setupCircleWithMembers(t, userRepo, circleRepo, 2, 0)
+	owner := userActor(t, circle.OwnerID().Value())
+	if err := NewDeleteCircleUseCase(circleRepo, clock, newTestAuditLog()).Execute(DeleteCircleInput{Actor: owner, CircleID: circle.ID().Value()}); err != nil {
+		t.Fatalf("Failed to delete circle: %v", err)
+	}
+	// サークルを削除している間にメンバーがアカウントを削除した
+	leaving, staying := circle.GetMemberIDs()[0], circle.GetMemberIDs()[1]
+	err := NewDeleteUserUseCase(userRepo, circleRepo, infrastructure.NewMemorySessionRepository(), infrastructure.NewMemoryRefreshTokenRepository(), clock, newTestAuditLog()).
+		Execute(DeleteUserInput{Actor: userActor(t, leaving.Value()), UserID: leaving.Value()})
+	if err != nil {
+		t.Fatalf("Failed to delete user: %v", err)
+	}
+	clock.Advance(time.Hour)
+	useCase := NewRestoreCircleUseCase(circleRepo, userRepo, domain.DefaultDeletionPolicy(), clock, newTestAuditLog())
+
+	// Act
+	err = useCase.Execute(RestoreCircleInput{Actor: owner, CircleID: circle.ID().Value()})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	restored, _ := circleRepo.FindByID(circle.ID())
+	if restored == nil {
+		t.Fatal("Expected the circle to be restored")
+	}
+	if !restored.IsMember(staying) {
+		t.Error("Expected the remaining member to stay")
+	}
+	if restored.IsMember(leaving) || !restored.IsDetachedMember(leaving) {
+		t.Error("Expected the deleted member to be detached")
+	}
+}
+
+func TestRestoreCircleUseCase_Execute_Rejected(t *testing.T) {
+	tests := []struct {
+		name      string
+		arrange   func(t *testing.T, f *deletionTestFixture)
+		assertErr func(err error) bool
+	}{
+		{"オーナーが削除されている", func(t *testing.T, f *deletionTestFixture) {}, func(err error) bool {
+			var notFound domain.UserNotFoundError
+			return errors.As(err, &notFound)
+		}},
+		{"復元できる期間を過ぎた", func(t *testing.T, f *deletionTestFixture) {
+			if _, err := f.restoreUser().Execute(RestoreUserInput{Actor: adminActor(), UserID: f.deleted.Value()}); err != nil {
+				t.Fatalf("Failed to restore user: %v", err)
+			}
+			if err := NewDeleteCircleUseCase(f.circleRepo, f.clock, newTestAuditLog()).
+				Execute(DeleteCircleInput{Actor: adminActor(), CircleID: f.ownedCircle.ID().Value()}); err != nil {
+				t.Fatalf("Failed to delete circle: %v", err)
+			}
+			f.clock.Advance(domain.DefaultDeletionGracePeriod)
+		}, func(err error) bool {
+			var expired domain.RestorePeriodExpiredError
+			return errors.As(err, &expired)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			f := newDeletionTestFixture(t, 2)
+			tt.arrange(t, f)
+			useCase := NewRestoreCircleUseCase(f.circleRepo, f.userRepo, domain.DefaultDeletionPolicy(), f.clock, newTestAuditLog())
+
+			// Act
+			err := useCase.Execute(RestoreCircleInput{Actor: adminActor(), CircleID: f.ownedCircle.ID().Value()})
+
+			// Assert
+			if !tt.assertErr(err) {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if found, _ := f.circleRepo.FindByID(f.ownedCircle.ID()); found != nil {
+				t.Error("Expected the circle to stay deleted")
+			}
+		})
+	}
+}
+
+func TestDeleteCircleUseCase_Execute_AlreadyDeleted(t *testing.T) {
+	// Arrange
+	userRepo := infrastructure.NewMemoryUserRepository()
+	circleRepo := infrastructure.NewMemoryCircleRepository()
+	circle := setupCircleWithMembers(t, userRepo, circleRepo, 1, 0)
+	useCase := NewDeleteCircleUseCase(circleRepo, domain.NewFixedClock(testAuditNow), newTestAuditLog())
+	input := DeleteCircleInput{Actor: adminActor(), CircleID: circle.ID().Value()}
+	if err := useCase.Execute(input); err != nil {
+		t.Fatalf("Failed to delete circle: %v", err)
+	}
+
+	// Act
+	err := useCase.Execute(input)
+
+	// Assert
+	var notFound domain.CircleNotFoundError
+	if !errors.As(err, &notFound) {
+		t.Errorf("Expected CircleNotFoundError, but got %v", err)
+	}
+}
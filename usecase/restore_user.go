@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"errors"
+)
+
+type RestoreUserInput struct {
+	Actor     *domain.Principal // users:write が必要（削除したユーザーはログインできない）
+	RequestID string            // 監査ログに記録するリクエストID
+	UserID    string
+}
+
+type RestoreUserOutput struct {
+	UserID              string
+	RestoredCircleIDs   []string // ユーザーと一緒に削除して復元したオーナーのサークル
+	ReattachedCircleIDs []string // メンバーに戻したサークル
+	FullCircleIDs       []string // 削除している間に定員に達したため戻せなかったサークル
+}
+
+// RestoreUserUseCase は論理削除したユーザーを復元できる期間内に元に戻す
+// ユーザーと一緒に削除したサークルを復元し、参加していたサークルにはメンバーとして戻す
+type RestoreUserUseCase struct {
+	userRepository       domain.UserRepository
+	circleRepository     domain.CircleRepository
+	userExistenceService *domain.UserExistenceService
+	circleMemberService  *domain.CircleMemberService
+	deletionPolicy       domain.DeletionPolicy
+	clock                domain.Clock
+	auditLog             *AuditLog
+}
+
+func NewRestoreUserUseCase(
+	userRepository domain.UserRepository,
+	circleRepository domain.CircleRepository,
+	userExistenceService *domain.UserExistenceService,
+	circleMemberService *domain.CircleMemberService,
+	deletionPolicy domain.DeletionPolicy,
+	clock domain.Clock,
+	auditLog *AuditLog,
+) *RestoreUserUseCase {
+	return &RestoreUserUseCase{
+		userRepository:       userRepository,
+		circleRepository:     circleRepository,
+		userExistenceService: userExistenceService,
+		circleMemberService:  circleMemberService,
+		deletionPolicy:       deletionPolicy,
+		clock:                clock,
+		auditLog:             auditLog,
+	}
+}
+
+func (uc *RestoreUserUseCase) Execute(input RestoreUserInput) (*RestoreUserOutput, error) {
+	userID, err := authorizeUser(input.Actor, domain.ActionRestoreUser, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepository.FindDeletedByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domain.UserNotFoundError{ID: input.UserID}
+	}
+	if !uc.deletionPolicy.CanRestore(user.DeletedAt(), uc.clock.Now()) {
+		return nil, domain.RestorePeriodExpiredError{ID: input.UserID, Deadline: uc.deletionPolicy.RestoreDeadline(user.DeletedAt())}
+	}
+
+	// 削除している間に同じメールアドレス・名前のユーザーが登録されていないか確認
+	existing, err := uc.userRepository.FindByEmail(user.Email())
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, domain.UserAlreadyExistsError{Email: user.Email().Value()}
+	}
+	exists, err := uc.userExistenceService.Exists(user)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, domain.DuplicateUserNameError{Name: user.Name().String()}
+	}
+
+	// ユーザーと一緒に削除したサークル（先に削除していたサークルは戻さない）
+	var ownedCircles []*domain.Circle
+	deletedCircles, err := uc.circleRepository.FindDeletedByOwnerID(userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, circle := range deletedCircles {
+		if circle.DeletedAt().Before(user.DeletedAt()) {
+			continue
+		}
+		if err := checkCircleRestorable(uc.circleRepository, circle); err != nil {
+			return nil, err
+		}
+		ownedCircles = append(ownedCircles, circle)
+	}
+
+	before := domain.UserAuditSnapshot(user)
+	user.Restore()
+	if err := uc.userRepository.Save(user); err != nil {
+		return nil, err
+	}
+	changes := domain.DiffAuditSnapshots(before, domain.UserAuditSnapshot(user))
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditUserRestored, domain.UserAuditTarget(userID), changes); err != nil {
+		return nil, err
+	}
+
+	output := &RestoreUserOutput{
+		UserID:              input.UserID,
+		RestoredCircleIDs:   []string{},
+		ReattachedCircleIDs: []string{},
+		FullCircleIDs:       []string{},
+	}
+	for _, circle := range ownedCircles {
+		if err := restoreCircle(uc.circleRepository, uc.userRepository, uc.auditLog, input.Actor, input.RequestID, circle, uc.clock.Now()); err != nil {
+			return nil, err
+		}
+		output.RestoredCircleIDs = append(output.RestoredCircleIDs, circle.ID().Value())
+	}
+
+	// 参加していたサークルにメンバーとして戻す
+	circles, err := uc.circleRepository.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, circle := range circles {
+		if !circle.IsDetachedMember(userID) {
+			continue
+		}
+		reattached, err := uc.reattach(input, circle, userID)
+		if err != nil {
+			return nil, err
+		}
+		if reattached {
+			output.ReattachedCircleIDs = append(output.ReattachedCircleIDs, circle.ID().Value())
+		} else {
+			output.FullCircleIDs = append(output.FullCircleIDs, circle.ID().Value())
+		}
+	}
+
+	return output, nil
+}
+
+// reattach はユーザーをサークルのメンバーに戻す（定員に達していた場合は false を返す）
+func (uc *RestoreUserUseCase) reattach(input RestoreUserInput, circle *domain.Circle, userID *domain.UserID) (bool, error) {
+	now := uc.clock.Now()
+	capacity, err := circleCapacity(uc.userRepository, uc.circleMemberService, circle, now)
+	if err != nil {
+		return false, err
+	}
+
+	before := domain.CircleAuditSnapshot(circle)
+	reattachErr := circle.ReattachMember(userID, capacity, now)
+	var fullErr domain.CircleFullError
+	if reattachErr != nil && !errors.As(reattachErr, &fullErr) {
+		return false, reattachErr
+	}
+
+	// 戻せなかった場合も外したメンバーの記録は消して保存する
+	if err := uc.circleRepository.Save(circle); err != nil {
+		return false, err
+	}
+	if reattachErr != nil {
+		return false, nil
+	}
+	changes := domain.DiffAuditSnapshots(before, domain.CircleAuditSnapshot(circle))
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditCircleMemberReattached, domain.CircleAuditTarget(circle.ID()), changes); err != nil {
+		return false, err
+	}
+	return true, nil
+}
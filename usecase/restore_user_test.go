@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"errors"
+	"testing"
+	"time"
+)
+
+// deletionTestFixture はメンバーを削除したサークルと、削除したメンバーがオーナーのサークルを用意する
+type deletionTestFixture struct {
+	userRepo    domain.UserRepository
+	circleRepo  domain.CircleRepository
+	auditRepo   domain.AuditRepository
+	clock       *domain.FixedClock
+	circle      *domain.Circle // deleted がメンバーとして参加していたサークル
+	ownedCircle *domain.Circle // deleted がオーナーのサークル
+	deleted     *domain.UserID
+}
+
+func newDeletionTestFixture(t *testing.T, memberCount int) *deletionTestFixture {
+	t.Helper()
+
+	f := &deletionTestFixture{
+		userRepo:   infrastructure.NewMemoryUserRepository(),
+		circleRepo: infrastructure.NewMemoryCircleRepository(),
+		auditRepo:  infrastructure.NewMemoryAuditRepository(),
+		clock:      domain.NewFixedClock(testAuditNow),
+	}
+	f.circle = setupCircleWithMembers(t, f.userRepo, f.circleRepo, memberCount, 0)
+	f.deleted = f.circle.GetMemberIDs()[0]
+	ownedName, _ := domain.NewCircleName("削除したメンバーのサークル")
+	f.ownedCircle = domain.NewCircle(ownedName, f.deleted)
+	if err := f.circleRepo.Save(f.ownedCircle); err != nil {
+		t.Fatalf("Failed to save circle: %v", err)
+	}
+
+	err := NewDeleteUserUseCase(f.userRepo, f.circleRepo, infrastructure.NewMemorySessionRepository(), infrastructure.NewMemoryRefreshTokenRepository(), f.clock, newTestAuditLog()).
+		Execute(DeleteUserInput{Actor: userActor(t, f.deleted.Value()), UserID: f.deleted.Value()})
+	if err != nil {
+		t.Fatalf("Failed to delete user: %v", err)
+	}
+	return f
+}
+
+func (f *deletionTestFixture) restoreUser() *RestoreUserUseCase {
+	return NewRestoreUserUseCase(f.userRepo, f.circleRepo, domain.NewUserExistenceService(f.userRepo), domain.NewCircleMemberService(nil),
+		domain.DefaultDeletionPolicy(), f.clock, NewAuditLog(f.auditRepo, f.clock))
+}
+
+func TestRestoreUserUseCase_Execute_ReattachesCircles(t *testing.T) {
+	// Arrange
+	f := newDeletionTestFixture(t, 2)
+	f.clock.Advance(24 * time.Hour)
+
+	// Act
+	output, err := f.restoreUser().Execute(RestoreUserInput{Actor: adminActor(), RequestID: "req-restore", UserID: f.deleted.Value()})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if len(output.RestoredCircleIDs) != 1 || output.RestoredCircleIDs[0] != f.ownedCircle.ID().Value() {
+		t.Errorf("Expected the owned circle to be restored, but got %v", output.RestoredCircleIDs)
+	}
+	if len(output.ReattachedCircleIDs) != 1 || output.ReattachedCircleIDs[0] != f.circle.ID().Value() {
+		t.Errorf("Expected the joined circle to be reattached, but got %v", output.ReattachedCircleIDs)
+	}
+	if user, _ := f.userRepo.FindByID(f.deleted); user == nil {
+		t.Error("Expected the user to be restored")
+	}
+	if owned, _ := f.circleRepo.FindByID(f.ownedCircle.ID()); owned == nil {
+		t.Error("Expected the owned circle to be restored")
+	}
+	joined, _ := f.circleRepo.FindByID(f.circle.ID())
+	if !joined.IsMember(f.deleted) || joined.IsDetachedMember(f.deleted) {
+		t.Error("Expected the user to be a member again")
+	}
+
+	var actions []domain.AuditAction
+	for _, entry := range findAuditEntries(t, f.auditRepo) {
+		if entry.RequestID() != "req-restore" {
+			t.Errorf("Expected request req-restore, but got %s", entry.RequestID())
+		}
+		actions = append(actions, entry.Action())
+	}
+	want := []domain.AuditAction{domain.AuditCircleMemberReattached, domain.AuditCircleRestored, domain.AuditUserRestored}
+	if len(actions) != len(want) {
+		t.Fatalf("Expected actions %v, but got %v", want, actions)
+	}
+	for i := range want {
+		if actions[i] != want[i] {
+			t.Errorf("Expected actions %v, but got %v", want, actions)
+			break
+		}
+	}
+}
+
+func TestRestoreUserUseCase_Execute_RestorePeriodExpired(t *testing.T) {
+	// Arrange
+	f := newDeletionTestFixture(t, 2)
+	f.clock.Advance(domain.DefaultDeletionGracePeriod)
+
+	// Act
+	_, err := f.restoreUser().Execute(RestoreUserInput{Actor: adminActor(), UserID: f.deleted.Value()})
+
+	// Assert
+	var expiredErr domain.RestorePeriodExpiredError
+	if !errors.As(err, &expiredErr) {
+		t.Fatalf("Expected RestorePeriodExpiredError, but got %v", err)
+	}
+	if !expiredErr.Deadline.Equal(testAuditNow.Add(domain.DefaultDeletionGracePeriod)) {
+		t.Errorf("Expected deadline %v, but got %v", testAuditNow.Add(domain.DefaultDeletionGracePeriod), expiredErr.Deadline)
+	}
+	if user, _ := f.userRepo.FindByID(f.deleted); user != nil {
+		t.Error("Expected the user to stay deleted")
+	}
+}
+
+func TestRestoreUserUseCase_Execute_CircleFilledWhileDeleted(t *testing.T) {
+	// Arrange: オーナーとメンバー29人で基本の定員30人に達している
+	f := newDeletionTestFixture(t, domain.BasicMemberLimit-1)
+	newcomer := saveNewUser(t, f.userRepo, "newcomer")
+	err := NewAddMemberUseCase(f.circleRepo, f.userRepo, infrastructure.NewMemoryLedgerRepository(), domain.NewCircleMemberService(nil), false, f.clock, newTestAuditLog()).
+		Execute(AddMemberInput{Actor: adminActor(), CircleID: f.circle.ID().Value(), UserID: newcomer.ID().Value()})
+	if err != nil {
+		t.Fatalf("Failed to add member: %v", err)
+	}
+
+	// Act
+	output, err := f.restoreUser().Execute(RestoreUserInput{Actor: adminActor(), UserID: f.deleted.Value()})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if len(output.FullCircleIDs) != 1 || len(output.ReattachedCircleIDs) != 0 {
+		t.Errorf("Expected the full circle to be reported, but got reattached=%v full=%v", output.ReattachedCircleIDs, output.FullCircleIDs)
+	}
+	joined, _ := f.circleRepo.FindByID(f.circle.ID())
+	if joined.IsMember(f.deleted) || joined.IsDetachedMember(f.deleted) {
+		t.Error("Expected the user to be neither a member nor detached")
+	}
+}
+
+func TestRestoreUserUseCase_Execute_Conflict(t *testing.T) {
+	tests := []struct {
+		name      string
+		arrange   func(t *testing.T, f *deletionTestFixture)
+		assertErr func(err error) bool
+	}{
+		{"同じメールアドレスのユーザーが登録された", func(t *testing.T, f *deletionTestFixture) {
+			deleted, _ := f.userRepo.FindDeletedByID(f.deleted)
+			name, _ := domain.NewFullName("別人", "登録")
+			if err := f.userRepo.Save(domain.NewUser(name, deleted.Email(), time.Now())); err != nil {
+				t.Fatalf("Failed to save user: %v", err)
+			}
+		}, func(err error) bool {
+			var conflict domain.UserAlreadyExistsError
+			return errors.As(err, &conflict)
+		}},
+		{"同じ名前のサークルが作られた", func(t *testing.T, f *deletionTestFixture) {
+			if err := f.circleRepo.Save(domain.NewCircle(f.ownedCircle.Name(), f.circle.OwnerID())); err != nil {
+				t.Fatalf("Failed to save circle: %v", err)
+			}
+		}, func(err error) bool {
+			var conflict domain.CircleAlreadyExistsError
+			return errors.As(err, &conflict)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			f := newDeletionTestFixture(t, 2)
+			tt.arrange(t, f)
+
+			// Act
+			_, err := f.restoreUser().Execute(RestoreUserInput{Actor: adminActor(), UserID: f.deleted.Value()})
+
+			// Assert
+			if !tt.assertErr(err) {
+				t.Fatalf("Expected a conflict error, but got %v", err)
+			}
+			if deleted, _ := f.userRepo.FindDeletedByID(f.deleted); deleted == nil {
+				t.Error("Expected the user to stay deleted")
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type RevokeAPIKeyInput struct {
+	Actor     *domain.Principal // api_keys:manage が必要
+	RequestID string            // 監査ログに記録するリクエストID
+	APIKeyID  string
+}
+
+// RevokeAPIKeyUseCase は API キーを失効させる。失効したキーは以降の認証に使えない
+// 失効済みのキーを指定してもエラーにしない
+type RevokeAPIKeyUseCase struct {
+	apiKeyRepository domain.APIKeyRepository
+	clock            domain.Clock
+	auditLog         *AuditLog
+}
+
+func NewRevokeAPIKeyUseCase(apiKeyRepository domain.APIKeyRepository, clock domain.Clock, auditLog *AuditLog) *RevokeAPIKeyUseCase {
+	return &RevokeAPIKeyUseCase{
+		apiKeyRepository: apiKeyRepository,
+		clock:            clock,
+		auditLog:         auditLog,
+	}
+}
+
+func (uc *RevokeAPIKeyUseCase) Execute(input RevokeAPIKeyInput) (*APIKeyOutput, error) {
+	if err := domain.Authorize(input.Actor, domain.ActionManageAPIKeys, domain.Resource{}); err != nil {
+		return nil, err
+	}
+
+	key, err := uc.apiKeyRepository.FindByID(input.APIKeyID)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, domain.APIKeyNotFoundError{ID: input.APIKeyID}
+	}
+
+	if !key.IsRevoked() {
+		key.Revoke(uc.clock.Now())
+		if err := uc.apiKeyRepository.Save(key); err != nil {
+			return nil, err
+		}
+		if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditAPIKeyRevoked, domain.AuditTarget{Type: domain.AuditTargetAPIKey, ID: key.ID()}, nil); err != nil {
+			return nil, err
+		}
+	}
+	return NewAPIKeyOutput(key), nil
+}
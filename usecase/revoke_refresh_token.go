@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+type RevokeRefreshTokenInput struct {
+	RefreshToken string
+}
+
+// RevokeRefreshTokenUseCase はリフレッシュトークンのファミリー（同じログインから続くトークン）を失効させる
+// 発行済みのアクセストークンは期限まで有効。存在しないトークンでもエラーにしない
+type RevokeRefreshTokenUseCase struct {
+	refreshTokenRepository domain.RefreshTokenRepository
+	clock                  domain.Clock
+}
+
+func NewRevokeRefreshTokenUseCase(refreshTokenRepository domain.RefreshTokenRepository, clock domain.Clock) *RevokeRefreshTokenUseCase {
+	return &RevokeRefreshTokenUseCase{
+		refreshTokenRepository: refreshTokenRepository,
+		clock:                  clock,
+	}
+}
+
+func (uc *RevokeRefreshTokenUseCase) Execute(input RevokeRefreshTokenInput) error {
+	token, err := uc.refreshTokenRepository.FindByTokenHash(domain.HashRefreshToken(input.RefreshToken))
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		return nil
+	}
+	return revokeRefreshTokenFamily(uc.refreshTokenRepository, token.FamilyID(), uc.clock.Now())
+}
+
+func revokeRefreshTokenFamily(repository domain.RefreshTokenRepository, familyID string, now time.Time) error {
+	tokens, err := repository.FindByFamilyID(familyID)
+	if err != nil {
+		return err
+	}
+	for _, token := range tokens {
+		if token.IsRevoked() {
+			continue
+		}
+		token.Revoke(now)
+		if err := repository.Save(token); err != nil {
+			return err
+		}
+	}
+	return nil
+}
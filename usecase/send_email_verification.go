@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+type SendEmailVerificationInput struct {
+	UserID      string
+	Email       string
+	RequestedAt time.Time
+}
+
+// SendEmailVerificationUseCase は確認用トークンを発行して確認待ちのアドレスへ送る
+// 有効期限は依頼の時刻から決めるため、イベントが再配信されても同じトークンになる
+type SendEmailVerificationUseCase struct {
+	userRepository domain.UserRepository
+	tokenCodec     domain.EmailVerificationTokenCodec
+	dispatcher     *NotificationDispatcher
+	ttl            time.Duration
+}
+
+func NewSendEmailVerificationUseCase(
+	userRepository domain.UserRepository,
+	tokenCodec domain.EmailVerificationTokenCodec,
+	dispatcher *NotificationDispatcher,
+	ttl time.Duration,
+) *SendEmailVerificationUseCase {
+	return &SendEmailVerificationUseCase{
+		userRepository: userRepository,
+		tokenCodec:     tokenCodec,
+		dispatcher:     dispatcher,
+		ttl:            ttl,
+	}
+}
+
+func (uc *SendEmailVerificationUseCase) Execute(input SendEmailVerificationInput) error {
+	user, err := findUser(uc.userRepository, input.UserID)
+	if err != nil {
+		return ignoreMissingRecipient(err)
+	}
+	email, err := domain.NewEmail(input.Email)
+	if err != nil {
+		return err
+	}
+	// 配信までに確認済み・取り消しになったアドレスには送らない
+	if !user.IsAwaitingVerification(email) {
+		return nil
+	}
+
+	token, err := domain.NewEmailVerificationToken(user.ID(), email, input.RequestedAt.Add(uc.ttl))
+	if err != nil {
+		return err
+	}
+	value, err := uc.tokenCodec.Encode(token)
+	if err != nil {
+		return err
+	}
+
+	preferences, err := uc.dispatcher.preferencesFor(user.ID())
+	if err != nil {
+		return err
+	}
+	expiresAt := token.ExpiresAt().In(preferences.TimeZone())
+	message, err := renderVerificationMail(preferences.Locale(), email, emailVerificationMail{
+		UserName:  displayName(user.Name(), preferences.Locale()),
+		UserID:    user.ID().Value(),
+		Email:     email.Value(),
+		Token:     value,
+		ExpiresAt: expiresAt.Format("2006-01-02 15:04 MST"),
+	})
+	if err != nil {
+		return err
+	}
+	return uc.dispatcher.sendImmediately(message)
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"errors"
+)
+
+type SendNotificationDigestsOutput struct {
+	Mails         int // 送信したメールの数
+	Notifications int // 送信した通知の数（まとめた通知はそれぞれ数える）
+}
+
+// SendNotificationDigestsUseCase は送る時刻を迎えた通知を宛先ごとに1通にまとめて送る
+// おやすみ時間で遅らせた通知が1件だけの場合は元のメールのまま送る
+type SendNotificationDigestsUseCase struct {
+	pendingRepository domain.PendingNotificationRepository
+	dispatcher        *NotificationDispatcher
+	mailer            domain.Mailer
+	clock             domain.Clock
+}
+
+func NewSendNotificationDigestsUseCase(
+	pendingRepository domain.PendingNotificationRepository,
+	dispatcher *NotificationDispatcher,
+	mailer domain.Mailer,
+	clock domain.Clock,
+) *SendNotificationDigestsUseCase {
+	return &SendNotificationDigestsUseCase{
+		pendingRepository: pendingRepository,
+		dispatcher:        dispatcher,
+		mailer:            mailer,
+		clock:             clock,
+	}
+}
+
+func (uc *SendNotificationDigestsUseCase) Execute() (*SendNotificationDigestsOutput, error) {
+	now := uc.clock.Now()
+	due, err := uc.pendingRepository.FindDue(now)
+	if err != nil {
+		return nil, err
+	}
+
+	// ユーザー・宛先ごとにまとめる（作成順を保つ）
+	type recipientKey struct{ userID, to string }
+	var keys []recipientKey
+	groups := make(map[recipientKey][]*domain.PendingNotification)
+	for _, notification := range due {
+		key := recipientKey{notification.UserID().Value(), notification.Message().To().Value()}
+		if _, exists := groups[key]; !exists {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], notification)
+	}
+
+	output := &SendNotificationDigestsOutput{}
+	var errs []error
+	for _, key := range keys {
+		notifications := groups[key]
+		if err := uc.send(notifications); err != nil {
+			// 1人への送信失敗で他の宛先を止めない（未送信のまま次回に再送する）
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, notification := range notifications {
+			notification.MarkSent(now)
+			if err := uc.pendingRepository.Save(notification); err != nil {
+				return nil, err
+			}
+		}
+		output.Mails++
+		output.Notifications += len(notifications)
+	}
+
+	return output, errors.Join(errs...)
+}
+
+func (uc *SendNotificationDigestsUseCase) send(notifications []*domain.PendingNotification) error {
+	first := notifications[0]
+	if len(notifications) == 1 && !first.IsDigest() {
+		return uc.mailer.Send(first.Message())
+	}
+
+	preferences, err := uc.dispatcher.preferencesFor(first.UserID())
+	if err != nil {
+		return err
+	}
+	messages := make([]*domain.MailMessage, 0, len(notifications))
+	for _, notification := range notifications {
+		messages = append(messages, notification.Message())
+	}
+	digest, err := renderDigestMail(preferences.Locale(), first.Message().To(), messages)
+	if err != nil {
+		return err
+	}
+	return uc.mailer.Send(digest)
+}
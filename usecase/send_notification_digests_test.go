@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSendNotificationDigestsUseCase_Execute_RespectsPreferences(t *testing.T) {
+	// Arrange
+	userRepo := infrastructure.NewMemoryUserRepository()
+	circleRepo := infrastructure.NewMemoryCircleRepository()
+	preferencesRepo := infrastructure.NewMemoryNotificationPreferencesRepository()
+	pendingRepo := infrastructure.NewMemoryPendingNotificationRepository()
+	clock := domain.NewFixedClock(time.Date(2025, 7, 1, 23, 0, 0, 0, time.UTC))
+	mailer := &recordingMailer{}
+	dispatcher := NewNotificationDispatcher(preferencesRepo, pendingRepo, mailer, domain.LocaleJapanese, clock)
+
+	circle := setupCircleWithMembers(t, userRepo, circleRepo, 0, 0)
+	other := setupCircleWithMembers(t, userRepo, circleRepo, 0, 0)
+	user := saveNewUser(t, userRepo, "member")
+	digest, off, english := "digest", "off", "en"
+	utc, digestTime := "UTC", "08:00"
+	_, err := NewUpdateNotificationSettingsUseCase(userRepo, preferencesRepo, domain.LocaleJapanese, clock, newTestAuditLog()).Execute(UpdateNotificationSettingsInput{
+		Actor:      adminActor(),
+		UserID:     user.ID().Value(),
+		Locale:     &english,
+		TimeZone:   &utc,
+		Channels:   map[string]string{"added_to_circle": digest, "email_changed": off},
+		QuietHours: &QuietHoursInput{Start: "22:00", End: "07:00"},
+		DigestTime: &digestTime,
+	})
+	if err != nil {
+		t.Fatalf("Failed to update settings: %v", err)
+	}
+
+	// 23:00（おやすみ時間中）に通知が発生する
+	notifyJoined := NewNotifyMemberJoinedUseCase(userRepo, circleRepo, dispatcher)
+	for _, c := range []*domain.Circle{circle, other} {
+		if err := notifyJoined.Execute(NotifyMemberJoinedInput{CircleID: c.ID().Value(), UserID: user.ID().Value()}); err != nil {
+			t.Fatalf("Failed to notify: %v", err)
+		}
+	}
+	err = NewNotifyEmailChangedUseCase(userRepo, dispatcher).Execute(NotifyEmailChangedInput{
+		UserID: user.ID().Value(), OldEmail: "old@example.com", NewEmail: "member@example.com",
+	})
+	if err != nil {
+		t.Fatalf("Failed to notify: %v", err)
+	}
+	if len(mailer.sent) != 0 {
+		t.Fatalf("Expected nothing to be sent immediately, but got %d", len(mailer.sent))
+	}
+
+	useCase := NewSendNotificationDigestsUseCase(pendingRepo, dispatcher, mailer, clock)
+	steps := []struct {
+		name          string
+		at            time.Time
+		expectedMails int
+	}{
+		{"8時前は送らない", time.Date(2025, 7, 2, 7, 59, 0, 0, time.UTC), 0},
+		{"8時に2件を1通にまとめる", time.Date(2025, 7, 2, 8, 0, 0, 0, time.UTC), 1},
+		{"送信済みは再送しない", time.Date(2025, 7, 3, 8, 0, 0, 0, time.UTC), 0},
+	}
+
+	for _, step := range steps {
+		// Act
+		clock.Set(step.at)
+		output, err := useCase.Execute()
+
+		// Assert
+		if err != nil {
+			t.Fatalf("%s: expected no error, but got: %v", step.name, err)
+		}
+		if output.Mails != step.expectedMails {
+			t.Errorf("%s: expected %d mails, but got %d", step.name, step.expectedMails, output.Mails)
+		}
+	}
+	if len(mailer.sent) != 1 {
+		t.Fatalf("Expected exactly 1 digest, but got %d", len(mailer.sent))
+	}
+	sent := mailer.sent[0]
+	if sent.Subject() != "Your notification digest (2)" || strings.Count(sent.Body(), "* You joined") != 2 {
+		t.Errorf("Unexpected digest %q:\n%s", sent.Subject(), sent.Body())
+	}
+}
+
+func TestSendNotificationDigestsUseCase_Execute_QuietHoursDeferredSentAsIs(t *testing.T) {
+	// Arrange
+	userRepo := infrastructure.NewMemoryUserRepository()
+	circleRepo := infrastructure.NewMemoryCircleRepository()
+	preferencesRepo := infrastructure.NewMemoryNotificationPreferencesRepository()
+	pendingRepo := infrastructure.NewMemoryPendingNotificationRepository()
+	clock := domain.NewFixedClock(time.Date(2025, 7, 1, 23, 0, 0, 0, time.UTC))
+	mailer := &recordingMailer{}
+	dispatcher := NewNotificationDispatcher(preferencesRepo, pendingRepo, mailer, domain.LocaleJapanese, clock)
+	circle := setupCircleWithMembers(t, userRepo, circleRepo, 2, 0)
+	_, err := NewUpdateNotificationSettingsUseCase(userRepo, preferencesRepo, domain.LocaleJapanese, clock, newTestAuditLog()).Execute(UpdateNotificationSettingsInput{
+		Actor:      adminActor(),
+		UserID:     circle.OwnerID().Value(),
+		QuietHours: &QuietHoursInput{Start: "22:00", End: "07:00"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to update settings: %v", err)
+	}
+	err = NewNotifyCircleFilledUseCase(userRepo, circleRepo, dispatcher).Execute(NotifyCircleFilledInput{
+		CircleID: circle.ID().Value(), MaxParticipants: 3,
+	})
+	if err != nil {
+		t.Fatalf("Failed to notify: %v", err)
+	}
+
+	// Act
+	clock.Set(time.Date(2025, 7, 2, 7, 0, 0, 0, time.UTC))
+	output, err := NewSendNotificationDigestsUseCase(pendingRepo, dispatcher, mailer, clock).Execute()
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if output.Mails != 1 || len(mailer.sent) != 1 {
+		t.Fatalf("Expected 1 mail at the end of quiet hours, but got %d", len(mailer.sent))
+	}
+	if mailer.sent[0].Subject() != "「テストサークル」が定員に達しました" {
+		t.Errorf("Expected the original message, but got %q", mailer.sent[0].Subject())
+	}
+}
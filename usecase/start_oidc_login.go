@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+type StartOIDCLoginInput struct {
+	Actor     *domain.Principal // ログイン中の利用者の場合はそのユーザーに外部のアカウントを紐付ける
+	LoginHint string            // プロバイダーに渡すメールアドレス（省略可）
+}
+
+type StartOIDCLoginOutput struct {
+	AuthorizationURL string
+	ExpiresAt        time.Time
+}
+
+// StartOIDCLoginUseCase は OpenID プロバイダーの認可エンドポイントへ送り出す URL を作る
+// state・nonce・PKCE の code_verifier はサーバー側に保存し、コールバックで照合する
+type StartOIDCLoginUseCase struct {
+	loginRequestRepository domain.OIDCLoginRequestRepository
+	provider               domain.OIDCProvider
+	loginTTL               time.Duration
+	clock                  domain.Clock
+}
+
+func NewStartOIDCLoginUseCase(
+	loginRequestRepository domain.OIDCLoginRequestRepository,
+	provider domain.OIDCProvider,
+	loginTTL time.Duration,
+	clock domain.Clock,
+) *StartOIDCLoginUseCase {
+	return &StartOIDCLoginUseCase{
+		loginRequestRepository: loginRequestRepository,
+		provider:               provider,
+		loginTTL:               loginTTL,
+		clock:                  clock,
+	}
+}
+
+func (uc *StartOIDCLoginUseCase) Execute(input StartOIDCLoginInput) (*StartOIDCLoginOutput, error) {
+	var linkUserID *domain.UserID
+	if input.Actor != nil {
+		if err := domain.Authorize(input.Actor, domain.ActionLinkExternalIdentity, domain.Resource{UserID: input.Actor.UserID()}); err != nil {
+			return nil, err
+		}
+		linkUserID = input.Actor.UserID()
+	}
+
+	request, err := domain.NewOIDCLoginRequest(linkUserID, uc.clock.Now(), uc.loginTTL)
+	if err != nil {
+		return nil, err
+	}
+	authorizationURL, err := uc.provider.AuthorizationURL(request.State(), request.Nonce(), request.CodeChallenge(), input.LoginHint)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.loginRequestRepository.Save(request); err != nil {
+		return nil, err
+	}
+
+	return &StartOIDCLoginOutput{
+		AuthorizationURL: authorizationURL,
+		ExpiresAt:        request.ExpiresAt(),
+	}, nil
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+type TokenLoginInput struct {
+	Email    string
+	Password string
+}
+
+// TokenPairOutput は発行したアクセストークンとリフレッシュトークン
+type TokenPairOutput struct {
+	UserID                string
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
+
+// TokenLoginUseCase はメールアドレスとパスワードを照合し、サーバー側にセッションを持たない
+// アクセストークン（JWT）と、それを更新するリフレッシュトークンを発行する
+type TokenLoginUseCase struct {
+	passwordVerifier       *passwordVerifier
+	refreshTokenRepository domain.RefreshTokenRepository
+	tokenIssuer            *tokenIssuer
+	clock                  domain.Clock
+}
+
+func NewTokenLoginUseCase(
+	userRepository domain.UserRepository,
+	credentialRepository domain.CredentialRepository,
+	refreshTokenRepository domain.RefreshTokenRepository,
+	passwordHasher domain.PasswordHasher,
+	accessTokenCodec domain.AccessTokenCodec,
+	accessTokenTTL time.Duration,
+	refreshTokenTTL time.Duration,
+	clock domain.Clock,
+) *TokenLoginUseCase {
+	return &TokenLoginUseCase{
+		passwordVerifier:       newPasswordVerifier(userRepository, credentialRepository, passwordHasher),
+		refreshTokenRepository: refreshTokenRepository,
+		tokenIssuer:            newTokenIssuer(accessTokenCodec, accessTokenTTL, refreshTokenTTL),
+		clock:                  clock,
+	}
+}
+
+func (uc *TokenLoginUseCase) Execute(input TokenLoginInput) (*TokenPairOutput, error) {
+	userID, err := uc.passwordVerifier.verify(input.Email, input.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+	refreshToken, refreshValue, err := domain.NewRefreshToken(userID, now, uc.tokenIssuer.refreshTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.refreshTokenRepository.Save(refreshToken); err != nil {
+		return nil, err
+	}
+	return uc.tokenIssuer.issue(refreshToken, refreshValue, now)
+}
+
+// tokenIssuer はリフレッシュトークンに対応するアクセストークンを署名して組にする
+type tokenIssuer struct {
+	accessTokenCodec domain.AccessTokenCodec
+	accessTokenTTL   time.Duration
+	refreshTokenTTL  time.Duration
+}
+
+func newTokenIssuer(accessTokenCodec domain.AccessTokenCodec, accessTokenTTL, refreshTokenTTL time.Duration) *tokenIssuer {
+	return &tokenIssuer{
+		accessTokenCodec: accessTokenCodec,
+		accessTokenTTL:   accessTokenTTL,
+		refreshTokenTTL:  refreshTokenTTL,
+	}
+}
+
+func (i *tokenIssuer) issue(refreshToken *domain.RefreshToken, refreshValue string, now time.Time) (*TokenPairOutput, error) {
+	accessToken, err := domain.NewAccessToken(refreshToken.UserID(), now, i.accessTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+	accessValue, err := i.accessTokenCodec.Encode(accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenPairOutput{
+		UserID:                refreshToken.UserID().Value(),
+		AccessToken:           accessValue,
+		AccessTokenExpiresAt:  accessToken.ExpiresAt(),
+		RefreshToken:          refreshValue,
+		RefreshTokenExpiresAt: refreshToken.ExpiresAt(),
+	}, nil
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+// UpdateCircleEventInput はイベント内容の全体を置き換える
+type UpdateCircleEventInput struct {
+	Actor     *domain.Principal // オーナーか circles:write が必要
+	RequestID string            // 監査ログに記録するリクエストID
+	CircleID  string
+	EventID   string
+	Title     string
+	StartsAt  time.Time
+	EndsAt    time.Time
+	Location  string
+	Capacity  int
+}
+
+type UpdateCircleEventUseCase struct {
+	circleRepository domain.CircleRepository
+	eventRepository  domain.CircleEventRepository
+	clock            domain.Clock
+	auditLog         *AuditLog
+}
+
+func NewUpdateCircleEventUseCase(
+	circleRepository domain.CircleRepository,
+	eventRepository domain.CircleEventRepository,
+	clock domain.Clock,
+	auditLog *AuditLog,
+) *UpdateCircleEventUseCase {
+	return &UpdateCircleEventUseCase{
+		circleRepository: circleRepository,
+		eventRepository:  eventRepository,
+		clock:            clock,
+		auditLog:         auditLog,
+	}
+}
+
+func (uc *UpdateCircleEventUseCase) Execute(input UpdateCircleEventInput) (*CircleEventOutput, error) {
+	schedule, err := domain.NewEventSchedule(input.StartsAt, input.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+
+	circle, err := findCircle(uc.circleRepository, input.CircleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeCircle(input.Actor, domain.ActionManageCircle, circle); err != nil {
+		return nil, err
+	}
+	event, err := findCircleEvent(uc.eventRepository, circle, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := event.Update(input.Title, schedule, input.Location, input.Capacity, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.eventRepository.Save(event); err != nil {
+		return nil, err
+	}
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditCircleEventUpdated, domain.AuditTarget{Type: domain.AuditTargetCircleEvent, ID: event.ID().Value()}, nil); err != nil {
+		return nil, err
+	}
+
+	return NewCircleEventOutput(event), nil
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+type QuietHoursInput struct {
+	Start string // HH:MM（Start・End とも空の場合はおやすみ時間を解除する）
+	End   string
+}
+
+type UpdateNotificationSettingsInput struct {
+	Actor      *domain.Principal // 本人か users:write が必要
+	RequestID  string            // 監査ログに記録するリクエストID
+	UserID     string
+	Locale     *string           // オプショナル
+	TimeZone   *string           // オプショナル（IANAのタイムゾーン名）
+	Channels   map[string]string // 指定した種類だけ変更する
+	QuietHours *QuietHoursInput  // オプショナル
+	DigestTime *string           // オプショナル
+}
+
+type UpdateNotificationSettingsUseCase struct {
+	userRepository        domain.UserRepository
+	preferencesRepository domain.NotificationPreferencesRepository
+	defaultLocale         domain.Locale
+	clock                 domain.Clock
+	auditLog              *AuditLog
+}
+
+func NewUpdateNotificationSettingsUseCase(
+	userRepository domain.UserRepository,
+	preferencesRepository domain.NotificationPreferencesRepository,
+	defaultLocale domain.Locale,
+	clock domain.Clock,
+	auditLog *AuditLog,
+) *UpdateNotificationSettingsUseCase {
+	return &UpdateNotificationSettingsUseCase{
+		userRepository:        userRepository,
+		preferencesRepository: preferencesRepository,
+		defaultLocale:         defaultLocale,
+		clock:                 clock,
+		auditLog:              auditLog,
+	}
+}
+
+func (uc *UpdateNotificationSettingsUseCase) Execute(input UpdateNotificationSettingsInput) (*NotificationSettingsOutput, error) {
+	if _, err := authorizeUser(input.Actor, domain.ActionUpdateUser, input.UserID); err != nil {
+		return nil, err
+	}
+
+	user, err := findUser(uc.userRepository, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := findNotificationPreferences(uc.preferencesRepository, user.ID(), uc.defaultLocale)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+	if input.Locale != nil {
+		locale, err := domain.ParseLocale(*input.Locale)
+		if err != nil {
+			return nil, err
+		}
+		preferences.ChangeLocale(locale, now)
+	}
+	if input.TimeZone != nil {
+		timeZone, err := time.LoadLocation(*input.TimeZone)
+		if err != nil || *input.TimeZone == "" || *input.TimeZone == "Local" {
+			return nil, domain.InvalidNotificationPreferencesError{Reason: "unknown time zone: " + *input.TimeZone}
+		}
+		if err := preferences.ChangeTimeZone(timeZone, now); err != nil {
+			return nil, err
+		}
+	}
+	for kindValue, channelValue := range input.Channels {
+		kind, err := domain.ParseNotificationKind(kindValue)
+		if err != nil {
+			return nil, err
+		}
+		channel, err := domain.ParseNotificationChannel(channelValue)
+		if err != nil {
+			return nil, err
+		}
+		preferences.ChangeChannel(kind, channel, now)
+	}
+	if input.QuietHours != nil {
+		quietHours, err := parseQuietHours(*input.QuietHours)
+		if err != nil {
+			return nil, err
+		}
+		preferences.ChangeQuietHours(quietHours, now)
+	}
+	if input.DigestTime != nil {
+		digestAt, err := domain.ParseTimeOfDay(*input.DigestTime)
+		if err != nil {
+			return nil, err
+		}
+		if err := preferences.ChangeDigestTime(digestAt, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.preferencesRepository.Save(preferences); err != nil {
+		return nil, err
+	}
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditNotificationSettingsUpdated, domain.AuditTarget{Type: domain.AuditTargetNotificationSettings, ID: user.ID().Value()}, nil); err != nil {
+		return nil, err
+	}
+
+	return NewNotificationSettingsOutput(preferences, now), nil
+}
+
+func parseQuietHours(input QuietHoursInput) (*domain.QuietHours, error) {
+	if input.Start == "" && input.End == "" {
+		return nil, nil
+	}
+	start, err := domain.ParseTimeOfDay(input.Start)
+	if err != nil {
+		return nil, err
+	}
+	end, err := domain.ParseTimeOfDay(input.End)
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewQuietHours(start, end)
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+// UpdateShipmentStatusInput は配送業者からの状況更新（TrackingNumber は shipped の場合のみ使う）
+type UpdateShipmentStatusInput struct {
+	Actor          *domain.Principal // shipments:write が必要
+	RequestID      string            // 監査ログに記録するリクエストID
+	ShipmentID     string
+	Status         string // shipped / delivered / returned
+	TrackingNumber string
+}
+
+type UpdateShipmentStatusUseCase struct {
+	shipmentRepository domain.ShipmentRepository
+	clock              domain.Clock
+	auditLog           *AuditLog
+}
+
+func NewUpdateShipmentStatusUseCase(shipmentRepository domain.ShipmentRepository, clock domain.Clock, auditLog *AuditLog) *UpdateShipmentStatusUseCase {
+	return &UpdateShipmentStatusUseCase{
+		shipmentRepository: shipmentRepository,
+		clock:              clock,
+		auditLog:           auditLog,
+	}
+}
+
+func (uc *UpdateShipmentStatusUseCase) Execute(input UpdateShipmentStatusInput) (*ShipmentOutput, error) {
+	if err := domain.Authorize(input.Actor, domain.ActionManageShipment, domain.Resource{}); err != nil {
+		return nil, err
+	}
+
+	status, err := domain.ParseShipmentStatus(input.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	shipment, err := findShipment(uc.shipmentRepository, input.ShipmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 遷移の可否は集約が判定する
+	now := uc.clock.Now()
+	switch status {
+	case domain.ShipmentStatusShipped:
+		err = shipment.Ship(input.TrackingNumber, now)
+	case domain.ShipmentStatusDelivered:
+		err = shipment.Deliver(now)
+	case domain.ShipmentStatusReturned:
+		err = shipment.Return(now)
+	default:
+		// 取り消しは CancelShipmentUseCase で行う
+		err = domain.InvalidShipmentTransitionError{From: shipment.Status(), To: status}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.shipmentRepository.Save(shipment); err != nil {
+		return nil, err
+	}
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditShipmentStatusUpdated, domain.AuditTarget{Type: domain.AuditTargetShipment, ID: shipment.ID().Value()}, nil); err != nil {
+		return nil, err
+	}
+
+	return NewShipmentOutput(shipment), nil
+}
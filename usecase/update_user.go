@@ -5,6 +5,8 @@ import (
 )
 
 type UpdateUserInput struct {
+	Actor     *domain.Principal // 本人か users:write が必要
+	RequestID string            // 監査ログに記録するリクエストID
 	UserID    string
 	FirstName *string // オプショナル
 	LastName  *string // オプショナル
@@ -12,35 +14,50 @@ type UpdateUserInput struct {
 }
 
 type UpdateUserOutput struct {
-	UserID    string
-	FirstName string
-	LastName  string
-	Email     string
+	UserID        string
+	FirstName     string
+	LastName      string
+	Email         string
+	EmailVerified bool
+	PendingEmail  string // 確認待ちの新しいメールアドレス（なければ空）
 }
 
 func NewUpdateUserOutput(user *domain.User) *UpdateUserOutput {
 	return &UpdateUserOutput{
-		UserID:    user.ID().Value(),
-		FirstName: user.Name().FirstName(),
-		LastName:  user.Name().LastName(),
-		Email:     user.Email().Value(),
+		UserID:        user.ID().Value(),
+		FirstName:     user.Name().FirstName(),
+		LastName:      user.Name().LastName(),
+		Email:         user.Email().Value(),
+		EmailVerified: user.IsEmailVerified(),
+		PendingEmail:  pendingEmailValue(user),
+	}
+}
+
+func pendingEmailValue(user *domain.User) string {
+	if user.PendingEmail() == nil {
+		return ""
 	}
+	return user.PendingEmail().Value()
 }
 
 type UpdateUserUseCase struct {
 	userRepository       domain.UserRepository
 	userExistenceService *domain.UserExistenceService
+	clock                domain.Clock
+	auditLog             *AuditLog
 }
 
-func NewUpdateUserUseCase(userRepository domain.UserRepository, userExistenceService *domain.UserExistenceService) *UpdateUserUseCase {
+func NewUpdateUserUseCase(userRepository domain.UserRepository, userExistenceService *domain.UserExistenceService, clock domain.Clock, auditLog *AuditLog) *UpdateUserUseCase {
 	return &UpdateUserUseCase{
 		userRepository:       userRepository,
 		userExistenceService: userExistenceService,
+		clock:                clock,
+		auditLog:             auditLog,
 	}
 }
 
 func (uc *UpdateUserUseCase) Execute(input UpdateUserInput) (*UpdateUserOutput, error) {
-	userID, err := domain.ReconstructUserID(input.UserID)
+	userID, err := authorizeUser(input.Actor, domain.ActionUpdateUser, input.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -52,6 +69,7 @@ func (uc *UpdateUserUseCase) Execute(input UpdateUserInput) (*UpdateUserOutput,
 	if user == nil {
 		return nil, domain.UserNotFoundError{ID: input.UserID}
 	}
+	before := domain.UserAuditSnapshot(user)
 
 	// 名前更新（指定されている場合）
 	if input.FirstName != nil && input.LastName != nil {
@@ -63,7 +81,7 @@ func (uc *UpdateUserUseCase) Execute(input UpdateUserInput) (*UpdateUserOutput,
 		// 現在の名前と同じかチェック
 		if !user.Name().Equals(newName) {
 			// 名前変更前に重複チェック（変更先の名前で一時的にユーザーを作成してチェック）
-			tempUser := domain.ReconstructUser(domain.NewUserID(), newName, user.Email(), user.IsPremium())
+			tempUser := domain.ReconstructUserWithSubscription(domain.NewUserID(), newName, user.Email(), user.Subscription())
 			exists, err := uc.userExistenceService.Exists(tempUser)
 			if err != nil {
 				return nil, err
@@ -72,18 +90,28 @@ func (uc *UpdateUserUseCase) Execute(input UpdateUserInput) (*UpdateUserOutput,
 				return nil, domain.DuplicateUserNameError{Name: newName.String()}
 			}
 
-			user.ChangeName(newName)
+			user.ChangeName(newName, uc.clock.Now())
 		}
 	}
 
 	// メール更新（指定されている場合）
+	// 確認済みのアドレスは新しいアドレスが確認されるまで変わらない
 	if input.Email != nil {
 		newEmail, err := domain.NewEmail(*input.Email)
 		if err != nil {
 			return nil, err
 		}
 
-		user.ChangeEmail(newEmail)
+		// ログインに使うため、他のユーザーのアドレスには変更できない
+		existing, err := uc.userRepository.FindByEmail(newEmail)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil && !existing.Equals(user) {
+			return nil, domain.UserAlreadyExistsError{Email: newEmail.Value()}
+		}
+
+		user.RequestEmailChange(newEmail, uc.clock.Now())
 	}
 
 	err = uc.userRepository.Save(user)
@@ -91,5 +119,10 @@ func (uc *UpdateUserUseCase) Execute(input UpdateUserInput) (*UpdateUserOutput,
 		return nil, err
 	}
 
+	changes := domain.DiffAuditSnapshots(before, domain.UserAuditSnapshot(user))
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditUserUpdated, domain.UserAuditTarget(user.ID()), changes); err != nil {
+		return nil, err
+	}
+
 	return NewUpdateUserOutput(user), nil
 }
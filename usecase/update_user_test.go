@@ -3,7 +3,9 @@ package usecase
 import (
 	"ddd-bottomup/domain"
 	"ddd-bottomup/infrastructure"
+	"errors"
 	"testing"
+	"time"
 )
 
 func TestUpdateUserUseCase_Execute_Success(t *testing.T) {
@@ -13,15 +15,16 @@ func TestUpdateUserUseCase_Execute_Success(t *testing.T) {
 	// 既存ユーザーを作成・保存
 	originalName, _ := domain.NewFullName("太郎", "田中")
 	email, _ := domain.NewEmail("taro@example.com")
-	user := domain.NewUser(originalName, email, false)
+	user := domain.NewUser(originalName, email, time.Now())
 	err := repo.Save(user)
 	if err != nil {
 		t.Fatalf("Failed to save test user: %v", err)
 	}
 
 	userExistenceService := domain.NewUserExistenceService(repo)
-	useCase := NewUpdateUserUseCase(repo, userExistenceService)
+	useCase := NewUpdateUserUseCase(repo, userExistenceService, domain.SystemClock{}, newTestAuditLog())
 	input := UpdateUserInput{
+		Actor:     domain.NewUserPrincipal(user.ID(), false),
 		UserID:    user.ID().Value(),
 		FirstName: func() *string { s := "次郎"; return &s }(),
 		LastName:  func() *string { s := "佐藤"; return &s }(),
@@ -66,10 +69,11 @@ func TestUpdateUserUseCase_Execute_UserNotFound(t *testing.T) {
 	// Arrange
 	repo := infrastructure.NewMemoryUserRepository()
 	userExistenceService := domain.NewUserExistenceService(repo)
-	useCase := NewUpdateUserUseCase(repo, userExistenceService)
+	useCase := NewUpdateUserUseCase(repo, userExistenceService, domain.SystemClock{}, newTestAuditLog())
 
 	nonExistentID := domain.NewUserID()
 	input := UpdateUserInput{
+		Actor:     domain.NewUserPrincipal(nonExistentID, false),
 		UserID:    nonExistentID.Value(),
 		FirstName: func() *string { s := "太郎"; return &s }(),
 		LastName:  func() *string { s := "田中"; return &s }(),
@@ -100,19 +104,20 @@ func TestUpdateUserUseCase_Execute_DuplicateName(t *testing.T) {
 	// 2人のユーザーを作成
 	user1Name, _ := domain.NewFullName("太郎", "田中")
 	email1, _ := domain.NewEmail("taro@example.com")
-	user1 := domain.NewUser(user1Name, email1, false)
+	user1 := domain.NewUser(user1Name, email1, time.Now())
 	repo.Save(user1)
 
 	user2Name, _ := domain.NewFullName("花子", "佐藤")
 	email2, _ := domain.NewEmail("hanako@example.com")
-	user2 := domain.NewUser(user2Name, email2, false)
+	user2 := domain.NewUser(user2Name, email2, time.Now())
 	repo.Save(user2)
 
 	userExistenceService := domain.NewUserExistenceService(repo)
-	useCase := NewUpdateUserUseCase(repo, userExistenceService)
+	useCase := NewUpdateUserUseCase(repo, userExistenceService, domain.SystemClock{}, newTestAuditLog())
 
 	// user2の名前をuser1と同じにしようとする
 	input := UpdateUserInput{
+		Actor:     domain.NewUserPrincipal(user2.ID(), false),
 		UserID:    user2.ID().Value(),
 		FirstName: func() *string { s := "太郎"; return &s }(),
 		LastName:  func() *string { s := "田中"; return &s }(),
@@ -148,14 +153,15 @@ func TestUpdateUserUseCase_Execute_SameNameUpdate(t *testing.T) {
 
 	originalName, _ := domain.NewFullName("太郎", "田中")
 	email, _ := domain.NewEmail("taro@example.com")
-	user := domain.NewUser(originalName, email, false)
+	user := domain.NewUser(originalName, email, time.Now())
 	repo.Save(user)
 
 	userExistenceService := domain.NewUserExistenceService(repo)
-	useCase := NewUpdateUserUseCase(repo, userExistenceService)
+	useCase := NewUpdateUserUseCase(repo, userExistenceService, domain.SystemClock{}, newTestAuditLog())
 
 	// 同じ名前に更新（自分自身なのでOK）
 	input := UpdateUserInput{
+		Actor:     domain.NewUserPrincipal(user.ID(), false),
 		UserID:    user.ID().Value(),
 		FirstName: func() *string { s := "太郎"; return &s }(),
 		LastName:  func() *string { s := "田中"; return &s }(),
@@ -184,11 +190,11 @@ func TestUpdateUserUseCase_Execute_InvalidName(t *testing.T) {
 
 	originalName, _ := domain.NewFullName("太郎", "田中")
 	email, _ := domain.NewEmail("taro@example.com")
-	user := domain.NewUser(originalName, email, false)
+	user := domain.NewUser(originalName, email, time.Now())
 	repo.Save(user)
 
 	userExistenceService := domain.NewUserExistenceService(repo)
-	useCase := NewUpdateUserUseCase(repo, userExistenceService)
+	useCase := NewUpdateUserUseCase(repo, userExistenceService, domain.SystemClock{}, newTestAuditLog())
 
 	testCases := []struct {
 		name      string
@@ -203,6 +209,7 @@ func TestUpdateUserUseCase_Execute_InvalidName(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			input := UpdateUserInput{
+				Actor:     domain.NewUserPrincipal(user.ID(), false),
 				UserID:    user.ID().Value(),
 				FirstName: &tc.firstName,
 				LastName:  &tc.lastName,
@@ -227,3 +234,88 @@ func TestUpdateUserUseCase_Execute_InvalidName(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateUserUseCase_Execute_RequiresSelf(t *testing.T) {
+	tests := []struct {
+		name     string
+		actor    func(user, other *domain.User) *domain.Principal
+		checkErr func(error) bool
+	}{
+		{
+			name:  "未認証",
+			actor: func(*domain.User, *domain.User) *domain.Principal { return nil },
+			checkErr: func(err error) bool {
+				var e domain.UnauthenticatedError
+				return errors.As(err, &e)
+			},
+		},
+		{
+			name:  "他のユーザー",
+			actor: func(_, other *domain.User) *domain.Principal { return domain.NewUserPrincipal(other.ID(), false) },
+			checkErr: func(err error) bool {
+				var e domain.ForbiddenError
+				return errors.As(err, &e)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			repo := infrastructure.NewMemoryUserRepository()
+			user := saveNewUser(t, repo, "taro")
+			other := saveNewUser(t, repo, "hanako")
+			useCase := NewUpdateUserUseCase(repo, domain.NewUserExistenceService(repo), domain.SystemClock{}, newTestAuditLog())
+			firstName, lastName := "次郎", "新規"
+
+			// Act
+			_, err := useCase.Execute(UpdateUserInput{
+				Actor:     tt.actor(user, other),
+				UserID:    user.ID().Value(),
+				FirstName: &firstName,
+				LastName:  &lastName,
+			})
+
+			// Assert
+			if !tt.checkErr(err) {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			saved, _ := repo.FindByID(user.ID())
+			if saved.Name().FirstName() != "taro" {
+				t.Errorf("Expected the name to stay unchanged, but got %s", saved.Name())
+			}
+		})
+	}
+}
+
+func TestUpdateUserUseCase_Execute_ServicePrincipal(t *testing.T) {
+	tests := []struct {
+		name        string
+		permissions []domain.Permission
+		wantErr     error
+	}{
+		{"users:write を持つサービス", []domain.Permission{domain.PermissionUsersWrite}, nil},
+		{"users:read のみのサービス", []domain.Permission{domain.PermissionUsersRead}, domain.ForbiddenError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			repo := infrastructure.NewMemoryUserRepository()
+			user := saveNewUser(t, repo, "taro")
+			useCase := NewUpdateUserUseCase(repo, domain.NewUserExistenceService(repo), domain.SystemClock{}, newTestAuditLog())
+			firstName, lastName := "次郎", "新規"
+
+			// Act
+			_, err := useCase.Execute(UpdateUserInput{
+				Actor:     servicePrincipal(t, tt.permissions...),
+				UserID:    user.ID().Value(),
+				FirstName: &firstName,
+				LastName:  &lastName,
+			})
+
+			// Assert
+			assertAuthorizationError(t, err, tt.wantErr)
+		})
+	}
+}
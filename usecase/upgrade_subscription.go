@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"time"
+)
+
+type UpgradeSubscriptionInput struct {
+	Actor     *domain.Principal // 本人か users:write が必要
+	RequestID string            // 監査ログに記録するリクエストID
+	UserID    string
+	Plan      string
+	Trial     bool // trueの場合は無料トライアルとして開始
+}
+
+type UpgradeSubscriptionUseCase struct {
+	userRepository   domain.UserRepository
+	ledgerRepository domain.LedgerRepository
+	priceList        *domain.PlanPriceList
+	clock            domain.Clock
+	auditLog         *AuditLog
+}
+
+func NewUpgradeSubscriptionUseCase(
+	userRepository domain.UserRepository,
+	ledgerRepository domain.LedgerRepository,
+	priceList *domain.PlanPriceList,
+	clock domain.Clock,
+	auditLog *AuditLog,
+) *UpgradeSubscriptionUseCase {
+	return &UpgradeSubscriptionUseCase{
+		userRepository:   userRepository,
+		ledgerRepository: ledgerRepository,
+		priceList:        priceList,
+		clock:            clock,
+		auditLog:         auditLog,
+	}
+}
+
+func (uc *UpgradeSubscriptionUseCase) Execute(input UpgradeSubscriptionInput) (*SubscriptionOutput, error) {
+	if _, err := authorizeUser(input.Actor, domain.ActionUpdateUser, input.UserID); err != nil {
+		return nil, err
+	}
+
+	plan, err := domain.ParseSubscriptionPlan(input.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	if !input.Trial {
+		// 料金未設定のプランは契約前に拒否する
+		if _, err := uc.priceList.PriceOf(plan); err != nil {
+			return nil, err
+		}
+	}
+
+	user, err := findUser(uc.userRepository, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := domain.UserAuditSnapshot(user)
+	now := uc.clock.Now()
+	if input.Trial {
+		err = user.StartTrial(plan, now)
+	} else {
+		// トライアル以外はプラン料金を台帳に請求してから契約を変更する
+		// 請求に失敗した場合はプレミアムにならず、そのまま再試行できる
+		if err := user.CanUpgradeSubscription(plan, now); err != nil {
+			return nil, err
+		}
+		if err := uc.chargePlan(user, plan, now); err != nil {
+			return nil, err
+		}
+		err = user.UpgradeSubscription(plan, now)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.userRepository.Save(user); err != nil {
+		return nil, err
+	}
+
+	changes := domain.DiffAuditSnapshots(before, domain.UserAuditSnapshot(user))
+	if err := uc.auditLog.Record(input.Actor, input.RequestID, domain.AuditSubscriptionUpgraded, domain.UserAuditTarget(user.ID()), changes); err != nil {
+		return nil, err
+	}
+
+	return NewSubscriptionOutput(user, uc.clock.Now()), nil
+}
+
+func (uc *UpgradeSubscriptionUseCase) chargePlan(user *domain.User, plan domain.SubscriptionPlan, now time.Time) error {
+	price, err := uc.priceList.PriceOf(plan)
+	if err != nil {
+		return err
+	}
+
+	ledger, err := uc.ledgerRepository.FindByUserID(user.ID())
+	if err != nil {
+		return err
+	}
+
+	entry, err := ledger.Charge(price, "Premium subscription: "+plan.String(), "subscription:"+plan.String(), now)
+	if err != nil {
+		return err
+	}
+
+	return uc.ledgerRepository.Append(entry)
+}
@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"testing"
+	"time"
+)
+
+func setupSubscriptionUser(t *testing.T, repo domain.UserRepository) *domain.User {
+	t.Helper()
+
+	name, _ := domain.NewFullName("太郎", "田中")
+	email, _ := domain.NewEmail("taro@example.com")
+	user := domain.ReconstructUserWithSubscription(domain.NewUserID(), name, email, domain.NewFreeSubscription())
+	if err := repo.Save(user); err != nil {
+		t.Fatalf("Failed to save test user: %v", err)
+	}
+	return user
+}
+
+func TestUpgradeSubscriptionUseCase_Execute_Success(t *testing.T) {
+	// Arrange
+	repo := infrastructure.NewMemoryUserRepository()
+	clock := domain.NewFixedClock(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC))
+	user := setupSubscriptionUser(t, repo)
+	useCase := NewUpgradeSubscriptionUseCase(repo, infrastructure.NewMemoryLedgerRepository(), domain.DefaultPlanPriceList(), clock, newTestAuditLog())
+
+	// Act
+	output, err := useCase.Execute(UpgradeSubscriptionInput{
+		Actor:  adminActor(),
+		UserID: user.ID().Value(),
+		Plan:   "premium_yearly",
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if output.Plan != "premium_yearly" || !output.IsPremium {
+		t.Errorf("Expected active premium_yearly, but got %s (premium=%v)", output.Plan, output.IsPremium)
+	}
+	if output.ExpiresAt == nil || !output.ExpiresAt.Equal(time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected expiry one year later, but got %v", output.ExpiresAt)
+	}
+
+	saved, _ := repo.FindByID(user.ID())
+	if !saved.IsPremiumAt(clock.Now()) {
+		t.Error("Expected saved user to be premium")
+	}
+}
+
+func TestUpgradeSubscriptionUseCase_Execute_ChargeFails_StaysOnFreePlan(t *testing.T) {
+	// Arrange
+	repo := infrastructure.NewMemoryUserRepository()
+	ledgerRepo := &flakyLedgerRepository{LedgerRepository: infrastructure.NewMemoryLedgerRepository(), failures: 1}
+	clock := domain.NewFixedClock(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC))
+	user := setupSubscriptionUser(t, repo)
+	useCase := NewUpgradeSubscriptionUseCase(repo, ledgerRepo, domain.DefaultPlanPriceList(), clock, newTestAuditLog())
+	input := UpgradeSubscriptionInput{Actor: adminActor(), UserID: user.ID().Value(), Plan: "premium_monthly"}
+
+	// Act
+	_, err := useCase.Execute(input)
+
+	// Assert
+	if err == nil {
+		t.Fatal("Expected the failed charge to be returned")
+	}
+	if saved, _ := repo.FindByID(user.ID()); saved.IsPremiumAt(clock.Now()) {
+		t.Error("Expected the user to stay on the free plan without a charge")
+	}
+
+	// Act
+	_, err = useCase.Execute(input)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected the retry to succeed, but got: %v", err)
+	}
+	if saved, _ := repo.FindByID(user.ID()); !saved.IsPremiumAt(clock.Now()) {
+		t.Error("Expected the user to be premium after the retry")
+	}
+	ledger, _ := ledgerRepo.FindByUserID(user.ID())
+	if entries := ledger.Entries(); len(entries) != 1 {
+		t.Errorf("Expected 1 charge, but got %d", len(entries))
+	}
+}
+
+func TestUpgradeSubscriptionUseCase_Execute_Trial(t *testing.T) {
+	// Arrange
+	repo := infrastructure.NewMemoryUserRepository()
+	clock := domain.NewFixedClock(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC))
+	user := setupSubscriptionUser(t, repo)
+	useCase := NewUpgradeSubscriptionUseCase(repo, infrastructure.NewMemoryLedgerRepository(), domain.DefaultPlanPriceList(), clock, newTestAuditLog())
+
+	// Act
+	output, err := useCase.Execute(UpgradeSubscriptionInput{
+		Actor:  adminActor(),
+		UserID: user.ID().Value(),
+		Plan:   "premium_monthly",
+		Trial:  true,
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if !output.IsTrial || !output.IsPremium {
+		t.Error("Expected active trial")
+	}
+
+	// トライアル期間が過ぎるとプレミアムでなくなる
+	clock.Advance(domain.SubscriptionTrialDays * 24 * time.Hour)
+	getOutput, err := NewGetSubscriptionUseCase(repo, domain.SystemClock{}).Execute(GetSubscriptionInput{Actor: adminActor(), UserID: user.ID().Value()})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if getOutput.IsPremium {
+		t.Error("Expected trial to have expired")
+	}
+}
+
+func TestUpgradeSubscriptionUseCase_Execute_InvalidInput_ReturnsError(t *testing.T) {
+	repo := infrastructure.NewMemoryUserRepository()
+	clock := domain.NewFixedClock(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC))
+	user := setupSubscriptionUser(t, repo)
+	useCase := NewUpgradeSubscriptionUseCase(repo, infrastructure.NewMemoryLedgerRepository(), domain.DefaultPlanPriceList(), clock, newTestAuditLog())
+
+	tests := []struct {
+		name  string
+		input UpgradeSubscriptionInput
+	}{
+		{"未知のプラン", UpgradeSubscriptionInput{Actor: adminActor(), UserID: user.ID().Value(), Plan: "gold"}},
+		{"無料プラン", UpgradeSubscriptionInput{Actor: adminActor(), UserID: user.ID().Value(), Plan: "free"}},
+		{"存在しないユーザー", UpgradeSubscriptionInput{Actor: adminActor(), UserID: domain.NewUserID().Value(), Plan: "premium_monthly"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := useCase.Execute(tt.input)
+			if err == nil {
+				t.Error("Expected error, but got nil")
+			}
+			if output != nil {
+				t.Error("Expected no output")
+			}
+		})
+	}
+}
+
+func TestDowngradeAndCancelSubscriptionUseCase_Execute(t *testing.T) {
+	// Arrange
+	repo := infrastructure.NewMemoryUserRepository()
+	clock := domain.NewFixedClock(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC))
+	user := setupSubscriptionUser(t, repo)
+	upgradeUseCase := NewUpgradeSubscriptionUseCase(repo, infrastructure.NewMemoryLedgerRepository(), domain.DefaultPlanPriceList(), clock, newTestAuditLog())
+	if _, err := upgradeUseCase.Execute(UpgradeSubscriptionInput{
+		Actor:  adminActor(),
+		UserID: user.ID().Value(),
+		Plan:   "premium_yearly",
+	}); err != nil {
+		t.Fatalf("Failed to upgrade: %v", err)
+	}
+
+	// Act: 年額から月額へダウングレード
+	downgraded, err := NewDowngradeSubscriptionUseCase(repo, clock, newTestAuditLog()).Execute(DowngradeSubscriptionInput{
+		Actor:  adminActor(),
+		UserID: user.ID().Value(),
+		Plan:   "premium_monthly",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if downgraded.Plan != "premium_monthly" || !downgraded.IsPremium {
+		t.Errorf("Expected active premium_monthly, but got %s", downgraded.Plan)
+	}
+
+	// Act: 解約
+	cancelled, err := NewCancelSubscriptionUseCase(repo, clock, newTestAuditLog()).Execute(CancelSubscriptionInput{
+		Actor:  adminActor(),
+		UserID: user.ID().Value(),
+	})
+
+	// Assert: 解約後も期限までは有効
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if !cancelled.IsCancelled || !cancelled.IsPremium {
+		t.Error("Expected cancelled subscription to stay active until expiry")
+	}
+
+	// 二重解約はエラー
+	_, err = NewCancelSubscriptionUseCase(repo, clock, newTestAuditLog()).Execute(CancelSubscriptionInput{Actor: adminActor(), UserID: user.ID().Value()})
+	if _, ok := err.(domain.SubscriptionChangeError); !ok {
+		t.Errorf("Expected SubscriptionChangeError, but got %T", err)
+	}
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+)
+
+type VerifyEmailInput struct {
+	RequestID string // 監査ログに記録するリクエストID
+	UserID    string
+	Token     string
+}
+
+type VerifyEmailOutput struct {
+	UserID        string
+	Email         string
+	EmailVerified bool
+	PendingEmail  string
+}
+
+func NewVerifyEmailOutput(user *domain.User) *VerifyEmailOutput {
+	return &VerifyEmailOutput{
+		UserID:        user.ID().Value(),
+		Email:         user.Email().Value(),
+		EmailVerified: user.IsEmailVerified(),
+		PendingEmail:  pendingEmailValue(user),
+	}
+}
+
+// VerifyEmailUseCase は確認用トークンでメールアドレスを確認済みにする
+// 確認待ちの新しいアドレスであれば、このとき現在のアドレスと置き換わる
+type VerifyEmailUseCase struct {
+	userRepository domain.UserRepository
+	tokenCodec     domain.EmailVerificationTokenCodec
+	clock          domain.Clock
+	auditLog       *AuditLog
+}
+
+func NewVerifyEmailUseCase(
+	userRepository domain.UserRepository,
+	tokenCodec domain.EmailVerificationTokenCodec,
+	clock domain.Clock,
+	auditLog *AuditLog,
+) *VerifyEmailUseCase {
+	return &VerifyEmailUseCase{
+		userRepository: userRepository,
+		tokenCodec:     tokenCodec,
+		clock:          clock,
+		auditLog:       auditLog,
+	}
+}
+
+func (uc *VerifyEmailUseCase) Execute(input VerifyEmailInput) (*VerifyEmailOutput, error) {
+	if input.Token == "" {
+		return nil, domain.EmptyFieldError{Field: "token"}
+	}
+	user, err := findUser(uc.userRepository, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := uc.tokenCodec.Decode(input.Token)
+	if err != nil {
+		return nil, err
+	}
+	before := domain.UserAuditSnapshot(user)
+	if err := user.VerifyEmail(token, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.userRepository.Save(user); err != nil {
+		return nil, err
+	}
+
+	// 確認は本人がメールのリンクから行うため、主体は匿名として記録する
+	changes := domain.DiffAuditSnapshots(before, domain.UserAuditSnapshot(user))
+	if err := uc.auditLog.Record(nil, input.RequestID, domain.AuditUserEmailVerified, domain.UserAuditTarget(user.ID()), changes); err != nil {
+		return nil, err
+	}
+	return NewVerifyEmailOutput(user), nil
+}
@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"ddd-bottomup/domain"
+	"ddd-bottomup/infrastructure"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testVerificationRequestedAt = time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)
+
+// saveVerifiedUser は確認済みのメールアドレスを持つユーザーを保存する
+func saveVerifiedUser(t *testing.T, userRepo domain.UserRepository, firstName string) *domain.User {
+	t.Helper()
+
+	name, _ := domain.NewFullName(firstName, "確認")
+	email, _ := domain.NewEmail(firstName + "@example.com")
+	user := domain.ReconstructUserWithSubscription(domain.NewUserID(), name, email, nil)
+	if err := userRepo.Save(user); err != nil {
+		t.Fatalf("Failed to save user: %v", err)
+	}
+	return user
+}
+
+// verificationTokenFromMail は確認メールの本文から確認コードを取り出す
+func verificationTokenFromMail(t *testing.T, message *domain.MailMessage) string {
+	t.Helper()
+
+	lines := strings.Split(message.Body(), "\n")
+	for i, line := range lines {
+		if strings.HasSuffix(line, "/email/verify") && i+1 < len(lines) {
+			return lines[i+1]
+		}
+	}
+	t.Fatalf("No verification code in mail: %q", message.Body())
+	return ""
+}
+
+func TestVerifyEmailUseCase_Execute_ConfirmsEmailChange(t *testing.T) {
+	// Arrange
+	userRepo := infrastructure.NewMemoryUserRepository()
+	user := saveVerifiedUser(t, userRepo, "taro")
+	codec := infrastructure.NewHMACEmailVerificationTokenCodec("test-secret")
+	mailer := &recordingMailer{}
+	sendVerification := NewSendEmailVerificationUseCase(userRepo, codec, newTestDispatcher(mailer, domain.LocaleEnglish), time.Hour)
+	clock := domain.NewFixedClock(testVerificationRequestedAt.Add(30 * time.Minute))
+	useCase := NewVerifyEmailUseCase(userRepo, codec, clock, newTestAuditLog())
+
+	newEmail := "taro.new@example.com"
+	updated, err := NewUpdateUserUseCase(userRepo, domain.NewUserExistenceService(userRepo), domain.SystemClock{}, newTestAuditLog()).Execute(UpdateUserInput{
+		Actor:  domain.NewUserPrincipal(user.ID(), false),
+		UserID: user.ID().Value(),
+		Email:  &newEmail,
+	})
+	if err != nil {
+		t.Fatalf("Failed to request email change: %v", err)
+	}
+	if updated.Email != "taro@example.com" || updated.PendingEmail != newEmail {
+		t.Fatalf("Expected the old email to stay active until verified, but got %+v", updated)
+	}
+	if err := sendVerification.Execute(SendEmailVerificationInput{
+		UserID:      user.ID().Value(),
+		Email:       newEmail,
+		RequestedAt: testVerificationRequestedAt,
+	}); err != nil {
+		t.Fatalf("Failed to send verification: %v", err)
+	}
+	if len(mailer.sent) != 1 || mailer.sent[0].To().Value() != newEmail {
+		t.Fatalf("Expected a verification mail to %s, but got %d mails", newEmail, len(mailer.sent))
+	}
+
+	// Act
+	output, err := useCase.Execute(VerifyEmailInput{
+		UserID: user.ID().Value(),
+		Token:  verificationTokenFromMail(t, mailer.sent[0]),
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if output.Email != newEmail || !output.EmailVerified || output.PendingEmail != "" {
+		t.Errorf("Expected %s to be verified and active, but got %+v", newEmail, output)
+	}
+	saved, _ := userRepo.FindByID(user.ID())
+	if saved.Email().Value() != newEmail {
+		t.Errorf("Expected saved email %s, but got %s", newEmail, saved.Email())
+	}
+}
+
+func TestVerifyEmailUseCase_Execute_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		verifyAt time.Time
+		token    func(valid string) string
+		checkErr func(error) bool
+	}{
+		{
+			name:     "有効期限切れ",
+			verifyAt: testVerificationRequestedAt.Add(time.Hour),
+			token:    func(valid string) string { return valid },
+			checkErr: func(err error) bool {
+				var e domain.EmailVerificationTokenExpiredError
+				return errors.As(err, &e)
+			},
+		},
+		{
+			name:     "改ざんされたトークン",
+			verifyAt: testVerificationRequestedAt,
+			token:    func(valid string) string { return valid + "x" },
+			checkErr: func(err error) bool {
+				var e domain.InvalidEmailVerificationTokenError
+				return errors.As(err, &e)
+			},
+		},
+		{
+			name:     "トークンなし",
+			verifyAt: testVerificationRequestedAt,
+			token:    func(string) string { return "" },
+			checkErr: func(err error) bool {
+				var e domain.EmptyFieldError
+				return errors.As(err, &e)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			userRepo := infrastructure.NewMemoryUserRepository()
+			user := saveNewUser(t, userRepo, "newcomer")
+			codec := infrastructure.NewHMACEmailVerificationTokenCodec("test-secret")
+			mailer := &recordingMailer{}
+			sendVerification := NewSendEmailVerificationUseCase(userRepo, codec, newTestDispatcher(mailer, domain.LocaleJapanese), time.Hour)
+			if err := sendVerification.Execute(SendEmailVerificationInput{
+				UserID:      user.ID().Value(),
+				Email:       user.Email().Value(),
+				RequestedAt: testVerificationRequestedAt,
+			}); err != nil {
+				t.Fatalf("Failed to send verification: %v", err)
+			}
+			useCase := NewVerifyEmailUseCase(userRepo, codec, domain.NewFixedClock(tt.verifyAt), newTestAuditLog())
+
+			// Act
+			_, err := useCase.Execute(VerifyEmailInput{
+				UserID: user.ID().Value(),
+				Token:  tt.token(verificationTokenFromMail(t, mailer.sent[0])),
+			})
+
+			// Assert
+			if !tt.checkErr(err) {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			saved, _ := userRepo.FindByID(user.ID())
+			if saved.IsEmailVerified() {
+				t.Error("Expected the email to stay unverified")
+			}
+		})
+	}
+}
+
+func TestSendEmailVerificationUseCase_Execute_SkipsVerifiedEmail(t *testing.T) {
+	// Arrange
+	userRepo := infrastructure.NewMemoryUserRepository()
+	user := saveVerifiedUser(t, userRepo, "taro")
+	mailer := &recordingMailer{}
+	useCase := NewSendEmailVerificationUseCase(
+		userRepo, infrastructure.NewHMACEmailVerificationTokenCodec("test-secret"),
+		newTestDispatcher(mailer, domain.LocaleJapanese), time.Hour,
+	)
+
+	// Act
+	err := useCase.Execute(SendEmailVerificationInput{
+		UserID:      user.ID().Value(),
+		Email:       user.Email().Value(),
+		RequestedAt: testVerificationRequestedAt,
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if len(mailer.sent) != 0 {
+		t.Errorf("Expected no mail for a verified email, but got %d", len(mailer.sent))
+	}
+}